@@ -0,0 +1,193 @@
+package account
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/google/uuid"
+	"github.com/mailru/easyjson"
+)
+
+// каталог оригиналов внутри архива
+const exportMediaDir = "media"
+
+func (s *AccountService) RequestExport(ctx context.Context, userID int) (domain.DataExport, error) {
+	return s.repo.CreateExport(ctx, userID)
+}
+
+func (s *AccountService) GetExport(ctx context.Context, userID, exportID int) (domain.DataExport, error) {
+	return s.repo.GetExport(ctx, exportID, userID)
+}
+
+// GetExportFile возвращает путь к собранному архиву пользователя
+func (s *AccountService) GetExportFile(ctx context.Context, userID, exportID int) (string, error) {
+	export, err := s.repo.GetExport(ctx, exportID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if export.Status != domain.ExportReady || export.FileName == "" {
+		return "", domain.ErrExportNotReady
+	}
+
+	return filepath.Join(s.dirs.ExportDir, filepath.Base(export.FileName)), nil
+}
+
+// ProcessExports собирает архивы из очереди, не больше BatchSize за вызов
+func (s *AccountService) ProcessExports(ctx context.Context) error {
+	for i := 0; i < s.cfg.BatchSize; i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		export, err := s.repo.ClaimExport(ctx)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := s.processExport(ctx, export); err != nil {
+			log.Printf("failed to build export %d: %v", export.ID, err)
+			if err := s.repo.FailExport(ctx, export.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *AccountService) processExport(ctx context.Context, export domain.DataExport) error {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.JobTimeout)
+	defer cancel()
+
+	data, err := s.repo.GetExportData(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dirs.ExportDir, 0o750); err != nil {
+		return err
+	}
+
+	fileName := uuid.New().String() + ".zip"
+	filePath := filepath.Join(s.dirs.ExportDir, fileName)
+	if err := s.writeArchive(filePath, data); err != nil {
+		os.Remove(filePath)
+		return err
+	}
+
+	if err := s.repo.FinishExport(ctx, export.ID, fileName, time.Now().Add(s.cfg.ExportTTL)); err != nil {
+		os.Remove(filePath)
+		return err
+	}
+
+	return nil
+}
+
+func (s *AccountService) writeArchive(filePath string, data domain.AccountExport) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	archive := zip.NewWriter(file)
+
+	// в архиве медиа лежат в отдельном каталоге, пути в flows.json ведут туда
+	var media []string
+	seen := make(map[string]bool)
+	for i := range data.Flows {
+		for j, name := range data.Flows[i].Media {
+			name = filepath.Base(name)
+			if !seen[name] {
+				seen[name] = true
+				media = append(media, name)
+			}
+			data.Flows[i].Media[j] = path.Join(exportMediaDir, name)
+		}
+	}
+
+	documents := []struct {
+		name  string
+		value easyjson.Marshaler
+	}{
+		{"profile.json", data.Profile},
+		{"flows.json", data.Flows},
+		{"boards.json", data.Boards},
+		{"comments.json", data.Comments},
+		{"messages.json", data.Messages},
+	}
+
+	for _, doc := range documents {
+		body, err := easyjson.Marshal(doc.value)
+		if err != nil {
+			return err
+		}
+
+		w, err := archive.Create(doc.name)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(body); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range media {
+		if err := addFile(archive, path.Join(exportMediaDir, name), filepath.Join(s.dirs.ImageDir, name)); err != nil {
+			return err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// addFile копирует файл в архив. Пропавший с диска оригинал не мешает выгрузке остального
+func addFile(archive *zip.Writer, name, source string) error {
+	src, err := os.Open(source)
+	if os.IsNotExist(err) {
+		log.Printf("export: media file %s is missing", source)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	w, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, src)
+	return err
+}
+
+// ExpireExports удаляет устаревшие архивы с диска
+func (s *AccountService) ExpireExports(ctx context.Context, now time.Time) error {
+	files, err := s.repo.ExpireExports(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range files {
+		removeFile(s.dirs.ExportDir, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/security"
+)
+
+type AccountRepository interface {
+	GetDeletionCredentials(ctx context.Context, userID int) (string, string, bool, error)       // имя, хеш пароля и признак входа через VK
+	ScheduleDeletion(ctx context.Context, userID int, deleteAfter time.Time) (time.Time, error) // назначить удаление аккаунта
+	CancelDeletion(ctx context.Context, userID int) error                                       // отменить удаление
+	GetDeletionStatus(ctx context.Context, userID int) (domain.AccountDeletionStatus, error)    // получить дату удаления
+	GetDueDeletions(ctx context.Context, now time.Time, limit int) ([]int, error)               // аккаунты, которые пора удалить
+	DeleteAccount(ctx context.Context, userID int) (domain.DeletedAccount, error)               // передать общие доски и удалить аккаунт со всеми данными
+	CreateExport(ctx context.Context, userID int) (domain.DataExport, error)                    // поставить архив в очередь
+	GetExport(ctx context.Context, exportID, userID int) (domain.DataExport, error)             // получить архив пользователя
+	ClaimExport(ctx context.Context) (domain.DataExport, error)                                 // взять архив из очереди
+	FinishExport(ctx context.Context, exportID int, fileName string, expiresAt time.Time) error // отметить архив собранным
+	FailExport(ctx context.Context, exportID int) error                                         // отметить ошибку сборки
+	ExpireExports(ctx context.Context, now time.Time) ([]string, error)                         // пометить устаревшие архивы
+	GetExportData(ctx context.Context, userID int) (domain.AccountExport, error)                // собрать данные для архива
+}
+
+type Config struct {
+	GracePeriod  time.Duration // через сколько после запроса удаляется аккаунт
+	PollInterval time.Duration // как часто искать аккаунты к удалению и архивы к сборке
+	BatchSize    int           // сколько аккаунтов удалять за проход
+	ExportTTL    time.Duration // сколько хранится собранный архив
+	JobTimeout   time.Duration // время на удаление одного аккаунта или сборку архива
+}
+
+func DefaultConfig() Config {
+	return Config{
+		GracePeriod:  14 * 24 * time.Hour,
+		PollInterval: time.Minute,
+		BatchSize:    20,
+		ExportTTL:    7 * 24 * time.Hour,
+		JobTimeout:   5 * time.Minute,
+	}
+}
+
+// Dirs — каталоги, в которых лежат файлы пользователя
+type Dirs struct {
+	ImageDir  string // картинки и видео флоу
+	AvatarDir string // аватары
+	ExportDir string // архивы с данными
+}
+
+type AccountService struct {
+	repo AccountRepository
+	cfg  Config
+	dirs Dirs
+}
+
+func NewAccountService(repo AccountRepository, cfg Config, dirs Dirs) *AccountService {
+	return &AccountService{
+		repo: repo,
+		cfg:  cfg,
+		dirs: dirs,
+	}
+}
+
+// RequestDeletion назначает удаление аккаунта через GracePeriod. Пользователь
+// подтверждает его своим именем и паролем; у аккаунтов VK пароля нет
+func (s *AccountService) RequestDeletion(ctx context.Context, userID int, req domain.AccountDeletionRequest) (domain.AccountDeletionStatus, error) {
+	username, hash, isExternal, err := s.repo.GetDeletionCredentials(ctx, userID)
+	if err != nil {
+		return domain.AccountDeletionStatus{}, err
+	}
+
+	if req.Username != username {
+		return domain.AccountDeletionStatus{}, domain.ErrDeletionNotConfirmed
+	}
+
+	if !isExternal && !security.ComparePassword(req.Password, hash) {
+		return domain.AccountDeletionStatus{}, domain.ErrInvalidCredentials
+	}
+
+	deleteAfter, err := s.repo.ScheduleDeletion(ctx, userID, time.Now().Add(s.cfg.GracePeriod))
+	if err != nil {
+		return domain.AccountDeletionStatus{}, err
+	}
+
+	return domain.AccountDeletionStatus{
+		Scheduled:   true,
+		DeleteAfter: &deleteAfter,
+	}, nil
+}
+
+func (s *AccountService) CancelDeletion(ctx context.Context, userID int) error {
+	return s.repo.CancelDeletion(ctx, userID)
+}
+
+func (s *AccountService) GetDeletionStatus(ctx context.Context, userID int) (domain.AccountDeletionStatus, error) {
+	return s.repo.GetDeletionStatus(ctx, userID)
+}
+
+// Run удаляет аккаунты, собирает архивы и чистит устаревшие до отмены контекста
+func (s *AccountService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.DeleteDue(ctx, time.Now()); err != nil {
+			log.Printf("account deletion error: %v", err)
+		}
+
+		if err := s.ProcessExports(ctx); err != nil {
+			log.Printf("data export error: %v", err)
+		}
+
+		if err := s.ExpireExports(ctx, time.Now()); err != nil {
+			log.Printf("data export cleanup error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteDue удаляет аккаунты, срок удаления которых наступил, и возвращает их число
+func (s *AccountService) DeleteDue(ctx context.Context, now time.Time) (int, error) {
+	ids, err := s.repo.GetDueDeletions(ctx, now, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return deleted, ctx.Err()
+		}
+
+		err := s.deleteAccount(ctx, id)
+		if errors.Is(err, domain.ErrDeletionNotScheduled) {
+			log.Printf("deletion of account %d was cancelled", id)
+			continue
+		}
+		if err != nil {
+			log.Printf("failed to delete account %d: %v", id, err)
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (s *AccountService) deleteAccount(ctx context.Context, userID int) error {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.JobTimeout)
+	defer cancel()
+
+	// соавторы получают общие доски в той же транзакции, что и удаление
+	files, err := s.repo.DeleteAccount(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// файлы удаляются после коммита: лишний файл на диске лучше ссылки в никуда
+	for _, name := range files.Media {
+		removeFile(s.dirs.ImageDir, name)
+	}
+	if files.Avatar != "" {
+		removeFile(s.dirs.AvatarDir, files.Avatar)
+	}
+	for _, name := range files.Exports {
+		removeFile(s.dirs.ExportDir, name)
+	}
+
+	return nil
+}
+
+// removeFile удаляет файл из каталога; имя из базы не может выйти за его пределы
+func removeFile(dir, name string) {
+	name = filepath.Base(name)
+	if name == "." || name == string(filepath.Separator) {
+		return
+	}
+
+	if err := os.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+		log.Printf("failed to remove %s: %v", name, err)
+	}
+}
@@ -0,0 +1,141 @@
+package account
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/security"
+	mock_account "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/account/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestDeletion(t *testing.T) {
+	hash, err := security.HashPassword("secret")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		req        domain.AccountDeletionRequest
+		isExternal bool
+		expected   error
+	}{
+		{"Сценарий: подтверждено", domain.AccountDeletionRequest{Username: "alice", Password: "secret"}, false, nil},
+		{"Сценарий: чужое имя", domain.AccountDeletionRequest{Username: "bob", Password: "secret"}, false, domain.ErrDeletionNotConfirmed},
+		{"Сценарий: неверный пароль", domain.AccountDeletionRequest{Username: "alice", Password: "wrong"}, false, domain.ErrInvalidCredentials},
+		{"Сценарий: аккаунт VK без пароля", domain.AccountDeletionRequest{Username: "alice"}, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock_account.NewMockAccountRepository(ctrl)
+			service := NewAccountService(mockRepo, DefaultConfig(), Dirs{})
+
+			mockRepo.EXPECT().GetDeletionCredentials(gomock.Any(), 7).Return("alice", hash, tt.isExternal, nil)
+			if tt.expected == nil {
+				deleteAfter := time.Now().Add(DefaultConfig().GracePeriod)
+				mockRepo.EXPECT().ScheduleDeletion(gomock.Any(), 7, gomock.Any()).Return(deleteAfter, nil)
+			}
+
+			status, err := service.RequestDeletion(context.Background(), 7, tt.req)
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Equal(t, tt.expected == nil, status.Scheduled)
+		})
+	}
+}
+
+func TestDeleteDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dirs := Dirs{
+		ImageDir:  t.TempDir(),
+		AvatarDir: t.TempDir(),
+		ExportDir: t.TempDir(),
+	}
+	for _, path := range []string{
+		filepath.Join(dirs.ImageDir, "a.jpg"),
+		filepath.Join(dirs.AvatarDir, "me.png"),
+		filepath.Join(dirs.ExportDir, "old.zip"),
+	} {
+		require.NoError(t, os.WriteFile(path, []byte("x"), 0o600))
+	}
+
+	mockRepo := mock_account.NewMockAccountRepository(ctrl)
+	service := NewAccountService(mockRepo, DefaultConfig(), dirs)
+
+	now := time.Now()
+	mockRepo.EXPECT().GetDueDeletions(gomock.Any(), now, DefaultConfig().BatchSize).Return([]int{3, 4}, nil)
+	mockRepo.EXPECT().DeleteAccount(gomock.Any(), 3).Return(domain.DeletedAccount{
+		Media:   []string{"a.jpg", "missing.jpg", "../escape.jpg"},
+		Avatar:  "me.png",
+		Exports: []string{"old.zip"},
+	}, nil)
+	// удаление успели отменить: аккаунт не считается удалённым
+	mockRepo.EXPECT().DeleteAccount(gomock.Any(), 4).Return(domain.DeletedAccount{}, domain.ErrDeletionNotScheduled)
+
+	deleted, err := service.DeleteDue(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	for _, path := range []string{
+		filepath.Join(dirs.ImageDir, "a.jpg"),
+		filepath.Join(dirs.AvatarDir, "me.png"),
+		filepath.Join(dirs.ExportDir, "old.zip"),
+	} {
+		_, err := os.Stat(path)
+		assert.True(t, os.IsNotExist(err), path)
+	}
+}
+
+func TestProcessExports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dirs := Dirs{
+		ImageDir:  t.TempDir(),
+		ExportDir: filepath.Join(t.TempDir(), "exports"),
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dirs.ImageDir, "a.jpg"), []byte("image"), 0o600))
+
+	mockRepo := mock_account.NewMockAccountRepository(ctrl)
+	service := NewAccountService(mockRepo, DefaultConfig(), dirs)
+
+	var fileName string
+	gomock.InOrder(
+		mockRepo.EXPECT().ClaimExport(gomock.Any()).Return(domain.DataExport{ID: 1, UserID: 7}, nil),
+		mockRepo.EXPECT().GetExportData(gomock.Any(), 7).Return(domain.AccountExport{
+			Profile: domain.ExportProfile{Username: "alice"},
+			Flows:   domain.ExportFlows{{ID: 5, Media: []string{"a.jpg", "gone.jpg"}}},
+		}, nil),
+		mockRepo.EXPECT().FinishExport(gomock.Any(), 1, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int, name string, _ time.Time) error {
+				fileName = name
+				return nil
+			}),
+		mockRepo.EXPECT().ClaimExport(gomock.Any()).Return(domain.DataExport{}, domain.ErrNotFound),
+	)
+
+	require.NoError(t, service.ProcessExports(context.Background()))
+
+	archive, err := zip.OpenReader(filepath.Join(dirs.ExportDir, fileName))
+	require.NoError(t, err)
+	defer archive.Close()
+
+	var names []string
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"profile.json", "flows.json", "boards.json", "comments.json", "messages.json", "media/a.jpg",
+	}, names)
+}
@@ -0,0 +1,112 @@
+package analytics
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+type EventRepository interface {
+	InsertEvents(ctx context.Context, events []domain.AnalyticsEvent) error
+}
+
+type RecorderConfig struct {
+	BufferSize    int           // сколько событий ждёт записи, остальные отбрасываются
+	BatchSize     int           // сколько событий записывать одним запросом
+	FlushInterval time.Duration // как часто записывать неполную пачку
+	FlushTimeout  time.Duration // время на запись одной пачки
+}
+
+func DefaultRecorderConfig() RecorderConfig {
+	return RecorderConfig{
+		BufferSize:    10000,
+		BatchSize:     500,
+		FlushInterval: 5 * time.Second,
+		FlushTimeout:  5 * time.Second,
+	}
+}
+
+// Recorder копит события в памяти и пишет их в журнал пачками,
+// чтобы запись статистики не замедляла обработку запросов
+type Recorder struct {
+	repo    EventRepository
+	cfg     RecorderConfig
+	events  chan domain.AnalyticsEvent
+	dropped atomic.Int64
+}
+
+func NewRecorder(repo EventRepository, cfg RecorderConfig) *Recorder {
+	return &Recorder{
+		repo:   repo,
+		cfg:    cfg,
+		events: make(chan domain.AnalyticsEvent, cfg.BufferSize),
+	}
+}
+
+// Record не блокируется: при переполненном буфере событие теряется
+func (r *Recorder) Record(event domain.AnalyticsEvent) {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	select {
+	case r.events <- event:
+	default:
+		r.dropped.Add(1)
+	}
+}
+
+// Run пишет события до отмены контекста, после отмены дописывает накопленное
+func (r *Recorder) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]domain.AnalyticsEvent, 0, r.cfg.BatchSize)
+	for {
+		select {
+		case event := <-r.events:
+			batch = append(batch, event)
+			if len(batch) >= r.cfg.BatchSize {
+				r.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			r.flush(batch)
+			batch = batch[:0]
+		case <-ctx.Done():
+			for {
+				select {
+				case event := <-r.events:
+					batch = append(batch, event)
+					if len(batch) >= r.cfg.BatchSize {
+						r.flush(batch)
+						batch = batch[:0]
+					}
+				default:
+					r.flush(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+func (r *Recorder) flush(batch []domain.AnalyticsEvent) {
+	if dropped := r.dropped.Swap(0); dropped > 0 {
+		log.Printf("analytics buffer is full, dropped %d events", dropped)
+	}
+
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.cfg.FlushTimeout)
+	defer cancel()
+
+	if err := r.repo.InsertEvents(ctx, batch); err != nil {
+		log.Printf("failed to write %d analytics events: %v", len(batch), err)
+	}
+}
@@ -0,0 +1,64 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	mock_recorder "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/analytics/recorder"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecord_DropsWhenFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_recorder.NewMockEventRepository(ctrl)
+	recorder := NewRecorder(mockRepo, RecorderConfig{BufferSize: 1, BatchSize: 10, FlushInterval: time.Hour, FlushTimeout: time.Second})
+
+	recorder.Record(domain.AnalyticsEvent{Type: domain.EventImpression, FlowID: 1})
+	recorder.Record(domain.AnalyticsEvent{Type: domain.EventImpression, FlowID: 2})
+
+	assert.Equal(t, int64(1), recorder.dropped.Load())
+}
+
+func TestRun_WritesBatchesAndFlushesOnCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_recorder.NewMockEventRepository(ctrl)
+	recorder := NewRecorder(mockRepo, RecorderConfig{BufferSize: 10, BatchSize: 2, FlushInterval: time.Hour, FlushTimeout: time.Second})
+
+	for i := 1; i <= 3; i++ {
+		recorder.Record(domain.AnalyticsEvent{Type: domain.EventCloseup, FlowID: uint64(i)})
+	}
+
+	// полная пачка пишется сразу, остаток — после отмены контекста
+	var written []int
+	mockRepo.EXPECT().InsertEvents(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []domain.AnalyticsEvent) error {
+		for _, event := range events {
+			assert.False(t, event.CreatedAt.IsZero())
+		}
+		written = append(written, len(events))
+		return nil
+	}).Times(2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		recorder.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("recorder did not stop")
+	}
+
+	assert.Equal(t, 3, written[0]+written[1])
+}
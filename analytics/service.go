@@ -0,0 +1,131 @@
+package analytics
+
+import (
+	"context"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+type AnalyticsRepository interface {
+	AggregateDaily(ctx context.Context, since time.Time) error                                                          // пересчитать дневную статистику начиная с since
+	PruneEvents(ctx context.Context, before time.Time) error                                                            // удалить старые события журнала
+	IsFlowAuthor(ctx context.Context, flowID uint64, userID int) (bool, error)                                          // проверить автора флоу
+	GetDailyStats(ctx context.Context, userID int, flowID uint64, from, to time.Time) ([]domain.AnalyticsDay, error)    // получить статистику по дням
+	GetTopFlows(ctx context.Context, userID int, from, to time.Time, sortBy string, limit int) ([]domain.TopFlow, error) // получить лучшие флоу за период
+}
+
+type AggregationConfig struct {
+	Interval  time.Duration // как часто пересчитывать статистику
+	Retention time.Duration // сколько хранить события журнала
+}
+
+func DefaultAggregationConfig() AggregationConfig {
+	return AggregationConfig{
+		Interval:  10 * time.Minute,
+		Retention: 90 * 24 * time.Hour,
+	}
+}
+
+const dayLayout = "2006-01-02"
+
+type AnalyticsService struct {
+	repo     AnalyticsRepository
+	baseURL  string
+	imageDir string
+}
+
+func NewAnalyticsService(repo AnalyticsRepository, baseURL, imageDir string) *AnalyticsService {
+	return &AnalyticsService{
+		repo:     repo,
+		baseURL:  baseURL,
+		imageDir: imageDir,
+	}
+}
+
+// GetAnalytics собирает статистику автора за период. Статистика одного флоу
+// доступна только его автору, лучшие флоу считаются только для всего аккаунта
+func (s *AnalyticsService) GetAnalytics(ctx context.Context, userID int, query domain.AnalyticsQuery) (domain.CreatorAnalytics, error) {
+	if err := query.Validate(); err != nil {
+		return domain.CreatorAnalytics{}, err
+	}
+
+	if query.FlowID != 0 {
+		isAuthor, err := s.repo.IsFlowAuthor(ctx, query.FlowID, userID)
+		if err != nil {
+			return domain.CreatorAnalytics{}, err
+		}
+		if !isAuthor {
+			return domain.CreatorAnalytics{}, domain.ErrNotFound
+		}
+	}
+
+	daily, err := s.repo.GetDailyStats(ctx, userID, query.FlowID, query.From, query.To)
+	if err != nil {
+		return domain.CreatorAnalytics{}, err
+	}
+
+	result := domain.CreatorAnalytics{
+		From:   query.From.Format(dayLayout),
+		To:     query.To.Format(dayLayout),
+		FlowID: query.FlowID,
+		Daily:  daily,
+	}
+
+	for _, day := range daily {
+		result.Totals.Add(day.Stats)
+		result.FollowersGained += day.FollowersGained
+		result.FollowersLost += day.FollowersLost
+	}
+
+	if query.FlowID == 0 && query.Top > 0 {
+		top, err := s.repo.GetTopFlows(ctx, userID, query.From, query.To, query.SortBy, query.Top)
+		if err != nil {
+			return domain.CreatorAnalytics{}, err
+		}
+
+		for i := range top {
+			top[i].MediaURL = s.generateImageURL(top[i].MediaURL)
+		}
+		result.TopFlows = top
+	}
+
+	return result, nil
+}
+
+// RunAggregation пересчитывает дневную статистику до отмены контекста
+func (s *AnalyticsService) RunAggregation(ctx context.Context, cfg AggregationConfig) {
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Aggregate(ctx, time.Now(), cfg.Retention); err != nil {
+			log.Printf("analytics aggregation error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Aggregate пересчитывает статистику за вчера и сегодня: события могут
+// дойти до журнала уже после полуночи
+func (s *AnalyticsService) Aggregate(ctx context.Context, now time.Time, retention time.Duration) error {
+	since := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+
+	if err := s.repo.AggregateDaily(ctx, since); err != nil {
+		return err
+	}
+
+	return s.repo.PruneEvents(ctx, now.Add(-retention))
+}
+
+func (s *AnalyticsService) generateImageURL(filename string) string {
+	return s.baseURL + filepath.Join(strings.ReplaceAll(s.imageDir, ".", ""), filename)
+}
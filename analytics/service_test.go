@@ -0,0 +1,96 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	mock_analytics "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/analytics/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func day(s string) time.Time {
+	date, _ := time.Parse(dayLayout, s)
+	return date
+}
+
+func TestGetAnalytics_Totals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_analytics.NewMockAnalyticsRepository(ctrl)
+	service := NewAnalyticsService(mockRepo, "https://host", "./static/img")
+
+	query := domain.AnalyticsQuery{
+		From:   day("2025-05-01"),
+		To:     day("2025-05-02"),
+		SortBy: domain.AnalyticsSortSaves,
+		Top:    5,
+	}
+
+	mockRepo.EXPECT().GetDailyStats(gomock.Any(), 7, uint64(0), query.From, query.To).Return([]domain.AnalyticsDay{
+		{Day: "2025-05-01", Stats: domain.FlowStats{Impressions: 10, Saves: 1}, FollowersGained: 2},
+		{Day: "2025-05-02", Stats: domain.FlowStats{Impressions: 5, Likes: 3}, FollowersLost: 1},
+	}, nil)
+	mockRepo.EXPECT().GetTopFlows(gomock.Any(), 7, query.From, query.To, domain.AnalyticsSortSaves, 5).
+		Return([]domain.TopFlow{{FlowID: 3, MediaURL: "a.jpg"}}, nil)
+
+	result, err := service.GetAnalytics(context.Background(), 7, query)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.FlowStats{Impressions: 15, Saves: 1, Likes: 3}, result.Totals)
+	assert.Equal(t, 2, result.FollowersGained)
+	assert.Equal(t, 1, result.FollowersLost)
+	assert.Equal(t, "2025-05-01", result.From)
+	assert.Equal(t, "https://host/static/img/a.jpg", result.TopFlows[0].MediaURL)
+}
+
+func TestGetAnalytics_NotAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_analytics.NewMockAnalyticsRepository(ctrl)
+	service := NewAnalyticsService(mockRepo, "", "")
+
+	query := domain.AnalyticsQuery{
+		From:   day("2025-05-01"),
+		To:     day("2025-05-01"),
+		FlowID: 4,
+		SortBy: domain.AnalyticsSortImpressions,
+	}
+
+	mockRepo.EXPECT().IsFlowAuthor(gomock.Any(), uint64(4), 7).Return(false, nil)
+
+	_, err := service.GetAnalytics(context.Background(), 7, query)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestGetAnalytics_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := NewAnalyticsService(mock_analytics.NewMockAnalyticsRepository(ctrl), "", "")
+
+	_, err := service.GetAnalytics(context.Background(), 7, domain.AnalyticsQuery{
+		From:   day("2025-05-02"),
+		To:     day("2025-05-01"),
+		SortBy: domain.AnalyticsSortImpressions,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidDateRange)
+}
+
+func TestAggregate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_analytics.NewMockAnalyticsRepository(ctrl)
+	service := NewAnalyticsService(mockRepo, "", "")
+
+	now := time.Date(2025, 5, 2, 0, 30, 0, 0, time.UTC)
+
+	mockRepo.EXPECT().AggregateDaily(gomock.Any(), day("2025-05-01")).Return(nil)
+	mockRepo.EXPECT().PruneEvents(gomock.Any(), now.Add(-time.Hour)).Return(nil)
+
+	assert.NoError(t, service.Aggregate(context.Background(), now, time.Hour))
+}
@@ -63,7 +63,9 @@ func main() {
 		log.Fatalf("Error creating pg user storage: %v", err)
 	}
 
-	boardRepo := repository.NewBoardStorage(db)
+	// сервису авторизации доски нужны только для создания стандартных,
+	// пины он не выдает
+	boardRepo := repository.NewBoardStorage(db, false)
 
 	usecase := auth.NewUserService(authRepo, boardRepo)
 
@@ -9,8 +9,8 @@ import os
 import logging
 import re
 import time
-from typing import List, Tuple
-from dataclasses import dataclass
+from typing import Dict, List, Tuple
+from dataclasses import dataclass, field
 from pathlib import Path
 from tqdm import tqdm
 
@@ -42,6 +42,13 @@ NSFW_BLACKLIST = {
     'duck', "утка", "ducks", 'утки'
 }
 
+# служебные слова из подписи BLIP, которые не годятся в теги
+TAG_STOP_WORDS = {
+    'the', 'and', 'with', 'for', 'from', 'that', 'this', 'there', 'are',
+    'has', 'have', 'its', 'his', 'her', 'their', 'into', 'onto', 'over',
+    'under', 'near', 'some', 'other', 'very', 'while', 'top', 'front', 'arafed',
+}
+
 @dataclass
 class ClassificationResult:
     """Результат классификации изображения"""
@@ -54,6 +61,8 @@ class ClassificationResult:
     processing_time: float
     timing_info: dict
     nsfw_reason: str = None
+    # уверенность модели для каждого тега
+    tag_scores: Dict[str, float] = field(default_factory=dict)
 
 
 class ImageClassificationService:
@@ -102,22 +111,35 @@ class ImageClassificationService:
             logger.error(f"Ошибка открытия изображения {image_path}: {e}")
             raise
 
-    def _generate_tags(self, image: Image.Image) -> Tuple[List[str], float]:
-        """Генерация тегов из изображения с замером времени"""
+    def _generate_tags(self, image: Image.Image) -> Tuple[Dict[str, float], float]:
+        """Генерация тегов из изображения с уверенностью и замером времени"""
         start_time = time.time()
         try:
             inputs = self.blip_processor(image, return_tensors="pt").to(self.device)
             with torch.no_grad():
-                out = self.blip_model.generate(**inputs)
-            caption = self.blip_processor.decode(out[0], skip_special_tokens=True)
+                out = self.blip_model.generate(
+                    **inputs,
+                    output_scores=True,
+                    return_dict_in_generate=True,
+                )
+            caption = self.blip_processor.decode(out.sequences[0], skip_special_tokens=True)
+
+            # уверенность подписи — среднее геометрическое вероятностей токенов,
+            # слова подписи получают её целиком
+            transition = self.blip_model.compute_transition_scores(
+                out.sequences, out.scores, normalize_logits=True
+            )
+            confidence = float(torch.exp(transition[0].mean())) if transition.numel() else 0.0
 
             # Извлекаем слова из описания
             tags = re.findall(r"\b\w+\b", caption.lower())
             processing_time = time.time() - start_time
-            return list(set(t for t in tags if len(t) > 2)), processing_time
+            return {
+                t: confidence for t in tags if len(t) > 2 and t not in TAG_STOP_WORDS
+            }, processing_time
         except Exception as e:
             logger.error(f"Ошибка генерации тегов: {e}")
-            return [], time.time() - start_time
+            return {}, time.time() - start_time
 
     def _detect_nsfw(self, image: Image.Image) -> Tuple[bool, float, float]:
         """Детекция NSFW контента с замером времени"""
@@ -173,7 +195,8 @@ class ImageClassificationService:
             timing_info["preprocessing"] = time.time() - start_time
 
             # Генерация тегов
-            tags, tags_time = self._generate_tags(image)
+            tag_scores, tags_time = self._generate_tags(image)
+            tags = list(tag_scores)
             timing_info["tag_generation"] = tags_time
 
             # Проверка черного списка
@@ -211,18 +234,11 @@ class ImageClassificationService:
                 profanity_score=profanity_score,
                 processing_time=total_time,
                 timing_info=timing_info,
-                nsfw_reason=nsfw_reason if blacklist_detected else "Модель классификации"
+                nsfw_reason=nsfw_reason if blacklist_detected else "Модель классификации",
+                tag_scores=tag_scores,
             )
         except Exception as e:
+            # ошибка пробрасывается наверх, чтобы задача классификации была повторена,
+            # а не помечена как безопасная
             logger.error(f"Критическая ошибка обработки {image_path}: {e}")
-            return ClassificationResult(
-                tags=[],
-                is_adult=False,
-                confidence_score=0.0,
-                detected_text="",
-                profanity_detected=False,
-                profanity_score=0.0,
-                processing_time=time.time() - total_start_time,
-                timing_info={},
-                nsfw_reason="Ошибка обработки"
-            )
+            raise
@@ -63,7 +63,7 @@ func main() {
 
 	server := grpc.NewServer(grpc.UnaryInterceptor(metricsService.ServerMetricsInterceptor))
 
-	feedRepo, err := repository.NewPGPinStorage(db, config.ImageBaseDir, config.BaseUrl)
+	feedRepo, err := repository.NewPGPinStorage(db, config.ImageBaseDir, config.BaseUrl, config.HideUnclassifiedFlows)
 	if err != nil {
 		log.Fatalf("Error creating pg user storage: %v", err)
 	}
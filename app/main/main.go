@@ -8,17 +8,23 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"os/exec"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"syscall"
 	"time"
 
+	"github.com/go-park-mail-ru/2025_1_SuperChips/account"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/analytics"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/board"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/classifier"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/comment"
 	boardshrService "github.com/go-park-mail-ru/2025_1_SuperChips/boardshr"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/configs"
 	_ "github.com/go-park-mail-ru/2025_1_SuperChips/docs"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/oauth"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/pg"
 	osStorage "github.com/go-park-mail-ru/2025_1_SuperChips/internal/repository/os/pincrud"
 	pgStorage "github.com/go-park-mail-ru/2025_1_SuperChips/internal/repository/pg"
@@ -32,12 +38,16 @@ import (
 	"github.com/go-park-mail-ru/2025_1_SuperChips/notification"
 	pincrudService "github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/profile"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/report"
 	genAuth "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/auth"
 	genChat "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/chat"
+	genClassifier "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/classifier"
 	genFeed "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/feed"
 	genWebsocket "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/websocket"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/search"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/subscription"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/tag"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/video"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -116,7 +126,7 @@ func main() {
 
 	defer db.Close()
 
-	pinStorage, err := pgStorage.NewPGPinStorage(db, config.ImageBaseDir, config.BaseUrl)
+	pinStorage, err := pgStorage.NewPGPinStorage(db, config.ImageBaseDir, config.BaseUrl, config.HideUnclassifiedFlows)
 	if err != nil {
 		log.Fatalf("Cannot launch due to pin storage db error: %s", err)
 	}
@@ -133,24 +143,47 @@ func main() {
 
 	subscriptionStorage := pgStorage.NewSubscriptionStorage(db)
 	likeStorage := pgStorage.NewPgLikeStorage(db)
-	boardStorage := pgStorage.NewBoardStorage(db)
+	boardStorage := pgStorage.NewBoardStorage(db, config.HideUnclassifiedFlows)
 	boardShrStorage := pgStorage.NewBoardShrStorage(db)
-	searchStorage := pgStorage.NewSearchRepository(db)
+	searchStorage := pgStorage.NewSearchRepository(db, config.HideUnclassifiedFlows)
 	chatStorage := pgStorage.NewChatRepository(db)
 	commentStorage := pgStorage.NewCommentRepository(db)
 	notificationStorage := pgStorage.NewNotificationRepository(db)
+	reportStorage := pgStorage.NewReportRepository(db)
+	classificationStorage := pgStorage.NewClassificationRepository(db)
+	tagStorage := pgStorage.NewTagRepository(db, config.HideUnclassifiedFlows)
+	analyticsStorage := pgStorage.NewAnalyticsRepository(db)
+	accountStorage := pgStorage.NewAccountRepository(db)
 
 	jwtManager := auth.NewJWTManager(config)
+	jwtManager.SetSessionValidator(accountStorage)
 
 	subscriptionService := subscription.NewSubscriptionUsecase(subscriptionStorage, chatStorage, config.BaseUrl, config.StaticBaseDir, config.AvatarDir)
-	pinCRUDService := pincrudService.NewPinCRUDService(pinStorage, boardStorage, imageStorage)
+	ffmpegPath, err := exec.LookPath(config.FFmpegPath)
+	if err != nil {
+		log.Printf("ffmpeg not found, video uploads are disabled: %v", err)
+		ffmpegPath = ""
+	} else if _, err := exec.LookPath(video.ProbePath(ffmpegPath)); err != nil {
+		log.Printf("ffprobe not found next to ffmpeg, video uploads are disabled: %v", err)
+		ffmpegPath = ""
+	}
+
+	pinCRUDService := pincrudService.NewPinCRUDService(pinStorage, boardStorage, imageStorage, ffmpegPath)
 	profileService := profile.NewProfileService(profileStorage, config.BaseUrl, config.StaticBaseDir, config.AvatarDir)
-	boardService := board.NewBoardService(boardStorage, pinStorage, boardShrStorage, config.BaseUrl, config.ImageBaseDir)
+	boardService := board.NewBoardService(boardStorage, pinStorage, boardShrStorage, config.BaseUrl, config.ImageBaseDir, config.StaticBaseDir, config.AvatarDir)
 	boardShrService := boardshrService.NewBoardShrService(boardShrStorage, config.BaseUrl, config.StaticBaseDir, config.AvatarDir)
 	likeService := like.NewLikeService(likeStorage, pinStorage)
 	searchService := search.NewSearchService(searchStorage, config.BaseUrl, config.ImageBaseDir, config.StaticBaseDir, config.AvatarDir)
 	commentService := comment.NewCommentService(commentStorage, pinStorage, config.BaseUrl, config.StaticBaseDir, config.AvatarDir)
 	notificationService := notification.NewNotificationService(notificationStorage, config.BaseUrl, config.StaticBaseDir, config.AvatarDir)
+	reportService := report.NewReportService(reportStorage)
+	tagService := tag.NewTagService(tagStorage, config.BaseUrl, config.ImageBaseDir)
+	analyticsService := analytics.NewAnalyticsService(analyticsStorage, config.BaseUrl, config.ImageBaseDir)
+	accountService := account.NewAccountService(accountStorage, account.DefaultConfig(), account.Dirs{
+		ImageDir:  config.ImageBaseDir,
+		AvatarDir: filepath.Join(".", config.StaticBaseDir, config.AvatarDir),
+		ExportDir: config.ExportDir,
+	})
 
 	metricsService := metrics.NewMetricsService()
 	metricsService.RegisterMetrics()
@@ -196,6 +229,49 @@ func main() {
 	chatClient := genChat.NewChatServiceClient(grpcConnChat)
 	websocketClient := genWebsocket.NewWebsocketClient(grpcConnWebsocket)
 
+	classificationCtx, stopClassification := context.WithCancel(context.Background())
+	defer stopClassification()
+
+	// без сервиса cv задачи остаются в очереди: помечать непроверенные flow
+	// безопасными нельзя
+	if config.ClassifierAddr != "" {
+		grpcConnClassifier, err := grpc.NewClient(
+			config.ClassifierAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer grpcConnClassifier.Close()
+
+		pinClassifier := classifier.NewGrpcClassifier(genClassifier.NewClassifierClient(grpcConnClassifier))
+		classificationService := classifier.NewClassificationService(pinClassifier, classificationStorage, classifier.DefaultConfig())
+
+		go classificationService.Run(classificationCtx)
+	} else {
+		log.Println("classifier address is empty, classification jobs will stay pending")
+	}
+
+	publishScheduler := pincrudService.NewPublishScheduler(pinStorage, pincrudService.DefaultSchedulerConfig())
+
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+
+	go publishScheduler.Run(schedulerCtx)
+
+	analyticsRecorder := analytics.NewRecorder(analyticsStorage, analytics.DefaultRecorderConfig())
+
+	analyticsCtx, stopAnalytics := context.WithCancel(context.Background())
+	defer stopAnalytics()
+
+	go analyticsRecorder.Run(analyticsCtx)
+	go analyticsService.RunAggregation(analyticsCtx, analytics.DefaultAggregationConfig())
+
+	accountCtx, stopAccount := context.WithCancel(context.Background())
+	defer stopAccount()
+
+	go accountService.Run(accountCtx)
+
 	notificationChan := make(chan domain.WebMessage)
 	defer close(notificationChan)
 
@@ -221,12 +297,15 @@ func main() {
 		UserService: authClient,
 		JWTManager:  *jwtManager,
 		ContextDuration: config.ContextExpiration,
+		OAuth:       oauth.NewRegistryFromConfig(context.Background(), config),
+		OAuthSigner: oauth.NewSigner(config.JWTSecret),
 	}
 
 	subscriptionHandler := rest.SubscriptionHandler{
 		ContextExpiration: config.ContextExpiration,
 		SubscriptionService: subscriptionService,
 		NotificationChan: notificationChan,
+		Events: analyticsRecorder,
 	}
 
 	chatHandler := rest.ChatHandler{
@@ -238,6 +317,9 @@ func main() {
 		Config:     config,
 		FeedClient: feedClient,
 		ContextExpiration: config.ContextExpiration,
+		FollowedBoards: boardService,
+		FollowedTopics: tagService,
+		Events: analyticsRecorder,
 	}
 
 	profileHandler := rest.ProfileHandler{
@@ -248,22 +330,27 @@ func main() {
 		BaseUrl:        config.BaseUrl,
 		ExpirationTime: config.ExpirationTime,
 		CookieSecure:   config.CookieSecure,
+		ContextExpiration: config.ContextExpiration,
 	}
 
 	pinCRUDHandler := pincrudDelivery.PinCRUDHandler{
 		Config:     config,
 		PinService: pinCRUDService,
+		Events:     analyticsRecorder,
 	}
 
 	likeHandler := rest.LikeHandler{
 		LikeService: likeService,
 		ContextTimeout: config.ContextExpiration,
 		NotificationChan: notificationChan,
+		Events: analyticsRecorder,
 	}
 
 	boardHandler := rest.BoardHandler{
-		BoardService:    boardService,
-		ContextDeadline: config.ContextExpiration,
+		BoardService:     boardService,
+		ContextDeadline:  config.ContextExpiration,
+		NotificationChan: notificationChan,
+		Events:           analyticsRecorder,
 	}
 
 	boardShrHandler := boardshrDelivery.BoardShrHandler{
@@ -274,6 +361,7 @@ func main() {
 	searchHander := rest.SearchHandler{
 		Service: searchService,
 		ContextTimeout: config.ContextExpiration,
+		Events: analyticsRecorder,
 	}
 	
 	notificationHandler := rest.NotificationHandler{
@@ -284,6 +372,28 @@ func main() {
 	commentHandler := rest.CommentHandler{
 		Service: commentService,
 		ContextExpiration: config.ContextExpiration,
+		Events: analyticsRecorder,
+	}
+
+	reportHandler := rest.ReportHandler{
+		Service: reportService,
+		ContextExpiration: config.ContextExpiration,
+	}
+
+	tagHandler := rest.TagHandler{
+		Service: tagService,
+		ContextExpiration: config.ContextExpiration,
+	}
+
+	accountHandler := rest.AccountHandler{
+		Service: accountService,
+		ContextExpiration: config.ContextExpiration,
+	}
+
+	analyticsHandler := rest.AnalyticsHandler{
+		Service: analyticsService,
+		Events: analyticsRecorder,
+		ContextExpiration: config.ContextExpiration,
 	}
 
 	fs := http.FileServer(http.Dir("." + config.StaticBaseDir))
@@ -329,7 +439,10 @@ func main() {
 
 	// feed
 	mux.HandleFunc("/api/v1/feed",
-		middleware.ChainMiddleware(pinsHandler.FeedHandler, middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.ChainMiddleware(pinsHandler.FeedHandler,
+		middleware.AuthMiddleware(jwtManager, false),
+		middleware.NSFWMiddleware(profileService),
+		middleware.CorsMiddleware(config, allowedGetOptions),
 		middleware.MetricsMiddleware(metricsService),
 		middleware.Log()))
 
@@ -346,6 +459,133 @@ func main() {
 		middleware.ChainMiddleware(authHandler.LogoutHandler, middleware.CorsMiddleware(config, allowedPostOptions),
 		middleware.MetricsMiddleware(metricsService),
 		middleware.Log()))
+	mux.HandleFunc("/api/v1/auth/login/2fa",
+		middleware.ChainMiddleware(authHandler.TwoFactorLoginHandler, middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	// external login providers
+	mux.HandleFunc("GET /api/v1/auth/oauth/providers",
+		middleware.ChainMiddleware(authHandler.OAuthProviders,
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("/api/v1/auth/oauth/{provider}/authorize",
+		middleware.ChainMiddleware(authHandler.OAuthAuthorize,
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("/api/v1/auth/oauth/{provider}/callback",
+		middleware.ChainMiddleware(authHandler.OAuthCallback,
+			middleware.AuthMiddleware(jwtManager, false),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("/api/v1/auth/oauth/register",
+		middleware.ChainMiddleware(authHandler.OAuthRegister,
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	// two-factor authentication
+	mux.HandleFunc("GET /api/v1/auth/2fa",
+		middleware.ChainMiddleware(authHandler.GetTwoFactorStatus,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("DELETE /api/v1/auth/2fa",
+		middleware.ChainMiddleware(authHandler.DisableTwoFactor,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedDeleteOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("POST /api/v1/auth/2fa/setup",
+		middleware.ChainMiddleware(authHandler.SetupTwoFactor,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("POST /api/v1/auth/2fa/confirm",
+		middleware.ChainMiddleware(authHandler.ConfirmTwoFactor,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("POST /api/v1/auth/2fa/recovery-codes",
+		middleware.ChainMiddleware(authHandler.RegenerateRecoveryCodes,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/auth/2fa",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/auth/2fa/setup",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/auth/2fa/confirm",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/auth/2fa/recovery-codes",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	// linked login providers
+	mux.HandleFunc("GET /api/v1/profile/identities",
+		middleware.ChainMiddleware(authHandler.GetIdentities,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("POST /api/v1/profile/identities/{provider}",
+		middleware.ChainMiddleware(authHandler.LinkIdentity,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("DELETE /api/v1/profile/identities/{provider}",
+		middleware.ChainMiddleware(authHandler.UnlinkIdentity,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedDeleteOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/profile/identities",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/profile/identities/{provider}",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
 
 	// profile
 	mux.HandleFunc("/api/v1/profile",
@@ -354,11 +594,92 @@ func main() {
 			middleware.CorsMiddleware(config, allowedGetOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/profile",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("DELETE /api/v1/profile",
+		middleware.ChainMiddleware(accountHandler.DeleteAccount,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedDeleteOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/profile/deletion",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("GET /api/v1/profile/deletion",
+		middleware.ChainMiddleware(accountHandler.GetDeletionStatus,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("DELETE /api/v1/profile/deletion",
+		middleware.ChainMiddleware(accountHandler.CancelDeletion,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedDeleteOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/profile/export",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("POST /api/v1/profile/export",
+		middleware.ChainMiddleware(accountHandler.RequestExport,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("GET /api/v1/profile/export/{export_id}",
+		middleware.ChainMiddleware(accountHandler.GetExport,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("GET /api/v1/profile/export/{export_id}/download",
+		middleware.ChainMiddleware(accountHandler.DownloadExport,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
 	mux.HandleFunc("/api/v1/users/{username}",
 		middleware.ChainMiddleware(profileHandler.PublicProfileHandler,
+			middleware.AuthMiddleware(jwtManager, false),
 			middleware.CorsMiddleware(config, allowedGetOptionsHead),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
+	mux.HandleFunc("GET /api/v1/profile/privacy",
+		middleware.ChainMiddleware(profileHandler.GetAccountPrivacyHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("PUT /api/v1/profile/privacy",
+		middleware.ChainMiddleware(profileHandler.UpdateAccountPrivacyHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPutOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/profile/privacy",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
 	mux.HandleFunc("/api/v1/profile/update",
 		middleware.ChainMiddleware(profileHandler.PatchUserProfileHandler,
 			middleware.AuthMiddleware(jwtManager, true),
@@ -373,6 +694,26 @@ func main() {
 			middleware.CorsMiddleware(config, allowedPostOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/profile/nsfw",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("GET /api/v1/profile/nsfw",
+		middleware.ChainMiddleware(profileHandler.GetNSFWSettingsHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("PUT /api/v1/profile/nsfw",
+		middleware.ChainMiddleware(profileHandler.UpdateNSFWSettingsHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPutOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
 	mux.HandleFunc("/api/v1/profile/password",
 		middleware.ChainMiddleware(profileHandler.ChangeUserPasswordHandler,
 			middleware.AuthMiddleware(jwtManager, true),
@@ -390,6 +731,7 @@ func main() {
 	mux.HandleFunc("GET /api/v1/flows",
 		middleware.ChainMiddleware(pinCRUDHandler.ReadHandler,
 			middleware.AuthMiddleware(jwtManager, false),
+			middleware.NSFWMiddleware(profileService),
 			middleware.CorsMiddleware(config, allowedGetOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
@@ -415,70 +757,332 @@ func main() {
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
 
-	// likes
-	mux.HandleFunc("POST /api/v1/like",
-		middleware.ChainMiddleware(likeHandler.LikeFlow, 
+	mux.HandleFunc("OPTIONS /api/v1/flows/from-url",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("POST /api/v1/flows/from-url",
+		middleware.ChainMiddleware(pinCRUDHandler.CreateFromURLHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	// scheduled flows
+	mux.HandleFunc("GET /api/v1/flows/scheduled",
+		middleware.ChainMiddleware(pinCRUDHandler.GetScheduledHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/flows/{flow_id}/schedule",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("PUT /api/v1/flows/{flow_id}/schedule",
+		middleware.ChainMiddleware(pinCRUDHandler.RescheduleHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPutOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("DELETE /api/v1/flows/{flow_id}/schedule",
+		middleware.ChainMiddleware(pinCRUDHandler.CancelScheduleHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedDeleteOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	// drafts and revisions
+	mux.HandleFunc("GET /api/v1/flows/drafts",
+		middleware.ChainMiddleware(pinCRUDHandler.GetDraftsHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/flows/{flow_id}/publish",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("POST /api/v1/flows/{flow_id}/publish",
+		middleware.ChainMiddleware(pinCRUDHandler.PublishDraftHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("GET /api/v1/flows/{flow_id}/revisions",
+		middleware.ChainMiddleware(pinCRUDHandler.GetRevisionsHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("OPTIONS /api/v1/flows/{flow_id}/revisions/{revision_id}/revert",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	mux.HandleFunc("POST /api/v1/flows/{flow_id}/revisions/{revision_id}/revert",
+		middleware.ChainMiddleware(pinCRUDHandler.RevertRevisionHandler,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	// likes
+	mux.HandleFunc("POST /api/v1/like",
+		middleware.ChainMiddleware(likeHandler.LikeFlow, 
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/like", middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, 
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+	
+	
+	// boards
+	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/flows",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			}, 
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/boards/{id}/flows",
+		middleware.ChainMiddleware(boardHandler.AddToBoard,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/boards/{board_id}/flows",
+		middleware.ChainMiddleware(boardHandler.GetBoardFlows,
+			middleware.AuthMiddleware(jwtManager, false),
+			middleware.NSFWMiddleware(profileService),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/flows/{id}",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	
+	mux.HandleFunc("GET /api/v1/boards/{board_id}/flows/{id}",
+		middleware.ChainMiddleware(boardHandler.GetFromBoard,
+			middleware.AuthMiddleware(jwtManager, false),
+			middleware.NSFWMiddleware(profileService),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("DELETE /api/v1/boards/{board_id}/flows/{id}",
+		middleware.ChainMiddleware(boardHandler.DeleteFromBoard,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedDeleteOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+	
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/flows/{id}/move",
+		middleware.ChainMiddleware(boardHandler.MoveFlow,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	// OPTIONS обслуживает маршрут /flows/{id}
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/flows/bulk",
+		middleware.ChainMiddleware(boardHandler.BulkFlows,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	// board sections
+	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/sections/{section_id}",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/sections",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/boards/{board_id}/sections",
+		middleware.ChainMiddleware(boardHandler.GetSections,
+			middleware.AuthMiddleware(jwtManager, false),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/sections",
+		middleware.ChainMiddleware(boardHandler.CreateSection,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("PUT /api/v1/boards/{board_id}/sections/{section_id}",
+		middleware.ChainMiddleware(boardHandler.RenameSection,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPutOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("DELETE /api/v1/boards/{board_id}/sections/{section_id}",
+		middleware.ChainMiddleware(boardHandler.DeleteSection,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedDeleteOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/sections/{section_id}/move",
+		middleware.ChainMiddleware(boardHandler.MoveSection,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/boards/{board_id}/sections/{section_id}/flows",
+		middleware.ChainMiddleware(boardHandler.GetSectionFlows,
+			middleware.AuthMiddleware(jwtManager, false),
+			middleware.NSFWMiddleware(profileService),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/cover",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/cover",
+		middleware.ChainMiddleware(boardHandler.UploadBoardCover,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/merge",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/merge",
+		middleware.ChainMiddleware(boardHandler.MergeBoards,
 			middleware.AuthMiddleware(jwtManager, true),
 			middleware.CSRFMiddleware(),
 			middleware.CorsMiddleware(config, allowedPostOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
 
-	mux.HandleFunc("OPTIONS /api/v1/like", middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusNoContent)
-	}, 
-		middleware.CorsMiddleware(config, allowedGetOptions),
-		middleware.MetricsMiddleware(metricsService),
-		middleware.Log()))
-	
-	
-	// boards
-	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/flows",
+	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/duplicate",
 		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
-			}, 
+			},
 			middleware.CorsMiddleware(config, allowedOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
 
-	mux.HandleFunc("POST /api/v1/boards/{id}/flows",
-		middleware.ChainMiddleware(boardHandler.AddToBoard,
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/duplicate",
+		middleware.ChainMiddleware(boardHandler.DuplicateBoard,
 			middleware.AuthMiddleware(jwtManager, true),
 			middleware.CSRFMiddleware(),
 			middleware.CorsMiddleware(config, allowedPostOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
 
-	mux.HandleFunc("GET /api/v1/boards/{board_id}/flows",
-		middleware.ChainMiddleware(boardHandler.GetBoardFlows,
-			middleware.AuthMiddleware(jwtManager, false),
+	mux.HandleFunc("GET /api/v1/boards/{board_id}/activity",
+		middleware.ChainMiddleware(boardHandler.GetBoardActivity,
+			middleware.AuthMiddleware(jwtManager, true),
 			middleware.CorsMiddleware(config, allowedGetOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
 
-	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/flows/{id}",
+	// board followers
+	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}/follow",
 		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 			},
 			middleware.CorsMiddleware(config, allowedOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
-	
-	mux.HandleFunc("GET /api/v1/boards/{board_id}/flows/{id}",
-		middleware.ChainMiddleware(boardHandler.GetFromBoard,
-			middleware.AuthMiddleware(jwtManager, false),
-			middleware.CorsMiddleware(config, allowedGetOptions),
+
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/follow",
+		middleware.ChainMiddleware(boardHandler.FollowBoard,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
 
-	mux.HandleFunc("DELETE /api/v1/boards/{board_id}/flows/{id}",
-		middleware.ChainMiddleware(boardHandler.DeleteFromBoard,
+	mux.HandleFunc("DELETE /api/v1/boards/{board_id}/follow",
+		middleware.ChainMiddleware(boardHandler.UnfollowBoard,
 			middleware.AuthMiddleware(jwtManager, true),
 			middleware.CSRFMiddleware(),
 			middleware.CorsMiddleware(config, allowedDeleteOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
-	
+
+	mux.HandleFunc("GET /api/v1/boards/{board_id}/followers",
+		middleware.ChainMiddleware(boardHandler.GetBoardFollowers,
+			middleware.AuthMiddleware(jwtManager, false),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/flows/{flow_id}/saves",
+		middleware.ChainMiddleware(boardHandler.GetFlowSaves,
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
 	mux.HandleFunc("OPTIONS /api/v1/boards/{board_id}",
 		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusNoContent)
@@ -506,6 +1110,7 @@ func main() {
 	mux.HandleFunc("GET /api/v1/boards/{board_id}",
 		middleware.ChainMiddleware(boardHandler.GetBoard,
 			middleware.AuthMiddleware(jwtManager, false),
+			middleware.NSFWMiddleware(profileService),
 			middleware.CorsMiddleware(config, allowedGetOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
@@ -520,6 +1125,8 @@ func main() {
 				
 	mux.HandleFunc("GET /api/v1/users/{username}/boards",
 		middleware.ChainMiddleware(boardHandler.GetUserPublic,
+			middleware.AuthMiddleware(jwtManager, false),
+			middleware.NSFWMiddleware(profileService),
 			middleware.CorsMiddleware(config, allowedGetOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
@@ -535,6 +1142,7 @@ func main() {
 	mux.HandleFunc("/api/v1/profile/boards",
 		middleware.ChainMiddleware(boardHandler.GetUserAllBoards,
 			middleware.AuthMiddleware(jwtManager, true),
+			middleware.NSFWMiddleware(profileService),
 			middleware.CorsMiddleware(config, allowedGetOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
@@ -563,6 +1171,36 @@ func main() {
 			middleware.CorsMiddleware(config, allowedDeleteOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
+
+	mux.HandleFunc("PUT /api/v1/boards/{board_id}/invites/{link}/pause",
+		middleware.ChainMiddleware(boardShrHandler.SetInvitationPaused,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPutOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/invites/{link}/regenerate",
+		middleware.ChainMiddleware(boardShrHandler.RegenerateInvitation,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/boards/{board_id}/invites/{link}/joins",
+		middleware.ChainMiddleware(boardShrHandler.GetInvitationJoins,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/boards/{board_id}/invites/{link}/qr",
+		middleware.ChainMiddleware(boardShrHandler.GetInvitationQR,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
 	
 	mux.HandleFunc("GET /api/v1/boards/{board_id}/coauthors",
 		middleware.ChainMiddleware(boardShrHandler.GetCoauthors,
@@ -578,6 +1216,45 @@ func main() {
 			middleware.CorsMiddleware(config, allowedDeleteOptions),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/ownership",
+		middleware.ChainMiddleware(boardShrHandler.ProposeOwnershipTransfer,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/boards/{board_id}/ownership",
+		middleware.ChainMiddleware(boardShrHandler.GetOwnershipTransfer,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("DELETE /api/v1/boards/{board_id}/ownership",
+		middleware.ChainMiddleware(boardShrHandler.RejectOwnershipTransfer,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedDeleteOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/boards/{board_id}/ownership/accept",
+		middleware.ChainMiddleware(boardShrHandler.AcceptOwnershipTransfer,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("PUT /api/v1/boards/{board_id}/coauthors",
+		middleware.ChainMiddleware(boardShrHandler.ChangeCoauthorRole,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPutOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
 	
 
 	// board sharing (coauthor)
@@ -601,6 +1278,8 @@ func main() {
 	// search
 	mux.HandleFunc("/api/v1/search/flows", 
 		middleware.ChainMiddleware(searchHander.SearchPins,
+			middleware.AuthMiddleware(jwtManager, false),
+			middleware.NSFWMiddleware(profileService),
 			middleware.CorsMiddleware(config, allowedGetOptionsHead),
 			middleware.MetricsMiddleware(metricsService),
 			middleware.Log(),
@@ -608,6 +1287,8 @@ func main() {
 
 	mux.HandleFunc("/api/v1/search/boards", 
 	middleware.ChainMiddleware(searchHander.SearchBoards,
+		middleware.AuthMiddleware(jwtManager, false),
+		middleware.NSFWMiddleware(profileService),
 		middleware.CorsMiddleware(config, allowedGetOptionsHead),
 		middleware.MetricsMiddleware(metricsService),
 		middleware.Log(),
@@ -672,6 +1353,36 @@ func main() {
 	}, middleware.CorsMiddleware(config, allowedOptions),
 	middleware.MetricsMiddleware(metricsService),
 	middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/profile/follow-requests",
+		middleware.ChainMiddleware(subscriptionHandler.GetFollowRequests,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CorsMiddleware(config, allowedGetOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/profile/follow-requests/{username}",
+		middleware.ChainMiddleware(subscriptionHandler.AcceptFollowRequest,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedPostOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("DELETE /api/v1/profile/follow-requests/{username}",
+		middleware.ChainMiddleware(subscriptionHandler.DeclineFollowRequest,
+			middleware.AuthMiddleware(jwtManager, true),
+			middleware.CSRFMiddleware(),
+			middleware.CorsMiddleware(config, allowedDeleteOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/profile/follow-requests/{username}",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
 	
 
 	// chat
@@ -742,6 +1453,30 @@ func main() {
 		middleware.CorsMiddleware(config, allowedGetOptions),
 		middleware.Log()))
 
+	mux.HandleFunc("PUT /api/v1/flows/{flow_id}/comments/{comment_id}", middleware.ChainMiddleware(commentHandler.EditComment,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPutOptions),
+		middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/flows/{flow_id}/comments/{comment_id}/hide", middleware.ChainMiddleware(commentHandler.HideComment,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.Log()))
+
+	mux.HandleFunc("DELETE /api/v1/flows/{flow_id}/comments/{comment_id}/hide", middleware.ChainMiddleware(commentHandler.UnhideComment,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedDeleteOptions),
+		middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/flows/{flow_id}/comments/{comment_id}/hide", middleware.ChainMiddleware(commentHandler.HideComment,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.Log()))
+
 	mux.HandleFunc("POST /api/v1/flows/{flow_id}/comments/{comment_id}/like", middleware.ChainMiddleware(commentHandler.LikeComment,
 		middleware.AuthMiddleware(jwtManager, true),
 		middleware.CSRFMiddleware(),
@@ -754,6 +1489,136 @@ func main() {
 		middleware.CorsMiddleware(config, allowedGetOptions),
 		middleware.Log()))
 
+	mux.HandleFunc("GET /api/v1/flows/{flow_id}/comments/{comment_id}/replies", middleware.ChainMiddleware(commentHandler.GetReplies,
+		middleware.AuthMiddleware(jwtManager, false),
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/flows/{flow_id}/comments/{comment_id}/replies", middleware.ChainMiddleware(commentHandler.GetReplies,
+		middleware.AuthMiddleware(jwtManager, false),
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/flows/{flow_id}/comments/{comment_id}/pin", middleware.ChainMiddleware(commentHandler.PinComment,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.Log()))
+
+	mux.HandleFunc("DELETE /api/v1/flows/{flow_id}/comments/{comment_id}/pin", middleware.ChainMiddleware(commentHandler.UnpinComment,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedDeleteOptions),
+		middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/flows/{flow_id}/comments/{comment_id}/pin", middleware.ChainMiddleware(commentHandler.PinComment,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.Log()))
+
+	// reports
+	mux.HandleFunc("POST /api/v1/reports", middleware.ChainMiddleware(reportHandler.CreateReport,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/reports", middleware.ChainMiddleware(reportHandler.CreateReport,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/admin/reports", middleware.ChainMiddleware(reportHandler.GetReports,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/admin/reports/{report_id}/resolve", middleware.ChainMiddleware(reportHandler.ResolveReport,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/admin/audit", middleware.ChainMiddleware(reportHandler.GetModeratorActions,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	// tags
+	mux.HandleFunc("GET /api/v1/tags", middleware.ChainMiddleware(tagHandler.AutocompleteTags,
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/tags/{name}", middleware.ChainMiddleware(tagHandler.GetTag,
+		middleware.AuthMiddleware(jwtManager, false),
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/tags/{name}/flows", middleware.ChainMiddleware(tagHandler.GetTagFlows,
+		middleware.AuthMiddleware(jwtManager, false),
+		middleware.NSFWMiddleware(profileService),
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/tags/{name}/follow",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/tags/{name}/follow", middleware.ChainMiddleware(tagHandler.FollowTag,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("DELETE /api/v1/tags/{name}/follow", middleware.ChainMiddleware(tagHandler.UnfollowTag,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedDeleteOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/profile/tags", middleware.ChainMiddleware(tagHandler.GetFollowedTags,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("GET /api/v1/profile/analytics", middleware.ChainMiddleware(analyticsHandler.GetAnalytics,
+		middleware.AuthMiddleware(jwtManager, true),
+		middleware.CorsMiddleware(config, allowedGetOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
+	mux.HandleFunc("OPTIONS /api/v1/flows/{flow_id}/clicks",
+		middleware.ChainMiddleware(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+			},
+			middleware.CorsMiddleware(config, allowedOptions),
+			middleware.MetricsMiddleware(metricsService),
+			middleware.Log()))
+
+	mux.HandleFunc("POST /api/v1/flows/{flow_id}/clicks", middleware.ChainMiddleware(analyticsHandler.RecordOutboundClick,
+		middleware.AuthMiddleware(jwtManager, false),
+		middleware.CSRFMiddleware(),
+		middleware.CorsMiddleware(config, allowedPostOptions),
+		middleware.MetricsMiddleware(metricsService),
+		middleware.Log()))
+
 	server := http.Server{
 		Addr:    config.Port,
 		Handler: mux,
@@ -783,6 +1648,11 @@ func main() {
 	ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
 
+	stopClassification()
+	stopScheduler()
+	stopAnalytics()
+	stopAccount()
+
 	if err := server.Shutdown(ctx); err != nil {
 		log.Printf("Graceful shutdown unsuccessful: %v", err)
 	}
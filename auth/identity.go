@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/security"
+)
+
+// LoginOAuthUser ищет пользователя по привязанному внешнему аккаунту.
+// Совпадение одной только почты не дает входа: иначе чужой провайдер
+// с той же почтой получил бы доступ к аккаунту
+func (u *UserService) LoginOAuthUser(ctx context.Context, provider, subject string) (int, string, string, bool, error) {
+	if provider == "" || subject == "" {
+		return 0, "", "", false, domain.ErrValidation
+	}
+
+	id, email, username, err := u.userRepo.FindUserByIdentity(ctx, provider, subject)
+	if err != nil {
+		return 0, "", "", false, err
+	}
+
+	// вход через провайдера не должен обходить второй фактор
+	twoFactor, err := u.isTwoFactorEnabled(ctx, id)
+	if err != nil {
+		return 0, "", "", false, err
+	}
+
+	return id, email, username, twoFactor, nil
+}
+
+// AddOAuthUser регистрирует пользователя без пароля: войти он сможет
+// только через привязанных провайдеров
+func (u *UserService) AddOAuthUser(ctx context.Context, identity domain.OAuthIdentity, username string) (uint64, error) {
+	if identity.Provider == "" || identity.Subject == "" {
+		return 0, domain.ErrValidation
+	}
+
+	if err := (domain.User{Email: identity.Email, Username: username}).ValidateUserNoPassword(); err != nil {
+		return 0, err
+	}
+
+	dummyPassword, err := security.GenerateRandomHash()
+	if err != nil {
+		return 0, err
+	}
+
+	dummyPassword, err = security.HashPassword(dummyPassword)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := u.userRepo.AddIdentityUser(ctx, identity, username, dummyPassword)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := u.createUserBoards(ctx, username, int(id)); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (u *UserService) LinkIdentity(ctx context.Context, userID int, identity domain.OAuthIdentity) error {
+	if identity.Provider == "" || identity.Subject == "" {
+		return domain.ErrValidation
+	}
+
+	return u.userRepo.LinkIdentity(ctx, userID, identity)
+}
+
+func (u *UserService) UnlinkIdentity(ctx context.Context, userID int, provider string) error {
+	return u.userRepo.UnlinkIdentity(ctx, userID, provider)
+}
+
+func (u *UserService) GetIdentities(ctx context.Context, userID int) ([]domain.LinkedIdentity, error) {
+	return u.userRepo.GetIdentities(ctx, userID)
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	mock_auth "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/user/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLoginOAuthUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_auth.NewMockUserRepository(ctrl)
+	service := NewUserService(repo, nil)
+
+	repo.EXPECT().FindUserByIdentity(gomock.Any(), "google", "sub").Return(3, "user@gmail.com", "user", nil)
+	repo.EXPECT().GetTwoFactorState(gomock.Any(), 3).Return(domain.TwoFactorState{Enabled: true}, nil)
+
+	id, email, username, twoFactor, err := service.LoginOAuthUser(context.Background(), "google", "sub")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, id)
+	assert.Equal(t, "user@gmail.com", email)
+	assert.Equal(t, "user", username)
+	assert.True(t, twoFactor, "вход через провайдера не должен обходить второй фактор")
+}
+
+func TestLoginExternalUser_UsesVKIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_auth.NewMockUserRepository(ctrl)
+	service := NewUserService(repo, nil)
+
+	repo.EXPECT().FindUserByIdentity(gomock.Any(), domain.ProviderVK, "vk-1").Return(0, "", "", domain.ErrNotFound)
+
+	_, _, _, _, err := service.LoginExternalUser(context.Background(), "user@vk.com", "vk-1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestAddOAuthUser_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity domain.OAuthIdentity
+		username string
+	}{
+		{"Сценарий: нет провайдера", domain.OAuthIdentity{Subject: "sub", Email: "user@mail.ru"}, "user"},
+		{"Сценарий: нет почты", domain.OAuthIdentity{Provider: "google", Subject: "sub"}, "user"},
+		{"Сценарий: короткое имя", domain.OAuthIdentity{Provider: "google", Subject: "sub", Email: "user@mail.ru"}, "u"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock_auth.NewMockUserRepository(ctrl)
+			service := NewUserService(repo, nil)
+
+			_, err := service.AddOAuthUser(context.Background(), tt.identity, tt.username)
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
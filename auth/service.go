@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/security"
@@ -12,9 +13,20 @@ type UserRepository interface {
 	GetHash(ctx context.Context, email, password string) (uint64, string, string, error)
 	GetUserPublicInfo(ctx context.Context, email string) (domain.PublicUser, error)
 	GetUserId(ctx context.Context, email string) (uint64, error)
-	FindExternalServiceUser(ctx context.Context, email string, externalID string) (int, string, string, error)
-	AddExternalUser(ctx context.Context, email, username, password, avatarURL string, externalID string) (uint64, error)
+	FindUserByIdentity(ctx context.Context, provider, subject string) (int, string, string, error)
+	AddIdentityUser(ctx context.Context, identity domain.OAuthIdentity, username, password string) (uint64, error)
+	LinkIdentity(ctx context.Context, userID int, identity domain.OAuthIdentity) error
+	UnlinkIdentity(ctx context.Context, userID int, provider string) error
+	GetIdentities(ctx context.Context, userID int) ([]domain.LinkedIdentity, error)
 	CheckImgPermission(ctx context.Context, imageName string, userID int) (bool, error)
+	GetTwoFactorState(ctx context.Context, userID int) (domain.TwoFactorState, error)
+	SetTwoFactorSecret(ctx context.Context, userID int, secret string) error
+	EnableTwoFactor(ctx context.Context, userID int, step int64, codeHashes []string) error
+	ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error
+	DisableTwoFactor(ctx context.Context, userID int) error
+	UseTOTPStep(ctx context.Context, userID int, step int64) error
+	UseRecoveryCode(ctx context.Context, userID int, codeHash string) error
+	RecordTwoFactorFailure(ctx context.Context, userID, maxAttempts int, lockUntil time.Time) error
 }
 
 type BoardRepository interface {
@@ -62,58 +74,41 @@ func (u *UserService) AddUser(ctx context.Context, user domain.User) (uint64, er
 	return id, nil
 }
 
-func (u *UserService) LoginUser(ctx context.Context, email, password string) (uint64, string, error) {
+// LoginUser проверяет пароль и сообщает, нужен ли второй фактор
+func (u *UserService) LoginUser(ctx context.Context, email, password string) (uint64, string, bool, error) {
 	if err := domain.ValidateEmailAndPassword(email, password); err != nil {
-		return 0, "", err
+		return 0, "", false, err
 	}
 
 	id, pswd, username, err := u.userRepo.GetHash(ctx, email, password)
 	if err != nil {
-		return 0, "", err
+		return 0, "", false, err
 	}
 
 	if !security.ComparePassword(password, pswd) {
-		return 0, "", domain.ErrInvalidCredentials
+		return 0, "", false, domain.ErrInvalidCredentials
 	}
 
-	return id, username, nil
-}
-
-func (u *UserService) LoginExternalUser(ctx context.Context, email string, externalID string) (int, string, string, error) {
-	id, gotEmail, username, err := u.userRepo.FindExternalServiceUser(ctx, email, externalID)
+	twoFactor, err := u.isTwoFactorEnabled(ctx, int(id))
 	if err != nil {
-		return 0, "", "", err
+		return 0, "", false, err
 	}
 
-	// this error shouldn't happen ever
-	if gotEmail != email {
-		return 0, "", "", domain.ErrForbidden
-	}
+	return id, username, twoFactor, nil
+}
 
-	return id, gotEmail, username, nil
+// LoginExternalUser — вход через VK ID по access token, полученному фронтендом
+func (u *UserService) LoginExternalUser(ctx context.Context, email string, externalID string) (int, string, string, bool, error) {
+	return u.LoginOAuthUser(ctx, domain.ProviderVK, externalID)
 }
 
 func (u *UserService) AddExternalUser(ctx context.Context, email, username, avatarURL string, externalID string) (uint64, error) {
-	dummyPassword, err := security.GenerateRandomHash()
-	if err != nil {
-		return 0, err
-	}
-
-	dummyPassword, err = security.HashPassword(dummyPassword)
-	if err != nil {
-		return 0, err
-	}
-
-	id, err := u.userRepo.AddExternalUser(ctx, email, username, dummyPassword, avatarURL, externalID)
-	if err != nil {
-		return 0, err
-	}
-
-	if err := u.createUserBoards(ctx, username, int(id)); err != nil {
-		return 0, err
-	}
-
-	return id, nil
+	return u.AddOAuthUser(ctx, domain.OAuthIdentity{
+		Provider: domain.ProviderVK,
+		Subject:  externalID,
+		Email:    email,
+		Avatar:   avatarURL,
+	}, username)
 }
 
 func (u *UserService) GetUserPublicInfo(ctx context.Context, email string) (domain.PublicUser, error) {
@@ -0,0 +1,187 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/security"
+)
+
+// длина кода восстановления без дефиса
+const recoveryCodeLength = 10
+
+func (u *UserService) isTwoFactorEnabled(ctx context.Context, userID int) (bool, error) {
+	state, err := u.userRepo.GetTwoFactorState(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return state.Enabled, nil
+}
+
+func (u *UserService) GetTwoFactorStatus(ctx context.Context, userID int) (domain.TwoFactorStatus, error) {
+	state, err := u.userRepo.GetTwoFactorState(ctx, userID)
+	if err != nil {
+		return domain.TwoFactorStatus{}, err
+	}
+
+	return domain.TwoFactorStatus{
+		Enabled:           state.Enabled,
+		RecoveryCodesLeft: state.RecoveryCodesLeft,
+	}, nil
+}
+
+// SetupTwoFactor создаёт новый секрет. Второй фактор включится только после
+// того, как пользователь подтвердит его кодом из приложения
+func (u *UserService) SetupTwoFactor(ctx context.Context, userID int) (domain.TwoFactorSetup, error) {
+	state, err := u.userRepo.GetTwoFactorState(ctx, userID)
+	if err != nil {
+		return domain.TwoFactorSetup{}, err
+	}
+
+	if state.Enabled {
+		return domain.TwoFactorSetup{}, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := security.GenerateTOTPSecret()
+	if err != nil {
+		return domain.TwoFactorSetup{}, err
+	}
+
+	if err := u.userRepo.SetTwoFactorSecret(ctx, userID, secret); err != nil {
+		return domain.TwoFactorSetup{}, err
+	}
+
+	return domain.TwoFactorSetup{
+		Secret:          secret,
+		ProvisioningURI: security.TOTPProvisioningURI(secret, domain.TwoFactorIssuer, state.Email),
+	}, nil
+}
+
+// ConfirmTwoFactor включает второй фактор и возвращает коды восстановления.
+// Коды показываются один раз, в базе хранятся только их хеши
+func (u *UserService) ConfirmTwoFactor(ctx context.Context, userID int, code string) ([]string, error) {
+	state, err := u.userRepo.GetTwoFactorState(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.Enabled {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+	if state.Secret == "" {
+		return nil, domain.ErrTwoFactorNotSetUp
+	}
+
+	now := time.Now()
+	if now.Before(state.LockedUntil) {
+		return nil, domain.ErrTwoFactorLocked
+	}
+
+	step, ok := security.ValidateTOTP(state.Secret, code, now)
+	if !ok {
+		return nil, u.recordFailure(ctx, userID, now)
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepo.EnableTwoFactor(ctx, userID, step, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// VerifyTwoFactor проверяет код из приложения или код восстановления
+func (u *UserService) VerifyTwoFactor(ctx context.Context, userID int, code string) error {
+	state, err := u.userRepo.GetTwoFactorState(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !state.Enabled {
+		return domain.ErrTwoFactorNotEnabled
+	}
+
+	now := time.Now()
+	if now.Before(state.LockedUntil) {
+		return domain.ErrTwoFactorLocked
+	}
+
+	if step, ok := security.ValidateTOTP(state.Secret, code, now); ok {
+		err := u.userRepo.UseTOTPStep(ctx, userID, step)
+		if !errors.Is(err, domain.ErrInvalidTwoFactorCode) {
+			return err
+		}
+	} else if isRecoveryCode(code) {
+		err := u.userRepo.UseRecoveryCode(ctx, userID, security.HashRecoveryCode(code))
+		if !errors.Is(err, domain.ErrInvalidTwoFactorCode) {
+			return err
+		}
+	}
+
+	return u.recordFailure(ctx, userID, now)
+}
+
+func (u *UserService) DisableTwoFactor(ctx context.Context, userID int, code string) error {
+	if err := u.VerifyTwoFactor(ctx, userID, code); err != nil {
+		return err
+	}
+
+	return u.userRepo.DisableTwoFactor(ctx, userID)
+}
+
+// RegenerateRecoveryCodes заменяет все коды восстановления новыми
+func (u *UserService) RegenerateRecoveryCodes(ctx context.Context, userID int, code string) ([]string, error) {
+	if err := u.VerifyTwoFactor(ctx, userID, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// recordFailure считает неверный код и возвращает ошибку для пользователя
+func (u *UserService) recordFailure(ctx context.Context, userID int, now time.Time) error {
+	if err := u.userRepo.RecordTwoFactorFailure(ctx, userID, domain.TwoFactorMaxAttempts, now.Add(domain.TwoFactorLockPeriod)); err != nil {
+		return err
+	}
+
+	return domain.ErrInvalidTwoFactorCode
+}
+
+func isRecoveryCode(code string) bool {
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	return len(code) == recoveryCodeLength
+}
+
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, domain.RecoveryCodeCount)
+	hashes := make([]string, 0, domain.RecoveryCodeCount)
+
+	for i := 0; i < domain.RecoveryCodeCount; i++ {
+		code, err := security.GenerateRecoveryCode()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes = append(codes, code)
+		hashes = append(hashes, security.HashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
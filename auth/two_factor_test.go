@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/security"
+	mock_auth "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/user/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func currentCode(t *testing.T) string {
+	code, err := security.TOTPCode(testSecret, time.Now().Unix()/30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return code
+}
+
+func TestConfirmTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_auth.NewMockUserRepository(ctrl)
+	service := NewUserService(repo, nil)
+
+	repo.EXPECT().GetTwoFactorState(gomock.Any(), 1).Return(domain.TwoFactorState{Secret: testSecret}, nil)
+	repo.EXPECT().EnableTwoFactor(gomock.Any(), 1, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, _ int64, hashes []string) error {
+			assert.Len(t, hashes, domain.RecoveryCodeCount)
+			return nil
+		})
+
+	codes, err := service.ConfirmTwoFactor(context.Background(), 1, currentCode(t))
+	assert.NoError(t, err)
+	assert.Len(t, codes, domain.RecoveryCodeCount)
+}
+
+func TestConfirmTwoFactor_NotSetUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_auth.NewMockUserRepository(ctrl)
+	service := NewUserService(repo, nil)
+
+	repo.EXPECT().GetTwoFactorState(gomock.Any(), 1).Return(domain.TwoFactorState{}, nil)
+
+	_, err := service.ConfirmTwoFactor(context.Background(), 1, "123456")
+	assert.ErrorIs(t, err, domain.ErrTwoFactorNotSetUp)
+}
+
+func TestVerifyTwoFactor(t *testing.T) {
+	enabled := domain.TwoFactorState{Secret: testSecret, Enabled: true}
+
+	tests := []struct {
+		name     string
+		state    domain.TwoFactorState
+		code     func(t *testing.T) string
+		setup    func(repo *mock_auth.MockUserRepository)
+		expected error
+	}{
+		{
+			name:  "Сценарий: код из приложения",
+			state: enabled,
+			code:  currentCode,
+			setup: func(repo *mock_auth.MockUserRepository) {
+				repo.EXPECT().UseTOTPStep(gomock.Any(), 1, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:  "Сценарий: код уже использован",
+			state: enabled,
+			code:  currentCode,
+			setup: func(repo *mock_auth.MockUserRepository) {
+				repo.EXPECT().UseTOTPStep(gomock.Any(), 1, gomock.Any()).Return(domain.ErrInvalidTwoFactorCode)
+				repo.EXPECT().RecordTwoFactorFailure(gomock.Any(), 1, domain.TwoFactorMaxAttempts, gomock.Any()).Return(nil)
+			},
+			expected: domain.ErrInvalidTwoFactorCode,
+		},
+		{
+			name:  "Сценарий: код восстановления",
+			state: enabled,
+			code:  func(*testing.T) string { return "ABCDE-FGHJK" },
+			setup: func(repo *mock_auth.MockUserRepository) {
+				repo.EXPECT().UseRecoveryCode(gomock.Any(), 1, security.HashRecoveryCode("abcdefghjk")).Return(nil)
+			},
+		},
+		{
+			name:  "Сценарий: неверный код",
+			state: enabled,
+			code:  func(*testing.T) string { return "000" },
+			setup: func(repo *mock_auth.MockUserRepository) {
+				repo.EXPECT().RecordTwoFactorFailure(gomock.Any(), 1, domain.TwoFactorMaxAttempts, gomock.Any()).Return(nil)
+			},
+			expected: domain.ErrInvalidTwoFactorCode,
+		},
+		{
+			name:     "Сценарий: ввод заблокирован",
+			state:    domain.TwoFactorState{Secret: testSecret, Enabled: true, LockedUntil: time.Now().Add(time.Minute)},
+			code:     currentCode,
+			setup:    func(*mock_auth.MockUserRepository) {},
+			expected: domain.ErrTwoFactorLocked,
+		},
+		{
+			name:     "Сценарий: второй фактор выключен",
+			state:    domain.TwoFactorState{},
+			code:     currentCode,
+			setup:    func(*mock_auth.MockUserRepository) {},
+			expected: domain.ErrTwoFactorNotEnabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock_auth.NewMockUserRepository(ctrl)
+			service := NewUserService(repo, nil)
+
+			repo.EXPECT().GetTwoFactorState(gomock.Any(), 1).Return(tt.state, nil)
+			tt.setup(repo)
+
+			err := service.VerifyTwoFactor(context.Background(), 1, tt.code(t))
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
@@ -4,25 +4,51 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"log"
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	imageUtil "github.com/go-park-mail-ru/2025_1_SuperChips/utils/image"
 )
 
 type BoardRepository interface {
-	GetUsernameID(ctx context.Context, username string, userID int) (int, error)                                    // получить айди юзернейма
-	CreateBoard(ctx context.Context, board *domain.Board, username string, userID int) error                        // создание доски
-	DeleteBoard(ctx context.Context, boardID, userID int) error                                                     // удаление доски
-	AddToBoard(ctx context.Context, boardID, userID, flowID int) error                                              // добавление пина в доску
-	DeleteFromBoard(ctx context.Context, boardID, userID, flowID int) error                                         // удаление пина из доски
-	UpdateBoard(ctx context.Context, boardID, userID int, newName string, isPrivate bool) error                     // обновление данных доски
-	GetBoard(ctx context.Context, boardID, userID, previewNum, previewStart int) (domain.Board, []string, error)    // получить доску
-	GetUserPublicBoards(ctx context.Context, username string, previewNum, previewStart int) ([]domain.Board, error) // получить публичные доски пользователя
-	GetUserAllBoards(ctx context.Context, userID, previewNum, previewStart int) ([]domain.Board, error)             // получтиь все доски пользователя
-	GetBoardFlow(ctx context.Context, boardID, userID, page, pageSize int) ([]domain.PinData, error)                // получить пины доски (с пагинацией)
+	GetUsernameID(ctx context.Context, username string, userID int) (int, error)                                                   // получить айди юзернейма
+	CreateBoard(ctx context.Context, board *domain.Board, username string, userID int) error                                       // создание доски
+	DeleteBoard(ctx context.Context, boardID, userID int) error                                                                    // удаление доски
+	AddToBoard(ctx context.Context, boardID, userID, flowID, savedFromBoardID int) error                                           // добавление пина в доску
+	DeleteFromBoard(ctx context.Context, boardID, userID, flowID int) error                                                        // удаление пина из доски
+	UpdateBoard(ctx context.Context, boardID, userID int, update domain.UpdateData) error                                          // обновление данных доски
+	SetBoardCoverImage(ctx context.Context, boardID, userID int, filename string) error                                            // установить загруженную обложку
+	GetBoard(ctx context.Context, boardID, userID, previewNum, previewStart int) (domain.Board, []string, error)                   // получить доску
+	GetUserPublicBoards(ctx context.Context, username string, viewerID, previewNum, previewStart int) ([]domain.Board, error)      // получить публичные доски пользователя
+	GetUserAllBoards(ctx context.Context, userID, previewNum, previewStart int) ([]domain.Board, error)                            // получтиь все доски пользователя
+	GetBoardFlow(ctx context.Context, boardID, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error)              // получить пины доски (с пагинацией)
+	GetSections(ctx context.Context, boardID, userID int) ([]domain.BoardSection, error)                                           // получить разделы доски
+	CreateSection(ctx context.Context, boardID, userID int, name string) (domain.BoardSection, error)                              // создать раздел
+	RenameSection(ctx context.Context, boardID, sectionID, userID int, name string) error                                          // переименовать раздел
+	DeleteSection(ctx context.Context, boardID, sectionID, userID int) error                                                       // удалить раздел
+	MoveSection(ctx context.Context, boardID, sectionID, userID int, move domain.SectionMove) error                                // переставить раздел
+	MoveFlow(ctx context.Context, boardID, flowID, userID int, move domain.FlowMove) error                                         // переместить пин
+	GetSectionFlow(ctx context.Context, boardID, sectionID, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error) // получить пины раздела
+	FollowBoard(ctx context.Context, boardID, userID int) error                                                                    // подписаться на доску
+	UnfollowBoard(ctx context.Context, boardID, userID int) error                                                                  // отписаться от доски
+	GetBoardFollowers(ctx context.Context, boardID, userID, page, pageSize int) ([]domain.PublicUser, error)                       // получить подписчиков доски
+	GetFollowedBoardsFlow(ctx context.Context, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error)              // получить новые пины отслеживаемых досок
+	RecordActivity(ctx context.Context, activity domain.BoardActivity) error                                                       // записать действие в журнал доски
+	GetBoardActivity(ctx context.Context, boardID, page, pageSize int) ([]domain.BoardActivity, error)                             // получить журнал доски
+	ClaimFollowersNotification(ctx context.Context, boardID, flowID, userID int, interval time.Duration) (string, []string, error) // получить подписчиков для уведомления
+	BulkAddToBoard(ctx context.Context, boardID, userID int, flowIDs []int) ([]domain.BulkFlowResult, error)                       // добавить несколько пинов
+	BulkDeleteFromBoard(ctx context.Context, boardID, userID int, flowIDs []int) ([]domain.BulkFlowResult, error)                  // удалить несколько пинов
+	BulkMoveFlows(ctx context.Context, boardID, targetBoardID, userID int, flowIDs []int) ([]domain.BulkFlowResult, error)         // перенести несколько пинов
+	BulkCopyFlows(ctx context.Context, boardID, targetBoardID, userID int, flowIDs []int) ([]domain.BulkFlowResult, error)         // скопировать несколько пинов
+	MergeBoards(ctx context.Context, boardID, targetBoardID, userID int) ([]int, error)                                            // слить доску в другую
+	DuplicateBoard(ctx context.Context, boardID, userID int, name string) (int, error)                                             // создать копию доски
+	GetFlowSaves(ctx context.Context, flowID, page, pageSize int) ([]domain.FlowSave, error)                                       // получить доски, на которые сохранён пин
+	GetFlowSaveRecipient(ctx context.Context, boardID, flowID, userID int) (string, string, error)                                 // получить автора пина для уведомления о сохранении
 }
 
 type PinRepository interface {
@@ -31,14 +57,17 @@ type PinRepository interface {
 
 type BoardSharingRepository interface {
 	GetCoauthorsIDs(ctx context.Context, boardID int) ([]int, error)
+	GetBoardRole(ctx context.Context, boardID, userID int) (string, error)
 }
 
 type BoardService struct {
-	repo     BoardRepository
-	repoPin  PinRepository
-	repoShr  BoardSharingRepository
-	baseURL  string
-	imageDir string
+	repo      BoardRepository
+	repoPin   PinRepository
+	repoShr   BoardSharingRepository
+	baseURL   string
+	imageDir  string
+	staticDir string
+	avatarDir string
 }
 
 var (
@@ -48,15 +77,20 @@ var (
 const (
 	previewNum   = 3
 	previewStart = 0
+
+	// подписчики доски получают не больше одного уведомления о новых пинах за интервал
+	followersNotifyInterval = time.Hour
 )
 
-func NewBoardService(repo BoardRepository, repoPin PinRepository, repoShr BoardSharingRepository, baseURL, imageDir string) *BoardService {
+func NewBoardService(repo BoardRepository, repoPin PinRepository, repoShr BoardSharingRepository, baseURL, imageDir, staticDir, avatarDir string) *BoardService {
 	return &BoardService{
-		repo:     repo,
-		repoPin:  repoPin,
-		repoShr:  repoShr,
-		baseURL:  baseURL,
-		imageDir: imageDir,
+		repo:      repo,
+		repoPin:   repoPin,
+		repoShr:   repoShr,
+		baseURL:   baseURL,
+		imageDir:  imageDir,
+		staticDir: staticDir,
+		avatarDir: avatarDir,
 	}
 }
 
@@ -79,6 +113,10 @@ func (b *BoardService) CreateBoard(ctx context.Context, board domain.Board, user
 }
 
 func (b *BoardService) DeleteBoard(ctx context.Context, boardID, userID int) error {
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleOwner); err != nil {
+		return err
+	}
+
 	if err := b.repo.DeleteBoard(ctx, boardID, userID); err != nil {
 		return err
 	}
@@ -86,23 +124,93 @@ func (b *BoardService) DeleteBoard(ctx context.Context, boardID, userID int) err
 	return nil
 }
 
-func (b *BoardService) AddToBoard(ctx context.Context, boardID, userID, flowID int) error {
+// AddToBoard сохраняет пин на доску. savedFromBoardID — доска, на которой
+// пользователь нашёл пин, 0 если пин сохранён не с доски
+func (b *BoardService) AddToBoard(ctx context.Context, boardID, userID, flowID, savedFromBoardID int) error {
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleContributor); err != nil {
+		return err
+	}
 
-	if err := b.repo.AddToBoard(ctx, boardID, userID, flowID); err != nil {
+	if err := b.repo.AddToBoard(ctx, boardID, userID, flowID, savedFromBoardID); err != nil {
 		return err
 	}
 
+	b.recordActivity(ctx, domain.BoardActivity{
+		BoardID: boardID,
+		ActorID: userID,
+		Action:  domain.ActivityFlowAdded,
+		FlowID:  flowID,
+	})
+
 	return nil
 }
 
-func (b *BoardService) UpdateBoard(ctx context.Context, boardID, userID int, newName string, isPrivate bool) error {
-	if err := b.repo.UpdateBoard(ctx, boardID, userID, newName, isPrivate); err != nil {
+func (b *BoardService) UpdateBoard(ctx context.Context, boardID, userID int, update domain.UpdateData) error {
+	if err := update.Validate(); err != nil {
+		return err
+	}
+
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleOwner); err != nil {
+		return err
+	}
+
+	// прежние имя и видимость нужны для журнала
+	previous, _, err := b.repo.GetBoard(ctx, boardID, userID, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	if err := b.repo.UpdateBoard(ctx, boardID, userID, update); err != nil {
 		return err
 	}
 
+	if previous.Name != update.Name {
+		b.recordActivity(ctx, domain.BoardActivity{
+			BoardID: boardID,
+			ActorID: userID,
+			Action:  domain.ActivityBoardRenamed,
+			Details: map[string]string{
+				"old_name": previous.Name,
+				"new_name": update.Name,
+			},
+		})
+	}
+
+	oldVisibility := domain.BoardVisibility(previous.IsPrivate, previous.IsSecret)
+	newVisibility := domain.BoardVisibility(update.IsPrivate, update.IsSecret)
+	if oldVisibility != newVisibility {
+		b.recordActivity(ctx, domain.BoardActivity{
+			BoardID: boardID,
+			ActorID: userID,
+			Action:  domain.ActivityPrivacyChanged,
+			Details: map[string]string{
+				"old_visibility": oldVisibility,
+				"new_visibility": newVisibility,
+			},
+		})
+	}
+
 	return nil
 }
 
+// SetBoardCover сохраняет загруженную обложку доски и возвращает её URL
+func (b *BoardService) SetBoardCover(ctx context.Context, boardID, userID int, file io.Reader, filename string) (string, error) {
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleOwner); err != nil {
+		return "", err
+	}
+
+	savedName, _, err := imageUtil.UploadImage(filename, "", b.imageDir, b.baseURL, file)
+	if err != nil {
+		return "", err
+	}
+
+	if err := b.repo.SetBoardCoverImage(ctx, boardID, userID, savedName); err != nil {
+		return "", err
+	}
+
+	return b.generateImageURL(savedName), nil
+}
+
 func (b *BoardService) GetFromBoard(ctx context.Context, boardID, userID, flowID int, authorized bool) (domain.PinData, error) {
 	data, authorID, err := b.repoPin.GetFromBoard(ctx, boardID, userID, flowID)
 	if err != nil {
@@ -134,10 +242,21 @@ func (b *BoardService) GetFromBoard(ctx context.Context, boardID, userID, flowID
 }
 
 func (b *BoardService) DeleteFromBoard(ctx context.Context, boardID, userID, flowID int) error {
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleEditor); err != nil {
+		return err
+	}
+
 	if err := b.repo.DeleteFromBoard(ctx, boardID, userID, flowID); err != nil {
 		return err
 	}
 
+	b.recordActivity(ctx, domain.BoardActivity{
+		BoardID: boardID,
+		ActorID: userID,
+		Action:  domain.ActivityFlowRemoved,
+		FlowID:  flowID,
+	})
+
 	return nil
 }
 
@@ -161,8 +280,22 @@ func (b *BoardService) GetBoard(ctx context.Context, boardID, userID int, author
 		}
 	}
 
+	if authorized {
+		role, err := b.repoShr.GetBoardRole(ctx, boardID, userID)
+		if err != nil {
+			return domain.Board{}, err
+		}
+
+		board.Role = role
+		board.IsEditable = domain.BoardRoleAllows(role, domain.BoardRoleEditor)
+	}
+
 	for i := range board.Preview {
-		board.Preview[i].MediaURL = b.generateImageURL(board.Preview[i].MediaURL)
+		board.Preview[i].MapMediaURLs(b.generateImageURL)
+	}
+
+	if board.Cover != "" {
+		board.Cover = b.generateImageURL(board.Cover)
 	}
 
 	if len(colors) > 0 && len(colors)%4 == 0 {
@@ -193,15 +326,19 @@ func (b *BoardService) GetBoard(ctx context.Context, boardID, userID int, author
 }
 
 // todo: paginate this
-func (b *BoardService) GetUserPublicBoards(ctx context.Context, username string) ([]domain.Board, error) {
-	boards, err := b.repo.GetUserPublicBoards(ctx, username, previewNum, previewStart)
+func (b *BoardService) GetUserPublicBoards(ctx context.Context, username string, viewerID int) ([]domain.Board, error) {
+	boards, err := b.repo.GetUserPublicBoards(ctx, username, viewerID, previewNum, previewStart)
 	if err != nil {
 		return []domain.Board{}, err
 	}
 
 	for i := range boards {
 		for j := range boards[i].Preview {
-			boards[i].Preview[j].MediaURL = b.generateImageURL(boards[i].Preview[j].MediaURL)
+			boards[i].Preview[j].MapMediaURLs(b.generateImageURL)
+		}
+
+		if boards[i].Cover != "" {
+			boards[i].Cover = b.generateImageURL(boards[i].Cover)
 		}
 	}
 
@@ -217,26 +354,333 @@ func (b *BoardService) GetUserAllBoards(ctx context.Context, userID int) ([]doma
 
 	for i := range boards {
 		for j := range boards[i].Preview {
-			boards[i].Preview[j].MediaURL = b.generateImageURL(boards[i].Preview[j].MediaURL)
+			boards[i].Preview[j].MapMediaURLs(b.generateImageURL)
+		}
+
+		if boards[i].Cover != "" {
+			boards[i].Cover = b.generateImageURL(boards[i].Cover)
 		}
 	}
 
 	return boards, nil
 }
 
-func (b *BoardService) GetBoardFlow(ctx context.Context, boardID, userID, page, pageSize int, authorized bool) ([]domain.PinData, error) {
-	flows, err := b.repo.GetBoardFlow(ctx, boardID, userID, page, pageSize)
+func (b *BoardService) GetBoardFlow(ctx context.Context, boardID, userID, page, pageSize int, authorized bool, nsfwMode string) ([]domain.PinData, error) {
+	flows, err := b.repo.GetBoardFlow(ctx, boardID, userID, page, pageSize, nsfwMode)
 	if err != nil {
 		return nil, err
 	}
 
 	for i := range flows {
-		flows[i].MediaURL = b.generateImageURL(flows[i].MediaURL)
+		flows[i].MapMediaURLs(b.generateImageURL)
 	}
 
 	return flows, nil
 }
 
+func (b *BoardService) GetSections(ctx context.Context, boardID, userID int) ([]domain.BoardSection, error) {
+	return b.repo.GetSections(ctx, boardID, userID)
+}
+
+func (b *BoardService) CreateSection(ctx context.Context, boardID, userID int, name string) (domain.BoardSection, error) {
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleEditor); err != nil {
+		return domain.BoardSection{}, err
+	}
+
+	return b.repo.CreateSection(ctx, boardID, userID, name)
+}
+
+func (b *BoardService) RenameSection(ctx context.Context, boardID, sectionID, userID int, name string) error {
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleEditor); err != nil {
+		return err
+	}
+
+	return b.repo.RenameSection(ctx, boardID, sectionID, userID, name)
+}
+
+func (b *BoardService) DeleteSection(ctx context.Context, boardID, sectionID, userID int) error {
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleEditor); err != nil {
+		return err
+	}
+
+	return b.repo.DeleteSection(ctx, boardID, sectionID, userID)
+}
+
+func (b *BoardService) MoveSection(ctx context.Context, boardID, sectionID, userID int, move domain.SectionMove) error {
+	if move.AfterID < 0 || move.BeforeID < 0 || (move.AfterID > 0 && move.AfterID == move.BeforeID) {
+		return domain.ErrInvalidPosition
+	}
+
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleEditor); err != nil {
+		return err
+	}
+
+	return b.repo.MoveSection(ctx, boardID, sectionID, userID, move)
+}
+
+func (b *BoardService) MoveFlow(ctx context.Context, boardID, flowID, userID int, move domain.FlowMove) error {
+	if move.TargetBoardID < 0 || move.SectionID < 0 || move.AfterID < 0 || move.BeforeID < 0 {
+		return domain.ErrInvalidPosition
+	}
+
+	if move.AfterID > 0 && move.AfterID == move.BeforeID {
+		return domain.ErrInvalidPosition
+	}
+
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleEditor); err != nil {
+		return err
+	}
+
+	// перенос в другую доску — это добавление в неё
+	if move.TargetBoardID > 0 && move.TargetBoardID != boardID {
+		if err := b.checkRole(ctx, move.TargetBoardID, userID, domain.BoardRoleContributor); err != nil {
+			return err
+		}
+	}
+
+	if err := b.repo.MoveFlow(ctx, boardID, flowID, userID, move); err != nil {
+		return err
+	}
+
+	if move.TargetBoardID > 0 && move.TargetBoardID != boardID {
+		b.recordActivity(ctx, domain.BoardActivity{
+			BoardID: boardID,
+			ActorID: userID,
+			Action:  domain.ActivityFlowRemoved,
+			FlowID:  flowID,
+		})
+		b.recordActivity(ctx, domain.BoardActivity{
+			BoardID: move.TargetBoardID,
+			ActorID: userID,
+			Action:  domain.ActivityFlowAdded,
+			FlowID:  flowID,
+		})
+	}
+
+	return nil
+}
+
+// BulkFlows выполняет действие над несколькими пинами одной транзакцией.
+// Права проверяются так же, как для одиночных операций
+func (b *BoardService) BulkFlows(ctx context.Context, boardID, userID int, req domain.BulkFlowRequest) ([]domain.BulkFlowResult, error) {
+	if err := req.Validate(boardID); err != nil {
+		return nil, err
+	}
+
+	var (
+		results []domain.BulkFlowResult
+		err     error
+	)
+
+	switch req.Action {
+	case domain.BulkActionAdd:
+		if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleContributor); err != nil {
+			return nil, err
+		}
+		results, err = b.repo.BulkAddToBoard(ctx, boardID, userID, req.FlowIDs)
+	case domain.BulkActionRemove:
+		if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleEditor); err != nil {
+			return nil, err
+		}
+		results, err = b.repo.BulkDeleteFromBoard(ctx, boardID, userID, req.FlowIDs)
+	case domain.BulkActionMove:
+		if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleEditor); err != nil {
+			return nil, err
+		}
+		if err := b.checkRole(ctx, req.TargetBoardID, userID, domain.BoardRoleContributor); err != nil {
+			return nil, err
+		}
+		results, err = b.repo.BulkMoveFlows(ctx, boardID, req.TargetBoardID, userID, req.FlowIDs)
+	case domain.BulkActionCopy:
+		// доступ на чтение исходной доски проверяет хранилище
+		if err := b.checkRole(ctx, req.TargetBoardID, userID, domain.BoardRoleContributor); err != nil {
+			return nil, err
+		}
+		results, err = b.repo.BulkCopyFlows(ctx, boardID, req.TargetBoardID, userID, req.FlowIDs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// откуда пины ушли и куда попали, для журнала
+	var removedFrom, addedTo int
+	switch req.Action {
+	case domain.BulkActionAdd:
+		addedTo = boardID
+	case domain.BulkActionRemove:
+		removedFrom = boardID
+	case domain.BulkActionMove:
+		removedFrom, addedTo = boardID, req.TargetBoardID
+	case domain.BulkActionCopy:
+		addedTo = req.TargetBoardID
+	}
+
+	for _, result := range results {
+		if result.Status != domain.BulkStatusOK {
+			continue
+		}
+
+		if removedFrom > 0 {
+			b.recordActivity(ctx, domain.BoardActivity{
+				BoardID: removedFrom,
+				ActorID: userID,
+				Action:  domain.ActivityFlowRemoved,
+				FlowID:  result.FlowID,
+			})
+		}
+		if addedTo > 0 {
+			b.recordActivity(ctx, domain.BoardActivity{
+				BoardID: addedTo,
+				ActorID: userID,
+				Action:  domain.ActivityFlowAdded,
+				FlowID:  result.FlowID,
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// MergeBoards переносит все пины доски в targetBoardID и удаляет её
+func (b *BoardService) MergeBoards(ctx context.Context, boardID, targetBoardID, userID int) error {
+	if targetBoardID <= 0 || targetBoardID == boardID {
+		return domain.ErrInvalidBulkRequest
+	}
+
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleOwner); err != nil {
+		return err
+	}
+
+	if err := b.checkRole(ctx, targetBoardID, userID, domain.BoardRoleContributor); err != nil {
+		return err
+	}
+
+	flowIDs, err := b.repo.MergeBoards(ctx, boardID, targetBoardID, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, flowID := range flowIDs {
+		b.recordActivity(ctx, domain.BoardActivity{
+			BoardID: targetBoardID,
+			ActorID: userID,
+			Action:  domain.ActivityFlowAdded,
+			FlowID:  flowID,
+		})
+	}
+
+	return nil
+}
+
+func (b *BoardService) DuplicateBoard(ctx context.Context, boardID, userID int, name string) (int, error) {
+	return b.repo.DuplicateBoard(ctx, boardID, userID, name)
+}
+
+func (b *BoardService) GetSectionFlow(ctx context.Context, boardID, sectionID, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	flows, err := b.repo.GetSectionFlow(ctx, boardID, sectionID, userID, page, pageSize, nsfwMode)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range flows {
+		flows[i].MapMediaURLs(b.generateImageURL)
+	}
+
+	return flows, nil
+}
+
+func (b *BoardService) FollowBoard(ctx context.Context, boardID, userID int) error {
+	return b.repo.FollowBoard(ctx, boardID, userID)
+}
+
+func (b *BoardService) UnfollowBoard(ctx context.Context, boardID, userID int) error {
+	return b.repo.UnfollowBoard(ctx, boardID, userID)
+}
+
+func (b *BoardService) GetBoardFollowers(ctx context.Context, boardID, userID, page, pageSize int) ([]domain.PublicUser, error) {
+	followers, err := b.repo.GetBoardFollowers(ctx, boardID, userID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range followers {
+		if !followers[i].IsExternalAvatar {
+			followers[i].Avatar = b.generateAvatarURL(followers[i].Avatar)
+		}
+	}
+
+	return followers, nil
+}
+
+func (b *BoardService) GetFollowedBoardsFlow(ctx context.Context, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	flows, err := b.repo.GetFollowedBoardsFlow(ctx, userID, page, pageSize, nsfwMode)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range flows {
+		flows[i].MapMediaURLs(b.generateImageURL)
+	}
+
+	return flows, nil
+}
+
+// FollowersToNotify возвращает имя доски и подписчиков, которых нужно уведомить о новом пине.
+// Уведомления отправляются не чаще followersNotifyInterval, в остальное время список пуст.
+func (b *BoardService) FollowersToNotify(ctx context.Context, boardID, flowID, userID int) (string, []string, error) {
+	return b.repo.ClaimFollowersNotification(ctx, boardID, flowID, userID, followersNotifyInterval)
+}
+
+// GetFlowSaves возвращает публичные доски, на которые другие пользователи сохранили пин
+func (b *BoardService) GetFlowSaves(ctx context.Context, flowID, page, pageSize int) ([]domain.FlowSave, error) {
+	return b.repo.GetFlowSaves(ctx, flowID, page, pageSize)
+}
+
+// FlowSaveToNotify возвращает автора пина и имя доски для уведомления о сохранении.
+// Если уведомлять не нужно, автор пуст
+func (b *BoardService) FlowSaveToNotify(ctx context.Context, boardID, flowID, userID int) (string, string, error) {
+	return b.repo.GetFlowSaveRecipient(ctx, boardID, flowID, userID)
+}
+
+// GetBoardActivity отдаёт журнал доски её автору и соавторам
+func (b *BoardService) GetBoardActivity(ctx context.Context, boardID, userID, page, pageSize int) ([]domain.BoardActivity, error) {
+	if err := b.checkRole(ctx, boardID, userID, domain.BoardRoleViewer); err != nil {
+		return nil, err
+	}
+
+	return b.repo.GetBoardActivity(ctx, boardID, page, pageSize)
+}
+
+// recordActivity пишет в журнал доски. Действие уже выполнено,
+// поэтому ошибка записи только логируется
+func (b *BoardService) recordActivity(ctx context.Context, activity domain.BoardActivity) {
+	if err := b.repo.RecordActivity(ctx, activity); err != nil {
+		log.Printf("failed to record board activity %s: %v", activity.Action, err)
+	}
+}
+
+// checkRole проверяет, что роль пользователя на доске не ниже required
+func (b *BoardService) checkRole(ctx context.Context, boardID, userID int, required string) error {
+	role, err := b.repoShr.GetBoardRole(ctx, boardID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !domain.BoardRoleAllows(role, required) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
 func (p *BoardService) generateImageURL(filename string) string {
 	return p.baseURL + filepath.Join(strings.ReplaceAll(p.imageDir, ".", ""), filename)
 }
+
+func (p *BoardService) generateAvatarURL(filename string) string {
+	if filename == "" {
+		return ""
+	}
+
+	return p.baseURL + filepath.Join(p.staticDir, p.avatarDir, filename)
+}
@@ -1,4 +1,223 @@
 package board
 
+import (
+	"context"
+	"testing"
 
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	mock_board "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/board/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
 
+func newTestBoardService(ctrl *gomock.Controller) (*BoardService, *mock_board.MockBoardRepository, *mock_board.MockBoardSharingRepository) {
+	repo := mock_board.NewMockBoardRepository(ctrl)
+	repoShr := mock_board.NewMockBoardSharingRepository(ctrl)
+
+	return NewBoardService(repo, mock_board.NewMockPinRepository(ctrl), repoShr, "http://localhost", "./static/img", "static", "avatars"), repo, repoShr
+}
+
+func TestAddToBoard_Contributor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, repo, repoShr := newTestBoardService(ctrl)
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleContributor, nil)
+	repo.EXPECT().AddToBoard(gomock.Any(), 1, 2, 3, 4).Return(nil)
+	repo.EXPECT().
+		RecordActivity(gomock.Any(), domain.BoardActivity{BoardID: 1, ActorID: 2, Action: domain.ActivityFlowAdded, FlowID: 3}).
+		Return(nil)
+
+	assert.NoError(t, service.AddToBoard(context.Background(), 1, 2, 3, 4))
+}
+
+func TestAddToBoard_Viewer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, _, repoShr := newTestBoardService(ctrl)
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleViewer, nil)
+
+	assert.ErrorIs(t, service.AddToBoard(context.Background(), 1, 2, 3, 0), ErrForbidden)
+}
+
+func TestDeleteFromBoard_ContributorForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, _, repoShr := newTestBoardService(ctrl)
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleContributor, nil)
+
+	assert.ErrorIs(t, service.DeleteFromBoard(context.Background(), 1, 2, 3), ErrForbidden)
+}
+
+func TestUpdateBoard_AdminForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, _, repoShr := newTestBoardService(ctrl)
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleAdmin, nil)
+
+	assert.ErrorIs(t, service.UpdateBoard(context.Background(), 1, 2, domain.UpdateData{Name: "name"}), ErrForbidden)
+}
+
+func TestUpdateBoard_PrivateAndSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, _, _ := newTestBoardService(ctrl)
+
+	update := domain.UpdateData{Name: "name", IsPrivate: true, IsSecret: true}
+	assert.ErrorIs(t, service.UpdateBoard(context.Background(), 1, 2, update), domain.ErrInvalidVisibility)
+}
+
+func TestUpdateBoard_NormalizesTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, repo, repoShr := newTestBoardService(ctrl)
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleOwner, nil)
+	repo.EXPECT().GetBoard(gomock.Any(), 1, 2, 0, 0).Return(domain.Board{ID: 1, Name: "name"}, nil, nil)
+	repo.EXPECT().
+		UpdateBoard(gomock.Any(), 1, 2, domain.UpdateData{Name: "name", Tags: []string{"кухня", "десерты"}}).
+		Return(nil)
+
+	update := domain.UpdateData{Name: "name", Tags: []string{" Кухня ", "десерты", "кухня"}}
+	assert.NoError(t, service.UpdateBoard(context.Background(), 1, 2, update))
+}
+
+func TestUpdateBoard_RecordsRenameAndPrivacy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, repo, repoShr := newTestBoardService(ctrl)
+	update := domain.UpdateData{Name: "new", IsSecret: true}
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleOwner, nil)
+	repo.EXPECT().GetBoard(gomock.Any(), 1, 2, 0, 0).Return(domain.Board{ID: 1, Name: "old"}, nil, nil)
+	repo.EXPECT().UpdateBoard(gomock.Any(), 1, 2, update).Return(nil)
+	repo.EXPECT().
+		RecordActivity(gomock.Any(), domain.BoardActivity{
+			BoardID: 1,
+			ActorID: 2,
+			Action:  domain.ActivityBoardRenamed,
+			Details: map[string]string{"old_name": "old", "new_name": "new"},
+		}).
+		Return(nil)
+	repo.EXPECT().
+		RecordActivity(gomock.Any(), domain.BoardActivity{
+			BoardID: 1,
+			ActorID: 2,
+			Action:  domain.ActivityPrivacyChanged,
+			Details: map[string]string{"old_visibility": "public", "new_visibility": "secret"},
+		}).
+		Return(nil)
+
+	assert.NoError(t, service.UpdateBoard(context.Background(), 1, 2, update))
+}
+
+func TestGetBoardActivity_NotMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, _, repoShr := newTestBoardService(ctrl)
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return("", nil)
+
+	_, err := service.GetBoardActivity(context.Background(), 1, 2, 1, 20)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestMoveFlow_RequiresRoleOnBothBoards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, repo, repoShr := newTestBoardService(ctrl)
+	move := domain.FlowMove{TargetBoardID: 5}
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleEditor, nil).Times(2)
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 5, 2).Return(domain.BoardRoleViewer, nil)
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 5, 2).Return(domain.BoardRoleContributor, nil)
+	repo.EXPECT().MoveFlow(gomock.Any(), 1, 3, 2, move).Return(nil)
+	repo.EXPECT().
+		RecordActivity(gomock.Any(), domain.BoardActivity{BoardID: 1, ActorID: 2, Action: domain.ActivityFlowRemoved, FlowID: 3}).
+		Return(nil)
+	repo.EXPECT().
+		RecordActivity(gomock.Any(), domain.BoardActivity{BoardID: 5, ActorID: 2, Action: domain.ActivityFlowAdded, FlowID: 3}).
+		Return(nil)
+
+	assert.ErrorIs(t, service.MoveFlow(context.Background(), 1, 3, 2, move), ErrForbidden)
+	assert.NoError(t, service.MoveFlow(context.Background(), 1, 3, 2, move))
+}
+
+func TestMoveFlow_InvalidPosition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, _, _ := newTestBoardService(ctrl)
+
+	err := service.MoveFlow(context.Background(), 1, 3, 2, domain.FlowMove{AfterID: 4, BeforeID: 4})
+	assert.ErrorIs(t, err, domain.ErrInvalidPosition)
+}
+
+func TestBulkFlows_MoveRecordsActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, repo, repoShr := newTestBoardService(ctrl)
+	req := domain.BulkFlowRequest{Action: domain.BulkActionMove, FlowIDs: []int{3, 4}, TargetBoardID: 5}
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleEditor, nil)
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 5, 2).Return(domain.BoardRoleContributor, nil)
+	repo.EXPECT().BulkMoveFlows(gomock.Any(), 1, 5, 2, []int{3, 4}).Return([]domain.BulkFlowResult{
+		{FlowID: 3, Status: domain.BulkStatusOK},
+		{FlowID: 4, Status: domain.BulkStatusAlreadySaved},
+	}, nil)
+	repo.EXPECT().
+		RecordActivity(gomock.Any(), domain.BoardActivity{BoardID: 1, ActorID: 2, Action: domain.ActivityFlowRemoved, FlowID: 3}).
+		Return(nil)
+	repo.EXPECT().
+		RecordActivity(gomock.Any(), domain.BoardActivity{BoardID: 5, ActorID: 2, Action: domain.ActivityFlowAdded, FlowID: 3}).
+		Return(nil)
+
+	results, err := service.BulkFlows(context.Background(), 1, 2, req)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+}
+
+func TestBulkFlows_RemoveRequiresEditor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, _, repoShr := newTestBoardService(ctrl)
+	req := domain.BulkFlowRequest{Action: domain.BulkActionRemove, FlowIDs: []int{3}}
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleContributor, nil)
+
+	_, err := service.BulkFlows(context.Background(), 1, 2, req)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestMergeBoards_RequiresOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, repo, repoShr := newTestBoardService(ctrl)
+
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleAdmin, nil)
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 1, 2).Return(domain.BoardRoleOwner, nil)
+	repoShr.EXPECT().GetBoardRole(gomock.Any(), 5, 2).Return(domain.BoardRoleEditor, nil)
+	repo.EXPECT().MergeBoards(gomock.Any(), 1, 5, 2).Return([]int{3}, nil)
+	repo.EXPECT().
+		RecordActivity(gomock.Any(), domain.BoardActivity{BoardID: 5, ActorID: 2, Action: domain.ActivityFlowAdded, FlowID: 3}).
+		Return(nil)
+
+	assert.ErrorIs(t, service.MergeBoards(context.Background(), 1, 5, 2), ErrForbidden)
+	assert.NoError(t, service.MergeBoards(context.Background(), 1, 5, 2))
+	assert.ErrorIs(t, service.MergeBoards(context.Background(), 1, 1, 2), domain.ErrInvalidBulkRequest)
+}
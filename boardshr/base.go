@@ -2,6 +2,7 @@ package boardshr
 
 import (
 	"context"
+	"log"
 	"path/filepath"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
@@ -11,6 +12,9 @@ type BoardShrRepository interface {
 	CreateInvitation(ctx context.Context, boardID int, UserID int, invitation domain.Invitaion, inviteeIDs []int) (string, error)
 	DeleteInvitation(ctx context.Context, boardID int, link string) error
 	GetInvitationLinks(ctx context.Context, boardID int) ([]domain.LinkParams, error)
+	SetInvitationPaused(ctx context.Context, boardID int, link string, paused bool) error
+	RegenerateInvitation(ctx context.Context, boardID int, link string) (string, error)
+	GetInvitationJoins(ctx context.Context, boardID int, link string) ([]domain.InvitationJoin, error)
 
 	AddBoardCoauthorByLink(ctx context.Context, boardID int, userID int, link string) error
 	DeleteCoauthor(ctx context.Context, boardID int, userID int) error
@@ -19,12 +23,23 @@ type BoardShrRepository interface {
 
 	IsBoardAuthor(ctx context.Context, boardID int, userID int) (bool, error)
 	IsBoardEditor(ctx context.Context, boardID int, userID int) (bool, error)
+	GetBoardRole(ctx context.Context, boardID int, userID int) (string, error)
+	GetCoauthorRole(ctx context.Context, boardID int, coauthorID int) (string, error)
+	SetCoauthorRole(ctx context.Context, boardID int, coauthorID int, role string) error
 
 	GetUserIDsFromUsernames(ctx context.Context, names []string) ([]NameToID, error)
 	GetUserIDFromUsername(ctx context.Context, name string) (int, error)
 	GetUsernameFromUserID(ctx context.Context, userID int) (string, error)
 
 	GetLinkParams(ctx context.Context, link string) (int, domain.LinkParams, error)
+
+	CreateOwnershipTransfer(ctx context.Context, boardID int, fromUserID int, toUserID int) (domain.OwnershipTransfer, error)
+	GetPendingOwnershipTransfer(ctx context.Context, boardID int) (domain.OwnershipTransfer, error)
+	ResolveOwnershipTransfer(ctx context.Context, transferID int, status string) error
+	AcceptOwnershipTransfer(ctx context.Context, transferID int) error
+	LeaveBoard(ctx context.Context, boardID int, authorID int) (int, error)
+
+	RecordActivity(ctx context.Context, activity domain.BoardActivity) error
 }
 
 type BoardShrService struct {
@@ -43,6 +58,26 @@ func NewBoardShrService(b BoardShrRepository, baseURL, staticDir, avatarDir stri
 	}
 }
 
+// requireRole возвращает роль пользователя на доске, если она не ниже required.
+func (b *BoardShrService) requireRole(ctx context.Context, boardID int, userID int, required string) (string, error) {
+	role, err := b.repo.GetBoardRole(ctx, boardID, userID)
+	if err != nil {
+		return "", err
+	}
+	if !domain.BoardRoleAllows(role, required) {
+		return "", ErrForbbiden
+	}
+
+	return role, nil
+}
+
+// recordActivity пишет в журнал доски. Действие уже выполнено, поэтому ошибка только логируется.
+func (b *BoardShrService) recordActivity(ctx context.Context, activity domain.BoardActivity) {
+	if err := b.repo.RecordActivity(ctx, activity); err != nil {
+		log.Printf("failed to record board activity %s: %v", activity.Action, err)
+	}
+}
+
 func (b *BoardShrService) generateAvatarURL(filename string) string {
 	if filename == "" {
 		return ""
@@ -1,16 +1,17 @@
 package boardshr
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
 
 func (b *BoardShrService) DeleteCoauthor(ctx context.Context, boardID int, userID int, coauthorName string) error {
-	// Проверка, что пользователь является автором доски.
-	isAuthor, err := b.repo.IsBoardAuthor(ctx, boardID, userID)
+	// Проверка, что пользователь может управлять соавторами (автор или admin).
+	role, err := b.requireRole(ctx, boardID, userID, domain.BoardRoleAdmin)
 	if err != nil {
 		return err
 	}
-	if !isAuthor {
-		return ErrForbbiden
-	}
 
 	// Получение ID соавтора по имени.
 	coauthorID, err := b.repo.GetUserIDFromUsername(ctx, coauthorName)
@@ -18,11 +19,27 @@ func (b *BoardShrService) DeleteCoauthor(ctx context.Context, boardID int, userI
 		return err
 	}
 
+	// Удалить можно только соавтора с ролью ниже своей.
+	coauthorRole, err := b.repo.GetCoauthorRole(ctx, boardID, coauthorID)
+	if err != nil {
+		return err
+	}
+	if !domain.CanManageBoardRole(role, coauthorRole) {
+		return ErrForbbiden
+	}
+
 	// Удаление соавтора.
 	err = b.repo.DeleteCoauthor(ctx, boardID, coauthorID)
 	if err != nil {
 		return err
 	}
 
+	b.recordActivity(ctx, domain.BoardActivity{
+		BoardID:  boardID,
+		ActorID:  userID,
+		Action:   domain.ActivityCoauthorRemoved,
+		TargetID: coauthorID,
+	})
+
 	return nil
 }
\ No newline at end of file
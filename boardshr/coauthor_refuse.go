@@ -2,6 +2,7 @@ package boardshr
 
 import (
 	"context"
+	"errors"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 )
@@ -16,13 +17,29 @@ func (b *BoardShrService) RefuseCoauthoring(ctx context.Context, boardID int, us
 		return domain.ErrForbidden
 	}
 
-	// Проверка, что пользователь не является автором доски (автор не может покинуть доску).
+	// Автор покидает доску, передавая её самому давнему соавтору.
+	// Если соавторов нет, доску можно только удалить.
 	isAuthor, err := b.repo.IsBoardAuthor(ctx, boardID, userID)
 	if err != nil {
 		return err
 	}
 	if isAuthor {
-		return ErrAuthorRefuseEditing
+		successorID, err := b.repo.LeaveBoard(ctx, boardID, userID)
+		if errors.Is(err, ErrNoSuccessor) {
+			return ErrAuthorRefuseEditing
+		}
+		if err != nil {
+			return err
+		}
+
+		b.recordActivity(ctx, domain.BoardActivity{
+			BoardID:  boardID,
+			ActorID:  userID,
+			Action:   domain.ActivityCoauthorLeft,
+			TargetID: successorID,
+		})
+
+		return nil
 	}
 
 	err = b.repo.DeleteCoauthor(ctx, boardID, userID)
@@ -30,5 +47,11 @@ func (b *BoardShrService) RefuseCoauthoring(ctx context.Context, boardID int, us
 		return err
 	}
 
+	b.recordActivity(ctx, domain.BoardActivity{
+		BoardID: boardID,
+		ActorID: userID,
+		Action:  domain.ActivityCoauthorLeft,
+	})
+
 	return nil
 }
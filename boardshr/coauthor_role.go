@@ -0,0 +1,40 @@
+package boardshr
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+func (b *BoardShrService) ChangeCoauthorRole(ctx context.Context, boardID int, userID int, coauthorName string, newRole string) error {
+	if !domain.IsAssignableBoardRole(newRole) {
+		return domain.ErrInvalidBoardRole
+	}
+
+	// Проверка, что пользователь может управлять соавторами (автор или admin).
+	role, err := b.requireRole(ctx, boardID, userID, domain.BoardRoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	// Получение ID соавтора по имени.
+	coauthorID, err := b.repo.GetUserIDFromUsername(ctx, coauthorName)
+	if err != nil {
+		return err
+	}
+
+	// Менять можно только роли ниже своей, и только на роль ниже своей.
+	currentRole, err := b.repo.GetCoauthorRole(ctx, boardID, coauthorID)
+	if err != nil {
+		return err
+	}
+	if !domain.CanManageBoardRole(role, currentRole) || !domain.CanManageBoardRole(role, newRole) {
+		return ErrForbbiden
+	}
+
+	if currentRole == newRole {
+		return nil
+	}
+
+	return b.repo.SetCoauthorRole(ctx, boardID, coauthorID, newRole)
+}
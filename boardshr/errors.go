@@ -12,4 +12,9 @@ var (
 	ErrFailCoauthorInsert   = errors.New("failed to add a coauthor")
 	ErrFailCoauthorDelete   = errors.New("failed to delete a coauthor")
 	ErrAuthorRefuseEditing  = errors.New("author can't refuse editing")
+	ErrNotCoauthor          = errors.New("user is not a coauthor of the board")
+	ErrTransferNotFound     = errors.New("ownership transfer not found")
+	ErrNoSuccessor          = errors.New("board has no coauthors to hand over to")
+	ErrLinkPaused           = errors.New("link is paused")
+	ErrInvalidQRFormat      = errors.New("unsupported qr code format")
 )
@@ -12,10 +12,21 @@ type NameToID struct {
 }
 
 func (b *BoardShrService) CreateInvitation(ctx context.Context, boardID int, userID int, invitation domain.Invitaion) (string, []string, error) {
-	// Проверка, что пользователь является автором доски.
-	isAuthor, err := b.repo.IsBoardAuthor(ctx, boardID, userID)
-	if !isAuthor || err != nil {
-		return "", nil, domain.ErrForbidden
+	// Проверка, что пользователь может приглашать (автор или admin).
+	role, err := b.requireRole(ctx, boardID, userID, domain.BoardRoleAdmin)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Проверка роли, которую получат приглашённые: выдать можно только роль ниже своей.
+	if invitation.Role == "" {
+		invitation.Role = domain.DefaultBoardRole
+	}
+	if !domain.IsAssignableBoardRole(invitation.Role) {
+		return "", nil, domain.ErrInvalidBoardRole
+	}
+	if !domain.CanManageBoardRole(role, invitation.Role) {
+		return "", nil, ErrForbbiden
 	}
 
 	// Индексы валидных имён и невалидные имена.
@@ -45,6 +56,13 @@ func (b *BoardShrService) CreateInvitation(ctx context.Context, boardID int, use
 		return "", nil, err
 	}
 
+	b.recordActivity(ctx, domain.BoardActivity{
+		BoardID: boardID,
+		ActorID: userID,
+		Action:  domain.ActivityInvitationCreated,
+		Details: map[string]string{"role": invitation.Role},
+	})
+
 	// В случае частичного успеха также возвращаются имена, которых нет в БД.
 	if len(invalidInviteeNames) != 0 {
 		return link, invalidInviteeNames, ErrNonExistentUsername
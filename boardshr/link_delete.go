@@ -7,17 +7,22 @@ import (
 )
 
 func (b *BoardShrService) DeleteInvitation(ctx context.Context, boardID int, userID int, link string) error {
-	// Проверка, что пользователь является автором доски.
-	isAuthor, err := b.repo.IsBoardAuthor(ctx, boardID, userID)
-	if !isAuthor || err != nil {
-		return domain.ErrForbidden
+	// Проверка, что пользователь может управлять приглашениями (автор или admin).
+	if _, err := b.requireRole(ctx, boardID, userID, domain.BoardRoleAdmin); err != nil {
+		return err
 	}
 	
 	// Удаление ссылки.
-	err = b.repo.DeleteInvitation(ctx, boardID, link)
+	err := b.repo.DeleteInvitation(ctx, boardID, link)
 	if err != nil {
 		return err
 	}
 
+	b.recordActivity(ctx, domain.BoardActivity{
+		BoardID: boardID,
+		ActorID: userID,
+		Action:  domain.ActivityInvitationRevoked,
+	})
+
 	return nil
 }
\ No newline at end of file
@@ -0,0 +1,28 @@
+package boardshr
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+func (b *BoardShrService) GetInvitationJoins(ctx context.Context, boardID int, userID int, link string) ([]domain.InvitationJoin, error) {
+	// Проверка, что пользователь может управлять приглашениями (автор или admin).
+	if _, err := b.requireRole(ctx, boardID, userID, domain.BoardRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	joins, err := b.repo.GetInvitationJoins(ctx, boardID, link)
+	if err != nil {
+		return nil, err
+	}
+
+	// Генерация ссылок на аватары.
+	for i := range joins {
+		if !joins[i].IsExternalAvatar {
+			joins[i].Avatar = b.generateAvatarURL(joins[i].Avatar)
+		}
+	}
+
+	return joins, nil
+}
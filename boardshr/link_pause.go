@@ -0,0 +1,17 @@
+package boardshr
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+func (b *BoardShrService) SetInvitationPaused(ctx context.Context, boardID int, userID int, link string, paused bool) error {
+	// Проверка, что пользователь может управлять приглашениями (автор или admin).
+	if _, err := b.requireRole(ctx, boardID, userID, domain.BoardRoleAdmin); err != nil {
+		return err
+	}
+
+	// Приостановленная ссылка сохраняет параметры и счётчик использований.
+	return b.repo.SetInvitationPaused(ctx, boardID, link, paused)
+}
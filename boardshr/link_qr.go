@@ -0,0 +1,54 @@
+package boardshr
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/qrcode"
+)
+
+// форматы QR-кода ссылки
+const (
+	QRFormatPNG = "png"
+	QRFormatSVG = "svg"
+)
+
+// размер модуля PNG в пикселях
+const qrPNGScale = 8
+
+func (b *BoardShrService) GetInvitationQR(ctx context.Context, boardID int, userID int, link string, format string) ([]byte, error) {
+	if format != QRFormatPNG && format != QRFormatSVG {
+		return nil, ErrInvalidQRFormat
+	}
+
+	// Проверка, что пользователь может управлять приглашениями (автор или admin).
+	if _, err := b.requireRole(ctx, boardID, userID, domain.BoardRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	// Проверка, что ссылка принадлежит доске.
+	linkBoardID, _, err := b.repo.GetLinkParams(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+	if linkBoardID != boardID {
+		return nil, ErrLinkNotFound
+	}
+
+	code, err := qrcode.Encode([]byte(b.invitationURL(link)))
+	if err != nil {
+		return nil, err
+	}
+
+	if format == QRFormatSVG {
+		return code.SVG(), nil
+	}
+
+	return code.PNG(qrPNGScale)
+}
+
+// invitationURL — адрес страницы вступления, который открывает сканер
+func (b *BoardShrService) invitationURL(link string) string {
+	return strings.TrimRight(b.baseURL, "/") + "/join/" + link
+}
@@ -0,0 +1,28 @@
+package boardshr
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+func (b *BoardShrService) RegenerateInvitation(ctx context.Context, boardID int, userID int, link string) (string, error) {
+	// Проверка, что пользователь может управлять приглашениями (автор или admin).
+	if _, err := b.requireRole(ctx, boardID, userID, domain.BoardRoleAdmin); err != nil {
+		return "", err
+	}
+
+	// Старая ссылка перестаёт действовать, параметры и история переходят к новой.
+	newLink, err := b.repo.RegenerateInvitation(ctx, boardID, link)
+	if err != nil {
+		return "", err
+	}
+
+	b.recordActivity(ctx, domain.BoardActivity{
+		BoardID: boardID,
+		ActorID: userID,
+		Action:  domain.ActivityInvitationRenewed,
+	})
+
+	return newLink, nil
+}
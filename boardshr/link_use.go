@@ -4,6 +4,8 @@ import (
 	"context"
 	"slices"
 	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 )
 
 func (b *BoardShrService) UseInvitationLink(ctx context.Context, userID int, link string) (int, error) {
@@ -22,6 +24,11 @@ func (b *BoardShrService) UseInvitationLink(ctx context.Context, userID int, lin
 		return boardID, ErrAlreadyEditor
 	}
 
+	// Проверка, что ссылка не приостановлена.
+	if linkParams.IsPaused {
+		return 0, ErrLinkPaused
+	}
+
 	// Для персональных ссылок: проверка на право пользования.
 	if linkParams.Names != nil {
 		name, err := b.repo.GetUsernameFromUserID(ctx, userID)
@@ -49,5 +56,12 @@ func (b *BoardShrService) UseInvitationLink(ctx context.Context, userID int, lin
 		return 0, err
 	}
 
+	b.recordActivity(ctx, domain.BoardActivity{
+		BoardID: boardID,
+		ActorID: userID,
+		Action:  domain.ActivityCoauthorJoined,
+		Details: map[string]string{"role": linkParams.Role},
+	})
+
 	return boardID, nil
 }
@@ -7,10 +7,9 @@ import (
 )
 
 func (b *BoardShrService) GetInvitationLinks(ctx context.Context, boardID int, userID int) ([]domain.LinkParams, error) {
-	// Проверка, что пользователь является автором доски.
-	isAuthor, err := b.repo.IsBoardAuthor(ctx, boardID, userID)
-	if !isAuthor || err != nil {
-		return nil, domain.ErrForbidden
+	// Проверка, что пользователь может управлять приглашениями (автор или admin).
+	if _, err := b.requireRole(ctx, boardID, userID, domain.BoardRoleAdmin); err != nil {
+		return nil, err
 	}
 
 	// Получение ссылок на доску.
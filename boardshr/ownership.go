@@ -0,0 +1,83 @@
+package boardshr
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+func (b *BoardShrService) ProposeOwnershipTransfer(ctx context.Context, boardID int, userID int, coauthorName string) (domain.OwnershipTransfer, error) {
+	// Проверка, что пользователь является автором доски.
+	isAuthor, err := b.repo.IsBoardAuthor(ctx, boardID, userID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	if !isAuthor {
+		return domain.OwnershipTransfer{}, ErrForbbiden
+	}
+
+	// Получение ID соавтора по имени.
+	coauthorID, err := b.repo.GetUserIDFromUsername(ctx, coauthorName)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	// Передать доску можно только соавтору.
+	if _, err := b.repo.GetCoauthorRole(ctx, boardID, coauthorID); err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	transfer, err := b.repo.CreateOwnershipTransfer(ctx, boardID, userID, coauthorID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	transfer.ToUsername = coauthorName
+
+	return transfer, nil
+}
+
+func (b *BoardShrService) GetOwnershipTransfer(ctx context.Context, boardID int, userID int) (domain.OwnershipTransfer, error) {
+	transfer, err := b.repo.GetPendingOwnershipTransfer(ctx, boardID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	// Предложение видят только его участники.
+	if transfer.FromUserID != userID && transfer.ToUserID != userID {
+		return domain.OwnershipTransfer{}, ErrTransferNotFound
+	}
+
+	return transfer, nil
+}
+
+func (b *BoardShrService) AcceptOwnershipTransfer(ctx context.Context, boardID int, userID int) error {
+	transfer, err := b.repo.GetPendingOwnershipTransfer(ctx, boardID)
+	if err != nil {
+		return err
+	}
+
+	// Принять предложение может только тот, кому оно адресовано.
+	if transfer.ToUserID != userID {
+		return ErrTransferNotFound
+	}
+
+	return b.repo.AcceptOwnershipTransfer(ctx, transfer.ID)
+}
+
+// RejectOwnershipTransfer отменяет предложение, если его вызывает автор,
+// и отклоняет, если адресат.
+func (b *BoardShrService) RejectOwnershipTransfer(ctx context.Context, boardID int, userID int) error {
+	transfer, err := b.repo.GetPendingOwnershipTransfer(ctx, boardID)
+	if err != nil {
+		return err
+	}
+
+	switch userID {
+	case transfer.FromUserID:
+		return b.repo.ResolveOwnershipTransfer(ctx, transfer.ID, domain.OwnershipTransferCancelled)
+	case transfer.ToUserID:
+		return b.repo.ResolveOwnershipTransfer(ctx, transfer.ID, domain.OwnershipTransferDeclined)
+	default:
+		return ErrTransferNotFound
+	}
+}
@@ -0,0 +1,47 @@
+package classifier
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	gen "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/classifier"
+)
+
+// GrpcClassifier обращается к сервису cv по gRPC
+type GrpcClassifier struct {
+	client gen.ClassifierClient
+}
+
+func NewGrpcClassifier(client gen.ClassifierClient) *GrpcClassifier {
+	return &GrpcClassifier{
+		client: client,
+	}
+}
+
+func (c *GrpcClassifier) Classify(ctx context.Context, filename string) (domain.Classification, error) {
+	resp, err := c.client.Classify(ctx, &gen.ClassifyRequest{
+		Filename: filename,
+	})
+	if err != nil {
+		return domain.Classification{}, err
+	}
+
+	return domain.Classification{
+		IsNSFW:     resp.GetIsNsfw(),
+		Confidence: resp.GetConfidence(),
+		Reason:     resp.GetReason(),
+		Tags:       grpcToTags(resp),
+	}, nil
+}
+
+func grpcToTags(resp *gen.ClassifyResponse) []domain.MachineTag {
+	var tags []domain.MachineTag
+	for _, tag := range resp.GetScoredTags() {
+		tags = append(tags, domain.MachineTag{
+			Name:       tag.GetName(),
+			Confidence: tag.GetConfidence(),
+		})
+	}
+
+	return tags
+}
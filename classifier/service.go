@@ -0,0 +1,141 @@
+package classifier
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/hashtag"
+)
+
+type Classifier interface {
+	Classify(ctx context.Context, filename string) (domain.Classification, error)
+}
+
+type JobRepository interface {
+	ClaimJobs(ctx context.Context, limit int, lease time.Duration) ([]domain.ClassificationJob, error)
+	CompleteJob(ctx context.Context, job domain.ClassificationJob, result domain.Classification) error
+	RetryJob(ctx context.Context, job domain.ClassificationJob, runAt time.Time, reason string) error
+	FailJob(ctx context.Context, job domain.ClassificationJob, reason string) error
+}
+
+type Config struct {
+	PollInterval   time.Duration // как часто проверять очередь
+	BatchSize      int           // сколько задач забирать за раз
+	Lease          time.Duration // через сколько зависшая задача снова станет доступна
+	MaxAttempts    int
+	RetryBackoff   time.Duration // задержка перед повтором, растёт линейно с числом попыток
+	RequestTimeout time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		PollInterval:   2 * time.Second,
+		BatchSize:      10,
+		Lease:          time.Minute,
+		MaxAttempts:    5,
+		RetryBackoff:   30 * time.Second,
+		RequestTimeout: 30 * time.Second,
+	}
+}
+
+type ClassificationService struct {
+	classifier Classifier
+	repo       JobRepository
+	cfg        Config
+	now        func() time.Time
+}
+
+func NewClassificationService(classifier Classifier, repo JobRepository, cfg Config) *ClassificationService {
+	return &ClassificationService{
+		classifier: classifier,
+		repo:       repo,
+		cfg:        cfg,
+		now:        time.Now,
+	}
+}
+
+// Run обрабатывает очередь до отмены контекста. Очередь хранится в базе,
+// поэтому после перезапуска необработанные задачи подхватываются заново.
+func (s *ClassificationService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			processed, err := s.ProcessBatch(ctx)
+			if err != nil {
+				log.Printf("classification queue error: %v", err)
+				break
+			}
+			if processed < s.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ClassificationService) ProcessBatch(ctx context.Context) (int, error) {
+	jobs, err := s.repo.ClaimJobs(ctx, s.cfg.BatchSize, s.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, job := range jobs {
+		if err := s.processJob(ctx, job); err != nil {
+			log.Printf("classification job %d (flow %d) error: %v", job.ID, job.FlowID, err)
+		}
+	}
+
+	return len(jobs), nil
+}
+
+func (s *ClassificationService) processJob(ctx context.Context, job domain.ClassificationJob) error {
+	classifyCtx, cancel := context.WithTimeout(ctx, s.cfg.RequestTimeout)
+	result, err := s.classifier.Classify(classifyCtx, job.MediaURL)
+	cancel()
+
+	if err == nil {
+		result.Tags = filterTags(result.Tags)
+		return s.repo.CompleteJob(ctx, job, result)
+	}
+
+	// attempts уже учитывает текущую попытку
+	if job.Attempts >= s.cfg.MaxAttempts {
+		if failErr := s.repo.FailJob(ctx, job, err.Error()); failErr != nil {
+			return failErr
+		}
+		return err
+	}
+
+	runAt := s.now().Add(s.cfg.RetryBackoff * time.Duration(job.Attempts))
+	if retryErr := s.repo.RetryJob(ctx, job, runAt, err.Error()); retryErr != nil {
+		return retryErr
+	}
+
+	return err
+}
+
+// filterTags оставляет уверенные машинные теги в том же виде, что и хэштеги
+func filterTags(tags []domain.MachineTag) []domain.MachineTag {
+	var filtered []domain.MachineTag
+	for _, tag := range tags {
+		if tag.Confidence < domain.MinMachineTagConfidence {
+			continue
+		}
+		name, ok := hashtag.Normalize(tag.Name)
+		if !ok {
+			continue
+		}
+		filtered = append(filtered, domain.MachineTag{Name: name, Confidence: tag.Confidence})
+	}
+
+	return filtered
+}
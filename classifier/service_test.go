@@ -0,0 +1,123 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	mock_classifier "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/classifier/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestService(classifier Classifier, repo JobRepository) *ClassificationService {
+	cfg := DefaultConfig()
+	cfg.MaxAttempts = 3
+	cfg.RetryBackoff = time.Minute
+
+	service := NewClassificationService(classifier, repo, cfg)
+	service.now = func() time.Time {
+		return time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	return service
+}
+
+func TestProcessBatch_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_classifier.NewMockJobRepository(ctrl)
+	result := domain.Classification{IsNSFW: true, Confidence: 0.93, Reason: "nsfw_model"}
+	stub := NewStubClassifier(result, nil)
+	service := newTestService(stub, mockRepo)
+
+	jobs := []domain.ClassificationJob{
+		{ID: 1, FlowID: 10, MediaURL: "a.jpg", Attempts: 1},
+		{ID: 2, FlowID: 11, MediaURL: "b.jpg", Attempts: 1},
+	}
+
+	mockRepo.EXPECT().ClaimJobs(gomock.Any(), 10, time.Minute).Return(jobs, nil)
+	mockRepo.EXPECT().CompleteJob(gomock.Any(), jobs[0], result).Return(nil)
+	mockRepo.EXPECT().CompleteJob(gomock.Any(), jobs[1], result).Return(nil)
+
+	processed, err := service.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, processed)
+	assert.Equal(t, []string{"a.jpg", "b.jpg"}, stub.Calls())
+}
+
+func TestProcessBatch_Retry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_classifier.NewMockJobRepository(ctrl)
+	service := newTestService(NewStubClassifier(domain.Classification{}, errors.New("unavailable")), mockRepo)
+
+	job := domain.ClassificationJob{ID: 1, FlowID: 10, MediaURL: "a.jpg", Attempts: 2}
+
+	mockRepo.EXPECT().ClaimJobs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.ClassificationJob{job}, nil)
+	mockRepo.EXPECT().RetryJob(gomock.Any(), job, service.now().Add(2*time.Minute), "unavailable").Return(nil)
+
+	processed, err := service.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+}
+
+func TestProcessBatch_MaxAttempts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_classifier.NewMockJobRepository(ctrl)
+	service := newTestService(NewStubClassifier(domain.Classification{}, errors.New("file not found")), mockRepo)
+
+	job := domain.ClassificationJob{ID: 1, FlowID: 10, MediaURL: "a.jpg", Attempts: 3}
+
+	mockRepo.EXPECT().ClaimJobs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.ClassificationJob{job}, nil)
+	mockRepo.EXPECT().FailJob(gomock.Any(), job, "file not found").Return(nil)
+
+	_, err := service.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestProcessBatch_ClaimError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_classifier.NewMockJobRepository(ctrl)
+	service := newTestService(NewStubClassifier(domain.Classification{}, nil), mockRepo)
+
+	mockRepo.EXPECT().ClaimJobs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+	processed, err := service.ProcessBatch(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 0, processed)
+}
+
+func TestProcessBatch_FiltersTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_classifier.NewMockJobRepository(ctrl)
+	stub := NewStubClassifier(domain.Classification{
+		Tags: []domain.MachineTag{
+			{Name: "Beach", Confidence: 0.8},
+			{Name: "sunset", Confidence: 0.1},
+			{Name: "two words", Confidence: 0.9},
+		},
+	}, nil)
+	service := newTestService(stub, mockRepo)
+
+	job := domain.ClassificationJob{ID: 1, FlowID: 10, MediaURL: "a.jpg", Attempts: 1}
+	expected := domain.Classification{
+		Tags: []domain.MachineTag{{Name: "beach", Confidence: 0.8}},
+	}
+
+	mockRepo.EXPECT().ClaimJobs(gomock.Any(), 10, time.Minute).Return([]domain.ClassificationJob{job}, nil)
+	mockRepo.EXPECT().CompleteJob(gomock.Any(), job, expected).Return(nil)
+
+	_, err := service.ProcessBatch(context.Background())
+	assert.NoError(t, err)
+}
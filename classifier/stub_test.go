@@ -0,0 +1,44 @@
+package classifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+// StubClassifier возвращает заранее заданный результат вместо сервиса cv
+type StubClassifier struct {
+	Result domain.Classification
+	Err    error
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func NewStubClassifier(result domain.Classification, err error) *StubClassifier {
+	return &StubClassifier{
+		Result: result,
+		Err:    err,
+	}
+}
+
+func (c *StubClassifier) Classify(ctx context.Context, filename string) (domain.Classification, error) {
+	c.mu.Lock()
+	c.calls = append(c.calls, filename)
+	c.mu.Unlock()
+
+	if c.Err != nil {
+		return domain.Classification{}, c.Err
+	}
+
+	return c.Result, nil
+}
+
+// Calls возвращает имена файлов, которые передавались в Classify
+func (c *StubClassifier) Calls() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string(nil), c.calls...)
+}
@@ -8,10 +8,16 @@ import (
 )
 
 type CommentRepository interface {
-	GetComments(ctx context.Context, flowID, userID, page, size int) ([]domain.Comment, error)
+	GetComments(ctx context.Context, flowID, userID, page, size int, sort string) ([]domain.Comment, error)
+	GetReplies(ctx context.Context, commentID, userID, page, size int, sort string) ([]domain.Comment, error)
+	GetComment(ctx context.Context, commentID int) (domain.Comment, error)
 	LikeComment(ctx context.Context, commentID, userID int) (string, error)
-	AddComment(ctx context.Context, flowID, userID int, content string) error
+	AddComment(ctx context.Context, flowID, userID int, content string, parentID *int, depth int) (int, error)
 	DeleteComment(ctx context.Context, commentID, userID int) error
+	EditComment(ctx context.Context, commentID, userID int, content string) error
+	HideComment(ctx context.Context, commentID int, hidden bool) error
+	PinComment(ctx context.Context, flowID, commentID int, pinned bool) error
+	IsFollower(ctx context.Context, userID, targetID int) (bool, error)
 }
 
 type PinRepository interface {
@@ -36,21 +42,41 @@ func NewCommentService(repo CommentRepository, pinRepo PinRepository, baseURL, s
 	}
 }
 
-func (s *CommentService) GetComments(ctx context.Context, flowID, userID, page, size int) ([]domain.Comment, error) {
-	comments, err := s.repo.GetComments(ctx, flowID, userID, page, size)
+func (s *CommentService) GetComments(ctx context.Context, flowID, userID, page, size int, sort string) ([]domain.Comment, error) {
+	comments, err := s.repo.GetComments(ctx, flowID, userID, page, size, sort)
 	if err != nil {
 		return nil, err
 	}
 
-	for i := range comments {
-		if !comments[i].AuthorIsExternalAvatar {
-			comments[i].AuthorAvatar = s.generateAvatarURL(comments[i].AuthorAvatar)
-		}
-	}
+	s.fillAvatars(comments)
 
 	return comments, nil
 }
 
+func (s *CommentService) GetReplies(ctx context.Context, flowID, commentID, userID, page, size int, sort string) ([]domain.Comment, error) {
+	parent, err := s.repo.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if parent.FlowID != flowID {
+		return nil, domain.ErrNotFound
+	}
+
+	if _, _, err := s.pinRepo.GetPin(ctx, uint64(flowID), uint64(userID)); err != nil {
+		return nil, err
+	}
+
+	replies, err := s.repo.GetReplies(ctx, commentID, userID, page, size, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillAvatars(replies)
+
+	return replies, nil
+}
+
 func (s *CommentService) LikeComment(ctx context.Context, flowID, commentID, userID int) (string, error) {
 	like, err := s.repo.LikeComment(ctx, commentID, userID)
 	if err != nil {
@@ -60,12 +86,36 @@ func (s *CommentService) LikeComment(ctx context.Context, flowID, commentID, use
 	return like, nil
 }
 
-func (s *CommentService) AddComment(ctx context.Context, flowID, userID int, content string) error {
-	if err := s.repo.AddComment(ctx, flowID, userID, content); err != nil {
-		return err
+func (s *CommentService) AddComment(ctx context.Context, flowID, userID int, content string, parentID *int) (int, error) {
+	if err := s.checkCanComment(ctx, flowID, userID); err != nil {
+		return 0, err
 	}
 
-	return nil
+	depth := 0
+
+	if parentID != nil {
+		parent, err := s.repo.GetComment(ctx, *parentID)
+		if err != nil {
+			return 0, err
+		}
+
+		if parent.FlowID != flowID {
+			return 0, domain.ErrCommentFlowMismatch
+		}
+
+		if parent.Depth+1 > domain.MaxCommentDepth {
+			return 0, domain.ErrCommentDepthLimit
+		}
+
+		depth = parent.Depth + 1
+	}
+
+	id, err := s.repo.AddComment(ctx, flowID, userID, content, parentID, depth)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
 }
 
 func (s* CommentService) DeleteComment(ctx context.Context, commentID, userID int) error {
@@ -76,6 +126,109 @@ func (s* CommentService) DeleteComment(ctx context.Context, commentID, userID in
 	return nil
 }	
 
+func (s *CommentService) EditComment(ctx context.Context, flowID, commentID, userID int, content string) error {
+	comment, err := s.repo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.FlowID != flowID {
+		return domain.ErrNotFound
+	}
+
+	// редактировать комментарий может только его автор
+	if comment.AuthorID != userID {
+		return domain.ErrForbidden
+	}
+
+	return s.repo.EditComment(ctx, commentID, userID, content)
+}
+
+func (s *CommentService) HideComment(ctx context.Context, flowID, commentID, userID int, hidden bool) error {
+	if err := s.checkFlowAuthor(ctx, flowID, userID); err != nil {
+		return err
+	}
+
+	comment, err := s.repo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.FlowID != flowID {
+		return domain.ErrNotFound
+	}
+
+	return s.repo.HideComment(ctx, commentID, hidden)
+}
+
+func (s *CommentService) PinComment(ctx context.Context, flowID, commentID, userID int, pinned bool) error {
+	// закреплять комментарии может только автор пина
+	if err := s.checkFlowAuthor(ctx, flowID, userID); err != nil {
+		return err
+	}
+
+	comment, err := s.repo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.FlowID != flowID || comment.ParentID != nil {
+		return domain.ErrNotFound
+	}
+
+	return s.repo.PinComment(ctx, flowID, commentID, pinned)
+}
+
+func (s *CommentService) checkFlowAuthor(ctx context.Context, flowID, userID int) error {
+	_, authorID, err := s.pinRepo.GetPin(ctx, uint64(flowID), uint64(userID))
+	if err != nil {
+		return err
+	}
+
+	if authorID != uint64(userID) {
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
+
+// checkCanComment проверяет настройки комментариев пина, автор пина может комментировать всегда
+func (s *CommentService) checkCanComment(ctx context.Context, flowID, userID int) error {
+	pin, authorID, err := s.pinRepo.GetPin(ctx, uint64(flowID), uint64(userID))
+	if err != nil {
+		return err
+	}
+
+	if authorID == uint64(userID) {
+		return nil
+	}
+
+	if !pin.CommentsEnabled {
+		return domain.ErrCommentsDisabled
+	}
+
+	if pin.CommentsFollowersOnly {
+		isFollower, err := s.repo.IsFollower(ctx, userID, int(authorID))
+		if err != nil {
+			return err
+		}
+
+		if !isFollower {
+			return domain.ErrCommentsFollowers
+		}
+	}
+
+	return nil
+}
+
+func (s *CommentService) fillAvatars(comments []domain.Comment) {
+	for i := range comments {
+		if !comments[i].AuthorIsExternalAvatar {
+			comments[i].AuthorAvatar = s.generateAvatarURL(comments[i].AuthorAvatar)
+		}
+	}
+}
+
 func (s *CommentService) generateAvatarURL(filename string) string {
 	if filename == "" {
 		return ""
@@ -25,6 +25,24 @@ type Config struct {
 	AllowedOrigins    []string
 	ContextExpiration time.Duration
 	VKClientID        string
+	// OAuth провайдеры: пустой client id отключает провайдера
+	OAuthRedirectURL   string
+	YandexClientID     string
+	YandexClientSecret string
+	GoogleClientID     string
+	GoogleClientSecret string
+	OIDCName           string
+	OIDCIssuer         string
+	OIDCClientID       string
+	OIDCClientSecret   string
+	// адрес gRPC сервиса cv, пустая строка - классификатор-заглушка
+	ClassifierAddr        string
+	HideUnclassifiedFlows bool
+	// ffmpeg и ffprobe рядом с ним нужны для обложек и проверки видео,
+	// без них видео не загружаются
+	FFmpegPath string
+	// архивы с данными пользователей, вне статики: отдаются только владельцу
+	ExportDir string
 }
 
 var (
@@ -145,6 +163,34 @@ func (config *Config) LoadConfigFromEnv() error {
 
 	config.VKClientID = VKClientID
 
+	// сюда провайдер возвращает пользователя, дальше фронтенд передает код на /callback
+	oauthRedirectURL, _ := getEnvHelper("OAUTH_REDIRECT_URL", baseUrl+"/oauth")
+	config.OAuthRedirectURL = oauthRedirectURL
+
+	config.YandexClientID, _ = getEnvHelper("YANDEX_CLIENT_ID", "")
+	config.YandexClientSecret, _ = getEnvHelper("YANDEX_CLIENT_SECRET", "")
+	config.GoogleClientID, _ = getEnvHelper("GOOGLE_CLIENT_ID", "")
+	config.GoogleClientSecret, _ = getEnvHelper("GOOGLE_CLIENT_SECRET", "")
+
+	config.OIDCName, _ = getEnvHelper("OIDC_NAME", "oidc")
+	if config.OIDCName == "" {
+		config.OIDCName = "oidc"
+	}
+	config.OIDCIssuer, _ = getEnvHelper("OIDC_ISSUER", "")
+	config.OIDCClientID, _ = getEnvHelper("OIDC_CLIENT_ID", "")
+	config.OIDCClientSecret, _ = getEnvHelper("OIDC_CLIENT_SECRET", "")
+
+	classifierAddr, _ := getEnvHelper("CLASSIFIER_ADDR", "cv:8050")
+	config.ClassifierAddr = classifierAddr
+
+	config.HideUnclassifiedFlows = getBoolEnvHelper("HIDE_UNCLASSIFIED_FLOWS", false)
+
+	ffmpegPath, _ := getEnvHelper("FFMPEG_PATH", "ffmpeg")
+	config.FFmpegPath = ffmpegPath
+
+	exportDir, _ := getEnvHelper("EXPORT_DIR", "./exports")
+	config.ExportDir = exportDir
+
 	config.printConfig()
 
 	return nil
@@ -164,9 +210,30 @@ func (cfg Config) printConfig() {
 	log.Printf("Static base dir: %s\n", cfg.StaticBaseDir)
 	log.Printf("Avatar folder: %s\n", cfg.AvatarDir)
 	log.Printf("Base URL: %s\n", cfg.BaseUrl)
+	log.Printf("OAuth redirect URL: %s\n", cfg.OAuthRedirectURL)
+	log.Printf("OIDC issuer: %s\n", cfg.OIDCIssuer)
+	log.Printf("Classifier address: %s\n", cfg.ClassifierAddr)
+	log.Printf("Hide unclassified flows: %t\n", cfg.HideUnclassifiedFlows)
+	log.Printf("FFmpeg path: %s\n", cfg.FFmpegPath)
+	log.Printf("Export dir: %s\n", cfg.ExportDir)
 	log.Println("-----------------------------------------------")
 }
 
+func getBoolEnvHelper(key string, defaultValue bool) bool {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+
+	boolValue, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("could not parse %s, setting default value (%t)", key, defaultValue)
+		return defaultValue
+	}
+
+	return boolValue
+}
+
 func getEnvHelper(key string, defaultValue ...string) (string, error) {
 	value, ok := os.LookupEnv(key)
 	if ok {
@@ -8,12 +8,13 @@ import (
 )
 
 type FeedConfig struct {
-	ImageBaseDir      string
-	StaticBaseDir     string
-	AvatarDir         string
-	BaseUrl           string
-	PageSize          int
-	ContextExpiration time.Duration
+	ImageBaseDir          string
+	StaticBaseDir         string
+	AvatarDir             string
+	BaseUrl               string
+	PageSize              int
+	ContextExpiration     time.Duration
+	HideUnclassifiedFlows bool
 }
 
 func (config *FeedConfig) LoadConfigFromEnv() error {
@@ -58,6 +59,8 @@ func (config *FeedConfig) LoadConfigFromEnv() error {
 	baseUrl, _ := getEnvHelper("BASE_URL", "https://yourflow.ru")
 	config.BaseUrl = baseUrl
 
+	config.HideUnclassifiedFlows = getBoolEnvHelper("HIDE_UNCLASSIFIED_FLOWS", false)
+
 	config.printConfig()
 
 	return nil
@@ -71,6 +74,6 @@ func (cfg FeedConfig) printConfig() {
 	log.Printf("Static base dir: %s\n", cfg.StaticBaseDir)
 	log.Printf("Avatar folder: %s\n", cfg.AvatarDir)
 	log.Printf("Base URL: %s\n", cfg.BaseUrl)
+	log.Printf("Hide unclassified flows: %t\n", cfg.HideUnclassifiedFlows)
 	log.Println("-----------------------------------------------")
 }
-
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_comment_flow_id_pinned;
+DROP INDEX IF EXISTS idx_comment_flow_id_parent_id;
+
+ALTER TABLE comment
+DROP COLUMN IF EXISTS is_pinned,
+DROP COLUMN IF EXISTS reply_count,
+DROP COLUMN IF EXISTS depth,
+DROP COLUMN IF EXISTS parent_id;
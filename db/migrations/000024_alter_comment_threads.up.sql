@@ -0,0 +1,8 @@
+ALTER TABLE comment
+ADD COLUMN IF NOT EXISTS parent_id INT REFERENCES comment(id) ON DELETE CASCADE,
+ADD COLUMN IF NOT EXISTS depth INT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS reply_count INT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS is_pinned BOOL NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_comment_flow_id_parent_id ON comment (flow_id, parent_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_comment_flow_id_pinned ON comment (flow_id) WHERE is_pinned = TRUE;
@@ -0,0 +1,8 @@
+ALTER TABLE flow
+DROP COLUMN IF EXISTS comments_followers_only,
+DROP COLUMN IF EXISTS comments_enabled;
+
+ALTER TABLE comment
+DROP COLUMN IF EXISTS updated_at,
+DROP COLUMN IF EXISTS is_hidden,
+DROP COLUMN IF EXISTS is_edited;
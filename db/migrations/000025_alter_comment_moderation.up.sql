@@ -0,0 +1,8 @@
+ALTER TABLE comment
+ADD COLUMN IF NOT EXISTS is_edited BOOL NOT NULL DEFAULT FALSE,
+ADD COLUMN IF NOT EXISTS is_hidden BOOL NOT NULL DEFAULT FALSE,
+ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+
+ALTER TABLE flow
+ADD COLUMN IF NOT EXISTS comments_enabled BOOL NOT NULL DEFAULT TRUE,
+ADD COLUMN IF NOT EXISTS comments_followers_only BOOL NOT NULL DEFAULT FALSE;
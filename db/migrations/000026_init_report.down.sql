@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS moderator_action;
+DROP TABLE IF EXISTS report;
+
+ALTER TABLE flow
+DROP COLUMN IF EXISTS is_hidden;
+
+ALTER TABLE flow_user
+DROP COLUMN IF EXISTS is_suspended,
+DROP COLUMN IF EXISTS role;
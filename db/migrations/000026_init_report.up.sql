@@ -0,0 +1,37 @@
+ALTER TABLE flow_user
+ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'moderator')),
+ADD COLUMN IF NOT EXISTS is_suspended BOOL NOT NULL DEFAULT FALSE;
+
+ALTER TABLE flow
+ADD COLUMN IF NOT EXISTS is_hidden BOOL NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS report (
+    id INT GENERATED ALWAYS AS IDENTITY (START WITH 1 INCREMENT BY 1) PRIMARY KEY,
+    reporter_id INT NOT NULL,
+    target_type TEXT NOT NULL CHECK (target_type IN ('flow', 'comment', 'board', 'user', 'message')),
+    target_id INT NOT NULL,
+    reason TEXT NOT NULL CHECK(LENGTH(reason) <= 1000),
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'resolved', 'dismissed')),
+    resolved_by INT,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (reporter_id) REFERENCES flow_user(id) ON DELETE CASCADE,
+    FOREIGN KEY (resolved_by) REFERENCES flow_user(id) ON DELETE SET NULL
+);
+
+-- один пользователь может держать только одну открытую жалобу на объект
+CREATE UNIQUE INDEX IF NOT EXISTS idx_report_reporter_target_open ON report (reporter_id, target_type, target_id) WHERE status = 'open';
+CREATE INDEX IF NOT EXISTS idx_report_status_created_at ON report (status, created_at);
+
+CREATE TABLE IF NOT EXISTS moderator_action (
+    id INT GENERATED ALWAYS AS IDENTITY (START WITH 1 INCREMENT BY 1) PRIMARY KEY,
+    moderator_id INT,
+    report_id INT,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id INT NOT NULL,
+    note TEXT NOT NULL DEFAULT '' CHECK(LENGTH(note) <= 1000),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (moderator_id) REFERENCES flow_user(id) ON DELETE SET NULL,
+    FOREIGN KEY (report_id) REFERENCES report(id) ON DELETE SET NULL
+);
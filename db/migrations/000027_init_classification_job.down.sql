@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS classification_job;
+
+ALTER TABLE flow
+DROP COLUMN IF EXISTS nsfw_status;
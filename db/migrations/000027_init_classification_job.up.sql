@@ -0,0 +1,21 @@
+ALTER TABLE flow
+ADD COLUMN IF NOT EXISTS nsfw_status TEXT NOT NULL DEFAULT 'pending' CHECK (nsfw_status IN ('pending', 'safe', 'nsfw', 'failed'));
+
+-- уже существующие пины были проверены сервисом cv напрямую
+UPDATE flow SET nsfw_status = CASE WHEN is_nsfw THEN 'nsfw' ELSE 'safe' END;
+
+CREATE TABLE IF NOT EXISTS classification_job (
+    id INT GENERATED ALWAYS AS IDENTITY (START WITH 1 INCREMENT BY 1) PRIMARY KEY,
+    flow_id INT NOT NULL UNIQUE,
+    media_url TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'processing', 'done', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    run_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (flow_id) REFERENCES flow(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_classification_job_status_run_at ON classification_job (status, run_at);
@@ -0,0 +1,2 @@
+ALTER TABLE flow_user
+DROP COLUMN IF EXISTS show_nsfw;
@@ -0,0 +1,2 @@
+ALTER TABLE flow_user
+ADD COLUMN IF NOT EXISTS show_nsfw BOOLEAN NOT NULL DEFAULT FALSE;
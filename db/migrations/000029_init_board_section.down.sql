@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_board_post_section;
+DROP INDEX IF EXISTS idx_board_post_board_rank;
+
+ALTER TABLE board_post
+DROP COLUMN IF EXISTS rank,
+DROP COLUMN IF EXISTS section_id;
+
+DROP TABLE IF EXISTS board_section;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS board_section (
+    id INT GENERATED ALWAYS AS IDENTITY (START WITH 1 INCREMENT BY 1) PRIMARY KEY,
+    board_id INT NOT NULL,
+    section_name TEXT NOT NULL,
+    rank TEXT COLLATE "C" NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (board_id, section_name),
+    FOREIGN KEY (board_id) REFERENCES board(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_section_board_rank ON board_section (board_id, rank);
+
+ALTER TABLE board_post
+ADD COLUMN IF NOT EXISTS section_id INT REFERENCES board_section(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS rank TEXT COLLATE "C";
+
+-- ключи в формате utils/rank: целая часть 'd' + 4 цифры base62,
+-- порядок совпадает с прежней сортировкой по saved_at DESC
+WITH numbered AS (
+    SELECT
+        board_id,
+        flow_id,
+        ROW_NUMBER() OVER (PARTITION BY board_id ORDER BY saved_at DESC) - 1 AS n
+    FROM board_post
+), alphabet AS (
+    SELECT '0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz'::TEXT AS d
+)
+UPDATE board_post bp
+SET rank = 'd'
+    || SUBSTR(alphabet.d, (numbered.n / 238328 % 62)::INT + 1, 1)
+    || SUBSTR(alphabet.d, (numbered.n / 3844 % 62)::INT + 1, 1)
+    || SUBSTR(alphabet.d, (numbered.n / 62 % 62)::INT + 1, 1)
+    || SUBSTR(alphabet.d, (numbered.n % 62)::INT + 1, 1)
+FROM numbered, alphabet
+WHERE bp.board_id = numbered.board_id AND bp.flow_id = numbered.flow_id;
+
+ALTER TABLE board_post ALTER COLUMN rank SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_board_post_board_rank ON board_post (board_id, rank);
+CREATE INDEX IF NOT EXISTS idx_board_post_section ON board_post (section_id);
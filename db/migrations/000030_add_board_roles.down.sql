@@ -0,0 +1,5 @@
+ALTER TABLE board_invitation
+DROP COLUMN IF EXISTS role;
+
+ALTER TABLE board_coauthor
+DROP COLUMN IF EXISTS role;
@@ -0,0 +1,6 @@
+-- существующие соавторы сохраняют прежние права редактора
+ALTER TABLE board_coauthor
+ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'editor' CHECK (role IN ('viewer', 'contributor', 'editor', 'admin'));
+
+ALTER TABLE board_invitation
+ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'editor' CHECK (role IN ('viewer', 'contributor', 'editor', 'admin'));
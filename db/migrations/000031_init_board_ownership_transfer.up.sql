@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS board_ownership_transfer (
+    id INT GENERATED ALWAYS AS IDENTITY (START WITH 1 INCREMENT BY 1) PRIMARY KEY,
+    board_id INT NOT NULL,
+    from_user_id INT NOT NULL,
+    to_user_id INT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'cancelled')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    FOREIGN KEY (board_id) REFERENCES board(id) ON DELETE CASCADE,
+    FOREIGN KEY (from_user_id) REFERENCES flow_user(id) ON DELETE CASCADE,
+    FOREIGN KEY (to_user_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+-- у доски может быть только одно активное предложение передачи
+CREATE UNIQUE INDEX IF NOT EXISTS idx_board_ownership_transfer_pending
+    ON board_ownership_transfer (board_id) WHERE status = 'pending';
@@ -0,0 +1,5 @@
+ALTER TABLE board
+DROP COLUMN IF EXISTS followers_notified_at,
+DROP COLUMN IF EXISTS follower_count;
+
+DROP TABLE IF EXISTS board_follower;
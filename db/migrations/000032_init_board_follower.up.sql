@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS board_follower (
+    board_id INT NOT NULL,
+    user_id INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (board_id, user_id),
+    FOREIGN KEY (board_id) REFERENCES board(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_follower_user ON board_follower (user_id);
+
+-- followers_notified_at нужен, чтобы уведомлять подписчиков не чаще заданного интервала
+ALTER TABLE board
+ADD COLUMN IF NOT EXISTS follower_count INT NOT NULL DEFAULT 0 CHECK (follower_count >= 0),
+ADD COLUMN IF NOT EXISTS followers_notified_at TIMESTAMPTZ;
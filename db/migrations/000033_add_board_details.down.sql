@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS board_tag;
+
+ALTER TABLE board
+DROP CONSTRAINT IF EXISTS board_visibility_check,
+DROP COLUMN IF EXISTS is_secret,
+DROP COLUMN IF EXISTS cover_image,
+DROP COLUMN IF EXISTS cover_flow_id,
+DROP COLUMN IF EXISTS description;
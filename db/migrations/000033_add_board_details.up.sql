@@ -0,0 +1,16 @@
+-- секретная доска доступна по ссылке, но не попадает в списки и поиск
+ALTER TABLE board
+ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '' CHECK (LENGTH(description) <= 500),
+ADD COLUMN IF NOT EXISTS cover_flow_id INT REFERENCES flow(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS cover_image TEXT,
+ADD COLUMN IF NOT EXISTS is_secret BOOLEAN NOT NULL DEFAULT FALSE,
+ADD CONSTRAINT board_visibility_check CHECK (NOT (is_private AND is_secret));
+
+CREATE TABLE IF NOT EXISTS board_tag (
+    board_id INT NOT NULL,
+    tag TEXT NOT NULL CHECK (LENGTH(tag) <= 32),
+    PRIMARY KEY (board_id, tag),
+    FOREIGN KEY (board_id) REFERENCES board(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_tag_tag ON board_tag (tag);
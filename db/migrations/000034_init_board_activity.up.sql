@@ -0,0 +1,16 @@
+-- журнал только дополняется; пользователи и пины могут быть удалены, запись остаётся
+CREATE TABLE IF NOT EXISTS board_activity (
+    id INT GENERATED ALWAYS AS IDENTITY (START WITH 1 INCREMENT BY 1) PRIMARY KEY,
+    board_id INT NOT NULL,
+    actor_id INT,
+    action TEXT NOT NULL,
+    flow_id INT,
+    target_id INT,
+    details JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (board_id) REFERENCES board(id) ON DELETE CASCADE,
+    FOREIGN KEY (actor_id) REFERENCES flow_user(id) ON DELETE SET NULL,
+    FOREIGN KEY (target_id) REFERENCES flow_user(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_activity_board ON board_activity (board_id, id DESC);
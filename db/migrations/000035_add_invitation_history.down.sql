@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS invitation_join;
+
+ALTER TABLE board_invitation
+DROP COLUMN IF EXISTS is_paused;
@@ -0,0 +1,15 @@
+-- приостановленная ссылка не принимает новых соавторов, но сохраняет настройки
+ALTER TABLE board_invitation
+ADD COLUMN IF NOT EXISTS is_paused BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- история привязана к id приглашения и переживает перевыпуск ссылки
+CREATE TABLE IF NOT EXISTS invitation_join (
+    id INT GENERATED ALWAYS AS IDENTITY (START WITH 1 INCREMENT BY 1) PRIMARY KEY,
+    invitation_id INT NOT NULL,
+    user_id INT NOT NULL,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (invitation_id) REFERENCES board_invitation(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_invitation_join_invitation ON invitation_join (invitation_id, joined_at DESC);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_flow_publish_at;
+
+ALTER TABLE flow
+DROP COLUMN IF EXISTS publish_at;
@@ -0,0 +1,5 @@
+-- отложенный флоу хранится приватным, пока планировщик не опубликует его
+ALTER TABLE flow
+ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_flow_publish_at ON flow (publish_at) WHERE publish_at IS NOT NULL;
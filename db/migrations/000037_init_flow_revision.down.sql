@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS flow_revision;
+
+ALTER TABLE flow
+DROP COLUMN IF EXISTS is_draft;
@@ -0,0 +1,15 @@
+-- черновик хранится приватным и не попадает в ленту до публикации
+ALTER TABLE flow
+ADD COLUMN IF NOT EXISTS is_draft BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- ревизия хранит текст флоу до очередной правки
+CREATE TABLE IF NOT EXISTS flow_revision (
+    id INT GENERATED ALWAYS AS IDENTITY (START WITH 1 INCREMENT BY 1) PRIMARY KEY,
+    flow_id INT NOT NULL,
+    title TEXT,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (flow_id) REFERENCES flow(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_flow_revision_flow ON flow_revision (flow_id, id DESC);
@@ -0,0 +1,19 @@
+-- элементы карусели и видео; flow.media_url остаётся обложкой:
+-- первой картинкой или кадром первого видео
+CREATE TABLE IF NOT EXISTS flow_media (
+    flow_id INT NOT NULL,
+    position INT NOT NULL CHECK (position >= 0),
+    media_type TEXT NOT NULL CHECK (media_type IN ('image', 'video')),
+    media_url TEXT NOT NULL,
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    duration_ms INT NOT NULL DEFAULT 0,
+    poster_url TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (flow_id, position),
+    FOREIGN KEY (flow_id) REFERENCES flow(id) ON DELETE CASCADE
+);
+
+INSERT INTO flow_media (flow_id, position, media_type, media_url, width, height)
+SELECT id, 0, 'image', media_url, COALESCE(width, 0), COALESCE(height, 0)
+FROM flow
+ON CONFLICT DO NOTHING;
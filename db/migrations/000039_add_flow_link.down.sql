@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_flow_source_domain;
+
+ALTER TABLE flow
+DROP COLUMN IF EXISTS source_domain,
+DROP COLUMN IF EXISTS link;
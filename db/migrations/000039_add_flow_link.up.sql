@@ -0,0 +1,6 @@
+-- ссылка на источник флоу и домен для атрибуции и фильтрации
+ALTER TABLE flow
+ADD COLUMN IF NOT EXISTS link TEXT CHECK (LENGTH(link) <= 2048),
+ADD COLUMN IF NOT EXISTS source_domain TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_flow_source_domain ON flow (source_domain) WHERE source_domain IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS tag_follower;
+DROP TABLE IF EXISTS flow_tag;
+DROP TABLE IF EXISTS tag;
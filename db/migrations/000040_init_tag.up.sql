@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS tag (
+    id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE CHECK (LENGTH(name) BETWEEN 1 AND 64)
+);
+
+-- для автодополнения по префиксу
+CREATE INDEX IF NOT EXISTS idx_tag_name_prefix ON tag (name text_pattern_ops);
+
+-- один тег может быть у флоу и от автора, и от сервиса cv
+CREATE TABLE IF NOT EXISTS flow_tag (
+    flow_id INT NOT NULL,
+    tag_id INT NOT NULL,
+    source TEXT NOT NULL CHECK (source IN ('user', 'machine')),
+    confidence REAL,
+    PRIMARY KEY (flow_id, tag_id, source),
+    FOREIGN KEY (flow_id) REFERENCES flow(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tag(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_flow_tag_tag ON flow_tag (tag_id);
+
+CREATE TABLE IF NOT EXISTS tag_follower (
+    tag_id INT NOT NULL,
+    user_id INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tag_id, user_id),
+    FOREIGN KEY (tag_id) REFERENCES tag(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_tag_follower_user ON tag_follower (user_id);
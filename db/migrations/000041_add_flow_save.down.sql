@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_board_post_flow;
+
+ALTER TABLE board_post
+DROP COLUMN IF EXISTS saved_from_board_id;
+
+ALTER TABLE flow
+DROP COLUMN IF EXISTS save_count;
@@ -0,0 +1,17 @@
+-- сохранением считается пин на доске, автор которой не автор пина
+ALTER TABLE flow
+ADD COLUMN IF NOT EXISTS save_count INT NOT NULL DEFAULT 0 CHECK (save_count >= 0);
+
+-- доска, с которой пин сохранили, для подписи «сохранено из»
+ALTER TABLE board_post
+ADD COLUMN IF NOT EXISTS saved_from_board_id INT REFERENCES board(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_board_post_flow ON board_post (flow_id);
+
+UPDATE flow f
+SET save_count = (
+    SELECT COUNT(*)
+    FROM board_post bp
+    JOIN board b ON b.id = bp.board_id
+    WHERE bp.flow_id = f.id AND b.author_id <> f.author_id
+);
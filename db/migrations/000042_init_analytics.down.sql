@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS account_stat_daily;
+
+DROP TABLE IF EXISTS flow_stat_daily;
+
+DROP TABLE IF EXISTS analytics_event;
@@ -0,0 +1,42 @@
+-- журнал событий, из которого раз в несколько минут пересчитывается дневная статистика.
+-- account_id — автор флоу или аккаунт, на который подписались
+CREATE TABLE IF NOT EXISTS analytics_event (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    event_type TEXT NOT NULL CHECK (event_type IN (
+        'impression', 'closeup', 'save', 'like', 'comment', 'outbound_click', 'follow', 'unfollow'
+    )),
+    flow_id INT,
+    account_id INT NOT NULL,
+    viewer_id INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (flow_id) REFERENCES flow(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_analytics_event_created ON analytics_event (created_at);
+
+CREATE TABLE IF NOT EXISTS flow_stat_daily (
+    flow_id INT NOT NULL,
+    account_id INT NOT NULL,
+    day DATE NOT NULL,
+    impressions INT NOT NULL DEFAULT 0,
+    closeups INT NOT NULL DEFAULT 0,
+    saves INT NOT NULL DEFAULT 0,
+    likes INT NOT NULL DEFAULT 0,
+    comments INT NOT NULL DEFAULT 0,
+    outbound_clicks INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (flow_id, day),
+    FOREIGN KEY (flow_id) REFERENCES flow(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_flow_stat_daily_account ON flow_stat_daily (account_id, day);
+
+CREATE TABLE IF NOT EXISTS account_stat_daily (
+    account_id INT NOT NULL,
+    day DATE NOT NULL,
+    followers_gained INT NOT NULL DEFAULT 0,
+    followers_lost INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (account_id, day),
+    FOREIGN KEY (account_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
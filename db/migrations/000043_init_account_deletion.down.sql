@@ -0,0 +1,21 @@
+DROP TABLE IF EXISTS data_export;
+
+ALTER TABLE chat DROP CONSTRAINT fk_user2;
+ALTER TABLE chat ADD CONSTRAINT fk_user2 FOREIGN KEY (user2) REFERENCES flow_user(username);
+
+ALTER TABLE chat DROP CONSTRAINT fk_user1;
+ALTER TABLE chat ADD CONSTRAINT fk_user1 FOREIGN KEY (user1) REFERENCES flow_user(username);
+
+ALTER TABLE color DROP CONSTRAINT color_flow_id_fkey;
+ALTER TABLE color ADD CONSTRAINT color_flow_id_fkey FOREIGN KEY (flow_id) REFERENCES flow(id);
+
+ALTER TABLE comment DROP CONSTRAINT comment_author_id_fkey;
+ALTER TABLE comment ADD CONSTRAINT comment_author_id_fkey FOREIGN KEY (author_id) REFERENCES flow_user(id);
+
+ALTER TABLE flow DROP CONSTRAINT flow_author_id_fkey;
+ALTER TABLE flow ADD CONSTRAINT flow_author_id_fkey FOREIGN KEY (author_id) REFERENCES flow_user(id);
+
+DROP INDEX IF EXISTS idx_flow_user_delete_after;
+
+ALTER TABLE flow_user
+DROP COLUMN IF EXISTS delete_after;
@@ -0,0 +1,37 @@
+-- после delete_after аккаунт удаляется фоновой задачей, до этого удаление можно отменить
+ALTER TABLE flow_user
+ADD COLUMN IF NOT EXISTS delete_after TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_flow_user_delete_after ON flow_user (delete_after) WHERE delete_after IS NOT NULL;
+
+-- данные пользователя должны удаляться вместе с аккаунтом
+ALTER TABLE flow DROP CONSTRAINT flow_author_id_fkey;
+ALTER TABLE flow ADD CONSTRAINT flow_author_id_fkey FOREIGN KEY (author_id) REFERENCES flow_user(id) ON DELETE CASCADE;
+
+ALTER TABLE comment DROP CONSTRAINT comment_author_id_fkey;
+ALTER TABLE comment ADD CONSTRAINT comment_author_id_fkey FOREIGN KEY (author_id) REFERENCES flow_user(id) ON DELETE CASCADE;
+
+ALTER TABLE color DROP CONSTRAINT color_flow_id_fkey;
+ALTER TABLE color ADD CONSTRAINT color_flow_id_fkey FOREIGN KEY (flow_id) REFERENCES flow(id) ON DELETE CASCADE;
+
+ALTER TABLE chat DROP CONSTRAINT fk_user1;
+ALTER TABLE chat ADD CONSTRAINT fk_user1 FOREIGN KEY (user1) REFERENCES flow_user(username) ON DELETE CASCADE;
+
+ALTER TABLE chat DROP CONSTRAINT fk_user2;
+ALTER TABLE chat ADD CONSTRAINT fk_user2 FOREIGN KEY (user2) REFERENCES flow_user(username) ON DELETE CASCADE;
+
+-- архив с данными пользователя собирается фоновой задачей и хранится ограниченное время
+CREATE TABLE IF NOT EXISTS data_export (
+    id INT GENERATED ALWAYS AS IDENTITY (START WITH 1 INCREMENT BY 1) PRIMARY KEY,
+    user_id INT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'processing', 'ready', 'failed', 'expired')),
+    file_name TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    FOREIGN KEY (user_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+-- у пользователя может собираться только один архив
+CREATE UNIQUE INDEX IF NOT EXISTS idx_data_export_user_active ON data_export (user_id) WHERE status IN ('pending', 'processing');
+CREATE INDEX IF NOT EXISTS idx_data_export_status ON data_export (status, id);
@@ -0,0 +1,14 @@
+ALTER TABLE contact DROP CONSTRAINT fk_contact;
+ALTER TABLE contact ADD CONSTRAINT fk_contact FOREIGN KEY (contact_username) REFERENCES flow_user(username) ON DELETE CASCADE;
+
+ALTER TABLE contact DROP CONSTRAINT fk_user;
+ALTER TABLE contact ADD CONSTRAINT fk_user FOREIGN KEY (user_username) REFERENCES flow_user(username) ON DELETE CASCADE;
+
+ALTER TABLE chat DROP CONSTRAINT fk_user2;
+ALTER TABLE chat ADD CONSTRAINT fk_user2 FOREIGN KEY (user2) REFERENCES flow_user(username) ON DELETE CASCADE;
+
+ALTER TABLE chat DROP CONSTRAINT fk_user1;
+ALTER TABLE chat ADD CONSTRAINT fk_user1 FOREIGN KEY (user1) REFERENCES flow_user(username) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_username_history_user;
+DROP TABLE IF EXISTS username_history;
@@ -0,0 +1,24 @@
+-- прежние имена пользователей: по ним работает редирект на профиль,
+-- и до reserved_until их не может занять другой пользователь
+CREATE TABLE IF NOT EXISTS username_history (
+    old_username TEXT PRIMARY KEY,
+    user_id INT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reserved_until TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_username_history_user ON username_history (user_id, changed_at DESC);
+
+-- чаты и контакты ссылаются на имя пользователя и должны переезжать вместе с ним
+ALTER TABLE chat DROP CONSTRAINT fk_user1;
+ALTER TABLE chat ADD CONSTRAINT fk_user1 FOREIGN KEY (user1) REFERENCES flow_user(username) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE chat DROP CONSTRAINT fk_user2;
+ALTER TABLE chat ADD CONSTRAINT fk_user2 FOREIGN KEY (user2) REFERENCES flow_user(username) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE contact DROP CONSTRAINT fk_user;
+ALTER TABLE contact ADD CONSTRAINT fk_user FOREIGN KEY (user_username) REFERENCES flow_user(username) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE contact DROP CONSTRAINT fk_contact;
+ALTER TABLE contact ADD CONSTRAINT fk_contact FOREIGN KEY (contact_username) REFERENCES flow_user(username) ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_subscription_request_target;
+DROP TABLE IF EXISTS subscription_request;
+
+ALTER TABLE flow_user
+DROP COLUMN IF EXISTS is_private_account;
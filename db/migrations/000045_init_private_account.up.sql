@@ -0,0 +1,15 @@
+-- флоу и доски закрытого аккаунта видны только одобренным подписчикам
+ALTER TABLE flow_user
+ADD COLUMN IF NOT EXISTS is_private_account BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- заявки на подписку к закрытым аккаунтам
+CREATE TABLE IF NOT EXISTS subscription_request (
+    user_id INT NOT NULL,
+    target_id INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, target_id),
+    FOREIGN KEY (user_id) REFERENCES flow_user(id) ON DELETE CASCADE,
+    FOREIGN KEY (target_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscription_request_target ON subscription_request (target_id, created_at DESC);
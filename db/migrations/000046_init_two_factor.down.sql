@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS recovery_code;
+
+ALTER TABLE flow_user
+DROP COLUMN IF EXISTS totp_locked_until,
+DROP COLUMN IF EXISTS totp_failed_attempts,
+DROP COLUMN IF EXISTS totp_last_step,
+DROP COLUMN IF EXISTS totp_enabled,
+DROP COLUMN IF EXISTS totp_secret;
@@ -0,0 +1,18 @@
+-- двухфакторная аутентификация по TOTP
+ALTER TABLE flow_user
+ADD COLUMN IF NOT EXISTS totp_secret TEXT,
+ADD COLUMN IF NOT EXISTS totp_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN IF NOT EXISTS totp_last_step BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS totp_failed_attempts INT NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS totp_locked_until TIMESTAMPTZ;
+
+-- одноразовые коды восстановления хранятся только в виде хешей
+CREATE TABLE IF NOT EXISTS recovery_code (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, code_hash),
+    FOREIGN KEY (user_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
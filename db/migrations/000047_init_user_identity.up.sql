@@ -0,0 +1,19 @@
+-- привязанные внешние аккаунты: у пользователя может быть несколько провайдеров
+CREATE TABLE IF NOT EXISTS user_identity (
+    user_id INT NOT NULL,
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, subject),
+    UNIQUE (user_id, provider),
+    FOREIGN KEY (user_id) REFERENCES flow_user(id) ON DELETE CASCADE
+);
+
+-- существующие входы через VK ID переезжают в новую таблицу,
+-- external_id остается признаком аккаунта без пароля
+INSERT INTO user_identity (user_id, provider, subject, email)
+SELECT id, 'vk', external_id, email
+FROM flow_user
+WHERE external_id IS NOT NULL AND external_id <> ''
+ON CONFLICT DO NOTHING;
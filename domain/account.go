@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// состояния архива с данными пользователя
+const (
+	ExportPending    = "pending"
+	ExportProcessing = "processing"
+	ExportReady      = "ready"
+	ExportFailed     = "failed"
+	ExportExpired    = "expired"
+)
+
+var (
+	ErrDeletionNotConfirmed = errors.New("account deletion is not confirmed")
+	ErrDeletionNotScheduled = errors.New("account deletion is not scheduled")
+	ErrExportNotReady       = errors.New("export is not ready")
+)
+
+// AccountDeletionRequest подтверждает удаление: имя пользователя вводится всегда,
+// пароль — только у аккаунтов, зарегистрированных не через VK
+//
+//easyjson:json
+type AccountDeletionRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+//easyjson:json
+type AccountDeletionStatus struct {
+	Scheduled   bool       `json:"scheduled"`
+	DeleteAfter *time.Time `json:"delete_after,omitempty"`
+}
+
+//easyjson:json
+type DataExport struct {
+	ID          int        `json:"export_id"`
+	UserID      int        `json:"-"`
+	Status      string     `json:"status"`
+	FileName    string     `json:"-"`
+	CreatedAt   time.Time  `json:"created_at"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	DownloadURL string     `json:"download_url,omitempty"`
+}
+
+// DeletedAccount — файлы удалённого аккаунта, которые нужно убрать с диска
+type DeletedAccount struct {
+	Media   []string // картинки и видео флоу, обложки досок
+	Avatar  string   // пустой, если аватара нет или он внешний
+	Exports []string // собранные архивы
+}
+
+//easyjson:json
+type ExportProfile struct {
+	Username   string     `json:"username"`
+	Email      string     `json:"email"`
+	PublicName string     `json:"public_name"`
+	About      string     `json:"about,omitempty"`
+	Birthday   *time.Time `json:"birthday,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+//easyjson:json
+type ExportFlow struct {
+	ID          int       `json:"flow_id"`
+	Header      string    `json:"header,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Link        string    `json:"link,omitempty"`
+	IsPrivate   bool      `json:"is_private"`
+	IsDraft     bool      `json:"is_draft"`
+	CreatedAt   time.Time `json:"created_at"`
+	Media       []string  `json:"media"` // пути к оригиналам внутри архива
+}
+
+//easyjson:json
+type ExportFlows []ExportFlow
+
+//easyjson:json
+type ExportBoard struct {
+	ID          int       `json:"board_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	IsPrivate   bool      `json:"is_private"`
+	IsSecret    bool      `json:"is_secret"`
+	CreatedAt   time.Time `json:"created_at"`
+	FlowIDs     []int     `json:"flow_ids"`
+}
+
+//easyjson:json
+type ExportBoards []ExportBoard
+
+//easyjson:json
+type ExportComment struct {
+	ID        int       `json:"comment_id"`
+	FlowID    int       `json:"flow_id"`
+	ParentID  *int      `json:"parent_id,omitempty"`
+	Contents  string    `json:"contents"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+//easyjson:json
+type ExportComments []ExportComment
+
+//easyjson:json
+type ExportMessages []Message
+
+// AccountExport — всё, что попадает в архив с данными пользователя
+type AccountExport struct {
+	Profile  ExportProfile
+	Flows    ExportFlows
+	Boards   ExportBoards
+	Comments ExportComments
+	Messages ExportMessages
+}
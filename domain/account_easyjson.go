@@ -0,0 +1,1071 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *ExportProfile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "username":
+			out.Username = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "public_name":
+			out.PublicName = string(in.String())
+		case "about":
+			out.About = string(in.String())
+		case "birthday":
+			if in.IsNull() {
+				in.Skip()
+				out.Birthday = nil
+			} else {
+				if out.Birthday == nil {
+					out.Birthday = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.Birthday).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in ExportProfile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"public_name\":"
+		out.RawString(prefix)
+		out.String(string(in.PublicName))
+	}
+	if in.About != "" {
+		const prefix string = ",\"about\":"
+		out.RawString(prefix)
+		out.String(string(in.About))
+	}
+	if in.Birthday != nil {
+		const prefix string = ",\"birthday\":"
+		out.RawString(prefix)
+		out.Raw((*in.Birthday).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportProfile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportProfile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *ExportMessages) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ExportMessages, 0, 0)
+			} else {
+				*out = ExportMessages{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 Message
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in ExportMessages) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportMessages) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportMessages) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportMessages) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportMessages) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *ExportFlows) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ExportFlows, 0, 0)
+			} else {
+				*out = ExportFlows{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v4 ExportFlow
+			(v4).UnmarshalEasyJSON(in)
+			*out = append(*out, v4)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in ExportFlows) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v5, v6 := range in {
+			if v5 > 0 {
+				out.RawByte(',')
+			}
+			(v6).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportFlows) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportFlows) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportFlows) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportFlows) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain3(in *jlexer.Lexer, out *ExportFlow) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flow_id":
+			out.ID = int(in.Int())
+		case "header":
+			out.Header = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "link":
+			out.Link = string(in.String())
+		case "is_private":
+			out.IsPrivate = bool(in.Bool())
+		case "is_draft":
+			out.IsDraft = bool(in.Bool())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "media":
+			if in.IsNull() {
+				in.Skip()
+				out.Media = nil
+			} else {
+				in.Delim('[')
+				if out.Media == nil {
+					if !in.IsDelim(']') {
+						out.Media = make([]string, 0, 4)
+					} else {
+						out.Media = []string{}
+					}
+				} else {
+					out.Media = (out.Media)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Media = append(out.Media, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain3(out *jwriter.Writer, in ExportFlow) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flow_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	if in.Header != "" {
+		const prefix string = ",\"header\":"
+		out.RawString(prefix)
+		out.String(string(in.Header))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.Link != "" {
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	{
+		const prefix string = ",\"is_private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivate))
+	}
+	{
+		const prefix string = ",\"is_draft\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDraft))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"media\":"
+		out.RawString(prefix)
+		if in.Media == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Media {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportFlow) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportFlow) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportFlow) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportFlow) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain3(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain4(in *jlexer.Lexer, out *ExportComments) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ExportComments, 0, 1)
+			} else {
+				*out = ExportComments{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v10 ExportComment
+			(v10).UnmarshalEasyJSON(in)
+			*out = append(*out, v10)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain4(out *jwriter.Writer, in ExportComments) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v11, v12 := range in {
+			if v11 > 0 {
+				out.RawByte(',')
+			}
+			(v12).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportComments) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportComments) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportComments) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportComments) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain4(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain5(in *jlexer.Lexer, out *ExportComment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comment_id":
+			out.ID = int(in.Int())
+		case "flow_id":
+			out.FlowID = int(in.Int())
+		case "parent_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(int)
+				}
+				*out.ParentID = int(in.Int())
+			}
+		case "contents":
+			out.Contents = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain5(out *jwriter.Writer, in ExportComment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comment_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"flow_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FlowID))
+	}
+	if in.ParentID != nil {
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.Int(int(*in.ParentID))
+	}
+	{
+		const prefix string = ",\"contents\":"
+		out.RawString(prefix)
+		out.String(string(in.Contents))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportComment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportComment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportComment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain5(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain6(in *jlexer.Lexer, out *ExportBoards) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ExportBoards, 0, 0)
+			} else {
+				*out = ExportBoards{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v13 ExportBoard
+			(v13).UnmarshalEasyJSON(in)
+			*out = append(*out, v13)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain6(out *jwriter.Writer, in ExportBoards) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v14, v15 := range in {
+			if v14 > 0 {
+				out.RawByte(',')
+			}
+			(v15).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportBoards) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportBoards) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportBoards) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportBoards) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain6(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain7(in *jlexer.Lexer, out *ExportBoard) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "is_private":
+			out.IsPrivate = bool(in.Bool())
+		case "is_secret":
+			out.IsSecret = bool(in.Bool())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "flow_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.FlowIDs = nil
+			} else {
+				in.Delim('[')
+				if out.FlowIDs == nil {
+					if !in.IsDelim(']') {
+						out.FlowIDs = make([]int, 0, 8)
+					} else {
+						out.FlowIDs = []int{}
+					}
+				} else {
+					out.FlowIDs = (out.FlowIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 int
+					v16 = int(in.Int())
+					out.FlowIDs = append(out.FlowIDs, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain7(out *jwriter.Writer, in ExportBoard) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"is_private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivate))
+	}
+	{
+		const prefix string = ",\"is_secret\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSecret))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"flow_ids\":"
+		out.RawString(prefix)
+		if in.FlowIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.FlowIDs {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportBoard) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportBoard) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportBoard) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportBoard) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain7(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain8(in *jlexer.Lexer, out *DataExport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "export_id":
+			out.ID = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "finished_at":
+			if in.IsNull() {
+				in.Skip()
+				out.FinishedAt = nil
+			} else {
+				if out.FinishedAt == nil {
+					out.FinishedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.FinishedAt).UnmarshalJSON(data))
+				}
+			}
+		case "expires_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpiresAt = nil
+			} else {
+				if out.ExpiresAt == nil {
+					out.ExpiresAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpiresAt).UnmarshalJSON(data))
+				}
+			}
+		case "download_url":
+			out.DownloadURL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain8(out *jwriter.Writer, in DataExport) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"export_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if in.FinishedAt != nil {
+		const prefix string = ",\"finished_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.FinishedAt).MarshalJSON())
+	}
+	if in.ExpiresAt != nil {
+		const prefix string = ",\"expires_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ExpiresAt).MarshalJSON())
+	}
+	if in.DownloadURL != "" {
+		const prefix string = ",\"download_url\":"
+		out.RawString(prefix)
+		out.String(string(in.DownloadURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DataExport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DataExport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DataExport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DataExport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain8(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain9(in *jlexer.Lexer, out *AccountDeletionStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "scheduled":
+			out.Scheduled = bool(in.Bool())
+		case "delete_after":
+			if in.IsNull() {
+				in.Skip()
+				out.DeleteAfter = nil
+			} else {
+				if out.DeleteAfter == nil {
+					out.DeleteAfter = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DeleteAfter).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain9(out *jwriter.Writer, in AccountDeletionStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"scheduled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Scheduled))
+	}
+	if in.DeleteAfter != nil {
+		const prefix string = ",\"delete_after\":"
+		out.RawString(prefix)
+		out.Raw((*in.DeleteAfter).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountDeletionStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountDeletionStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountDeletionStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountDeletionStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain9(l, v)
+}
+func easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain10(in *jlexer.Lexer, out *AccountDeletionRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "username":
+			out.Username = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain10(out *jwriter.Writer, in AccountDeletionRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountDeletionRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountDeletionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson349b126bEncodeGithubComGoParkMailRu20251SuperChipsDomain10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountDeletionRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountDeletionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson349b126bDecodeGithubComGoParkMailRu20251SuperChipsDomain10(l, v)
+}
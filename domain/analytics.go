@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+	"html"
+	"time"
+)
+
+// типы событий журнала аналитики
+const (
+	EventImpression    = "impression"     // флоу показан в ленте или поиске
+	EventCloseup       = "closeup"        // открыта страница флоу
+	EventSave          = "save"           // флоу сохранён на доску
+	EventLike          = "like"           // лайк
+	EventComment       = "comment"        // комментарий
+	EventOutboundClick = "outbound_click" // переход по ссылке-источнику
+	EventFollow        = "follow"         // подписка на автора
+	EventUnfollow      = "unfollow"       // отписка от автора
+)
+
+// по чему можно отсортировать лучшие флоу
+const (
+	AnalyticsSortImpressions    = "impressions"
+	AnalyticsSortCloseups       = "closeups"
+	AnalyticsSortSaves          = "saves"
+	AnalyticsSortLikes          = "likes"
+	AnalyticsSortComments       = "comments"
+	AnalyticsSortOutboundClicks = "outbound_clicks"
+)
+
+const (
+	// максимальная длина запрашиваемого периода в днях
+	MaxAnalyticsRangeDays = 366
+	MaxAnalyticsTopFlows  = 50
+)
+
+var (
+	ErrInvalidDateRange     = errors.New("invalid date range")
+	ErrInvalidAnalyticsSort = errors.New("invalid sort")
+)
+
+// AnalyticsEvent — одно действие с флоу или аккаунтом. У событий подписки
+// флоу не задан, аккаунт определяется по AccountUsername
+type AnalyticsEvent struct {
+	Type            string
+	FlowID          uint64
+	AccountUsername string
+	ViewerID        int
+	CreatedAt       time.Time
+}
+
+//easyjson:json
+type FlowStats struct {
+	Impressions    int `json:"impressions"`
+	Closeups       int `json:"closeups"`
+	Saves          int `json:"saves"`
+	Likes          int `json:"likes"`
+	Comments       int `json:"comments"`
+	OutboundClicks int `json:"outbound_clicks"`
+}
+
+func (s *FlowStats) Add(other FlowStats) {
+	s.Impressions += other.Impressions
+	s.Closeups += other.Closeups
+	s.Saves += other.Saves
+	s.Likes += other.Likes
+	s.Comments += other.Comments
+	s.OutboundClicks += other.OutboundClicks
+}
+
+//easyjson:json
+type AnalyticsDay struct {
+	Day             string    `json:"day"`
+	Stats           FlowStats `json:"stats"`
+	FollowersGained int       `json:"followers_gained"`
+	FollowersLost   int       `json:"followers_lost"`
+}
+
+//easyjson:json
+type TopFlow struct {
+	FlowID   uint64    `json:"flow_id"`
+	Header   string    `json:"header,omitempty"`
+	MediaURL string    `json:"media_url,omitempty"`
+	Stats    FlowStats `json:"stats"`
+}
+
+func (f *TopFlow) Escape() {
+	f.Header = html.EscapeString(f.Header)
+}
+
+// CreatorAnalytics — статистика автора за период, по всем флоу или по одному
+//
+//easyjson:json
+type CreatorAnalytics struct {
+	From            string         `json:"from"`
+	To              string         `json:"to"`
+	FlowID          uint64         `json:"flow_id,omitempty"`
+	Totals          FlowStats      `json:"totals"`
+	FollowersGained int            `json:"followers_gained"`
+	FollowersLost   int            `json:"followers_lost"`
+	Daily           []AnalyticsDay `json:"daily"`
+	TopFlows        []TopFlow      `json:"top_flows,omitempty"`
+}
+
+// AnalyticsQuery — период включает обе даты. FlowID = 0 — весь аккаунт
+type AnalyticsQuery struct {
+	From   time.Time
+	To     time.Time
+	FlowID uint64
+	SortBy string
+	Top    int
+}
+
+func (q AnalyticsQuery) Validate() error {
+	if q.To.Before(q.From) || q.To.Sub(q.From) >= MaxAnalyticsRangeDays*24*time.Hour {
+		return ErrInvalidDateRange
+	}
+
+	switch q.SortBy {
+	case AnalyticsSortImpressions, AnalyticsSortCloseups, AnalyticsSortSaves,
+		AnalyticsSortLikes, AnalyticsSortComments, AnalyticsSortOutboundClicks:
+	default:
+		return ErrInvalidAnalyticsSort
+	}
+
+	return nil
+}
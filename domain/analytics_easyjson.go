@@ -0,0 +1,471 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *TopFlow) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flow_id":
+			out.FlowID = uint64(in.Uint64())
+		case "header":
+			out.Header = string(in.String())
+		case "media_url":
+			out.MediaURL = string(in.String())
+		case "stats":
+			(out.Stats).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in TopFlow) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flow_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FlowID))
+	}
+	if in.Header != "" {
+		const prefix string = ",\"header\":"
+		out.RawString(prefix)
+		out.String(string(in.Header))
+	}
+	if in.MediaURL != "" {
+		const prefix string = ",\"media_url\":"
+		out.RawString(prefix)
+		out.String(string(in.MediaURL))
+	}
+	{
+		const prefix string = ",\"stats\":"
+		out.RawString(prefix)
+		(in.Stats).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TopFlow) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TopFlow) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TopFlow) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TopFlow) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *FlowStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "impressions":
+			out.Impressions = int(in.Int())
+		case "closeups":
+			out.Closeups = int(in.Int())
+		case "saves":
+			out.Saves = int(in.Int())
+		case "likes":
+			out.Likes = int(in.Int())
+		case "comments":
+			out.Comments = int(in.Int())
+		case "outbound_clicks":
+			out.OutboundClicks = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in FlowStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"impressions\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Impressions))
+	}
+	{
+		const prefix string = ",\"closeups\":"
+		out.RawString(prefix)
+		out.Int(int(in.Closeups))
+	}
+	{
+		const prefix string = ",\"saves\":"
+		out.RawString(prefix)
+		out.Int(int(in.Saves))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		out.Int(int(in.Likes))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		out.Int(int(in.Comments))
+	}
+	{
+		const prefix string = ",\"outbound_clicks\":"
+		out.RawString(prefix)
+		out.Int(int(in.OutboundClicks))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlowStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlowStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlowStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlowStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *CreatorAnalytics) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from":
+			out.From = string(in.String())
+		case "to":
+			out.To = string(in.String())
+		case "flow_id":
+			out.FlowID = uint64(in.Uint64())
+		case "totals":
+			(out.Totals).UnmarshalEasyJSON(in)
+		case "followers_gained":
+			out.FollowersGained = int(in.Int())
+		case "followers_lost":
+			out.FollowersLost = int(in.Int())
+		case "daily":
+			if in.IsNull() {
+				in.Skip()
+				out.Daily = nil
+			} else {
+				in.Delim('[')
+				if out.Daily == nil {
+					if !in.IsDelim(']') {
+						out.Daily = make([]AnalyticsDay, 0, 0)
+					} else {
+						out.Daily = []AnalyticsDay{}
+					}
+				} else {
+					out.Daily = (out.Daily)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 AnalyticsDay
+					(v1).UnmarshalEasyJSON(in)
+					out.Daily = append(out.Daily, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "top_flows":
+			if in.IsNull() {
+				in.Skip()
+				out.TopFlows = nil
+			} else {
+				in.Delim('[')
+				if out.TopFlows == nil {
+					if !in.IsDelim(']') {
+						out.TopFlows = make([]TopFlow, 0, 0)
+					} else {
+						out.TopFlows = []TopFlow{}
+					}
+				} else {
+					out.TopFlows = (out.TopFlows)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 TopFlow
+					(v2).UnmarshalEasyJSON(in)
+					out.TopFlows = append(out.TopFlows, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in CreatorAnalytics) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.From))
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.String(string(in.To))
+	}
+	if in.FlowID != 0 {
+		const prefix string = ",\"flow_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.FlowID))
+	}
+	{
+		const prefix string = ",\"totals\":"
+		out.RawString(prefix)
+		(in.Totals).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"followers_gained\":"
+		out.RawString(prefix)
+		out.Int(int(in.FollowersGained))
+	}
+	{
+		const prefix string = ",\"followers_lost\":"
+		out.RawString(prefix)
+		out.Int(int(in.FollowersLost))
+	}
+	{
+		const prefix string = ",\"daily\":"
+		out.RawString(prefix)
+		if in.Daily == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.Daily {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				(v4).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.TopFlows) != 0 {
+		const prefix string = ",\"top_flows\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.TopFlows {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreatorAnalytics) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreatorAnalytics) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreatorAnalytics) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreatorAnalytics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+}
+func easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain3(in *jlexer.Lexer, out *AnalyticsDay) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "day":
+			out.Day = string(in.String())
+		case "stats":
+			(out.Stats).UnmarshalEasyJSON(in)
+		case "followers_gained":
+			out.FollowersGained = int(in.Int())
+		case "followers_lost":
+			out.FollowersLost = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain3(out *jwriter.Writer, in AnalyticsDay) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"day\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Day))
+	}
+	{
+		const prefix string = ",\"stats\":"
+		out.RawString(prefix)
+		(in.Stats).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"followers_gained\":"
+		out.RawString(prefix)
+		out.Int(int(in.FollowersGained))
+	}
+	{
+		const prefix string = ",\"followers_lost\":"
+		out.RawString(prefix)
+		out.Int(int(in.FollowersLost))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AnalyticsDay) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AnalyticsDay) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251SuperChipsDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AnalyticsDay) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AnalyticsDay) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251SuperChipsDomain3(l, v)
+}
@@ -3,6 +3,8 @@ package domain
 import (
 	"errors"
 	"html"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -20,9 +22,17 @@ type Board struct {
 	AuthorUsername string    `json:"author_username,omitempty"`
 	Name           string    `json:"name"`
 	IsEditable     bool      `json:"is_editable"`
+	Role           string    `json:"role,omitempty"`
 	CreatedAt      time.Time `json:"-"`
 	IsPrivate      bool      `json:"is_private"`
+	IsSecret       bool      `json:"is_secret"`
+	Description    string    `json:"description,omitempty"`
+	CoverFlowID    int       `json:"cover_flow_id,omitempty"`
+	Cover          string    `json:"cover,omitempty"`
+	Tags           []string  `json:"tags,omitempty"`
 	FlowCount      int       `json:"flow_count"`
+	FollowerCount  int       `json:"follower_count"`
+	IsFollowed     bool      `json:"is_followed"`
 	Preview        []PinData `json:"preview,omitempty"`
 	Gradient       []string  `json:"gradient,omitempty"`
 }
@@ -30,16 +40,141 @@ type Board struct {
 //easyjson:json
 type BoardRequest struct {
 	FlowID int `json:"flow_id,omitempty"`
+	// доска, на которой пользователь нашёл пин
+	SavedFromBoardID int `json:"saved_from_board_id,omitempty"`
 }
 
+// секретная доска доступна по ссылке, но не показывается в профиле и поиске.
+// description, cover_flow_id и tags меняются, только если переданы;
+// cover_flow_id = 0 убирает обложку
+//
 //easyjson:json
 type UpdateData struct {
-	Name      string `json:"name"`
-	IsPrivate bool   `json:"is_private"`
+	Name        string   `json:"name"`
+	IsPrivate   bool     `json:"is_private"`
+	IsSecret    bool     `json:"is_secret"`
+	Description *string  `json:"description,omitempty"`
+	CoverFlowID *int     `json:"cover_flow_id,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+const (
+	MaxBoardDescriptionLength = 500
+	MaxBoardTags              = 10
+	MaxBoardTagLength         = 32
+)
+
+// Validate проверяет описание и видимость доски и приводит теги к нижнему регистру без повторов
+func (u *UpdateData) Validate() error {
+	if u.IsPrivate && u.IsSecret {
+		return ErrInvalidVisibility
+	}
+
+	if u.Description != nil {
+		description := strings.TrimSpace(*u.Description)
+		if len([]rune(description)) > MaxBoardDescriptionLength {
+			return ErrDescriptionTooLong
+		}
+		u.Description = &description
+	}
+
+	if u.Tags == nil {
+		return nil
+	}
+
+	tags := make([]string, 0, len(u.Tags))
+	for _, tag := range u.Tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || len([]rune(tag)) > MaxBoardTagLength {
+			return ErrInvalidBoardTags
+		}
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	if len(tags) > MaxBoardTags {
+		return ErrInvalidBoardTags
+	}
+	u.Tags = tags
+
+	return nil
+}
+
+//easyjson:json
+type BoardSection struct {
+	ID        int       `json:"id"`
+	BoardID   int       `json:"board_id"`
+	Name      string    `json:"name"`
+	FlowCount int       `json:"flow_count"`
+	CreatedAt time.Time `json:"-"`
+}
+
+//easyjson:json
+type SectionRequest struct {
+	Name string `json:"name"`
+}
+
+// позиция задаётся соседями: after_id — элемент сразу перед, before_id — сразу после.
+// если соседи не указаны, раздел встаёт в конец доски
+//
+//easyjson:json
+type SectionMove struct {
+	AfterID  int `json:"after_id,omitempty"`
+	BeforeID int `json:"before_id,omitempty"`
+}
+
+// перемещение пина внутри доски, между разделами или в другую доску.
+// target_board_id = 0 — та же доска, section_id = 0 — без раздела,
+// без соседей пин встаёт в начало доски
+//
+//easyjson:json
+type FlowMove struct {
+	TargetBoardID int `json:"target_board_id,omitempty"`
+	SectionID     int `json:"section_id,omitempty"`
+	AfterID       int `json:"after_id,omitempty"`
+	BeforeID      int `json:"before_id,omitempty"`
+}
+
+// данные уведомления о новых пинах в отслеживаемой доске
+//
+//easyjson:json
+type BoardFlowAdded struct {
+	BoardID   int    `json:"board_id"`
+	BoardName string `json:"board_name"`
+	FlowID    int    `json:"flow_id"`
+}
+
+// данные уведомления автору о сохранении его пина
+//
+//easyjson:json
+type FlowSaved struct {
+	FlowID    int    `json:"flow_id"`
+	BoardID   int    `json:"board_id"`
+	BoardName string `json:"board_name"`
+}
+
+// публичная доска, на которую сохранён пин
+//
+//easyjson:json
+type FlowSave struct {
+	BoardID   int       `json:"board_id"`
+	BoardName string    `json:"board_name"`
+	Username  string    `json:"username"`
+	SavedAt   time.Time `json:"saved_at"`
+}
+
+func (s *FlowSave) Escape() {
+	s.BoardName = html.EscapeString(s.BoardName)
+	s.Username = html.EscapeString(s.Username)
 }
 
 func (b *Board) Escape() {
 	b.Name = html.EscapeString(b.Name)
+	b.Description = html.EscapeString(b.Description)
+	for i := range b.Tags {
+		b.Tags[i] = html.EscapeString(b.Tags[i])
+	}
 }
 
 func EscapeBoards(boards []Board) {
@@ -51,7 +186,23 @@ func EscapeBoards(boards []Board) {
 	}
 }
 
+func (s *BoardSection) Escape() {
+	s.Name = html.EscapeString(s.Name)
+}
+
+func EscapeSections(sections []BoardSection) {
+	for i := range sections {
+		sections[i].Escape()
+	}
+}
+
 var (
 	ErrNoBoardName        = errors.New("board must have a name")
 	ErrBoardAlreadyExists = errors.New("a board with that name already exists in your account")
+	ErrSectionNotFound    = errors.New("section not found")
+	ErrInvalidPosition    = errors.New("invalid position")
+	ErrInvalidVisibility  = errors.New("board cannot be private and secret at the same time")
+	ErrDescriptionTooLong = errors.New("board description is too long")
+	ErrInvalidBoardTags   = errors.New("invalid board tags")
+	ErrInvalidBoardCover  = errors.New("cover flow must belong to the board")
 )
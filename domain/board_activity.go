@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"html"
+	"time"
+)
+
+// действия в журнале доски
+const (
+	ActivityFlowAdded         = "flow_added"
+	ActivityFlowRemoved       = "flow_removed"
+	ActivityBoardRenamed      = "board_renamed"
+	ActivityPrivacyChanged    = "privacy_changed"
+	ActivityCoauthorJoined    = "coauthor_joined"
+	ActivityCoauthorLeft      = "coauthor_left"
+	ActivityCoauthorRemoved   = "coauthor_removed"
+	ActivityInvitationCreated = "invitation_created"
+	ActivityInvitationRevoked = "invitation_revoked"
+	ActivityInvitationRenewed = "invitation_renewed"
+)
+
+// запись журнала доски. TargetID — пользователь, над которым совершено действие
+//
+//easyjson:json
+type BoardActivity struct {
+	ID             int               `json:"id"`
+	BoardID        int               `json:"board_id"`
+	ActorID        int               `json:"-"`
+	ActorUsername  string            `json:"actor_username,omitempty"`
+	Action         string            `json:"action"`
+	FlowID         int               `json:"flow_id,omitempty"`
+	TargetID       int               `json:"-"`
+	TargetUsername string            `json:"target_username,omitempty"`
+	Details        map[string]string `json:"details,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+}
+
+func (a *BoardActivity) Escape() {
+	for key, value := range a.Details {
+		a.Details[key] = html.EscapeString(value)
+	}
+}
+
+func EscapeActivities(activities []BoardActivity) {
+	for i := range activities {
+		activities[i].Escape()
+	}
+}
+
+// BoardVisibility возвращает название уровня видимости доски для журнала
+func BoardVisibility(isPrivate, isSecret bool) string {
+	switch {
+	case isPrivate:
+		return "private"
+	case isSecret:
+		return "secret"
+	default:
+		return "public"
+	}
+}
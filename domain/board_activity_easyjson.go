@@ -0,0 +1,168 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson5578b4e4DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *BoardActivity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "actor_username":
+			out.ActorUsername = string(in.String())
+		case "action":
+			out.Action = string(in.String())
+		case "flow_id":
+			out.FlowID = int(in.Int())
+		case "target_username":
+			out.TargetUsername = string(in.String())
+		case "details":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Details = make(map[string]string)
+				} else {
+					out.Details = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 string
+					v1 = string(in.String())
+					(out.Details)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5578b4e4EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in BoardActivity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoardID))
+	}
+	if in.ActorUsername != "" {
+		const prefix string = ",\"actor_username\":"
+		out.RawString(prefix)
+		out.String(string(in.ActorUsername))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	if in.FlowID != 0 {
+		const prefix string = ",\"flow_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FlowID))
+	}
+	if in.TargetUsername != "" {
+		const prefix string = ",\"target_username\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetUsername))
+	}
+	if len(in.Details) != 0 {
+		const prefix string = ",\"details\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.Details {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.String(string(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BoardActivity) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson5578b4e4EncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BoardActivity) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5578b4e4EncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BoardActivity) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson5578b4e4DecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BoardActivity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5578b4e4DecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
@@ -0,0 +1,80 @@
+package domain
+
+import "errors"
+
+// действия над несколькими пинами доски
+const (
+	BulkActionAdd    = "add"
+	BulkActionRemove = "remove"
+	BulkActionMove   = "move"
+	BulkActionCopy   = "copy"
+)
+
+// результат для отдельного пина
+const (
+	BulkStatusOK           = "ok"
+	BulkStatusNotFound     = "not_found"
+	BulkStatusAlreadySaved = "already_on_board"
+)
+
+const MaxBulkFlows = 50
+
+// target_board_id нужен для move и copy
+//
+//easyjson:json
+type BulkFlowRequest struct {
+	Action        string `json:"action"`
+	FlowIDs       []int  `json:"flow_ids"`
+	TargetBoardID int    `json:"target_board_id,omitempty"`
+}
+
+//easyjson:json
+type BulkFlowResult struct {
+	FlowID int    `json:"flow_id"`
+	Status string `json:"status"`
+}
+
+//easyjson:json
+type MergeRequest struct {
+	TargetBoardID int `json:"target_board_id"`
+}
+
+//easyjson:json
+type DuplicateRequest struct {
+	Name string `json:"name"`
+}
+
+var ErrInvalidBulkRequest = errors.New("invalid bulk request")
+
+// Validate проверяет действие, список пинов и целевую доску для запроса к доске boardID
+func (r BulkFlowRequest) Validate(boardID int) error {
+	switch r.Action {
+	case BulkActionAdd, BulkActionRemove:
+		if r.TargetBoardID != 0 {
+			return ErrInvalidBulkRequest
+		}
+	case BulkActionMove, BulkActionCopy:
+		if r.TargetBoardID <= 0 || r.TargetBoardID == boardID {
+			return ErrInvalidBulkRequest
+		}
+	default:
+		return ErrInvalidBulkRequest
+	}
+
+	if len(r.FlowIDs) == 0 || len(r.FlowIDs) > MaxBulkFlows {
+		return ErrInvalidBulkRequest
+	}
+
+	seen := make(map[int]struct{}, len(r.FlowIDs))
+	for _, id := range r.FlowIDs {
+		if id <= 0 {
+			return ErrInvalidBulkRequest
+		}
+		if _, ok := seen[id]; ok {
+			return ErrInvalidBulkRequest
+		}
+		seen[id] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,336 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *MergeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "target_board_id":
+			out.TargetBoardID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in MergeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"target_board_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TargetBoardID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MergeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MergeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MergeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MergeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *DuplicateRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in DuplicateRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DuplicateRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DuplicateRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DuplicateRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DuplicateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *BulkFlowResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flow_id":
+			out.FlowID = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in BulkFlowResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flow_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.FlowID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BulkFlowResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BulkFlowResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BulkFlowResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BulkFlowResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+}
+func easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain3(in *jlexer.Lexer, out *BulkFlowRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			out.Action = string(in.String())
+		case "flow_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.FlowIDs = nil
+			} else {
+				in.Delim('[')
+				if out.FlowIDs == nil {
+					if !in.IsDelim(']') {
+						out.FlowIDs = make([]int, 0, 8)
+					} else {
+						out.FlowIDs = []int{}
+					}
+				} else {
+					out.FlowIDs = (out.FlowIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 int
+					v1 = int(in.Int())
+					out.FlowIDs = append(out.FlowIDs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "target_board_id":
+			out.TargetBoardID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain3(out *jwriter.Writer, in BulkFlowRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"flow_ids\":"
+		out.RawString(prefix)
+		if in.FlowIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.FlowIDs {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.TargetBoardID != 0 {
+		const prefix string = ",\"target_board_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.TargetBoardID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BulkFlowRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BulkFlowRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3ce35d1bEncodeGithubComGoParkMailRu20251SuperChipsDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BulkFlowRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BulkFlowRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3ce35d1bDecodeGithubComGoParkMailRu20251SuperChipsDomain3(l, v)
+}
@@ -0,0 +1,36 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkFlowRequestValidate(t *testing.T) {
+	tooMany := make([]int, domain.MaxBulkFlows+1)
+	for i := range tooMany {
+		tooMany[i] = i + 1
+	}
+
+	tests := []struct {
+		name string
+		req  domain.BulkFlowRequest
+		err  error
+	}{
+		{"Сценарий: добавление пинов", domain.BulkFlowRequest{Action: domain.BulkActionAdd, FlowIDs: []int{1, 2}}, nil},
+		{"Сценарий: перенос в другую доску", domain.BulkFlowRequest{Action: domain.BulkActionMove, FlowIDs: []int{1}, TargetBoardID: 2}, nil},
+		{"Сценарий: неизвестное действие", domain.BulkFlowRequest{Action: "pin", FlowIDs: []int{1}}, domain.ErrInvalidBulkRequest},
+		{"Сценарий: пустой список", domain.BulkFlowRequest{Action: domain.BulkActionRemove}, domain.ErrInvalidBulkRequest},
+		{"Сценарий: слишком много пинов", domain.BulkFlowRequest{Action: domain.BulkActionAdd, FlowIDs: tooMany}, domain.ErrInvalidBulkRequest},
+		{"Сценарий: повтор пина", domain.BulkFlowRequest{Action: domain.BulkActionAdd, FlowIDs: []int{1, 1}}, domain.ErrInvalidBulkRequest},
+		{"Сценарий: копирование без целевой доски", domain.BulkFlowRequest{Action: domain.BulkActionCopy, FlowIDs: []int{1}}, domain.ErrInvalidBulkRequest},
+		{"Сценарий: перенос в ту же доску", domain.BulkFlowRequest{Action: domain.BulkActionMove, FlowIDs: []int{1}, TargetBoardID: 7}, domain.ErrInvalidBulkRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(7), tt.err)
+		})
+	}
+}
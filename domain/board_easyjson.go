@@ -40,6 +40,471 @@ func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 			out.Name = string(in.String())
 		case "is_private":
 			out.IsPrivate = bool(in.Bool())
+		case "is_secret":
+			out.IsSecret = bool(in.Bool())
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				*out.Description = string(in.String())
+			}
+		case "cover_flow_id":
+			if in.IsNull() {
+				in.Skip()
+				out.CoverFlowID = nil
+			} else {
+				if out.CoverFlowID == nil {
+					out.CoverFlowID = new(int)
+				}
+				*out.CoverFlowID = int(in.Int())
+			}
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Tags = append(out.Tags, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in UpdateData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"is_private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivate))
+	}
+	{
+		const prefix string = ",\"is_secret\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSecret))
+	}
+	if in.Description != nil {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(*in.Description))
+	}
+	if in.CoverFlowID != nil {
+		const prefix string = ",\"cover_flow_id\":"
+		out.RawString(prefix)
+		out.Int(int(*in.CoverFlowID))
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Tags {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *SectionRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in SectionRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SectionRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SectionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SectionRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SectionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *SectionMove) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "after_id":
+			out.AfterID = int(in.Int())
+		case "before_id":
+			out.BeforeID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in SectionMove) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.AfterID != 0 {
+		const prefix string = ",\"after_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.AfterID))
+	}
+	if in.BeforeID != 0 {
+		const prefix string = ",\"before_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.BeforeID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SectionMove) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SectionMove) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SectionMove) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SectionMove) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+}
+func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain3(in *jlexer.Lexer, out *FlowSaved) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "flow_id":
+			out.FlowID = int(in.Int())
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "board_name":
+			out.BoardName = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain3(out *jwriter.Writer, in FlowSaved) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"flow_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.FlowID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"board_name\":"
+		out.RawString(prefix)
+		out.String(string(in.BoardName))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlowSaved) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlowSaved) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlowSaved) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlowSaved) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain3(l, v)
+}
+func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain4(in *jlexer.Lexer, out *FlowSave) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "board_name":
+			out.BoardName = string(in.String())
+		case "username":
+			out.Username = string(in.String())
+		case "saved_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.SavedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain4(out *jwriter.Writer, in FlowSave) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"board_name\":"
+		out.RawString(prefix)
+		out.String(string(in.BoardName))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"saved_at\":"
+		out.RawString(prefix)
+		out.Raw((in.SavedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlowSave) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlowSave) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlowSave) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlowSave) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain4(l, v)
+}
+func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain5(in *jlexer.Lexer, out *FlowMove) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "target_board_id":
+			out.TargetBoardID = int(in.Int())
+		case "section_id":
+			out.SectionID = int(in.Int())
+		case "after_id":
+			out.AfterID = int(in.Int())
+		case "before_id":
+			out.BeforeID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -50,47 +515,160 @@ func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in UpdateData) {
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain5(out *jwriter.Writer, in FlowMove) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.TargetBoardID != 0 {
+		const prefix string = ",\"target_board_id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.TargetBoardID))
+	}
+	if in.SectionID != 0 {
+		const prefix string = ",\"section_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.SectionID))
+	}
+	if in.AfterID != 0 {
+		const prefix string = ",\"after_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.AfterID))
+	}
+	if in.BeforeID != 0 {
+		const prefix string = ",\"before_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.BeforeID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlowMove) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlowMove) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlowMove) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlowMove) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain5(l, v)
+}
+func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain6(in *jlexer.Lexer, out *BoardSection) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "flow_count":
+			out.FlowCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain6(out *jwriter.Writer, in BoardSection) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"is_private\":"
+		const prefix string = ",\"flow_count\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsPrivate))
+		out.Int(int(in.FlowCount))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdateData) MarshalJSON() ([]byte, error) {
+func (v BoardSection) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdateData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+func (v BoardSection) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdateData) UnmarshalJSON(data []byte) error {
+func (v *BoardSection) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdateData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+func (v *BoardSection) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain6(l, v)
 }
-func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *BoardRequest) {
+func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain7(in *jlexer.Lexer, out *BoardRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -111,6 +689,8 @@ func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexe
 		switch key {
 		case "flow_id":
 			out.FlowID = int(in.Int())
+		case "saved_from_board_id":
+			out.SavedFromBoardID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -121,7 +701,7 @@ func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexe
 		in.Consumed()
 	}
 }
-func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in BoardRequest) {
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain7(out *jwriter.Writer, in BoardRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -131,33 +711,123 @@ func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwri
 		out.RawString(prefix[1:])
 		out.Int(int(in.FlowID))
 	}
+	if in.SavedFromBoardID != 0 {
+		const prefix string = ",\"saved_from_board_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.SavedFromBoardID))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v BoardRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BoardRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BoardRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BoardRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain7(l, v)
+}
+func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain8(in *jlexer.Lexer, out *BoardFlowAdded) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "board_name":
+			out.BoardName = string(in.String())
+		case "flow_id":
+			out.FlowID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain8(out *jwriter.Writer, in BoardFlowAdded) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"board_name\":"
+		out.RawString(prefix)
+		out.String(string(in.BoardName))
+	}
+	{
+		const prefix string = ",\"flow_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.FlowID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BoardFlowAdded) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BoardFlowAdded) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BoardFlowAdded) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BoardFlowAdded) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain8(l, v)
 }
-func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *Board) {
+func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain9(in *jlexer.Lexer, out *Board) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -186,10 +856,47 @@ func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexe
 			out.Name = string(in.String())
 		case "is_editable":
 			out.IsEditable = bool(in.Bool())
+		case "role":
+			out.Role = string(in.String())
 		case "is_private":
 			out.IsPrivate = bool(in.Bool())
+		case "is_secret":
+			out.IsSecret = bool(in.Bool())
+		case "description":
+			out.Description = string(in.String())
+		case "cover_flow_id":
+			out.CoverFlowID = int(in.Int())
+		case "cover":
+			out.Cover = string(in.String())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Tags = append(out.Tags, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		case "flow_count":
 			out.FlowCount = int(in.Int())
+		case "follower_count":
+			out.FollowerCount = int(in.Int())
+		case "is_followed":
+			out.IsFollowed = bool(in.Bool())
 		case "preview":
 			if in.IsNull() {
 				in.Skip()
@@ -206,9 +913,9 @@ func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexe
 					out.Preview = (out.Preview)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 PinData
-					(v1).UnmarshalEasyJSON(in)
-					out.Preview = append(out.Preview, v1)
+					var v5 PinData
+					(v5).UnmarshalEasyJSON(in)
+					out.Preview = append(out.Preview, v5)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -229,9 +936,9 @@ func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexe
 					out.Gradient = (out.Gradient)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v2 string
-					v2 = string(in.String())
-					out.Gradient = append(out.Gradient, v2)
+					var v6 string
+					v6 = string(in.String())
+					out.Gradient = append(out.Gradient, v6)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -246,7 +953,7 @@ func easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexe
 		in.Consumed()
 	}
 }
-func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in Board) {
+func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain9(out *jwriter.Writer, in Board) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -275,26 +982,75 @@ func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwri
 		out.RawString(prefix)
 		out.Bool(bool(in.IsEditable))
 	}
+	if in.Role != "" {
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
 	{
 		const prefix string = ",\"is_private\":"
 		out.RawString(prefix)
 		out.Bool(bool(in.IsPrivate))
 	}
+	{
+		const prefix string = ",\"is_secret\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSecret))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.CoverFlowID != 0 {
+		const prefix string = ",\"cover_flow_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.CoverFlowID))
+	}
+	if in.Cover != "" {
+		const prefix string = ",\"cover\":"
+		out.RawString(prefix)
+		out.String(string(in.Cover))
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v7, v8 := range in.Tags {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v8))
+			}
+			out.RawByte(']')
+		}
+	}
 	{
 		const prefix string = ",\"flow_count\":"
 		out.RawString(prefix)
 		out.Int(int(in.FlowCount))
 	}
+	{
+		const prefix string = ",\"follower_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.FollowerCount))
+	}
+	{
+		const prefix string = ",\"is_followed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFollowed))
+	}
 	if len(in.Preview) != 0 {
 		const prefix string = ",\"preview\":"
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v3, v4 := range in.Preview {
-				if v3 > 0 {
+			for v9, v10 := range in.Preview {
+				if v9 > 0 {
 					out.RawByte(',')
 				}
-				(v4).MarshalEasyJSON(out)
+				(v10).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -304,11 +1060,11 @@ func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwri
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v5, v6 := range in.Gradient {
-				if v5 > 0 {
+			for v11, v12 := range in.Gradient {
+				if v11 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v6))
+				out.String(string(v12))
 			}
 			out.RawByte(']')
 		}
@@ -319,23 +1075,23 @@ func easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwri
 // MarshalJSON supports json.Marshaler interface
 func (v Board) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Board) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+	easyjson202377feEncodeGithubComGoParkMailRu20251SuperChipsDomain9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Board) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Board) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+	easyjson202377feDecodeGithubComGoParkMailRu20251SuperChipsDomain9(l, v)
 }
@@ -0,0 +1,39 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateDataValidate(t *testing.T) {
+	longDescription := strings.Repeat("а", domain.MaxBoardDescriptionLength+1)
+
+	tests := []struct {
+		name string
+		data domain.UpdateData
+		err  error
+	}{
+		{"Сценарий: публичная доска", domain.UpdateData{Name: "доска"}, nil},
+		{"Сценарий: секретная доска", domain.UpdateData{Name: "доска", IsSecret: true}, nil},
+		{"Сценарий: приватная и секретная одновременно", domain.UpdateData{Name: "доска", IsPrivate: true, IsSecret: true}, domain.ErrInvalidVisibility},
+		{"Сценарий: слишком длинное описание", domain.UpdateData{Name: "доска", Description: &longDescription}, domain.ErrDescriptionTooLong},
+		{"Сценарий: пустой тег", domain.UpdateData{Name: "доска", Tags: []string{"  "}}, domain.ErrInvalidBoardTags},
+		{"Сценарий: слишком много тегов", domain.UpdateData{Name: "доска", Tags: strings.Split("a b c d e f g h i j k", " ")}, domain.ErrInvalidBoardTags},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.data.Validate(), tt.err)
+		})
+	}
+}
+
+func TestUpdateDataValidate_NormalizesTags(t *testing.T) {
+	data := domain.UpdateData{Name: "доска", Tags: []string{" Кухня", "ДЕСЕРТЫ ", "кухня"}}
+
+	assert.NoError(t, data.Validate())
+	assert.Equal(t, []string{"кухня", "десерты"}, data.Tags)
+}
@@ -1,10 +1,65 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// роли соавторов доски, каждая следующая включает права предыдущих:
+// - viewer:      видит приватную доску и её пины;
+// - contributor: может добавлять пины;
+// - editor:      может удалять и переставлять пины, управлять разделами;
+// - admin:       может приглашать, менять роли и удалять соавторов с ролью ниже своей.
+// Роль owner принадлежит автору доски и не назначается.
+const (
+	BoardRoleViewer      = "viewer"
+	BoardRoleContributor = "contributor"
+	BoardRoleEditor      = "editor"
+	BoardRoleAdmin       = "admin"
+	BoardRoleOwner       = "owner"
+)
+
+// роль по умолчанию для приглашений без явной роли
+const DefaultBoardRole = BoardRoleEditor
+
+var boardRoleLevels = map[string]int{
+	BoardRoleViewer:      1,
+	BoardRoleContributor: 2,
+	BoardRoleEditor:      3,
+	BoardRoleAdmin:       4,
+	BoardRoleOwner:       5,
+}
+
+var ErrInvalidBoardRole = errors.New("invalid board role")
+
+// BoardRoleAllows проверяет, что роль role не ниже required.
+// пустая роль означает, что пользователь не участник доски
+func BoardRoleAllows(role, required string) bool {
+	level, ok := boardRoleLevels[role]
+	return ok && level >= boardRoleLevels[required]
+}
+
+// IsAssignableBoardRole проверяет, что роль можно выдать соавтору
+func IsAssignableBoardRole(role string) bool {
+	_, ok := boardRoleLevels[role]
+	return ok && role != BoardRoleOwner
+}
+
+// CanManageBoardRole проверяет, что granter может выдать или отобрать роль role:
+// управлять можно только ролями ниже своей, и только начиная с admin
+func CanManageBoardRole(granter, role string) bool {
+	if !BoardRoleAllows(granter, BoardRoleAdmin) {
+		return false
+	}
+
+	level, ok := boardRoleLevels[role]
+	return ok && level < boardRoleLevels[granter]
+}
 
 // Приглашения могут иметь следующие параметры:
 // - Names:     Имена приглашаемых пользователей. При отсутствии параметра ссылка публичная.
 // - TimeLimit: Время, в течение которого ссылка активна. При отсутствии параметра ссылка бессрочная.
+// - Role:      Роль, которую получит соавтор. При отсутствии параметра — editor.
 // - UsageLimit: Количество пользователей, которые могут воспользоваться этой ссылкой. При отсутствии параметра количество не ограничено. При неоднократном становлении соавтором одним и тем же пользователем лимит не растрачивается.
 //
 //easyjson:json
@@ -12,6 +67,7 @@ type Invitaion struct {
 	Names      *[]string `json:"names,omitempty"`
 	TimeLimit  *time.Time  `json:"time_limit,omitempty"`
 	UsageLimit *int      `json:"usage_limit,omitempty"`
+	Role       string    `json:"role,omitempty"`
 }
 
 //easyjson:json
@@ -21,9 +77,55 @@ type LinkParams struct {
 	TimeLimit  *time.Time `json:"time_limit"`
 	UsageLimit *int64     `json:"usage_limit"`
 	UsageCount int64     `json:"usage_count"`
+	Role       string     `json:"role"`
+	IsPaused   bool       `json:"is_paused"`
+}
+
+// вступление в соавторы по ссылке
+//
+//easyjson:json
+type InvitationJoin struct {
+	Username         string    `json:"username"`
+	Avatar           string    `json:"avatar,omitempty"`
+	IsExternalAvatar bool      `json:"-"`
+	JoinedAt         time.Time `json:"joined_at"`
+}
+
+//easyjson:json
+type LinkPause struct {
+	IsPaused bool `json:"is_paused"`
 }
 
 //easyjson:json
 type BodyWithUsername struct {
 	Name string `json:"name"`
 }
+
+//easyjson:json
+type CoauthorRoleUpdate struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+// статусы передачи владения доской
+const (
+	OwnershipTransferPending   = "pending"
+	OwnershipTransferAccepted  = "accepted"
+	OwnershipTransferDeclined  = "declined"
+	OwnershipTransferCancelled = "cancelled"
+)
+
+// Передачу предлагает автор доски, принимает или отклоняет соавтор.
+// После принятия прежний автор остаётся соавтором с ролью admin.
+//
+//easyjson:json
+type OwnershipTransfer struct {
+	ID           int       `json:"id"`
+	BoardID      int       `json:"board_id"`
+	FromUserID   int       `json:"-"`
+	FromUsername string    `json:"from_username"`
+	ToUserID     int       `json:"-"`
+	ToUsername   string    `json:"to_username"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+}
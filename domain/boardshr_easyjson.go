@@ -18,7 +18,176 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *LinkParams) {
+func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *OwnershipTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "from_username":
+			out.FromUsername = string(in.String())
+		case "to_username":
+			out.ToUsername = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in OwnershipTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"from_username\":"
+		out.RawString(prefix)
+		out.String(string(in.FromUsername))
+	}
+	{
+		const prefix string = ",\"to_username\":"
+		out.RawString(prefix)
+		out.String(string(in.ToUsername))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OwnershipTransfer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OwnershipTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OwnershipTransfer) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OwnershipTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *LinkPause) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_paused":
+			out.IsPaused = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in LinkPause) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_paused\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsPaused))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LinkPause) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LinkPause) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LinkPause) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LinkPause) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *LinkParams) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -94,6 +263,10 @@ func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 			}
 		case "usage_count":
 			out.UsageCount = int64(in.Int64())
+		case "role":
+			out.Role = string(in.String())
+		case "is_paused":
+			out.IsPaused = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -104,7 +277,7 @@ func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in LinkParams) {
+func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in LinkParams) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -156,33 +329,125 @@ func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwrit
 		out.RawString(prefix)
 		out.Int64(int64(in.UsageCount))
 	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"is_paused\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPaused))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v LinkParams) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LinkParams) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LinkParams) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LinkParams) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
 }
-func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *Invitaion) {
+func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain3(in *jlexer.Lexer, out *InvitationJoin) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "username":
+			out.Username = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "joined_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.JoinedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain3(out *jwriter.Writer, in InvitationJoin) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Username))
+	}
+	if in.Avatar != "" {
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"joined_at\":"
+		out.RawString(prefix)
+		out.Raw((in.JoinedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v InvitationJoin) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v InvitationJoin) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *InvitationJoin) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *InvitationJoin) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain3(l, v)
+}
+func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain4(in *jlexer.Lexer, out *Invitaion) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -254,6 +519,8 @@ func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexe
 				}
 				*out.UsageLimit = int(in.Int())
 			}
+		case "role":
+			out.Role = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -264,7 +531,7 @@ func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexe
 		in.Consumed()
 	}
 }
-func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in Invitaion) {
+func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain4(out *jwriter.Writer, in Invitaion) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -305,33 +572,116 @@ func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwri
 		}
 		out.Int(int(*in.UsageLimit))
 	}
+	if in.Role != "" {
+		const prefix string = ",\"role\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Role))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Invitaion) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Invitaion) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Invitaion) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Invitaion) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain4(l, v)
+}
+func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain5(in *jlexer.Lexer, out *CoauthorRoleUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain5(out *jwriter.Writer, in CoauthorRoleUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CoauthorRoleUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CoauthorRoleUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain5(w, v)
 }
-func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *BodyWithUsername) {
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CoauthorRoleUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CoauthorRoleUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain5(l, v)
+}
+func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain6(in *jlexer.Lexer, out *BodyWithUsername) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -362,7 +712,7 @@ func easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexe
 		in.Consumed()
 	}
 }
-func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in BodyWithUsername) {
+func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain6(out *jwriter.Writer, in BodyWithUsername) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -377,23 +727,23 @@ func easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwri
 // MarshalJSON supports json.Marshaler interface
 func (v BodyWithUsername) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BodyWithUsername) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+	easyjsonEf7bfb6dEncodeGithubComGoParkMailRu20251SuperChipsDomain6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BodyWithUsername) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BodyWithUsername) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+	easyjsonEf7bfb6dDecodeGithubComGoParkMailRu20251SuperChipsDomain6(l, v)
 }
@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardRoleAllows(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		required string
+		want     bool
+	}{
+		{"Сценарий: не участник доски", "", domain.BoardRoleViewer, false},
+		{"Сценарий: зритель смотрит доску", domain.BoardRoleViewer, domain.BoardRoleViewer, true},
+		{"Сценарий: зритель добавляет пин", domain.BoardRoleViewer, domain.BoardRoleContributor, false},
+		{"Сценарий: участник добавляет пин", domain.BoardRoleContributor, domain.BoardRoleContributor, true},
+		{"Сценарий: участник удаляет пин", domain.BoardRoleContributor, domain.BoardRoleEditor, false},
+		{"Сценарий: редактор удаляет пин", domain.BoardRoleEditor, domain.BoardRoleEditor, true},
+		{"Сценарий: редактор приглашает", domain.BoardRoleEditor, domain.BoardRoleAdmin, false},
+		{"Сценарий: автор делает всё", domain.BoardRoleOwner, domain.BoardRoleAdmin, true},
+		{"Сценарий: неизвестная роль", "superuser", domain.BoardRoleViewer, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, domain.BoardRoleAllows(tt.role, tt.required))
+		})
+	}
+}
+
+func TestCanManageBoardRole(t *testing.T) {
+	assert.True(t, domain.CanManageBoardRole(domain.BoardRoleOwner, domain.BoardRoleAdmin))
+	assert.True(t, domain.CanManageBoardRole(domain.BoardRoleAdmin, domain.BoardRoleEditor))
+	assert.False(t, domain.CanManageBoardRole(domain.BoardRoleAdmin, domain.BoardRoleAdmin))
+	assert.False(t, domain.CanManageBoardRole(domain.BoardRoleEditor, domain.BoardRoleViewer))
+	assert.False(t, domain.CanManageBoardRole(domain.BoardRoleOwner, domain.BoardRoleOwner))
+
+	assert.True(t, domain.IsAssignableBoardRole(domain.BoardRoleViewer))
+	assert.False(t, domain.IsAssignableBoardRole(domain.BoardRoleOwner))
+	assert.False(t, domain.IsAssignableBoardRole(""))
+}
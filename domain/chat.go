@@ -35,6 +35,7 @@ type Contact struct {
 	PublicName       string `json:"public_name"`
 	Avatar           string `json:"avatar"`
 	IsExternalAvatar bool   `json:"-"`
+	Role             string `json:"role,omitempty"`
 }
 
 func (m *Message) Escape() {
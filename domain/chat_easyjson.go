@@ -152,6 +152,8 @@ func easyjson9b8f5552DecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexe
 			out.PublicName = string(in.String())
 		case "avatar":
 			out.Avatar = string(in.String())
+		case "role":
+			out.Role = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -181,6 +183,11 @@ func easyjson9b8f5552EncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwri
 		out.RawString(prefix)
 		out.String(string(in.Avatar))
 	}
+	if in.Role != "" {
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// статусы проверки пина на NSFW
+const (
+	NSFWStatusPending = "pending"
+	NSFWStatusSafe    = "safe"
+	NSFWStatusNSFW    = "nsfw"
+	NSFWStatusFailed  = "failed"
+)
+
+type Classification struct {
+	IsNSFW     bool
+	Confidence float32
+	Reason     string
+	Tags       []MachineTag
+}
+
+type ClassificationJob struct {
+	ID        int
+	FlowID    int
+	MediaURL  string
+	Attempts  int
+	CreatedAt time.Time
+}
@@ -1,15 +1,40 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
 	"html"
 	"time"
 )
 
+// режимы сортировки комментариев
+const (
+	CommentSortNewest    = "newest"
+	CommentSortOldest    = "oldest"
+	CommentSortMostLiked = "most_liked"
+)
+
+// максимальная глубина вложенности ответов (у корневого комментария глубина 0)
+const MaxCommentDepth = 2
+
+var (
+	ErrInvalidCommentSort  = errors.New("invalid comment sort mode")
+	ErrCommentDepthLimit   = errors.New("reply depth limit exceeded")
+	ErrCommentFlowMismatch = errors.New("parent comment belongs to another flow")
+	ErrCommentsDisabled    = errors.New("comments are disabled for this flow")
+	ErrCommentsFollowers   = errors.New("only followers of the author can comment this flow")
+)
+
 //easyjson:json
 type Comment struct {
 	ID                     int       `json:"id"`
 	FlowID                 int       `json:"flow_id"`
+	ParentID               *int      `json:"parent_id,omitempty"`
+	Depth                  int       `json:"depth"`
+	ReplyCount             int       `json:"reply_count"`
+	IsPinned               bool      `json:"is_pinned"`
+	IsEdited               bool      `json:"is_edited"`
+	IsHidden               bool      `json:"is_hidden"`
 	AuthorID               int       `json:"-"`
 	AuthorUsername         string    `json:"author_username"`
 	AuthorAvatar           string    `json:"author_avatar"`
@@ -31,3 +56,16 @@ func (c *Comment) Escape() {
 	c.AuthorUsername = html.EscapeString(c.AuthorUsername)
 	c.Content = html.EscapeString(c.Content)
 }
+
+// ParseCommentSort возвращает режим сортировки по значению query-параметра,
+// пустое значение означает сортировку по умолчанию (сначала новые)
+func ParseCommentSort(sort string) (string, error) {
+	switch sort {
+	case "":
+		return CommentSortNewest, nil
+	case CommentSortNewest, CommentSortOldest, CommentSortMostLiked:
+		return sort, nil
+	default:
+		return "", ErrInvalidCommentSort
+	}
+}
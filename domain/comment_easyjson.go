@@ -40,6 +40,26 @@ func easyjsonE9abebc9DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 			out.ID = int(in.Int())
 		case "flow_id":
 			out.FlowID = int(in.Int())
+		case "parent_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(int)
+				}
+				*out.ParentID = int(in.Int())
+			}
+		case "depth":
+			out.Depth = int(in.Int())
+		case "reply_count":
+			out.ReplyCount = int(in.Int())
+		case "is_pinned":
+			out.IsPinned = bool(in.Bool())
+		case "is_edited":
+			out.IsEdited = bool(in.Bool())
+		case "is_hidden":
+			out.IsHidden = bool(in.Bool())
 		case "author_username":
 			out.AuthorUsername = string(in.String())
 		case "author_avatar":
@@ -78,6 +98,36 @@ func easyjsonE9abebc9EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwrit
 		out.RawString(prefix)
 		out.Int(int(in.FlowID))
 	}
+	if in.ParentID != nil {
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.Int(int(*in.ParentID))
+	}
+	{
+		const prefix string = ",\"depth\":"
+		out.RawString(prefix)
+		out.Int(int(in.Depth))
+	}
+	{
+		const prefix string = ",\"reply_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReplyCount))
+	}
+	{
+		const prefix string = ",\"is_pinned\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPinned))
+	}
+	{
+		const prefix string = ",\"is_edited\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsEdited))
+	}
+	{
+		const prefix string = ",\"is_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsHidden))
+	}
 	{
 		const prefix string = ",\"author_username\":"
 		out.RawString(prefix)
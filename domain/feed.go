@@ -2,29 +2,86 @@ package domain
 
 import (
 	"html"
+	"time"
 )
 
 //easyjson:json
 type PinData struct {
-	FlowID         uint64 `json:"flow_id,omitempty"`
-	Header         string `json:"header,omitempty"`
-	AuthorID       uint64 `json:"author_id,omitempty"`
-	AuthorUsername string `json:"author_username"`
-	Description    string `json:"description,omitempty"`
-	MediaURL       string `json:"media_url,omitempty"`
-	IsPrivate      bool   `json:"is_private"`
-	CreatedAt      string `json:"created_at,omitempty"`
-	UpdatedAt      string `json:"updated_at,omitempty"`
-	IsLiked        bool   `json:"is_liked"`
-	IsNSFW         bool   `json:"is_nsfw"`
-	LikeCount      int    `json:"like_count"`
-	Width          int    `json:"width,omitempty"`
-	Height         int    `json:"height,omitempty"`
+	FlowID                uint64 `json:"flow_id,omitempty"`
+	Header                string `json:"header,omitempty"`
+	AuthorID              uint64 `json:"author_id,omitempty"`
+	AuthorUsername        string `json:"author_username"`
+	Description           string `json:"description,omitempty"`
+	MediaURL              string `json:"media_url,omitempty"`
+	IsPrivate             bool   `json:"is_private"`
+	CreatedAt             string `json:"created_at,omitempty"`
+	UpdatedAt             string `json:"updated_at,omitempty"`
+	IsLiked               bool   `json:"is_liked"`
+	IsNSFW                bool   `json:"is_nsfw"`
+	LikeCount             int    `json:"like_count"`
+	SaveCount             int    `json:"save_count"`
+	Width                 int    `json:"width,omitempty"`
+	Height                int    `json:"height,omitempty"`
+	CommentsEnabled       bool   `json:"comments_enabled"`
+	CommentsFollowersOnly bool   `json:"comments_followers_only"`
+	NSFWStatus            string `json:"nsfw_status,omitempty"`
+	IsBlurred             bool   `json:"is_blurred"`
+	BlurReason            string `json:"blur_reason,omitempty"`
+	SectionID             int    `json:"section_id,omitempty"`
+	// время отложенной публикации, видно только автору
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	IsDraft   bool       `json:"is_draft,omitempty"`
+	// элементы флоу по порядку, первый совпадает с обложкой media_url
+	Media []FlowMedia `json:"media,omitempty"`
+	// страница, с которой сохранён флоу, и её домен
+	Link         string    `json:"link,omitempty"`
+	SourceDomain string    `json:"source_domain,omitempty"`
+	Tags         []FlowTag `json:"tags,omitempty"`
+	// доска, с которой пин сохранён на текущую
+	SavedFrom *SaveAttribution `json:"saved_from,omitempty"`
+}
+
+// SaveAttribution — подпись «сохранено из @user / доска»
+//
+//easyjson:json
+type SaveAttribution struct {
+	BoardID   int    `json:"board_id"`
+	BoardName string `json:"board_name"`
+	Username  string `json:"username"`
+}
+
+const (
+	MediaTypeImage = "image"
+	MediaTypeVideo = "video"
+)
+
+//easyjson:json
+type FlowMedia struct {
+	Type       string `json:"type"`
+	URL        string `json:"url"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	DurationMs int    `json:"duration_ms,omitempty"`
+	PosterURL  string `json:"poster_url,omitempty"`
+}
+
+// MapMediaURLs превращает имена файлов обложки и элементов в ссылки
+func (p *PinData) MapMediaURLs(toURL func(string) string) {
+	p.MediaURL = toURL(p.MediaURL)
+	for i := range p.Media {
+		p.Media[i].URL = toURL(p.Media[i].URL)
+		if p.Media[i].PosterURL != "" {
+			p.Media[i].PosterURL = toURL(p.Media[i].PosterURL)
+		}
+	}
 }
 
 func (p *PinData) Escape() {
 	p.Header = html.EscapeString(p.Header)
 	p.Description = html.EscapeString(p.Description)
+	if p.SavedFrom != nil {
+		p.SavedFrom.BoardName = html.EscapeString(p.SavedFrom.BoardName)
+	}
 }
 
 func EscapeFlows(flows []PinData) {
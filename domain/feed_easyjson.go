@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +18,87 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *PinData) {
+func easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *SaveAttribution) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "board_id":
+			out.BoardID = int(in.Int())
+		case "board_name":
+			out.BoardName = string(in.String())
+		case "username":
+			out.Username = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in SaveAttribution) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"board_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.BoardID))
+	}
+	{
+		const prefix string = ",\"board_name\":"
+		out.RawString(prefix)
+		out.String(string(in.BoardName))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SaveAttribution) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SaveAttribution) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SaveAttribution) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SaveAttribution) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *PinData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -60,10 +141,98 @@ func easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 			out.IsNSFW = bool(in.Bool())
 		case "like_count":
 			out.LikeCount = int(in.Int())
+		case "save_count":
+			out.SaveCount = int(in.Int())
 		case "width":
 			out.Width = int(in.Int())
 		case "height":
 			out.Height = int(in.Int())
+		case "comments_enabled":
+			out.CommentsEnabled = bool(in.Bool())
+		case "comments_followers_only":
+			out.CommentsFollowersOnly = bool(in.Bool())
+		case "nsfw_status":
+			out.NSFWStatus = string(in.String())
+		case "is_blurred":
+			out.IsBlurred = bool(in.Bool())
+		case "blur_reason":
+			out.BlurReason = string(in.String())
+		case "section_id":
+			out.SectionID = int(in.Int())
+		case "publish_at":
+			if in.IsNull() {
+				in.Skip()
+				out.PublishAt = nil
+			} else {
+				if out.PublishAt == nil {
+					out.PublishAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PublishAt).UnmarshalJSON(data))
+				}
+			}
+		case "is_draft":
+			out.IsDraft = bool(in.Bool())
+		case "media":
+			if in.IsNull() {
+				in.Skip()
+				out.Media = nil
+			} else {
+				in.Delim('[')
+				if out.Media == nil {
+					if !in.IsDelim(']') {
+						out.Media = make([]FlowMedia, 0, 0)
+					} else {
+						out.Media = []FlowMedia{}
+					}
+				} else {
+					out.Media = (out.Media)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 FlowMedia
+					(v1).UnmarshalEasyJSON(in)
+					out.Media = append(out.Media, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "link":
+			out.Link = string(in.String())
+		case "source_domain":
+			out.SourceDomain = string(in.String())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]FlowTag, 0, 1)
+					} else {
+						out.Tags = []FlowTag{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 FlowTag
+					(v2).UnmarshalEasyJSON(in)
+					out.Tags = append(out.Tags, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "saved_from":
+			if in.IsNull() {
+				in.Skip()
+				out.SavedFrom = nil
+			} else {
+				if out.SavedFrom == nil {
+					out.SavedFrom = new(SaveAttribution)
+				}
+				(*out.SavedFrom).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -74,7 +243,7 @@ func easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in PinData) {
+func easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in PinData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -154,6 +323,11 @@ func easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwrit
 		out.RawString(prefix)
 		out.Int(int(in.LikeCount))
 	}
+	{
+		const prefix string = ",\"save_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.SaveCount))
+	}
 	if in.Width != 0 {
 		const prefix string = ",\"width\":"
 		out.RawString(prefix)
@@ -164,29 +338,213 @@ func easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwrit
 		out.RawString(prefix)
 		out.Int(int(in.Height))
 	}
+	{
+		const prefix string = ",\"comments_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CommentsEnabled))
+	}
+	{
+		const prefix string = ",\"comments_followers_only\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.CommentsFollowersOnly))
+	}
+	if in.NSFWStatus != "" {
+		const prefix string = ",\"nsfw_status\":"
+		out.RawString(prefix)
+		out.String(string(in.NSFWStatus))
+	}
+	{
+		const prefix string = ",\"is_blurred\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsBlurred))
+	}
+	if in.BlurReason != "" {
+		const prefix string = ",\"blur_reason\":"
+		out.RawString(prefix)
+		out.String(string(in.BlurReason))
+	}
+	if in.SectionID != 0 {
+		const prefix string = ",\"section_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.SectionID))
+	}
+	if in.PublishAt != nil {
+		const prefix string = ",\"publish_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.PublishAt).MarshalJSON())
+	}
+	if in.IsDraft {
+		const prefix string = ",\"is_draft\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDraft))
+	}
+	if len(in.Media) != 0 {
+		const prefix string = ",\"media\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v3, v4 := range in.Media {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				(v4).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Link != "" {
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		out.String(string(in.Link))
+	}
+	if in.SourceDomain != "" {
+		const prefix string = ",\"source_domain\":"
+		out.RawString(prefix)
+		out.String(string(in.SourceDomain))
+	}
+	if len(in.Tags) != 0 {
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Tags {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.SavedFrom != nil {
+		const prefix string = ",\"saved_from\":"
+		out.RawString(prefix)
+		(*in.SavedFrom).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v PinData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PinData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+	easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PinData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PinData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+	easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *FlowMedia) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "width":
+			out.Width = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "duration_ms":
+			out.DurationMs = int(in.Int())
+		case "poster_url":
+			out.PosterURL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in FlowMedia) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"width\":"
+		out.RawString(prefix)
+		out.Int(int(in.Width))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	if in.DurationMs != 0 {
+		const prefix string = ",\"duration_ms\":"
+		out.RawString(prefix)
+		out.Int(int(in.DurationMs))
+	}
+	if in.PosterURL != "" {
+		const prefix string = ",\"poster_url\":"
+		out.RawString(prefix)
+		out.String(string(in.PosterURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlowMedia) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlowMedia) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD77e0694EncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlowMedia) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlowMedia) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD77e0694DecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
 }
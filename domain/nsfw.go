@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// режимы показа NSFW пинов пользователю
+const (
+	NSFWModeHide = "hide" // пины не показываются совсем
+	NSFWModeBlur = "blur" // пины показываются размытыми, открываются по клику
+	NSFWModeShow = "show"
+)
+
+// минимальный возраст для просмотра NSFW пинов
+const NSFWMinAge = 18
+
+const NSFWBlurReason = "sensitive_content"
+
+var ErrNSFWAgeRestricted = errors.New("sensitive content is available only to adult users")
+
+//easyjson:json
+type NSFWSettings struct {
+	ShowNSFW bool   `json:"show_nsfw"`
+	IsAdult  bool   `json:"is_adult"`
+	Mode     string `json:"mode"`
+}
+
+//easyjson:json
+type NSFWSettingsUpdate struct {
+	ShowNSFW *bool `json:"show_nsfw"`
+}
+
+// IsAdult проверяет возраст по дню рождения, если день рождения
+// не указан, пользователь считается несовершеннолетним
+func IsAdult(birthday time.Time, now time.Time) bool {
+	if birthday.IsZero() {
+		return false
+	}
+
+	return !birthday.AddDate(NSFWMinAge, 0, 0).After(now)
+}
+
+func ResolveNSFWMode(birthday time.Time, showNSFW bool, now time.Time) string {
+	if !IsAdult(birthday, now) {
+		return NSFWModeHide
+	}
+
+	if showNSFW {
+		return NSFWModeShow
+	}
+
+	return NSFWModeBlur
+}
+
+func NewNSFWSettings(birthday time.Time, showNSFW bool, now time.Time) NSFWSettings {
+	return NSFWSettings{
+		ShowNSFW: showNSFW,
+		IsAdult:  IsAdult(birthday, now),
+		Mode:     ResolveNSFWMode(birthday, showNSFW, now),
+	}
+}
+
+// ApplyNSFWMode проверяет, можно ли показать пин пользователю, и при необходимости
+// помечает его размытым. Собственные пины автор видит всегда
+func (p *PinData) ApplyNSFWMode(mode string, viewerID uint64) bool {
+	if !p.IsNSFW || (viewerID != 0 && p.AuthorID == viewerID) {
+		return true
+	}
+
+	switch mode {
+	case NSFWModeShow:
+		return true
+	case NSFWModeBlur:
+		p.IsBlurred = true
+		p.BlurReason = NSFWBlurReason
+		return true
+	default:
+		return false
+	}
+}
+
+// FilterNSFW убирает недоступные пользователю пины и размывает остальные NSFW пины
+func FilterNSFW(flows []PinData, mode string, viewerID uint64) []PinData {
+	filtered := flows[:0]
+	for i := range flows {
+		if flows[i].ApplyNSFWMode(mode, viewerID) {
+			filtered = append(filtered, flows[i])
+		}
+	}
+
+	return filtered
+}
+
+func FilterBoardsNSFW(boards []Board, mode string, viewerID uint64) {
+	for i := range boards {
+		boards[i].Preview = FilterNSFW(boards[i].Preview, mode, viewerID)
+	}
+}
+
+type nsfwModeKey struct{}
+
+func ContextWithNSFWMode(ctx context.Context, mode string) context.Context {
+	return context.WithValue(ctx, nsfwModeKey{}, mode)
+}
+
+// NSFWModeFromContext возвращает режим, определённый middleware,
+// по умолчанию NSFW пины скрываются
+func NSFWModeFromContext(ctx context.Context) string {
+	mode, ok := ctx.Value(nsfwModeKey{}).(string)
+	if !ok || mode == "" {
+		return NSFWModeHide
+	}
+
+	return mode
+}
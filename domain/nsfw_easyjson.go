@@ -0,0 +1,177 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonEa55d378DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *NSFWSettingsUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "show_nsfw":
+			if in.IsNull() {
+				in.Skip()
+				out.ShowNSFW = nil
+			} else {
+				if out.ShowNSFW == nil {
+					out.ShowNSFW = new(bool)
+				}
+				*out.ShowNSFW = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEa55d378EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in NSFWSettingsUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"show_nsfw\":"
+		out.RawString(prefix[1:])
+		if in.ShowNSFW == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.ShowNSFW))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NSFWSettingsUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEa55d378EncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NSFWSettingsUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEa55d378EncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NSFWSettingsUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEa55d378DecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NSFWSettingsUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEa55d378DecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjsonEa55d378DecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *NSFWSettings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "show_nsfw":
+			out.ShowNSFW = bool(in.Bool())
+		case "is_adult":
+			out.IsAdult = bool(in.Bool())
+		case "mode":
+			out.Mode = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEa55d378EncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in NSFWSettings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"show_nsfw\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.ShowNSFW))
+	}
+	{
+		const prefix string = ",\"is_adult\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAdult))
+	}
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix)
+		out.String(string(in.Mode))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NSFWSettings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonEa55d378EncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NSFWSettings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEa55d378EncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NSFWSettings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonEa55d378DecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NSFWSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEa55d378DecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
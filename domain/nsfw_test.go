@@ -0,0 +1,89 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveNSFWMode(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		birthday time.Time
+		showNSFW bool
+		want     string
+	}{
+		{
+			name:     "Сценарий: день рождения не указан",
+			showNSFW: true,
+			want:     domain.NSFWModeHide,
+		},
+		{
+			name:     "Сценарий: несовершеннолетний включил показ",
+			birthday: time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC),
+			showNSFW: true,
+			want:     domain.NSFWModeHide,
+		},
+		{
+			name:     "Сценарий: восемнадцать лет исполняется сегодня",
+			birthday: time.Date(2007, 6, 1, 0, 0, 0, 0, time.UTC),
+			showNSFW: true,
+			want:     domain.NSFWModeShow,
+		},
+		{
+			name:     "Сценарий: взрослый не включал показ",
+			birthday: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:     domain.NSFWModeBlur,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, domain.ResolveNSFWMode(tt.birthday, tt.showNSFW, now))
+		})
+	}
+}
+
+func TestFilterNSFW(t *testing.T) {
+	flows := func() []domain.PinData {
+		return []domain.PinData{
+			{FlowID: 1, AuthorID: 10},
+			{FlowID: 2, AuthorID: 10, IsNSFW: true},
+			{FlowID: 3, AuthorID: 20, IsNSFW: true},
+		}
+	}
+
+	t.Run("Сценарий: режим hide, свои пины видны", func(t *testing.T) {
+		filtered := domain.FilterNSFW(flows(), domain.NSFWModeHide, 20)
+		assert.Len(t, filtered, 2)
+		assert.Equal(t, uint64(1), filtered[0].FlowID)
+		assert.Equal(t, uint64(3), filtered[1].FlowID)
+		assert.False(t, filtered[1].IsBlurred)
+	})
+
+	t.Run("Сценарий: режим blur", func(t *testing.T) {
+		filtered := domain.FilterNSFW(flows(), domain.NSFWModeBlur, 0)
+		assert.Len(t, filtered, 3)
+		assert.False(t, filtered[0].IsBlurred)
+		assert.True(t, filtered[1].IsBlurred)
+		assert.Equal(t, domain.NSFWBlurReason, filtered[1].BlurReason)
+	})
+
+	t.Run("Сценарий: режим show", func(t *testing.T) {
+		filtered := domain.FilterNSFW(flows(), domain.NSFWModeShow, 0)
+		assert.Len(t, filtered, 3)
+		assert.False(t, filtered[2].IsBlurred)
+	})
+}
+
+func TestNSFWModeFromContext(t *testing.T) {
+	assert.Equal(t, domain.NSFWModeHide, domain.NSFWModeFromContext(context.Background()))
+
+	ctx := domain.ContextWithNSFWMode(context.Background(), domain.NSFWModeShow)
+	assert.Equal(t, domain.NSFWModeShow, domain.NSFWModeFromContext(ctx))
+}
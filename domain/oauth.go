@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrUnknownProvider   = errors.New("unknown login provider")
+	ErrInvalidOAuthState = errors.New("invalid or expired oauth state")
+	ErrIdentityLinked    = errors.New("this account is already linked to another user")
+	ErrLastLoginMethod   = errors.New("cannot unlink the only way to log in")
+	ErrIdentityNotFound  = errors.New("login provider is not linked")
+	ErrOAuthNoEmail      = errors.New("login provider did not share a verified email")
+)
+
+const (
+	ProviderVK     = "vk"
+	ProviderYandex = "yandex"
+	ProviderGoogle = "google"
+
+	OAuthModeLogin = "login"
+	OAuthModeLink  = "link"
+
+	OAuthStateTTL        = 10 * time.Minute // сколько ждем возвращения пользователя от провайдера
+	OAuthRegistrationTTL = 10 * time.Minute // сколько живет токен для завершения регистрации
+)
+
+// OAuthIdentity — пользователь внешнего провайдера после обмена кода на токен
+type OAuthIdentity struct {
+	Provider string
+	Subject  string
+	Email    string
+	Avatar   string
+}
+
+//easyjson:json
+type OAuthProviders struct {
+	Providers []string `json:"providers"`
+}
+
+//easyjson:json
+type OAuthAuthorization struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
+
+//easyjson:json
+type OAuthCallback struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+	// VK ID возвращает device_id вместе с кодом и ждет его при обмене
+	DeviceID string `json:"device_id,omitempty"`
+}
+
+//easyjson:json
+type OAuthRegistrationRequired struct {
+	RegistrationToken string `json:"registration_token"`
+	Email             string `json:"email,omitempty"`
+	ExpiresIn         int    `json:"expires_in"`
+}
+
+//easyjson:json
+type OAuthRegistration struct {
+	RegistrationToken string `json:"registration_token"`
+	Username          string `json:"username"`
+}
+
+//easyjson:json
+type LinkedIdentity struct {
+	Provider  string    `json:"provider"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+//easyjson:json
+type LinkedIdentities struct {
+	Identities []LinkedIdentity `json:"identities"`
+}
@@ -0,0 +1,596 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *OAuthRegistrationRequired) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "registration_token":
+			out.RegistrationToken = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "expires_in":
+			out.ExpiresIn = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in OAuthRegistrationRequired) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"registration_token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RegistrationToken))
+	}
+	if in.Email != "" {
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"expires_in\":"
+		out.RawString(prefix)
+		out.Int(int(in.ExpiresIn))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthRegistrationRequired) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthRegistrationRequired) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthRegistrationRequired) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthRegistrationRequired) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *OAuthRegistration) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "registration_token":
+			out.RegistrationToken = string(in.String())
+		case "username":
+			out.Username = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in OAuthRegistration) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"registration_token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RegistrationToken))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthRegistration) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthRegistration) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthRegistration) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthRegistration) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *OAuthProviders) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "providers":
+			if in.IsNull() {
+				in.Skip()
+				out.Providers = nil
+			} else {
+				in.Delim('[')
+				if out.Providers == nil {
+					if !in.IsDelim(']') {
+						out.Providers = make([]string, 0, 4)
+					} else {
+						out.Providers = []string{}
+					}
+				} else {
+					out.Providers = (out.Providers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Providers = append(out.Providers, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in OAuthProviders) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"providers\":"
+		out.RawString(prefix[1:])
+		if in.Providers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Providers {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthProviders) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthProviders) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthProviders) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthProviders) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+}
+func easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain3(in *jlexer.Lexer, out *OAuthCallback) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "device_id":
+			out.DeviceID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain3(out *jwriter.Writer, in OAuthCallback) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	if in.DeviceID != "" {
+		const prefix string = ",\"device_id\":"
+		out.RawString(prefix)
+		out.String(string(in.DeviceID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthCallback) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthCallback) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthCallback) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthCallback) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain3(l, v)
+}
+func easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain4(in *jlexer.Lexer, out *OAuthAuthorization) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "authorization_url":
+			out.AuthorizationURL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain4(out *jwriter.Writer, in OAuthAuthorization) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"authorization_url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AuthorizationURL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OAuthAuthorization) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OAuthAuthorization) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OAuthAuthorization) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OAuthAuthorization) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain4(l, v)
+}
+func easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain5(in *jlexer.Lexer, out *LinkedIdentity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "provider":
+			out.Provider = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain5(out *jwriter.Writer, in LinkedIdentity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"provider\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Provider))
+	}
+	if in.Email != "" {
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LinkedIdentity) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LinkedIdentity) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LinkedIdentity) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LinkedIdentity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain5(l, v)
+}
+func easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain6(in *jlexer.Lexer, out *LinkedIdentities) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "identities":
+			if in.IsNull() {
+				in.Skip()
+				out.Identities = nil
+			} else {
+				in.Delim('[')
+				if out.Identities == nil {
+					if !in.IsDelim(']') {
+						out.Identities = make([]LinkedIdentity, 0, 1)
+					} else {
+						out.Identities = []LinkedIdentity{}
+					}
+				} else {
+					out.Identities = (out.Identities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 LinkedIdentity
+					(v4).UnmarshalEasyJSON(in)
+					out.Identities = append(out.Identities, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain6(out *jwriter.Writer, in LinkedIdentities) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"identities\":"
+		out.RawString(prefix[1:])
+		if in.Identities == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Identities {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LinkedIdentities) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LinkedIdentities) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC4d5a6bfEncodeGithubComGoParkMailRu20251SuperChipsDomain6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LinkedIdentities) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LinkedIdentities) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC4d5a6bfDecodeGithubComGoParkMailRu20251SuperChipsDomain6(l, v)
+}
@@ -1,11 +1,22 @@
 package domain
 
+import (
+	"strings"
+	"time"
+)
+
 //easyjson:json
 type PinDataUpdate struct {
-	FlowID      *uint64 `json:"flow_id,omitempty"`
-	Header      *string `json:"header,omitempty"`
-	Description *string `json:"description,omitempty"`
-	IsPrivate   *bool   `json:"is_private,omitempty"`
+	FlowID                *uint64 `json:"flow_id,omitempty"`
+	Header                *string `json:"header,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	IsPrivate             *bool   `json:"is_private,omitempty"`
+	CommentsEnabled       *bool   `json:"comments_enabled,omitempty"`
+	CommentsFollowersOnly *bool   `json:"comments_followers_only,omitempty"`
+	// пустая строка убирает ссылку
+	Link *string `json:"link,omitempty"`
+	// домен вычисляется из ссылки, клиент его не передаёт
+	SourceDomain string `json:"-"`
 }
 
 type PinDataCreate struct {
@@ -15,4 +26,59 @@ type PinDataCreate struct {
 	Colors      []string
 	Width       int
 	Height      int
+	// если задано, флоу станет публичным в указанное время
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// черновик сохраняется приватным и публикуется отдельным запросом
+	IsDraft      bool        `json:"is_draft,omitempty"`
+	Media        []FlowMedia `json:"-"`
+	Link         string      `json:"link,omitempty"`
+	SourceDomain string      `json:"-"`
+	// хэштеги из заголовка и описания
+	Tags []string `json:"-"`
+}
+
+// NormalizeSourceDomain приводит домен к виду, в котором он хранится у флоу
+func NormalizeSourceDomain(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	return strings.TrimPrefix(host, "www.")
+}
+
+//easyjson:json
+type PinFromURL struct {
+	URL         string `json:"url"`
+	Header      string `json:"header,omitempty"`
+	Description string `json:"description,omitempty"`
+	IsPrivate   bool   `json:"is_private,omitempty"`
+}
+
+const (
+	// сколько картинок и видео можно добавить в один флоу
+	MaxFlowMedia     = 10
+	MaxVideoDuration = 60 * time.Second
+)
+
+//easyjson:json
+type PinSchedule struct {
+	PublishAt time.Time `json:"publish_at"`
+}
+
+// сколько последних ревизий флоу отдаётся в истории
+const MaxFlowRevisions = 50
+
+//easyjson:json
+type TextChange struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// FlowRevision — текст флоу до правки и изменения, которые внесла правка
+//
+//easyjson:json
+type FlowRevision struct {
+	ID              int          `json:"revision_id"`
+	Header          string       `json:"header"`
+	Description     string       `json:"description"`
+	CreatedAt       time.Time    `json:"created_at"`
+	HeaderDiff      []TextChange `json:"header_diff"`
+	DescriptionDiff []TextChange `json:"description_diff"`
 }
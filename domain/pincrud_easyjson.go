@@ -17,7 +17,235 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *PinDataUpdate) {
+func easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *TextChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "op":
+			out.Op = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in TextChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"op\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TextChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TextChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TextChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TextChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *PinSchedule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "publish_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PublishAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in PinSchedule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"publish_at\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.PublishAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PinSchedule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PinSchedule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PinSchedule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PinSchedule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *PinFromURL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "url":
+			out.URL = string(in.String())
+		case "header":
+			out.Header = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "is_private":
+			out.IsPrivate = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in PinFromURL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.URL))
+	}
+	if in.Header != "" {
+		const prefix string = ",\"header\":"
+		out.RawString(prefix)
+		out.String(string(in.Header))
+	}
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.IsPrivate {
+		const prefix string = ",\"is_private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PinFromURL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PinFromURL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PinFromURL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PinFromURL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+}
+func easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain3(in *jlexer.Lexer, out *PinDataUpdate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -76,6 +304,36 @@ func easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 				}
 				*out.IsPrivate = bool(in.Bool())
 			}
+		case "comments_enabled":
+			if in.IsNull() {
+				in.Skip()
+				out.CommentsEnabled = nil
+			} else {
+				if out.CommentsEnabled == nil {
+					out.CommentsEnabled = new(bool)
+				}
+				*out.CommentsEnabled = bool(in.Bool())
+			}
+		case "comments_followers_only":
+			if in.IsNull() {
+				in.Skip()
+				out.CommentsFollowersOnly = nil
+			} else {
+				if out.CommentsFollowersOnly == nil {
+					out.CommentsFollowersOnly = new(bool)
+				}
+				*out.CommentsFollowersOnly = bool(in.Bool())
+			}
+		case "link":
+			if in.IsNull() {
+				in.Skip()
+				out.Link = nil
+			} else {
+				if out.Link == nil {
+					out.Link = new(string)
+				}
+				*out.Link = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -86,7 +344,7 @@ func easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 		in.Consumed()
 	}
 }
-func easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in PinDataUpdate) {
+func easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain3(out *jwriter.Writer, in PinDataUpdate) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -126,29 +384,226 @@ func easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwrit
 		}
 		out.Bool(bool(*in.IsPrivate))
 	}
+	if in.CommentsEnabled != nil {
+		const prefix string = ",\"comments_enabled\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(*in.CommentsEnabled))
+	}
+	if in.CommentsFollowersOnly != nil {
+		const prefix string = ",\"comments_followers_only\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(*in.CommentsFollowersOnly))
+	}
+	if in.Link != nil {
+		const prefix string = ",\"link\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.Link))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v PinDataUpdate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PinDataUpdate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PinDataUpdate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PinDataUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain3(l, v)
+}
+func easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain4(in *jlexer.Lexer, out *FlowRevision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "revision_id":
+			out.ID = int(in.Int())
+		case "header":
+			out.Header = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "header_diff":
+			if in.IsNull() {
+				in.Skip()
+				out.HeaderDiff = nil
+			} else {
+				in.Delim('[')
+				if out.HeaderDiff == nil {
+					if !in.IsDelim(']') {
+						out.HeaderDiff = make([]TextChange, 0, 2)
+					} else {
+						out.HeaderDiff = []TextChange{}
+					}
+				} else {
+					out.HeaderDiff = (out.HeaderDiff)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 TextChange
+					(v1).UnmarshalEasyJSON(in)
+					out.HeaderDiff = append(out.HeaderDiff, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "description_diff":
+			if in.IsNull() {
+				in.Skip()
+				out.DescriptionDiff = nil
+			} else {
+				in.Delim('[')
+				if out.DescriptionDiff == nil {
+					if !in.IsDelim(']') {
+						out.DescriptionDiff = make([]TextChange, 0, 2)
+					} else {
+						out.DescriptionDiff = []TextChange{}
+					}
+				} else {
+					out.DescriptionDiff = (out.DescriptionDiff)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 TextChange
+					(v2).UnmarshalEasyJSON(in)
+					out.DescriptionDiff = append(out.DescriptionDiff, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain4(out *jwriter.Writer, in FlowRevision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"revision_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"header\":"
+		out.RawString(prefix)
+		out.String(string(in.Header))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"header_diff\":"
+		out.RawString(prefix)
+		if in.HeaderDiff == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.HeaderDiff {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				(v4).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"description_diff\":"
+		out.RawString(prefix)
+		if in.DescriptionDiff == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.DescriptionDiff {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FlowRevision) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FlowRevision) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson36700d57EncodeGithubComGoParkMailRu20251SuperChipsDomain4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FlowRevision) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FlowRevision) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson36700d57DecodeGithubComGoParkMailRu20251SuperChipsDomain4(l, v)
 }
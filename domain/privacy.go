@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// отношение зрителя к закрытому аккаунту
+const (
+	FollowStatusNone      = "none"      // не подписан
+	FollowStatusRequested = "requested" // заявка ждёт одобрения
+	FollowStatusFollowing = "following" // подписка одобрена
+)
+
+var ErrFollowRequestNotFound = errors.New("follow request not found")
+
+//easyjson:json
+type AccountPrivacy struct {
+	IsPrivateAccount bool `json:"is_private_account"`
+}
+
+//easyjson:json
+type AccountPrivacyUpdate struct {
+	IsPrivateAccount *bool `json:"is_private_account"`
+}
+
+//easyjson:json
+type FollowRequest struct {
+	User      PublicUser `json:"user"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+//easyjson:json
+type FollowRequests []FollowRequest
+
+// HidePrivateProfile оставляет в профиле закрытого аккаунта только то,
+// что нужно, чтобы отправить заявку на подписку
+func (u *User) HidePrivateProfile() {
+	u.About = ""
+	u.Birthday = time.Time{}
+	u.Email = ""
+	u.ContentHidden = true
+}
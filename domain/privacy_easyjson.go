@@ -0,0 +1,304 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *FollowRequests) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(FollowRequests, 0, 0)
+			} else {
+				*out = FollowRequests{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 FollowRequest
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in FollowRequests) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FollowRequests) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FollowRequests) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FollowRequests) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FollowRequests) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *FollowRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in FollowRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		(in.User).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FollowRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FollowRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FollowRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FollowRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *AccountPrivacyUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_private_account":
+			if in.IsNull() {
+				in.Skip()
+				out.IsPrivateAccount = nil
+			} else {
+				if out.IsPrivateAccount == nil {
+					out.IsPrivateAccount = new(bool)
+				}
+				*out.IsPrivateAccount = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in AccountPrivacyUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_private_account\":"
+		out.RawString(prefix[1:])
+		if in.IsPrivateAccount == nil {
+			out.RawString("null")
+		} else {
+			out.Bool(bool(*in.IsPrivateAccount))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountPrivacyUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountPrivacyUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountPrivacyUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountPrivacyUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+}
+func easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain3(in *jlexer.Lexer, out *AccountPrivacy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_private_account":
+			out.IsPrivateAccount = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain3(out *jwriter.Writer, in AccountPrivacy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_private_account\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsPrivateAccount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountPrivacy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountPrivacy) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20251SuperChipsDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountPrivacy) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountPrivacy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20251SuperChipsDomain3(l, v)
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"errors"
+	"html"
+	"time"
+)
+
+// типы объектов, на которые можно пожаловаться
+const (
+	ReportTargetFlow    = "flow"
+	ReportTargetComment = "comment"
+	ReportTargetBoard   = "board"
+	ReportTargetUser    = "user"
+	ReportTargetMessage = "message"
+)
+
+const (
+	ReportStatusOpen      = "open"
+	ReportStatusResolved  = "resolved"
+	ReportStatusDismissed = "dismissed"
+)
+
+// действия модератора при разборе жалобы
+const (
+	ModerationActionDismiss       = "dismiss"
+	ModerationActionHideFlow      = "hide_flow"
+	ModerationActionDeleteComment = "delete_comment"
+	ModerationActionSuspendUser   = "suspend_user"
+)
+
+const (
+	UserRoleUser      = "user"
+	UserRoleModerator = "moderator"
+)
+
+const maxReportReasonLength = 1000
+
+var (
+	ErrAccountSuspended        = errors.New("account is suspended")
+	ErrReportExists            = errors.New("report already exists")
+	ErrReportResolved          = errors.New("report is already resolved")
+	ErrInvalidReportTarget     = errors.New("invalid report target")
+	ErrInvalidReportReason     = errors.New("invalid report reason")
+	ErrInvalidModerationAction = errors.New("action is not applicable to report target")
+	ErrInvalidReportStatus     = errors.New("invalid report status")
+)
+
+//easyjson:json
+type Report struct {
+	ID               int        `json:"id"`
+	ReporterID       int        `json:"-"`
+	ReporterUsername string     `json:"reporter_username,omitempty"`
+	TargetType       string     `json:"target_type"`
+	TargetID         int        `json:"target_id"`
+	Reason           string     `json:"reason"`
+	Status           string     `json:"status"`
+	ResolvedBy       string     `json:"resolved_by,omitempty"`
+	ResolvedAt       *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+func (r *Report) Validate() error {
+	switch r.TargetType {
+	case ReportTargetFlow, ReportTargetComment, ReportTargetBoard, ReportTargetUser, ReportTargetMessage:
+	default:
+		return ErrInvalidReportTarget
+	}
+
+	if r.TargetID <= 0 {
+		return ErrInvalidReportTarget
+	}
+
+	if r.Reason == "" || len(r.Reason) > maxReportReasonLength {
+		return ErrInvalidReportReason
+	}
+
+	return nil
+}
+
+func (r *Report) Escape() {
+	r.Reason = html.EscapeString(r.Reason)
+}
+
+//easyjson:json
+type ReportResolution struct {
+	Action string `json:"action"`
+	Note   string `json:"note,omitempty"`
+}
+
+// Validate проверяет, что действие применимо к объекту жалобы
+func (r *ReportResolution) Validate(targetType string) error {
+	if len(r.Note) > maxReportReasonLength {
+		return ErrValidation
+	}
+
+	switch r.Action {
+	case ModerationActionDismiss, ModerationActionSuspendUser:
+		return nil
+	case ModerationActionHideFlow:
+		if targetType == ReportTargetFlow {
+			return nil
+		}
+	case ModerationActionDeleteComment:
+		if targetType == ReportTargetComment {
+			return nil
+		}
+	}
+
+	return ErrInvalidModerationAction
+}
+
+//easyjson:json
+type ModeratorAction struct {
+	ID                int       `json:"id"`
+	ModeratorUsername string    `json:"moderator_username"`
+	ReportID          *int      `json:"report_id,omitempty"`
+	Action            string    `json:"action"`
+	TargetType        string    `json:"target_type"`
+	TargetID          int       `json:"target_id"`
+	Note              string    `json:"note,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
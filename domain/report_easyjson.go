@@ -0,0 +1,352 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonBd361432DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *ReportResolution) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			out.Action = string(in.String())
+		case "note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBd361432EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in ReportResolution) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Action))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReportResolution) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBd361432EncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReportResolution) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBd361432EncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReportResolution) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBd361432DecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReportResolution) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBd361432DecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjsonBd361432DecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *Report) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "reporter_username":
+			out.ReporterUsername = string(in.String())
+		case "target_type":
+			out.TargetType = string(in.String())
+		case "target_id":
+			out.TargetID = int(in.Int())
+		case "reason":
+			out.Reason = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "resolved_by":
+			out.ResolvedBy = string(in.String())
+		case "resolved_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ResolvedAt = nil
+			} else {
+				if out.ResolvedAt == nil {
+					out.ResolvedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ResolvedAt).UnmarshalJSON(data))
+				}
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBd361432EncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in Report) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	if in.ReporterUsername != "" {
+		const prefix string = ",\"reporter_username\":"
+		out.RawString(prefix)
+		out.String(string(in.ReporterUsername))
+	}
+	{
+		const prefix string = ",\"target_type\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"target_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.TargetID))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.ResolvedBy != "" {
+		const prefix string = ",\"resolved_by\":"
+		out.RawString(prefix)
+		out.String(string(in.ResolvedBy))
+	}
+	if in.ResolvedAt != nil {
+		const prefix string = ",\"resolved_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.ResolvedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Report) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBd361432EncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Report) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBd361432EncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Report) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBd361432DecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Report) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBd361432DecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjsonBd361432DecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *ModeratorAction) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "moderator_username":
+			out.ModeratorUsername = string(in.String())
+		case "report_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ReportID = nil
+			} else {
+				if out.ReportID == nil {
+					out.ReportID = new(int)
+				}
+				*out.ReportID = int(in.Int())
+			}
+		case "action":
+			out.Action = string(in.String())
+		case "target_type":
+			out.TargetType = string(in.String())
+		case "target_id":
+			out.TargetID = int(in.Int())
+		case "note":
+			out.Note = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBd361432EncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in ModeratorAction) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"moderator_username\":"
+		out.RawString(prefix)
+		out.String(string(in.ModeratorUsername))
+	}
+	if in.ReportID != nil {
+		const prefix string = ",\"report_id\":"
+		out.RawString(prefix)
+		out.Int(int(*in.ReportID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"target_type\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"target_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.TargetID))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModeratorAction) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBd361432EncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModeratorAction) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBd361432EncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModeratorAction) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBd361432DecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModeratorAction) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBd361432DecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+}
@@ -0,0 +1,53 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportResolutionValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		action     string
+		targetType string
+		wantErr    error
+	}{
+		{
+			name:       "Сценарий: скрыть пин",
+			action:     domain.ModerationActionHideFlow,
+			targetType: domain.ReportTargetFlow,
+		},
+		{
+			name:       "Сценарий: скрыть пин по жалобе на комментарий",
+			action:     domain.ModerationActionHideFlow,
+			targetType: domain.ReportTargetComment,
+			wantErr:    domain.ErrInvalidModerationAction,
+		},
+		{
+			name:       "Сценарий: удалить комментарий",
+			action:     domain.ModerationActionDeleteComment,
+			targetType: domain.ReportTargetComment,
+		},
+		{
+			name:       "Сценарий: заблокировать автора сообщения",
+			action:     domain.ModerationActionSuspendUser,
+			targetType: domain.ReportTargetMessage,
+		},
+		{
+			name:       "Сценарий: неизвестное действие",
+			action:     "ban_forever",
+			targetType: domain.ReportTargetUser,
+			wantErr:    domain.ErrInvalidModerationAction,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolution := domain.ReportResolution{Action: tt.action}
+			err := resolution.Validate(tt.targetType)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
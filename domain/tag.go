@@ -0,0 +1,34 @@
+package domain
+
+import "errors"
+
+// откуда у флоу тег: хэштег автора или подпись сервиса cv
+const (
+	TagSourceUser    = "user"
+	TagSourceMachine = "machine"
+)
+
+// машинные теги с меньшей уверенностью не сохраняются
+const MinMachineTagConfidence = 0.3
+
+var ErrInvalidTag = errors.New("invalid tag")
+
+type MachineTag struct {
+	Name       string
+	Confidence float32
+}
+
+//easyjson:json
+type FlowTag struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+	// уверенность модели, у хэштегов автора не заполняется
+	Confidence float32 `json:"confidence,omitempty"`
+}
+
+//easyjson:json
+type Tag struct {
+	Name       string `json:"name"`
+	FlowCount  int    `json:"flow_count"`
+	IsFollowed bool   `json:"is_followed"`
+}
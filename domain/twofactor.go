@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotSetUp       = errors.New("two-factor authentication setup is not started")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrTwoFactorLocked         = errors.New("too many invalid two-factor codes, try again later")
+)
+
+const (
+	TwoFactorIssuer      = "flow"           // издатель в приложении-аутентификаторе
+	TwoFactorPendingTTL  = 5 * time.Minute  // сколько живёт токен второго шага входа
+	TwoFactorMaxAttempts = 5                // неверных кодов до блокировки
+	TwoFactorLockPeriod  = 15 * time.Minute // на сколько блокируется ввод кодов
+	RecoveryCodeCount    = 10               // сколько кодов восстановления выдаётся
+)
+
+// TwoFactorState — настройки второго фактора пользователя, хранящиеся в базе
+type TwoFactorState struct {
+	Email             string
+	Secret            string
+	Enabled           bool
+	LastStep          int64
+	LockedUntil       time.Time
+	RecoveryCodesLeft int
+}
+
+//easyjson:json
+type TwoFactorStatus struct {
+	Enabled           bool `json:"enabled"`
+	RecoveryCodesLeft int  `json:"recovery_codes_left"`
+}
+
+//easyjson:json
+type TwoFactorSetup struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+//easyjson:json
+type TwoFactorCode struct {
+	Code string `json:"code"`
+}
+
+//easyjson:json
+type TwoFactorLogin struct {
+	PendingToken string `json:"pending_token"`
+	Code         string `json:"code"`
+}
+
+//easyjson:json
+type TwoFactorChallenge struct {
+	TwoFactorRequired bool   `json:"two_factor_required"`
+	PendingToken      string `json:"pending_token"`
+	ExpiresIn         int    `json:"expires_in"`
+}
+
+//easyjson:json
+type RecoveryCodes struct {
+	Codes []string `json:"recovery_codes"`
+}
@@ -0,0 +1,482 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package domain
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer.Lexer, out *TwoFactorStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enabled":
+			out.Enabled = bool(in.Bool())
+		case "recovery_codes_left":
+			out.RecoveryCodesLeft = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwriter.Writer, in TwoFactorStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Enabled))
+	}
+	{
+		const prefix string = ",\"recovery_codes_left\":"
+		out.RawString(prefix)
+		out.Int(int(in.RecoveryCodesLeft))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain(l, v)
+}
+func easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain1(in *jlexer.Lexer, out *TwoFactorSetup) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "secret":
+			out.Secret = string(in.String())
+		case "provisioning_uri":
+			out.ProvisioningURI = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain1(out *jwriter.Writer, in TwoFactorSetup) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"secret\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Secret))
+	}
+	{
+		const prefix string = ",\"provisioning_uri\":"
+		out.RawString(prefix)
+		out.String(string(in.ProvisioningURI))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorSetup) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorSetup) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorSetup) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorSetup) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain1(l, v)
+}
+func easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain2(in *jlexer.Lexer, out *TwoFactorLogin) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pending_token":
+			out.PendingToken = string(in.String())
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain2(out *jwriter.Writer, in TwoFactorLogin) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pending_token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.PendingToken))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorLogin) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorLogin) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorLogin) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorLogin) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain2(l, v)
+}
+func easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain3(in *jlexer.Lexer, out *TwoFactorCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain3(out *jwriter.Writer, in TwoFactorCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain3(l, v)
+}
+func easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain4(in *jlexer.Lexer, out *TwoFactorChallenge) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "two_factor_required":
+			out.TwoFactorRequired = bool(in.Bool())
+		case "pending_token":
+			out.PendingToken = string(in.String())
+		case "expires_in":
+			out.ExpiresIn = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain4(out *jwriter.Writer, in TwoFactorChallenge) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"two_factor_required\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.TwoFactorRequired))
+	}
+	{
+		const prefix string = ",\"pending_token\":"
+		out.RawString(prefix)
+		out.String(string(in.PendingToken))
+	}
+	{
+		const prefix string = ",\"expires_in\":"
+		out.RawString(prefix)
+		out.Int(int(in.ExpiresIn))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TwoFactorChallenge) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TwoFactorChallenge) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TwoFactorChallenge) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TwoFactorChallenge) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain4(l, v)
+}
+func easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain5(in *jlexer.Lexer, out *RecoveryCodes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recovery_codes":
+			if in.IsNull() {
+				in.Skip()
+				out.Codes = nil
+			} else {
+				in.Delim('[')
+				if out.Codes == nil {
+					if !in.IsDelim(']') {
+						out.Codes = make([]string, 0, 4)
+					} else {
+						out.Codes = []string{}
+					}
+				} else {
+					out.Codes = (out.Codes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Codes = append(out.Codes, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain5(out *jwriter.Writer, in RecoveryCodes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recovery_codes\":"
+		out.RawString(prefix[1:])
+		if in.Codes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Codes {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryCodes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryCodes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson92b31249EncodeGithubComGoParkMailRu20251SuperChipsDomain5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryCodes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryCodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson92b31249DecodeGithubComGoParkMailRu20251SuperChipsDomain5(l, v)
+}
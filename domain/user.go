@@ -23,6 +23,9 @@ type User struct {
 	IsExternal       bool      `json:"is_external"`
 	IsExternalAvatar bool      `json:"-"`
 	SubscriberCount  int       `json:"subscriber_count"`
+	IsPrivateAccount bool      `json:"is_private_account,omitempty"`
+	FollowStatus     string    `json:"follow_status,omitempty"`
+	ContentHidden    bool      `json:"content_hidden,omitempty"`
 }
 
 //easyjson:json
@@ -39,16 +42,23 @@ type PublicUser struct {
 }
 
 var (
-	ErrInvalidEmail         = errors.New("invalid email")
-	ErrInvalidUsername      = errors.New("invalid username")
-	ErrNoPassword           = errors.New("no password")
-	ErrInvalidBirthday      = errors.New("invalid birthday")
-	ErrEmailAlreadyTaken    = errors.New("the email is already used")
-	ErrUsernameAlreadyTaken = errors.New("the username is already used")
-	ErrInvalidCredentials   = errors.New("invalid credentials")
-	ErrPasswordTooLong      = errors.New("password is too long")
-	ErrInternalError        = errors.New("internal error")
-	ErrUserNotFound         = errors.New("user not found")
+	ErrInvalidEmail          = errors.New("invalid email")
+	ErrInvalidUsername       = errors.New("invalid username")
+	ErrNoPassword            = errors.New("no password")
+	ErrInvalidBirthday       = errors.New("invalid birthday")
+	ErrEmailAlreadyTaken     = errors.New("the email is already used")
+	ErrUsernameAlreadyTaken  = errors.New("the username is already used")
+	ErrInvalidCredentials    = errors.New("invalid credentials")
+	ErrPasswordTooLong       = errors.New("password is too long")
+	ErrInternalError         = errors.New("internal error")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrUsernameChangeTooSoon = errors.New("username was changed recently")
+	ErrSessionOutdated       = errors.New("session was issued for a previous username")
+)
+
+const (
+	UsernameReservationPeriod = 30 * 24 * time.Hour // сколько прежнее имя закреплено за пользователем
+	UsernameChangeCooldown    = 7 * 24 * time.Hour  // как часто можно менять имя
 )
 
 func (p *PublicUser) Escape() {
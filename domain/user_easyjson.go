@@ -58,6 +58,12 @@ func easyjson9e1087fdDecodeGithubComGoParkMailRu20251SuperChipsDomain(in *jlexer
 			out.IsExternal = bool(in.Bool())
 		case "subscriber_count":
 			out.SubscriberCount = int(in.Int())
+		case "is_private_account":
+			out.IsPrivateAccount = bool(in.Bool())
+		case "follow_status":
+			out.FollowStatus = string(in.String())
+		case "content_hidden":
+			out.ContentHidden = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -128,6 +134,21 @@ func easyjson9e1087fdEncodeGithubComGoParkMailRu20251SuperChipsDomain(out *jwrit
 		out.RawString(prefix)
 		out.Int(int(in.SubscriberCount))
 	}
+	if in.IsPrivateAccount {
+		const prefix string = ",\"is_private_account\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivateAccount))
+	}
+	if in.FollowStatus != "" {
+		const prefix string = ",\"follow_status\":"
+		out.RawString(prefix)
+		out.String(string(in.FollowStatus))
+	}
+	if in.ContentHidden {
+		const prefix string = ",\"content_hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ContentHidden))
+	}
 	out.RawByte('}')
 }
 
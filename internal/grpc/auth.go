@@ -12,10 +12,21 @@ import (
 
 type UserUsecase interface {
 	AddUser(ctx context.Context, user domain.User) (uint64, error)
-	LoginUser(ctx context.Context, email, password string) (uint64, string, error)
-	LoginExternalUser(ctx context.Context, email string, externalID string) (int, string, string, error)
+	LoginUser(ctx context.Context, email, password string) (uint64, string, bool, error)
+	LoginExternalUser(ctx context.Context, email string, externalID string) (int, string, string, bool, error)
 	AddExternalUser(ctx context.Context, email, username, avatarURL string, externalID string) (uint64, error)
 	CheckImgPermission(ctx context.Context, imageName string, userID int) (bool, error)
+	GetTwoFactorStatus(ctx context.Context, userID int) (domain.TwoFactorStatus, error)
+	SetupTwoFactor(ctx context.Context, userID int) (domain.TwoFactorSetup, error)
+	ConfirmTwoFactor(ctx context.Context, userID int, code string) ([]string, error)
+	VerifyTwoFactor(ctx context.Context, userID int, code string) error
+	DisableTwoFactor(ctx context.Context, userID int, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID int, code string) ([]string, error)
+	LoginOAuthUser(ctx context.Context, provider, subject string) (int, string, string, bool, error)
+	AddOAuthUser(ctx context.Context, identity domain.OAuthIdentity, username string) (uint64, error)
+	LinkIdentity(ctx context.Context, userID int, identity domain.OAuthIdentity) error
+	UnlinkIdentity(ctx context.Context, userID int, provider string) error
+	GetIdentities(ctx context.Context, userID int) ([]domain.LinkedIdentity, error)
 }
 
 type GrpcAuthHandler struct {
@@ -47,7 +58,7 @@ func (h *GrpcAuthHandler) AddUser(ctx context.Context, in *gen.AddUserRequest) (
 }
 
 func (h *GrpcAuthHandler) LoginUser(ctx context.Context, in *gen.LoginUserRequest) (*gen.LoginUserResponse, error) {
-	id, username, err := h.usecase.LoginUser(ctx, in.Email, in.Password)
+	id, username, twoFactor, err := h.usecase.LoginUser(ctx, in.Email, in.Password)
 	if err != nil { 
 		return nil, mapToGrpcError(err)
 	}
@@ -55,11 +66,12 @@ func (h *GrpcAuthHandler) LoginUser(ctx context.Context, in *gen.LoginUserReques
 	return &gen.LoginUserResponse{
 		ID: int64(id),
 		Username: username,
+		TwoFactorRequired: twoFactor,
 	}, nil
 }
 
 func (h *GrpcAuthHandler) LoginExternalUser(ctx context.Context, in *gen.LoginExternalUserRequest) (*gen.LoginExternalUserResponse, error) {
-	id, email, username, err := h.usecase.LoginExternalUser(ctx, in.Email, in.ExternalID)
+	id, email, username, twoFactor, err := h.usecase.LoginExternalUser(ctx, in.Email, in.ExternalID)
 	if err != nil {
 		return nil, mapToGrpcError(err)
 	}
@@ -68,6 +80,7 @@ func (h *GrpcAuthHandler) LoginExternalUser(ctx context.Context, in *gen.LoginEx
 		ID:    int64(id),
 		Email: email,
 		Username: username,
+		TwoFactorRequired: twoFactor,
 	}, nil
 }
 
@@ -93,8 +106,159 @@ func (h *GrpcAuthHandler) CheckImgPermission(ctx context.Context, in *gen.CheckI
 	}, nil
 }
 
+func (h *GrpcAuthHandler) GetTwoFactorStatus(ctx context.Context, in *gen.TwoFactorRequest) (*gen.TwoFactorStatusResponse, error) {
+	twoFactor, err := h.usecase.GetTwoFactorStatus(ctx, int(in.ID))
+	if err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.TwoFactorStatusResponse{
+		Enabled:           twoFactor.Enabled,
+		RecoveryCodesLeft: int64(twoFactor.RecoveryCodesLeft),
+	}, nil
+}
+
+func (h *GrpcAuthHandler) SetupTwoFactor(ctx context.Context, in *gen.TwoFactorRequest) (*gen.SetupTwoFactorResponse, error) {
+	setup, err := h.usecase.SetupTwoFactor(ctx, int(in.ID))
+	if err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.SetupTwoFactorResponse{
+		Secret:          setup.Secret,
+		ProvisioningURI: setup.ProvisioningURI,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) ConfirmTwoFactor(ctx context.Context, in *gen.TwoFactorCodeRequest) (*gen.RecoveryCodesResponse, error) {
+	codes, err := h.usecase.ConfirmTwoFactor(ctx, int(in.ID), in.Code)
+	if err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.RecoveryCodesResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) VerifyTwoFactor(ctx context.Context, in *gen.TwoFactorCodeRequest) (*gen.VerifyTwoFactorResponse, error) {
+	if err := h.usecase.VerifyTwoFactor(ctx, int(in.ID), in.Code); err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.VerifyTwoFactorResponse{
+		Verified: true,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) DisableTwoFactor(ctx context.Context, in *gen.TwoFactorCodeRequest) (*gen.DisableTwoFactorResponse, error) {
+	if err := h.usecase.DisableTwoFactor(ctx, int(in.ID), in.Code); err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.DisableTwoFactorResponse{
+		Disabled: true,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) RegenerateRecoveryCodes(ctx context.Context, in *gen.TwoFactorCodeRequest) (*gen.RecoveryCodesResponse, error) {
+	codes, err := h.usecase.RegenerateRecoveryCodes(ctx, int(in.ID), in.Code)
+	if err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.RecoveryCodesResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) LoginOAuthUser(ctx context.Context, in *gen.LoginOAuthUserRequest) (*gen.LoginExternalUserResponse, error) {
+	id, email, username, twoFactor, err := h.usecase.LoginOAuthUser(ctx, in.Provider, in.Subject)
+	if err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.LoginExternalUserResponse{
+		ID:                int64(id),
+		Email:             email,
+		Username:          username,
+		TwoFactorRequired: twoFactor,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) AddOAuthUser(ctx context.Context, in *gen.AddOAuthUserRequest) (*gen.AddExternalUserResponse, error) {
+	id, err := h.usecase.AddOAuthUser(ctx, domain.OAuthIdentity{
+		Provider: in.Provider,
+		Subject:  in.Subject,
+		Email:    in.Email,
+		Avatar:   in.Avatar,
+	}, in.Username)
+	if err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.AddExternalUserResponse{
+		ID: int64(id),
+	}, nil
+}
+
+func (h *GrpcAuthHandler) LinkIdentity(ctx context.Context, in *gen.LinkIdentityRequest) (*gen.LinkIdentityResponse, error) {
+	if err := h.usecase.LinkIdentity(ctx, int(in.ID), domain.OAuthIdentity{
+		Provider: in.Provider,
+		Subject:  in.Subject,
+		Email:    in.Email,
+	}); err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.LinkIdentityResponse{
+		Linked: true,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) UnlinkIdentity(ctx context.Context, in *gen.UnlinkIdentityRequest) (*gen.UnlinkIdentityResponse, error) {
+	if err := h.usecase.UnlinkIdentity(ctx, int(in.ID), in.Provider); err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	return &gen.UnlinkIdentityResponse{
+		Unlinked: true,
+	}, nil
+}
+
+func (h *GrpcAuthHandler) GetIdentities(ctx context.Context, in *gen.IdentitiesRequest) (*gen.IdentitiesResponse, error) {
+	identities, err := h.usecase.GetIdentities(ctx, int(in.ID))
+	if err != nil {
+		return nil, mapToGrpcError(err)
+	}
+
+	resp := &gen.IdentitiesResponse{}
+	for _, identity := range identities {
+		resp.Identities = append(resp.Identities, &gen.LinkedIdentity{
+			Provider:  identity.Provider,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt.Unix(),
+		})
+	}
+
+	return resp, nil
+}
+
 func mapToGrpcError(err error) error {
     switch {
+	case errors.Is(err, domain.ErrIdentityLinked), errors.Is(err, domain.ErrLastLoginMethod):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrAccountSuspended):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrIdentityNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidTwoFactorCode):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrTwoFactorLocked):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, domain.ErrTwoFactorNotEnabled), errors.Is(err, domain.ErrTwoFactorNotSetUp),
+		errors.Is(err, domain.ErrTwoFactorAlreadyEnabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
     case errors.Is(err, domain.ErrInvalidCredentials):
         return status.Errorf(codes.Unauthenticated, "invalid credentials")
     case errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrNotFound):
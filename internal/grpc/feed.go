@@ -8,7 +8,7 @@ import (
 )
 
 type PinService interface {
-	GetPins(page int, pageSize int) ([]domain.PinData, error)
+	GetPins(page int, pageSize int, nsfwMode string) ([]domain.PinData, error)
 }
 
 type GrpcFeedHandler struct {
@@ -26,7 +26,7 @@ func (h *GrpcFeedHandler) GetPins(ctx context.Context, in *gen.GetPinsRequest) (
 	page := in.Page
 	pageSize := in.PageSize
 
-	pins, err := h.usecase.GetPins(int(page), int(pageSize))
+	pins, err := h.usecase.GetPins(int(page), int(pageSize), in.NsfwMode)
 	if err != nil {
 		return nil, err
 	}
@@ -52,10 +52,29 @@ func pinsToGrpc(pins []domain.PinData) []*gen.Pin {
 			UpdatedAt:      pin.UpdatedAt,
 			IsLiked:        pin.IsLiked,
 			LikeCount:      int64(pin.LikeCount),
+			SaveCount:      int64(pin.SaveCount),
 			Width:          int64(pin.Width),
 			Height:         int64(pin.Height),
+			IsNsfw:         pin.IsNSFW,
+			Media:          mediaToGrpc(pin.Media),
 		})
 	}
 
 	return grpcPins
 }
+
+func mediaToGrpc(media []domain.FlowMedia) []*gen.Media {
+	var grpcMedia []*gen.Media
+	for _, item := range media {
+		grpcMedia = append(grpcMedia, &gen.Media{
+			Type:       item.Type,
+			Url:        item.URL,
+			Width:      int64(item.Width),
+			Height:     int64(item.Height),
+			DurationMs: int64(item.DurationMs),
+			PosterUrl:  item.PosterURL,
+		})
+	}
+
+	return grpcMedia
+}
@@ -25,7 +25,7 @@ func TestGrpcFeedHandler_GetPins(t *testing.T) {
         pageSize := int64(10)
 
         mockPinService.EXPECT().
-            GetPins(int(page), int(pageSize)).
+            GetPins(int(page), int(pageSize), "").
             Return([]domain.PinData{
                 {
                     FlowID:         1,
@@ -82,7 +82,7 @@ func TestGrpcFeedHandler_GetPins(t *testing.T) {
         pageSize := int64(10)
 
         mockPinService.EXPECT().
-            GetPins(int(page), int(pageSize)).
+            GetPins(int(page), int(pageSize), "").
             Return(nil, errors.New("database error"))
 
         req := &gen.GetPinsRequest{
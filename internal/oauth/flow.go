@@ -0,0 +1,188 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+// ответы провайдера читаются не больше чем на 1 МБ
+const maxResponseSize = 1 << 20
+
+// NewState возвращает случайную строку для параметра state
+func NewState() (string, error) {
+	return randomString(24)
+}
+
+// NewCodeVerifier возвращает code_verifier для PKCE (RFC 7636)
+func NewCodeVerifier() (string, error) {
+	return randomString(32)
+}
+
+// CodeChallenge считает code_challenge методом S256
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// AuthCodeURL — адрес, на который фронтенд отправляет пользователя
+func (p *Provider) AuthCodeURL(redirectURI, state, verifier string) string {
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.ClientID)
+	params.Set("redirect_uri", redirectURI)
+	params.Set("state", state)
+	params.Set("code_challenge", CodeChallenge(verifier))
+	params.Set("code_challenge_method", "S256")
+	if len(p.Scopes) > 0 {
+		params.Set("scope", strings.Join(p.Scopes, " "))
+	}
+
+	sep := "?"
+	if strings.Contains(p.AuthURL, "?") {
+		sep = "&"
+	}
+
+	return p.AuthURL + sep + params.Encode()
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Exchange меняет код авторизации на access token
+func (p *Provider) Exchange(ctx context.Context, redirectURI, verifier string, callback domain.OAuthCallback) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", callback.Code)
+	form.Set("redirect_uri", redirectURI)
+	form.Set("code_verifier", verifier)
+	form.Set("client_id", p.ClientID)
+	if p.ClientSecret != "" {
+		form.Set("client_secret", p.ClientSecret)
+	}
+	if p.vkID {
+		form.Set("device_id", callback.DeviceID)
+		form.Set("state", callback.State)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	body, err := p.do(req)
+	if err != nil {
+		return "", err
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrProviderCall, err)
+	}
+
+	if token.Error != "" {
+		return "", fmt.Errorf("%w: %s %s", ErrProviderCall, token.Error, token.ErrorDescription)
+	}
+
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("%w: empty access token", ErrProviderCall)
+	}
+
+	return token.AccessToken, nil
+}
+
+// Identity запрашивает профиль пользователя у провайдера. Токен получен
+// напрямую с token endpoint, поэтому ответу userinfo можно доверять
+func (p *Provider) Identity(ctx context.Context, accessToken string) (domain.OAuthIdentity, error) {
+	var req *http.Request
+	var err error
+
+	if p.vkID {
+		form := url.Values{}
+		form.Set("client_id", p.ClientID)
+		form.Set("access_token", accessToken)
+
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, p.UserInfoURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return domain.OAuthIdentity{}, err
+		}
+
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, p.UserInfoURL, nil)
+		if err != nil {
+			return domain.OAuthIdentity{}, err
+		}
+
+		scheme := p.tokenScheme
+		if scheme == "" {
+			scheme = "Bearer"
+		}
+
+		req.Header.Set("Authorization", scheme+" "+accessToken)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	body, err := p.do(req)
+	if err != nil {
+		return domain.OAuthIdentity{}, err
+	}
+
+	identity, err := p.parseUser(body)
+	if err != nil {
+		return domain.OAuthIdentity{}, fmt.Errorf("%w: %v", ErrProviderCall, err)
+	}
+
+	if identity.Subject == "" {
+		return domain.OAuthIdentity{}, fmt.Errorf("%w: empty subject", ErrProviderCall)
+	}
+
+	identity.Provider = p.Name
+
+	return identity, nil
+}
+
+func (p *Provider) do(req *http.Request) ([]byte, error) {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProviderCall, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProviderCall, err)
+	}
+
+	// ошибки token endpoint приходят с кодом 400 и описанием в теле
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest {
+		return nil, fmt.Errorf("%w: status %d", ErrProviderCall, resp.StatusCode)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,256 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/configs"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+var (
+	ErrDiscovery    = errors.New("oidc discovery failed")
+	ErrProviderCall = errors.New("login provider request failed")
+)
+
+// Provider — настройки одного OAuth2 провайдера с authorization code + PKCE
+type Provider struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	AuthURL      string
+	TokenURL     string
+	UserInfoURL  string
+	Scopes       []string
+
+	client *http.Client
+	// схема заголовка Authorization при запросе профиля
+	tokenScheme string
+	// VK ID принимает токен только в теле POST запроса и ждет device_id при обмене кода
+	vkID      bool
+	parseUser func(body []byte) (domain.OAuthIdentity, error)
+}
+
+type Registry struct {
+	providers map[string]*Provider
+}
+
+func NewRegistry(providers ...*Provider) *Registry {
+	r := &Registry{
+		providers: make(map[string]*Provider),
+	}
+
+	for _, p := range providers {
+		r.providers[p.Name] = p
+	}
+
+	return r
+}
+
+func (r *Registry) Get(name string) (*Provider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, domain.ErrUnknownProvider
+	}
+
+	return p, nil
+}
+
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// NewRegistryFromConfig включает провайдеров, для которых задан client id.
+// Если discovery OIDC провайдера не удался, он пропускается, а не роняет сервис
+func NewRegistryFromConfig(ctx context.Context, cfg configs.Config) *Registry {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var providers []*Provider
+
+	if cfg.VKClientID != "" {
+		providers = append(providers, NewVKProvider(client, cfg.VKClientID))
+	}
+
+	if cfg.YandexClientID != "" {
+		providers = append(providers, NewYandexProvider(client, cfg.YandexClientID, cfg.YandexClientSecret))
+	}
+
+	if cfg.GoogleClientID != "" {
+		p, err := DiscoverOIDCProvider(ctx, client, domain.ProviderGoogle, "https://accounts.google.com", cfg.GoogleClientID, cfg.GoogleClientSecret)
+		if err != nil {
+			log.Printf("oauth: google provider disabled: %v", err)
+		} else {
+			providers = append(providers, p)
+		}
+	}
+
+	if cfg.OIDCClientID != "" && cfg.OIDCIssuer != "" {
+		p, err := DiscoverOIDCProvider(ctx, client, cfg.OIDCName, cfg.OIDCIssuer, cfg.OIDCClientID, cfg.OIDCClientSecret)
+		if err != nil {
+			log.Printf("oauth: %s provider disabled: %v", cfg.OIDCName, err)
+		} else {
+			providers = append(providers, p)
+		}
+	}
+
+	return NewRegistry(providers...)
+}
+
+func NewVKProvider(client *http.Client, clientID string) *Provider {
+	return &Provider{
+		Name:        domain.ProviderVK,
+		ClientID:    clientID,
+		AuthURL:     "https://id.vk.com/authorize",
+		TokenURL:    "https://id.vk.com/oauth2/auth",
+		UserInfoURL: "https://id.vk.com/oauth2/user_info",
+		Scopes:      []string{"email"},
+		client:      client,
+		vkID:        true,
+		parseUser:   parseVKUser,
+	}
+}
+
+func NewYandexProvider(client *http.Client, clientID, clientSecret string) *Provider {
+	return &Provider{
+		Name:         domain.ProviderYandex,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		AuthURL:      "https://oauth.yandex.ru/authorize",
+		TokenURL:     "https://oauth.yandex.ru/token",
+		UserInfoURL:  "https://login.yandex.ru/info?format=json",
+		Scopes:       []string{"login:email", "login:avatar"},
+		client:       client,
+		tokenScheme:  "OAuth",
+		parseUser:    parseYandexUser,
+	}
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// DiscoverOIDCProvider настраивает провайдера по /.well-known/openid-configuration
+func DiscoverOIDCProvider(ctx context.Context, client *http.Client, name, issuer, clientID, clientSecret string) (*Provider, error) {
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d", ErrDiscovery, resp.StatusCode)
+	}
+
+	var doc discoveryDocument
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+
+	// документ должен описывать именно тот issuer, который мы запрашивали
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("%w: issuer mismatch %q", ErrDiscovery, doc.Issuer)
+	}
+
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.UserInfoEndpoint == "" {
+		return nil, fmt.Errorf("%w: missing endpoints", ErrDiscovery)
+	}
+
+	return &Provider{
+		Name:         name,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		AuthURL:      doc.AuthorizationEndpoint,
+		TokenURL:     doc.TokenEndpoint,
+		UserInfoURL:  doc.UserInfoEndpoint,
+		Scopes:       []string{"openid", "email", "profile"},
+		client:       client,
+		parseUser:    parseOIDCUser,
+	}, nil
+}
+
+type oidcUser struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified *bool  `json:"email_verified"`
+	Picture       string `json:"picture"`
+}
+
+func parseOIDCUser(body []byte) (domain.OAuthIdentity, error) {
+	var user oidcUser
+	if err := json.Unmarshal(body, &user); err != nil {
+		return domain.OAuthIdentity{}, err
+	}
+
+	identity := domain.OAuthIdentity{
+		Subject: user.Subject,
+		Avatar:  user.Picture,
+	}
+
+	// неподтвержденной почте не доверяем, пользователь укажет ее сам
+	if user.EmailVerified == nil || *user.EmailVerified {
+		identity.Email = user.Email
+	}
+
+	return identity, nil
+}
+
+type yandexUser struct {
+	ID              string `json:"id"`
+	DefaultEmail    string `json:"default_email"`
+	DefaultAvatarID string `json:"default_avatar_id"`
+	IsAvatarEmpty   bool   `json:"is_avatar_empty"`
+}
+
+func parseYandexUser(body []byte) (domain.OAuthIdentity, error) {
+	var user yandexUser
+	if err := json.Unmarshal(body, &user); err != nil {
+		return domain.OAuthIdentity{}, err
+	}
+
+	identity := domain.OAuthIdentity{
+		Subject: user.ID,
+		Email:   user.DefaultEmail,
+	}
+
+	if !user.IsAvatarEmpty && user.DefaultAvatarID != "" {
+		identity.Avatar = "https://avatars.yandex.net/get-yapic/" + user.DefaultAvatarID + "/islands-200"
+	}
+
+	return identity, nil
+}
+
+func parseVKUser(body []byte) (domain.OAuthIdentity, error) {
+	var data domain.VKUserTop
+	if err := data.UnmarshalJSON(body); err != nil {
+		return domain.OAuthIdentity{}, err
+	}
+
+	return domain.OAuthIdentity{
+		Subject: data.User.UserID,
+		Email:   data.User.Email,
+		Avatar:  data.User.Avatar,
+	}, nil
+}
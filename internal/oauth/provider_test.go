@@ -0,0 +1,177 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOIDCServer — минимальный OIDC провайдер: discovery, token с проверкой PKCE и userinfo
+type fakeOIDCServer struct {
+	*httptest.Server
+	challenge     string
+	emailVerified bool
+	issuer        string
+}
+
+func newFakeOIDCServer(t *testing.T) *fakeOIDCServer {
+	f := &fakeOIDCServer{emailVerified: true}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer := f.URL
+		if f.issuer != "" {
+			issuer = f.issuer
+		}
+
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer,
+			"authorization_endpoint": f.URL + "/authorize",
+			"token_endpoint":         f.URL + "/token",
+			"userinfo_endpoint":      f.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if r.PostForm.Get("code") != "good-code" || r.PostForm.Get("client_secret") != "secret" ||
+			CodeChallenge(r.PostForm.Get("code_verifier")) != f.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "access",
+			"token_type":   "Bearer",
+		})
+	})
+	mux.HandleFunc("GET /userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"sub":            "user-1",
+			"email":          "user@example.com",
+			"email_verified": f.emailVerified,
+			"picture":        "https://example.com/avatar.png",
+		})
+	})
+
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+
+	return f
+}
+
+func TestOIDCProviderFlow(t *testing.T) {
+	server := newFakeOIDCServer(t)
+	ctx := context.Background()
+
+	provider, err := DiscoverOIDCProvider(ctx, server.Client(), "fake", server.URL+"/", "client", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/token", provider.TokenURL)
+
+	verifier, err := NewCodeVerifier()
+	require.NoError(t, err)
+
+	authURL, err := url.Parse(provider.AuthCodeURL("https://yourflow.ru/oauth/fake", "state-1", verifier))
+	require.NoError(t, err)
+
+	query := authURL.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, "client", query.Get("client_id"))
+	assert.Equal(t, "state-1", query.Get("state"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+	assert.Empty(t, query.Get("code_verifier"))
+
+	server.challenge = query.Get("code_challenge")
+
+	token, err := provider.Exchange(ctx, "https://yourflow.ru/oauth/fake", verifier, domain.OAuthCallback{Code: "good-code"})
+	require.NoError(t, err)
+
+	identity, err := provider.Identity(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OAuthIdentity{
+		Provider: "fake",
+		Subject:  "user-1",
+		Email:    "user@example.com",
+		Avatar:   "https://example.com/avatar.png",
+	}, identity)
+}
+
+func TestOIDCProviderFlow_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Сценарий: неверный code_verifier", func(t *testing.T) {
+		server := newFakeOIDCServer(t)
+		provider, err := DiscoverOIDCProvider(ctx, server.Client(), "fake", server.URL, "client", "secret")
+		require.NoError(t, err)
+
+		server.challenge = CodeChallenge("expected")
+
+		_, err = provider.Exchange(ctx, "https://yourflow.ru/oauth/fake", "other", domain.OAuthCallback{Code: "good-code"})
+		assert.ErrorIs(t, err, ErrProviderCall)
+	})
+
+	t.Run("Сценарий: неподтвержденная почта", func(t *testing.T) {
+		server := newFakeOIDCServer(t)
+		server.emailVerified = false
+		provider, err := DiscoverOIDCProvider(ctx, server.Client(), "fake", server.URL, "client", "secret")
+		require.NoError(t, err)
+
+		identity, err := provider.Identity(ctx, "access")
+		require.NoError(t, err)
+		assert.Empty(t, identity.Email)
+	})
+
+	t.Run("Сценарий: чужой issuer в discovery", func(t *testing.T) {
+		server := newFakeOIDCServer(t)
+		server.issuer = "https://evil.example.com"
+
+		_, err := DiscoverOIDCProvider(ctx, server.Client(), "fake", server.URL, "client", "secret")
+		assert.ErrorIs(t, err, ErrDiscovery)
+	})
+}
+
+func TestYandexProviderIdentity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "OAuth access", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"id": "42", "default_email": "user@ya.ru", "default_avatar_id": "abc", "is_avatar_empty": false}`))
+	}))
+	defer server.Close()
+
+	provider := NewYandexProvider(server.Client(), "client", "secret")
+	provider.UserInfoURL = server.URL
+
+	identity, err := provider.Identity(context.Background(), "access")
+	require.NoError(t, err)
+	assert.Equal(t, domain.OAuthIdentity{
+		Provider: domain.ProviderYandex,
+		Subject:  "42",
+		Email:    "user@ya.ru",
+		Avatar:   "https://avatars.yandex.net/get-yapic/abc/islands-200",
+	}, identity)
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry(NewVKProvider(http.DefaultClient, "vk"), NewYandexProvider(http.DefaultClient, "ya", ""))
+
+	assert.Equal(t, []string{"vk", "yandex"}, registry.Names())
+
+	_, err := registry.Get("google")
+	assert.ErrorIs(t, err, domain.ErrUnknownProvider)
+}
@@ -0,0 +1,122 @@
+package oauth
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const StateCookie = "oauth_state"
+
+// аудитории не дают использовать эти токены как сессию и путать их между собой
+const (
+	stateAudience        = "oauth-state"
+	registrationAudience = "oauth-registration"
+)
+
+// StateClaims хранится в cookie между началом входа и возвращением от провайдера.
+// code_verifier не покидает браузер пользователя и наш сервер
+type StateClaims struct {
+	Provider string
+	State    string
+	Verifier string
+	Mode     string
+	UserID   int
+	jwt.RegisteredClaims
+}
+
+type registrationClaims struct {
+	Provider string
+	Subject  string
+	Email    string
+	Avatar   string
+	jwt.RegisteredClaims
+}
+
+// Signer подписывает state и токены регистрации секретом JWT
+type Signer struct {
+	secret []byte
+	issuer string
+}
+
+func NewSigner(secret []byte) *Signer {
+	return &Signer{
+		secret: secret,
+		issuer: "flow",
+	}
+}
+
+func (s *Signer) CreateState(claims StateClaims) (string, error) {
+	claims.RegisteredClaims = s.registered(stateAudience, domain.OAuthStateTTL)
+	return s.sign(&claims)
+}
+
+// ParseState проверяет cookie и то, что провайдер вернул тот же state
+func (s *Signer) ParseState(token, provider, state string) (*StateClaims, error) {
+	claims := &StateClaims{}
+	if err := s.parse(token, stateAudience, claims); err != nil {
+		return nil, domain.ErrInvalidOAuthState
+	}
+
+	if claims.Provider != provider || state == "" ||
+		subtle.ConstantTimeCompare([]byte(claims.State), []byte(state)) != 1 {
+		return nil, domain.ErrInvalidOAuthState
+	}
+
+	return claims, nil
+}
+
+// CreateRegistration выдает токен, по которому новый пользователь
+// завершает регистрацию, выбрав имя
+func (s *Signer) CreateRegistration(identity domain.OAuthIdentity) (string, error) {
+	return s.sign(&registrationClaims{
+		Provider:         identity.Provider,
+		Subject:          identity.Subject,
+		Email:            identity.Email,
+		Avatar:           identity.Avatar,
+		RegisteredClaims: s.registered(registrationAudience, domain.OAuthRegistrationTTL),
+	})
+}
+
+func (s *Signer) ParseRegistration(token string) (domain.OAuthIdentity, error) {
+	claims := &registrationClaims{}
+	if err := s.parse(token, registrationAudience, claims); err != nil {
+		return domain.OAuthIdentity{}, domain.ErrInvalidOAuthState
+	}
+
+	return domain.OAuthIdentity{
+		Provider: claims.Provider,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+		Avatar:   claims.Avatar,
+	}, nil
+}
+
+func (s *Signer) registered(audience string, ttl time.Duration) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+		Issuer:    s.issuer,
+		Audience:  jwt.ClaimStrings{audience},
+	}
+}
+
+func (s *Signer) sign(claims jwt.Claims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+}
+
+func (s *Signer) parse(token, audience string, claims jwt.Claims) error {
+	parsed, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return s.secret, nil
+	}, jwt.WithAudience(audience), jwt.WithIssuer(s.issuer))
+	if err != nil || !parsed.Valid {
+		return domain.ErrInvalidOAuthState
+	}
+
+	return nil
+}
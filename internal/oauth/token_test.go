@@ -0,0 +1,71 @@
+package oauth
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseState(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+
+	token, err := signer.CreateState(StateClaims{
+		Provider: "google",
+		State:    "state-1",
+		Verifier: "verifier",
+		Mode:     domain.OAuthModeLink,
+		UserID:   5,
+	})
+	require.NoError(t, err)
+
+	claims, err := signer.ParseState(token, "google", "state-1")
+	require.NoError(t, err)
+	assert.Equal(t, "verifier", claims.Verifier)
+	assert.Equal(t, 5, claims.UserID)
+
+	tests := []struct {
+		name     string
+		token    string
+		provider string
+		state    string
+	}{
+		{"Сценарий: другой state", token, "google", "state-2"},
+		{"Сценарий: пустой state", token, "google", ""},
+		{"Сценарий: другой провайдер", token, "yandex", "state-1"},
+		{"Сценарий: чужая подпись", mustState(t, NewSigner([]byte("other"))), "google", "state-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := signer.ParseState(tt.token, tt.provider, tt.state)
+			assert.ErrorIs(t, err, domain.ErrInvalidOAuthState)
+		})
+	}
+}
+
+func TestRegistrationTokenIsNotState(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+	identity := domain.OAuthIdentity{Provider: "google", Subject: "sub", Email: "user@gmail.com"}
+
+	token, err := signer.CreateRegistration(identity)
+	require.NoError(t, err)
+
+	parsed, err := signer.ParseRegistration(token)
+	require.NoError(t, err)
+	assert.Equal(t, identity, parsed)
+
+	_, err = signer.ParseState(token, "google", "")
+	assert.ErrorIs(t, err, domain.ErrInvalidOAuthState)
+
+	_, err = signer.ParseRegistration(mustState(t, signer))
+	assert.ErrorIs(t, err, domain.ErrInvalidOAuthState)
+}
+
+func mustState(t *testing.T, signer *Signer) string {
+	token, err := signer.CreateState(StateClaims{Provider: "google", State: "state-1"})
+	require.NoError(t, err)
+
+	return token
+}
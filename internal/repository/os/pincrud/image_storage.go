@@ -8,6 +8,7 @@ import (
 
 	pincrudService "github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/image"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/video"
 	"github.com/google/uuid"
 )
 
@@ -28,9 +29,25 @@ func (strg *osImageStorage) Save(file multipart.File, header *multipart.FileHead
 		return "", pincrudService.ErrInvalidImageExt
 	}
 
+	return strg.save(file, header)
+}
+
+func (strg *osImageStorage) SaveVideo(file multipart.File, header *multipart.FileHeader) (string, error) {
+	if !video.IsVideoFile(header.Filename) {
+		return "", pincrudService.ErrInvalidVideo
+	}
+
+	return strg.save(file, header)
+}
+
+func (strg *osImageStorage) Path(name string) string {
+	return filepath.Join(strg.imgDir, name)
+}
+
+func (strg *osImageStorage) save(file multipart.File, header *multipart.FileHeader) (string, error) {
 	imgUUID := uuid.New()
 	imgName := imgUUID.String() + filepath.Ext(header.Filename)
-	imgPath := filepath.Join(strg.imgDir, imgName)
+	imgPath := strg.Path(imgName)
 
 	dst, err := os.Create(imgPath)
 	if err != nil {
@@ -46,7 +63,7 @@ func (strg *osImageStorage) Save(file multipart.File, header *multipart.FileHead
 }
 
 func (strg *osImageStorage) Delete(imgName string) error {
-	imgPath := filepath.Join(strg.imgDir, imgName)
+	imgPath := strg.Path(imgName)
 
 	_, err := os.Stat(imgPath)
 	if os.IsNotExist(err) {
@@ -0,0 +1,317 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+type AccountRepository struct {
+	db *sql.DB
+}
+
+func NewAccountRepository(db *sql.DB) *AccountRepository {
+	return &AccountRepository{
+		db: db,
+	}
+}
+
+// ValidateSession отклоняет токены заблокированных и удалённых пользователей,
+// а также токены, выписанные до смены имени: по имени из токена работает чат
+func (r *AccountRepository) ValidateSession(ctx context.Context, userID int, username string) error {
+	var current string
+	var suspended bool
+	err := r.db.QueryRowContext(ctx, `
+	SELECT username, is_suspended
+	FROM flow_user
+	WHERE id = $1
+	`, userID).Scan(&current, &suspended)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if suspended {
+		return domain.ErrAccountSuspended
+	}
+	if current != username {
+		return domain.ErrSessionOutdated
+	}
+
+	return nil
+}
+
+// GetDeletionCredentials возвращает данные для подтверждения удаления аккаунта
+func (r *AccountRepository) GetDeletionCredentials(ctx context.Context, userID int) (string, string, bool, error) {
+	var username, hash string
+	var externalID sql.NullString
+
+	err := r.db.QueryRowContext(ctx, `
+	SELECT username, password, external_id
+	FROM flow_user
+	WHERE id = $1
+	`, userID).Scan(&username, &hash, &externalID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", false, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return "", "", false, err
+	}
+
+	return username, hash, externalID.String != "", nil
+}
+
+// ScheduleDeletion назначает удаление аккаунта. Повторный запрос не сдвигает уже назначенную дату
+func (r *AccountRepository) ScheduleDeletion(ctx context.Context, userID int, deleteAfter time.Time) (time.Time, error) {
+	var scheduled time.Time
+	err := r.db.QueryRowContext(ctx, `
+	UPDATE flow_user
+	SET delete_after = COALESCE(delete_after, $2)
+	WHERE id = $1
+	RETURNING delete_after
+	`, userID, deleteAfter).Scan(&scheduled)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return scheduled, nil
+}
+
+func (r *AccountRepository) CancelDeletion(ctx context.Context, userID int) error {
+	res, err := r.db.ExecContext(ctx, `
+	UPDATE flow_user
+	SET delete_after = NULL
+	WHERE id = $1 AND delete_after IS NOT NULL
+	`, userID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrDeletionNotScheduled
+	}
+
+	return nil
+}
+
+func (r *AccountRepository) GetDeletionStatus(ctx context.Context, userID int) (domain.AccountDeletionStatus, error) {
+	var deleteAfter sql.NullTime
+	err := r.db.QueryRowContext(ctx, `
+	SELECT delete_after
+	FROM flow_user
+	WHERE id = $1
+	`, userID).Scan(&deleteAfter)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.AccountDeletionStatus{}, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return domain.AccountDeletionStatus{}, err
+	}
+
+	if !deleteAfter.Valid {
+		return domain.AccountDeletionStatus{}, nil
+	}
+
+	return domain.AccountDeletionStatus{
+		Scheduled:   true,
+		DeleteAfter: &deleteAfter.Time,
+	}, nil
+}
+
+// GetDueDeletions возвращает аккаунты, срок удаления которых наступил
+func (r *AccountRepository) GetDueDeletions(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT id
+	FROM flow_user
+	WHERE delete_after IS NOT NULL AND delete_after <= $1
+	ORDER BY delete_after
+	LIMIT $2
+	`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// DeleteAccount передаёт общие доски соавторам, удаляет аккаунт со всеми
+// данными и поправляет счётчики чужих флоу, досок, комментариев и профилей.
+// Если удаление успели отменить, ничего не меняется и возвращается
+// ErrDeletionNotScheduled
+func (r *AccountRepository) DeleteAccount(ctx context.Context, userID int) (domain.DeletedAccount, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.DeletedAccount{}, err
+	}
+	defer tx.Rollback()
+
+	var deleted domain.DeletedAccount
+	var avatar sql.NullString
+	var isExternalAvatar sql.NullBool
+	err = tx.QueryRowContext(ctx, `
+	SELECT avatar, is_external_avatar
+	FROM flow_user
+	WHERE id = $1 AND delete_after IS NOT NULL AND delete_after <= NOW()
+	FOR UPDATE
+	`, userID).Scan(&avatar, &isExternalAvatar)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DeletedAccount{}, domain.ErrDeletionNotScheduled
+	}
+	if err != nil {
+		return domain.DeletedAccount{}, err
+	}
+
+	if !isExternalAvatar.Bool {
+		deleted.Avatar = avatar.String
+	}
+
+	// общие доски передаются соавторам только после проверки, что удаление
+	// не отменено, и до сбора файлов: их обложки остаются новым авторам
+	if _, err := handOverBoards(ctx, tx, userID); err != nil {
+		return domain.DeletedAccount{}, err
+	}
+
+	deleted.Media, err = queryStrings(ctx, tx, `
+	SELECT f.media_url FROM flow f WHERE f.author_id = $1
+	UNION
+	SELECT fm.media_url FROM flow_media fm JOIN flow f ON f.id = fm.flow_id WHERE f.author_id = $1
+	UNION
+	SELECT fm.poster_url FROM flow_media fm JOIN flow f ON f.id = fm.flow_id WHERE f.author_id = $1 AND fm.poster_url <> ''
+	UNION
+	SELECT b.cover_image FROM board b WHERE b.author_id = $1 AND b.cover_image IS NOT NULL AND b.cover_image <> ''
+	`, userID)
+	if err != nil {
+		return domain.DeletedAccount{}, err
+	}
+
+	deleted.Exports, err = queryStrings(ctx, tx, `
+	SELECT file_name FROM data_export WHERE user_id = $1 AND file_name <> ''
+	`, userID)
+	if err != nil {
+		return domain.DeletedAccount{}, err
+	}
+
+	// счётчики на чужих объектах не пересчитываются каскадным удалением
+	counters := []string{
+		`UPDATE flow AS f
+		SET like_count = f.like_count - 1
+		FROM flow_like AS fl
+		WHERE fl.flow_id = f.id AND fl.user_id = $1 AND f.author_id <> $1 AND f.like_count > 0`,
+
+		`UPDATE comment AS c
+		SET like_count = c.like_count - 1
+		FROM comment_like AS cl
+		WHERE cl.comment_id = c.id AND cl.user_id = $1 AND c.author_id <> $1 AND c.like_count > 0`,
+
+		`UPDATE comment AS c
+		SET reply_count = GREATEST(c.reply_count - r.cnt, 0)
+		FROM (
+			SELECT parent_id, COUNT(*) AS cnt
+			FROM comment
+			WHERE author_id = $1 AND parent_id IS NOT NULL
+			GROUP BY parent_id
+		) AS r
+		WHERE c.id = r.parent_id AND c.author_id <> $1`,
+
+		`UPDATE flow_user AS u
+		SET subscriber_count = u.subscriber_count - 1
+		FROM subscription AS s
+		WHERE s.target_id = u.id AND s.user_id = $1 AND u.subscriber_count > 0`,
+
+		`UPDATE board AS b
+		SET follower_count = b.follower_count - 1
+		FROM board_follower AS bf
+		WHERE bf.board_id = b.id AND bf.user_id = $1 AND b.author_id <> $1 AND b.follower_count > 0`,
+
+		`UPDATE board AS b
+		SET flow_count = GREATEST(b.flow_count - p.cnt, 0)
+		FROM (
+			SELECT bp.board_id, COUNT(*) AS cnt
+			FROM board_post bp
+			JOIN flow f ON f.id = bp.flow_id
+			WHERE f.author_id = $1
+			GROUP BY bp.board_id
+		) AS p
+		WHERE b.id = p.board_id AND b.author_id <> $1`,
+
+		`UPDATE flow AS f
+		SET save_count = GREATEST(f.save_count - s.cnt, 0)
+		FROM (
+			SELECT bp.flow_id, COUNT(*) AS cnt
+			FROM board_post bp
+			JOIN board b ON b.id = bp.board_id
+			WHERE b.author_id = $1
+			GROUP BY bp.flow_id
+		) AS s
+		WHERE f.id = s.flow_id AND f.author_id <> $1`,
+	}
+
+	for _, query := range counters {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return domain.DeletedAccount{}, err
+		}
+	}
+
+	// чаты, сообщения, флоу, доски, комментарии, лайки и уведомления удаляются каскадно
+	if _, err := tx.ExecContext(ctx, `
+	DELETE FROM flow_user
+	WHERE id = $1
+	`, userID); err != nil {
+		return domain.DeletedAccount{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.DeletedAccount{}, err
+	}
+
+	return deleted, nil
+}
+
+func queryStrings(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
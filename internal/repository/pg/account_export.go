@@ -0,0 +1,432 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+const dataExportColumns = `id, user_id, status, file_name, created_at, finished_at, expires_at`
+
+func scanDataExport(row interface{ Scan(...any) error }) (domain.DataExport, error) {
+	var export domain.DataExport
+	var finishedAt, expiresAt sql.NullTime
+
+	err := row.Scan(
+		&export.ID,
+		&export.UserID,
+		&export.Status,
+		&export.FileName,
+		&export.CreatedAt,
+		&finishedAt,
+		&expiresAt,
+	)
+	if err != nil {
+		return domain.DataExport{}, err
+	}
+
+	if finishedAt.Valid {
+		export.FinishedAt = &finishedAt.Time
+	}
+	if expiresAt.Valid {
+		export.ExpiresAt = &expiresAt.Time
+	}
+
+	return export, nil
+}
+
+// CreateExport ставит сборку архива в очередь. Если архив уже собирается,
+// возвращается он, новый не создаётся
+func (r *AccountRepository) CreateExport(ctx context.Context, userID int) (domain.DataExport, error) {
+	export, err := scanDataExport(r.db.QueryRowContext(ctx, `
+	WITH active AS (
+		SELECT `+dataExportColumns+`
+		FROM data_export
+		WHERE user_id = $1 AND status IN ('pending', 'processing')
+	), inserted AS (
+		INSERT INTO data_export (user_id)
+		SELECT $1
+		WHERE NOT EXISTS (SELECT 1 FROM active)
+		ON CONFLICT DO NOTHING
+		RETURNING `+dataExportColumns+`
+	)
+	SELECT `+dataExportColumns+` FROM inserted
+	UNION ALL
+	SELECT `+dataExportColumns+` FROM active
+	`, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DataExport{}, domain.ErrConflict
+	}
+	if err != nil {
+		return domain.DataExport{}, err
+	}
+
+	return export, nil
+}
+
+func (r *AccountRepository) GetExport(ctx context.Context, exportID, userID int) (domain.DataExport, error) {
+	export, err := scanDataExport(r.db.QueryRowContext(ctx, `
+	SELECT `+dataExportColumns+`
+	FROM data_export
+	WHERE id = $1 AND user_id = $2
+	`, exportID, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DataExport{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.DataExport{}, err
+	}
+
+	return export, nil
+}
+
+// ClaimExport забирает самый старый архив из очереди. Если очередь пуста,
+// возвращается ErrNotFound
+func (r *AccountRepository) ClaimExport(ctx context.Context) (domain.DataExport, error) {
+	export, err := scanDataExport(r.db.QueryRowContext(ctx, `
+	UPDATE data_export
+	SET status = 'processing'
+	WHERE id = (
+		SELECT id
+		FROM data_export
+		WHERE status = 'pending'
+		ORDER BY id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING `+dataExportColumns))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DataExport{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.DataExport{}, err
+	}
+
+	return export, nil
+}
+
+func (r *AccountRepository) FinishExport(ctx context.Context, exportID int, fileName string, expiresAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+	UPDATE data_export
+	SET status = 'ready', file_name = $2, finished_at = NOW(), expires_at = $3
+	WHERE id = $1
+	`, exportID, fileName, expiresAt)
+
+	return err
+}
+
+func (r *AccountRepository) FailExport(ctx context.Context, exportID int) error {
+	_, err := r.db.ExecContext(ctx, `
+	UPDATE data_export
+	SET status = 'failed', finished_at = NOW()
+	WHERE id = $1
+	`, exportID)
+
+	return err
+}
+
+// ExpireExports помечает устаревшие архивы и возвращает их файлы для удаления
+func (r *AccountRepository) ExpireExports(ctx context.Context, now time.Time) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	UPDATE data_export
+	SET status = 'expired'
+	WHERE status = 'ready' AND expires_at <= $1
+	RETURNING file_name
+	`, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var files []string
+	for rows.Next() {
+		var file string
+		if err := rows.Scan(&file); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// GetExportData собирает все данные пользователя для архива. Пути к медиа
+// возвращаются как имена файлов в хранилище
+func (r *AccountRepository) GetExportData(ctx context.Context, userID int) (domain.AccountExport, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelRepeatableRead})
+	if err != nil {
+		return domain.AccountExport{}, err
+	}
+	defer tx.Rollback()
+
+	var data domain.AccountExport
+	var about sql.NullString
+	var birthday sql.NullTime
+	err = tx.QueryRowContext(ctx, `
+	SELECT username, email, public_name, about, birthday, created_at
+	FROM flow_user
+	WHERE id = $1
+	`, userID).Scan(
+		&data.Profile.Username,
+		&data.Profile.Email,
+		&data.Profile.PublicName,
+		&about,
+		&birthday,
+		&data.Profile.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.AccountExport{}, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return domain.AccountExport{}, err
+	}
+
+	data.Profile.About = about.String
+	if birthday.Valid {
+		data.Profile.Birthday = &birthday.Time
+	}
+
+	if data.Flows, err = exportFlows(ctx, tx, userID); err != nil {
+		return domain.AccountExport{}, err
+	}
+	if data.Boards, err = exportBoards(ctx, tx, userID); err != nil {
+		return domain.AccountExport{}, err
+	}
+	if data.Comments, err = exportComments(ctx, tx, userID); err != nil {
+		return domain.AccountExport{}, err
+	}
+	if data.Messages, err = exportMessages(ctx, tx, data.Profile.Username); err != nil {
+		return domain.AccountExport{}, err
+	}
+
+	return data, nil
+}
+
+func exportFlows(ctx context.Context, tx *sql.Tx, userID int) (domain.ExportFlows, error) {
+	rows, err := tx.QueryContext(ctx, `
+	SELECT f.id, f.title, f.description, f.link, f.is_private, f.is_draft, f.created_at, f.media_url
+	FROM flow f
+	WHERE f.author_id = $1
+	ORDER BY f.id
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	flows := domain.ExportFlows{}
+	index := make(map[int]int)
+	for rows.Next() {
+		var flow domain.ExportFlow
+		var title, description, link sql.NullString
+		var cover string
+		err := rows.Scan(
+			&flow.ID,
+			&title,
+			&description,
+			&link,
+			&flow.IsPrivate,
+			&flow.IsDraft,
+			&flow.CreatedAt,
+			&cover,
+		)
+		if err != nil {
+			return nil, err
+		}
+		flow.Header = title.String
+		flow.Description = description.String
+		flow.Link = link.String
+		flow.Media = []string{cover}
+		index[flow.ID] = len(flows)
+		flows = append(flows, flow)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	// у каруселей и видео оригиналы лежат в flow_media, обложка туда не входит
+	mediaRows, err := tx.QueryContext(ctx, `
+	SELECT fm.flow_id, fm.media_url
+	FROM flow_media fm
+	JOIN flow f ON f.id = fm.flow_id
+	WHERE f.author_id = $1
+	ORDER BY fm.flow_id, fm.position
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer mediaRows.Close()
+
+	withMedia := make(map[int]bool)
+	for mediaRows.Next() {
+		var flowID int
+		var mediaURL string
+		if err := mediaRows.Scan(&flowID, &mediaURL); err != nil {
+			return nil, err
+		}
+
+		i, ok := index[flowID]
+		if !ok {
+			continue
+		}
+		if !withMedia[flowID] {
+			flows[i].Media = nil
+			withMedia[flowID] = true
+		}
+		flows[i].Media = append(flows[i].Media, mediaURL)
+	}
+
+	if err := mediaRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return flows, nil
+}
+
+func exportBoards(ctx context.Context, tx *sql.Tx, userID int) (domain.ExportBoards, error) {
+	rows, err := tx.QueryContext(ctx, `
+	SELECT b.id, b.board_name, b.description, b.is_private, b.is_secret, b.created_at
+	FROM board b
+	WHERE b.author_id = $1
+	ORDER BY b.id
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	boards := domain.ExportBoards{}
+	index := make(map[int]int)
+	for rows.Next() {
+		var board domain.ExportBoard
+		err := rows.Scan(
+			&board.ID,
+			&board.Name,
+			&board.Description,
+			&board.IsPrivate,
+			&board.IsSecret,
+			&board.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		board.FlowIDs = []int{}
+		index[board.ID] = len(boards)
+		boards = append(boards, board)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	postRows, err := tx.QueryContext(ctx, `
+	SELECT bp.board_id, bp.flow_id
+	FROM board_post bp
+	JOIN board b ON b.id = bp.board_id
+	WHERE b.author_id = $1
+	ORDER BY bp.board_id, bp.flow_id
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer postRows.Close()
+
+	for postRows.Next() {
+		var boardID, flowID int
+		if err := postRows.Scan(&boardID, &flowID); err != nil {
+			return nil, err
+		}
+
+		if i, ok := index[boardID]; ok {
+			boards[i].FlowIDs = append(boards[i].FlowIDs, flowID)
+		}
+	}
+
+	if err := postRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return boards, nil
+}
+
+func exportComments(ctx context.Context, tx *sql.Tx, userID int) (domain.ExportComments, error) {
+	rows, err := tx.QueryContext(ctx, `
+	SELECT id, flow_id, parent_id, contents, created_at
+	FROM comment
+	WHERE author_id = $1
+	ORDER BY id
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := domain.ExportComments{}
+	for rows.Next() {
+		var comment domain.ExportComment
+		var parentID sql.NullInt64
+		var contents sql.NullString
+		if err := rows.Scan(&comment.ID, &comment.FlowID, &parentID, &contents, &comment.CreatedAt); err != nil {
+			return nil, err
+		}
+		if parentID.Valid {
+			id := int(parentID.Int64)
+			comment.ParentID = &id
+		}
+		comment.Contents = contents.String
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func exportMessages(ctx context.Context, tx *sql.Tx, username string) (domain.ExportMessages, error) {
+	rows, err := tx.QueryContext(ctx, `
+	SELECT id, content, timestamp, is_read, sender, recipient, chat_id
+	FROM message
+	WHERE sender = $1 OR recipient = $1
+	ORDER BY chat_id, id
+	`, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := domain.ExportMessages{}
+	for rows.Next() {
+		var message domain.Message
+		err := rows.Scan(
+			&message.MessageID,
+			&message.Content,
+			&message.Timestamp,
+			&message.IsRead,
+			&message.Sender,
+			&message.Recipient,
+			&message.ChatID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
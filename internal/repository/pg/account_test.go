@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupAccountMock(t *testing.T) (*AccountRepository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock DB: %v", err)
+	}
+
+	repo := NewAccountRepository(db)
+	return repo, mock, func() { db.Close() }
+}
+
+func TestCancelDeletion_NotScheduled(t *testing.T) {
+	repo, mock, closeFn := setupAccountMock(t)
+	defer closeFn()
+
+	mock.ExpectExec(regexp.QuoteMeta("SET delete_after = NULL WHERE id = $1 AND delete_after IS NOT NULL")).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.CancelDeletion(context.Background(), 7)
+	assert.ErrorIs(t, err, domain.ErrDeletionNotScheduled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestValidateSession(t *testing.T) {
+	tests := []struct {
+		name   string
+		rows   *sqlmock.Rows
+		expErr error
+	}{
+		{"Сценарий: активный пользователь", sqlmock.NewRows([]string{"username", "is_suspended"}).AddRow("alice", false), nil},
+		{"Сценарий: заблокирован", sqlmock.NewRows([]string{"username", "is_suspended"}).AddRow("alice", true), domain.ErrAccountSuspended},
+		{"Сценарий: удален", sqlmock.NewRows([]string{"username", "is_suspended"}), domain.ErrUserNotFound},
+		{"Сценарий: токен выписан на прежнее имя", sqlmock.NewRows([]string{"username", "is_suspended"}).AddRow("alice_new", false), domain.ErrSessionOutdated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeFn := setupAccountMock(t)
+			defer closeFn()
+
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT username, is_suspended FROM flow_user WHERE id = $1")).
+				WithArgs(7).
+				WillReturnRows(tt.rows)
+
+			err := repo.ValidateSession(context.Background(), 7, "alice")
+			if tt.expErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeleteAccount_Cancelled(t *testing.T) {
+	repo, mock, closeFn := setupAccountMock(t)
+	defer closeFn()
+
+	// удаление отменили после выборки GetDueDeletions: доски соавторам не передаются
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT avatar, is_external_avatar FROM flow_user")).
+		WithArgs(7).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err := repo.DeleteAccount(context.Background(), 7)
+	assert.ErrorIs(t, err, domain.ErrDeletionNotScheduled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAccount(t *testing.T) {
+	repo, mock, closeFn := setupAccountMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT avatar, is_external_avatar FROM flow_user")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"avatar", "is_external_avatar"}).AddRow("https://vk.com/me.png", true))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT b.id FROM board AS b WHERE b.author_id = $1")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT f.media_url FROM flow f WHERE f.author_id = $1")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"media_url"}).AddRow("a.jpg").AddRow("b.mp4"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT file_name FROM data_export")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"file_name"}))
+	for i := 0; i < 7; i++ {
+		mock.ExpectExec("UPDATE").WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM flow_user WHERE id = $1")).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	deleted, err := repo.DeleteAccount(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.jpg", "b.mp4"}, deleted.Media)
+	assert.Empty(t, deleted.Avatar)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimExport_Empty(t *testing.T) {
+	repo, mock, closeFn := setupAccountMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE SKIP LOCKED")).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.ClaimExport(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateExport(t *testing.T) {
+	repo, mock, closeFn := setupAccountMock(t)
+	defer closeFn()
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO data_export (user_id)")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "status", "file_name", "created_at", "finished_at", "expires_at"}).
+			AddRow(2, 7, domain.ExportPending, "", now, nil, nil))
+
+	export, err := repo.CreateExport(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.DataExport{ID: 2, UserID: 7, Status: domain.ExportPending, CreatedAt: now}, export)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
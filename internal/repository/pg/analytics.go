@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+// столбцы дневной статистики, по которым можно сортировать лучшие флоу
+var analyticsSortColumns = map[string]string{
+	domain.AnalyticsSortImpressions:    "impressions",
+	domain.AnalyticsSortCloseups:       "closeups",
+	domain.AnalyticsSortSaves:          "saves",
+	domain.AnalyticsSortLikes:          "likes",
+	domain.AnalyticsSortComments:       "comments",
+	domain.AnalyticsSortOutboundClicks: "outbound_clicks",
+}
+
+type AnalyticsRepository struct {
+	db *sql.DB
+}
+
+func NewAnalyticsRepository(db *sql.DB) *AnalyticsRepository {
+	return &AnalyticsRepository{
+		db: db,
+	}
+}
+
+// InsertEvents пишет пачку событий одним запросом. Аккаунт события — автор флоу
+// или владелец имени; события удалённых флоу и аккаунтов пропускаются
+func (r *AnalyticsRepository) InsertEvents(ctx context.Context, events []domain.AnalyticsEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(events))
+	args := make([]any, 0, len(events)*5)
+	for _, event := range events {
+		n := len(args)
+		values = append(values, fmt.Sprintf("($%d::TEXT, $%d::INT, $%d::TEXT, $%d::INT, $%d::TIMESTAMPTZ)",
+			n+1, n+2, n+3, n+4, n+5))
+		args = append(args, event.Type, event.FlowID, event.AccountUsername, event.ViewerID, event.CreatedAt)
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+	INSERT INTO analytics_event (event_type, flow_id, account_id, viewer_id, created_at)
+	SELECT v.event_type, f.id, COALESCE(f.author_id, u.id), NULLIF(v.viewer_id, 0), v.created_at
+	FROM (VALUES `+strings.Join(values, ", ")+`) AS v (event_type, flow_id, username, viewer_id, created_at)
+	LEFT JOIN flow f ON f.id = v.flow_id
+	LEFT JOIN flow_user u ON v.flow_id = 0 AND u.username = v.username
+	WHERE f.id IS NOT NULL OR u.id IS NOT NULL
+	`, args...)
+
+	return err
+}
+
+// AggregateDaily пересчитывает дневную статистику за полные дни начиная с since.
+// Действия автора со своими флоу не учитываются
+func (r *AnalyticsRepository) AggregateDaily(ctx context.Context, since time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+	INSERT INTO flow_stat_daily (flow_id, account_id, day, impressions, closeups, saves, likes, comments, outbound_clicks)
+	SELECT
+		e.flow_id,
+		e.account_id,
+		(e.created_at AT TIME ZONE 'UTC')::DATE AS day,
+		COUNT(*) FILTER (WHERE e.event_type = 'impression'),
+		COUNT(*) FILTER (WHERE e.event_type = 'closeup'),
+		COUNT(*) FILTER (WHERE e.event_type = 'save'),
+		COUNT(*) FILTER (WHERE e.event_type = 'like'),
+		COUNT(*) FILTER (WHERE e.event_type = 'comment'),
+		COUNT(*) FILTER (WHERE e.event_type = 'outbound_click')
+	FROM analytics_event e
+	WHERE e.flow_id IS NOT NULL
+	AND e.created_at >= $1
+	AND e.viewer_id IS DISTINCT FROM e.account_id
+	GROUP BY e.flow_id, e.account_id, day
+	ON CONFLICT (flow_id, day) DO UPDATE SET
+		impressions = EXCLUDED.impressions,
+		closeups = EXCLUDED.closeups,
+		saves = EXCLUDED.saves,
+		likes = EXCLUDED.likes,
+		comments = EXCLUDED.comments,
+		outbound_clicks = EXCLUDED.outbound_clicks
+	`, since); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	INSERT INTO account_stat_daily (account_id, day, followers_gained, followers_lost)
+	SELECT
+		e.account_id,
+		(e.created_at AT TIME ZONE 'UTC')::DATE AS day,
+		COUNT(*) FILTER (WHERE e.event_type = 'follow'),
+		COUNT(*) FILTER (WHERE e.event_type = 'unfollow')
+	FROM analytics_event e
+	WHERE e.flow_id IS NULL
+	AND e.created_at >= $1
+	GROUP BY e.account_id, day
+	ON CONFLICT (account_id, day) DO UPDATE SET
+		followers_gained = EXCLUDED.followers_gained,
+		followers_lost = EXCLUDED.followers_lost
+	`, since); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *AnalyticsRepository) PruneEvents(ctx context.Context, before time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+	DELETE FROM analytics_event
+	WHERE created_at < $1
+	`, before)
+
+	return err
+}
+
+func (r *AnalyticsRepository) IsFlowAuthor(ctx context.Context, flowID uint64, userID int) (bool, error) {
+	var authorID int
+	err := r.db.QueryRowContext(ctx, `
+	SELECT author_id
+	FROM flow
+	WHERE id = $1
+	`, flowID).Scan(&authorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return authorID == userID, nil
+}
+
+// GetDailyStats возвращает статистику за каждый день периода, дни без событий — нулевые.
+// Подписчики считаются для всего аккаунта
+func (r *AnalyticsRepository) GetDailyStats(ctx context.Context, userID int, flowID uint64, from, to time.Time) ([]domain.AnalyticsDay, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT
+		d.day::DATE,
+		COALESCE(SUM(s.impressions), 0),
+		COALESCE(SUM(s.closeups), 0),
+		COALESCE(SUM(s.saves), 0),
+		COALESCE(SUM(s.likes), 0),
+		COALESCE(SUM(s.comments), 0),
+		COALESCE(SUM(s.outbound_clicks), 0),
+		COALESCE(a.followers_gained, 0),
+		COALESCE(a.followers_lost, 0)
+	FROM generate_series($2::DATE, $3::DATE, INTERVAL '1 day') AS d (day)
+	LEFT JOIN flow_stat_daily s
+		ON s.day = d.day::DATE AND s.account_id = $1 AND ($4 = 0 OR s.flow_id = $4)
+	LEFT JOIN account_stat_daily a
+		ON a.day = d.day::DATE AND a.account_id = $1
+	GROUP BY d.day, a.followers_gained, a.followers_lost
+	ORDER BY d.day
+	`, userID, from, to, flowID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []domain.AnalyticsDay
+	for rows.Next() {
+		var day domain.AnalyticsDay
+		var date time.Time
+		err := rows.Scan(
+			&date,
+			&day.Stats.Impressions,
+			&day.Stats.Closeups,
+			&day.Stats.Saves,
+			&day.Stats.Likes,
+			&day.Stats.Comments,
+			&day.Stats.OutboundClicks,
+			&day.FollowersGained,
+			&day.FollowersLost,
+		)
+		if err != nil {
+			return nil, err
+		}
+		day.Day = date.Format("2006-01-02")
+		days = append(days, day)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
+
+func (r *AnalyticsRepository) GetTopFlows(ctx context.Context, userID int, from, to time.Time, sortBy string, limit int) ([]domain.TopFlow, error) {
+	column, ok := analyticsSortColumns[sortBy]
+	if !ok {
+		return nil, domain.ErrInvalidAnalyticsSort
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT
+		f.id,
+		f.title,
+		f.media_url,
+		SUM(s.impressions) AS impressions,
+		SUM(s.closeups) AS closeups,
+		SUM(s.saves) AS saves,
+		SUM(s.likes) AS likes,
+		SUM(s.comments) AS comments,
+		SUM(s.outbound_clicks) AS outbound_clicks
+	FROM flow_stat_daily s
+	JOIN flow f ON f.id = s.flow_id
+	WHERE s.account_id = $1 AND s.day BETWEEN $2::DATE AND $3::DATE
+	GROUP BY f.id
+	ORDER BY `+column+` DESC, f.id DESC
+	LIMIT $4
+	`, userID, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var flows []domain.TopFlow
+	for rows.Next() {
+		var flow domain.TopFlow
+		var title sql.NullString
+		err := rows.Scan(
+			&flow.FlowID,
+			&title,
+			&flow.MediaURL,
+			&flow.Stats.Impressions,
+			&flow.Stats.Closeups,
+			&flow.Stats.Saves,
+			&flow.Stats.Likes,
+			&flow.Stats.Comments,
+			&flow.Stats.OutboundClicks,
+		)
+		if err != nil {
+			return nil, err
+		}
+		flow.Header = title.String
+		flows = append(flows, flow)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return flows, nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupAnalyticsMock(t *testing.T) (*AnalyticsRepository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock DB: %v", err)
+	}
+
+	repo := NewAnalyticsRepository(db)
+	return repo, mock, func() { db.Close() }
+}
+
+func TestInsertEvents(t *testing.T) {
+	repo, mock, closeFn := setupAnalyticsMock(t)
+	defer closeFn()
+
+	now := time.Now()
+	events := []domain.AnalyticsEvent{
+		{Type: domain.EventLike, FlowID: 3, ViewerID: 2, CreatedAt: now},
+		{Type: domain.EventFollow, AccountUsername: "alice", ViewerID: 2, CreatedAt: now},
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO analytics_event")).
+		WithArgs(domain.EventLike, uint64(3), "", 2, now, domain.EventFollow, uint64(0), "alice", 2, now).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	assert.NoError(t, repo.InsertEvents(context.Background(), events))
+	assert.NoError(t, repo.InsertEvents(context.Background(), nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIsFlowAuthor(t *testing.T) {
+	tests := []struct {
+		name     string
+		authorID int
+		err      error
+		expected bool
+	}{
+		{"Сценарий: автор", 7, nil, true},
+		{"Сценарий: чужой флоу", 8, nil, false},
+		{"Сценарий: флоу нет", 0, sql.ErrNoRows, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeFn := setupAnalyticsMock(t)
+			defer closeFn()
+
+			query := mock.ExpectQuery(regexp.QuoteMeta("SELECT author_id FROM flow WHERE id = $1")).WithArgs(uint64(5))
+			if tt.err != nil {
+				query.WillReturnError(tt.err)
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"author_id"}).AddRow(tt.authorID))
+			}
+
+			isAuthor, err := repo.IsFlowAuthor(context.Background(), 5, 7)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, isAuthor)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetTopFlows(t *testing.T) {
+	repo, mock, closeFn := setupAnalyticsMock(t)
+	defer closeFn()
+
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 6)
+
+	_, err := repo.GetTopFlows(context.Background(), 7, from, to, "id; DROP TABLE flow", 5)
+	assert.ErrorIs(t, err, domain.ErrInvalidAnalyticsSort)
+
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY saves DESC, f.id DESC")).
+		WithArgs(7, from, to, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "media_url", "impressions", "closeups", "saves", "likes", "comments", "outbound_clicks"}).
+			AddRow(3, nil, "a.jpg", 10, 4, 2, 1, 0, 0))
+
+	flows, err := repo.GetTopFlows(context.Background(), 7, from, to, domain.AnalyticsSortSaves, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.TopFlow{{FlowID: 3, MediaURL: "a.jpg", Stats: domain.FlowStats{Impressions: 10, Closeups: 4, Saves: 2, Likes: 1}}}, flows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -20,11 +21,12 @@ const (
 )
 
 type pgBoardStorage struct {
-	db       *sql.DB
+	db               *sql.DB
+	hideUnclassified bool
 }
 
-func NewBoardStorage(db *sql.DB) *pgBoardStorage {
-	return &pgBoardStorage{db: db}
+func NewBoardStorage(db *sql.DB, hideUnclassified bool) *pgBoardStorage {
+	return &pgBoardStorage{db: db, hideUnclassified: hideUnclassified}
 }
 
 func (p *pgBoardStorage) GetUsernameID(ctx context.Context, username string, userID int) (int, error) {
@@ -64,13 +66,25 @@ func (p *pgBoardStorage) CreateBoard(ctx context.Context, board *domain.Board, u
 }
 
 func (p *pgBoardStorage) DeleteBoard(ctx context.Context, boardID, userID int) error {
+	// пины доски ещё видны в снимке запроса, поэтому сохранения
+	// снимаются в том же запросе
 	var id int
 	err := p.db.QueryRowContext(ctx, `
+	WITH deleted AS (
 	DELETE FROM board 
 	WHERE id = $1
 	AND
 	author_id = $2
-	RETURNING id`, boardID, userID).
+	RETURNING id
+	), unsaved AS (
+		UPDATE flow AS f
+		SET save_count = GREATEST(f.save_count - 1, 0)
+		FROM board_post AS bp
+		WHERE bp.board_id IN (SELECT id FROM deleted)
+		AND f.id = bp.flow_id
+		AND f.author_id <> $2
+	)
+	SELECT id FROM deleted`, boardID, userID).
 		Scan(&id)
 	if errors.Is(err, sql.ErrNoRows) {
 		return ErrNotFound
@@ -82,25 +96,16 @@ func (p *pgBoardStorage) DeleteBoard(ctx context.Context, boardID, userID int) e
 	return nil
 }
 
-func (p *pgBoardStorage) AddToBoard(ctx context.Context, boardID, userID, flowID int) error {
+// AddToBoard сохраняет пин в начало доски. savedFromBoardID запоминается,
+// только если это публичная доска с этим пином
+func (p *pgBoardStorage) AddToBoard(ctx context.Context, boardID, userID, flowID, savedFromBoardID int) error {
 	tx, err := p.db.Begin()
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	row := tx.QueryRowContext(ctx, `
-		SELECT EXISTS (
-			SELECT 1 FROM board
-				WHERE id = $1 AND author_id = $2
-			UNION
-			SELECT 1 FROM board_coauthor
-				WHERE board_id = $1 AND coauthor_id = $2
-		) AS is_editor
-	`, boardID, userID)
-
-	var isEditor bool
-	err = row.Scan(&isEditor)
+	isEditor, err := isBoardEditor(ctx, tx, boardID, userID)
 	if err != nil {
 		return err
 	}
@@ -125,12 +130,24 @@ func (p *pgBoardStorage) AddToBoard(ctx context.Context, boardID, userID, flowID
 		return ErrNotFound
 	}
 
+	// новый пин встаёт в начало доски
+	key, err := flowRankScope.between(ctx, tx, boardID, flowID, 0, 0, false)
+	if err != nil {
+		return err
+	}
+
 	var insertedID int
 	err = tx.QueryRowContext(ctx, `
-        INSERT INTO board_post (board_id, flow_id)
-        VALUES ($1, $2)
+        INSERT INTO board_post (board_id, flow_id, rank, saved_from_board_id)
+        VALUES ($1, $2, $3, (
+            SELECT bp.board_id
+            FROM board_post AS bp
+            JOIN board AS b ON b.id = bp.board_id
+            WHERE bp.board_id = $4 AND bp.flow_id = $2
+            AND b.is_private = false AND b.is_secret = false
+        ))
         RETURNING board_id
-    `, boardID, flowID).Scan(&insertedID)
+    `, boardID, flowID, key, savedFromBoardID).Scan(&insertedID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return ErrNotFound
 	}
@@ -138,6 +155,10 @@ func (p *pgBoardStorage) AddToBoard(ctx context.Context, boardID, userID, flowID
 		return err
 	}
 
+	if err := refreshSaveCount(ctx, tx, flowID); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return err
 	}
@@ -180,12 +201,18 @@ func (p *pgBoardStorage) AddToSavedBoard(ctx context.Context, userID, flowID int
 		return ErrNotFound
 	}
 
+	// новый пин встаёт в начало доски
+	key, err := flowRankScope.between(ctx, tx, boardID, flowID, 0, 0, false)
+	if err != nil {
+		return err
+	}
+
 	var insertedID int
 	err = tx.QueryRowContext(ctx, `
-        INSERT INTO board_post (board_id, flow_id)
-        VALUES ($1, $2)
+        INSERT INTO board_post (board_id, flow_id, rank)
+        VALUES ($1, $2, $3)
         RETURNING board_id
-    `, boardID, flowID).Scan(&insertedID)
+    `, boardID, flowID, key).Scan(&insertedID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return ErrNotFound
 	}
@@ -249,18 +276,67 @@ func (p *pgBoardStorage) DeleteFromBoard(ctx context.Context, boardID, userID, f
         return errors.New("failed to update flow_count: possible inconsistency")
     }
 
+    if err := refreshSaveCount(ctx, tx, flowID); err != nil {
+        return err
+    }
+
     return tx.Commit()
 }
 
-func (p *pgBoardStorage) UpdateBoard(ctx context.Context, boardID, userID int, newName string, isPrivate bool) error {
+func (p *pgBoardStorage) UpdateBoard(ctx context.Context, boardID, userID int, update domain.UpdateData) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var description sql.NullString
+	if update.Description != nil {
+		description = sql.NullString{String: *update.Description, Valid: true}
+	}
+
+	var coverFlowID sql.NullInt64
+	if update.CoverFlowID != nil {
+		coverFlowID = sql.NullInt64{Int64: int64(*update.CoverFlowID), Valid: true}
+	}
+
+	// обложкой может быть только пин этой доски
+	if coverFlowID.Int64 > 0 {
+		var onBoard bool
+		err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM board_post
+				WHERE board_id = $1 AND flow_id = $2
+			)
+		`, boardID, coverFlowID.Int64).Scan(&onBoard)
+		if err != nil {
+			return err
+		}
+		if !onBoard {
+			return domain.ErrInvalidBoardCover
+		}
+	}
+
+	// выбранный пин заменяет загруженную обложку
 	query := `
         UPDATE board
         SET board_name = COALESCE($1, board_name),
-            is_private = COALESCE($2, is_private)
-        WHERE id = $3 AND author_id = $4
+            is_private = COALESCE($2, is_private),
+            is_secret = $3,
+            description = COALESCE($4, description),
+            cover_flow_id = CASE
+                WHEN $5::INT IS NULL THEN cover_flow_id
+                ELSE NULLIF($5, 0)
+            END,
+            cover_image = CASE
+                WHEN $5::INT IS NULL THEN cover_image
+                ELSE NULL
+            END
+        WHERE id = $6 AND author_id = $7
     `
 
-	result, err := p.db.ExecContext(ctx, query, newName, isPrivate, boardID, userID)
+	result, err := tx.ExecContext(ctx, query, update.Name, update.IsPrivate, update.IsSecret,
+		description, coverFlowID, boardID, userID)
 	if err != nil {
 		return err
 	}
@@ -270,11 +346,70 @@ func (p *pgBoardStorage) UpdateBoard(ctx context.Context, boardID, userID int, n
 		return ErrNotFound
 	}
 
+	if update.Tags != nil {
+		if err := replaceBoardTags(ctx, tx, boardID, update.Tags); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (p *pgBoardStorage) SetBoardCoverImage(ctx context.Context, boardID, userID int, filename string) error {
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE board
+		SET cover_image = $1, cover_flow_id = NULL
+		WHERE id = $2 AND author_id = $3
+	`, filename, boardID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func replaceBoardTags(ctx context.Context, tx *sql.Tx, boardID int, tags []string) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM board_tag
+		WHERE board_id = $1
+	`, boardID)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO board_tag (board_id, tag)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+		`, boardID, tag)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// теги приходят из базы массивом json, собранным json_agg
+func parseBoardTags(raw string) ([]string, error) {
+	var tags []string
+	if err := json.Unmarshal([]byte(raw), &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse board tags: %w", err)
+	}
+
+	return tags, nil
+}
+
 func (p *pgBoardStorage) GetBoard(ctx context.Context, boardID, userID, previewNum, previewStart int) (domain.Board, []string, error) {
 	var board domain.Board
+	var coverFlowID sql.NullInt64
+	var rawTags string
 	err := p.db.QueryRowContext(ctx, `
 		SELECT 
 			board.id, 
@@ -282,13 +417,27 @@ func (p *pgBoardStorage) GetBoard(ctx context.Context, boardID, userID, previewN
 			board.board_name,
 			board.created_at, 
 			board.is_private, 
+			board.is_secret,
+			board.description,
+			board.cover_flow_id,
+			COALESCE(board.cover_image, cover_flow.media_url, '') AS cover,
+			COALESCE((
+				SELECT json_agg(board_tag.tag ORDER BY board_tag.tag)
+				FROM board_tag
+				WHERE board_tag.board_id = board.id
+			)::TEXT, '[]') AS tags,
 			board.flow_count,
+			board.follower_count,
 			flow_user.username,
 			CASE
 				WHEN board_coauthor.coauthor_id IS NOT NULL AND board_coauthor.coauthor_id = $2 THEN true
 				WHEN board.author_id = $2 THEN true
 				ELSE false
-			END AS is_editable
+			END AS is_editable,
+			EXISTS (
+				SELECT 1 FROM board_follower
+				WHERE board_follower.board_id = board.id AND board_follower.user_id = $2
+			) AS is_followed
 		FROM
 			board
 		INNER JOIN 
@@ -299,17 +448,29 @@ func (p *pgBoardStorage) GetBoard(ctx context.Context, boardID, userID, previewN
 			board_coauthor
 		ON
 			board.id = board_coauthor.board_id AND board_coauthor.coauthor_id = $2
+		LEFT JOIN
+			flow AS cover_flow
+		ON
+			cover_flow.id = board.cover_flow_id AND cover_flow.is_private = false
 		WHERE 
     		board.id = $1
+			AND (board_coauthor.coauthor_id IS NOT NULL OR `+accountVisibleFilter("board.author_id", "$2")+`)
 	`, boardID, userID).Scan(
 		&board.ID,
 		&board.AuthorID,
 		&board.Name,
 		&board.CreatedAt,
 		&board.IsPrivate,
+		&board.IsSecret,
+		&board.Description,
+		&coverFlowID,
+		&board.Cover,
+		&rawTags,
 		&board.FlowCount,
+		&board.FollowerCount,
 		&board.AuthorUsername,
 		&board.IsEditable,
+		&board.IsFollowed,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Board{}, nil, ErrNotFound
@@ -318,6 +479,11 @@ func (p *pgBoardStorage) GetBoard(ctx context.Context, boardID, userID, previewN
 		return domain.Board{}, nil, err
 	}
 
+	board.CoverFlowID = int(coverFlowID.Int64)
+	if board.Tags, err = parseBoardTags(rawTags); err != nil {
+		return domain.Board{}, nil, err
+	}
+
 	flows, colors, err := p.fetchFlowsAndColors(ctx, board.ID, userID, previewNum, previewStart, board.FlowCount)
 	if err != nil {
 		return domain.Board{}, nil, err
@@ -327,8 +493,9 @@ func (p *pgBoardStorage) GetBoard(ctx context.Context, boardID, userID, previewN
 	return board, colors, nil
 }
 
-func (p *pgBoardStorage) GetUserPublicBoards(ctx context.Context, username string, previewNum, previewStart int) ([]domain.Board, error) {
-	var userID int
+// GetUserPublicBoards отдаёт публичные доски пользователя. Доски закрытого
+// аккаунта видны только его подписчикам
+func (p *pgBoardStorage) GetUserPublicBoards(ctx context.Context, username string, viewerID, previewNum, previewStart int) ([]domain.Board, error) {
 	rows, err := p.db.QueryContext(ctx, `
 		SELECT DISTINCT
 			b.id,
@@ -336,7 +503,17 @@ func (p *pgBoardStorage) GetUserPublicBoards(ctx context.Context, username strin
 			b.board_name,
 			b.created_at,
 			b.is_private,
-			b.flow_count
+			b.is_secret,
+			b.description,
+			b.cover_flow_id,
+			COALESCE(b.cover_image, cf.media_url, '') AS cover,
+			COALESCE((
+				SELECT json_agg(bt.tag ORDER BY bt.tag)
+				FROM board_tag AS bt
+				WHERE bt.board_id = b.id
+			)::TEXT, '[]') AS tags,
+			b.flow_count,
+			b.follower_count
     	FROM board AS b
 		LEFT JOIN board_coauthor AS bc
 			ON b.id = bc.board_id
@@ -344,9 +521,14 @@ func (p *pgBoardStorage) GetUserPublicBoards(ctx context.Context, username strin
 			ON bu.id = b.author_id
 		LEFT JOIN flow_user AS bcu
 			ON bcu.id = bc.coauthor_id
+		LEFT JOIN flow AS cf
+			ON cf.id = b.cover_flow_id AND cf.is_private = false
     	WHERE b.is_private = false
+			AND b.is_secret = false
     		AND (bu.username = $1 OR bcu.username = $1)
-	`, username)
+			AND `+accountVisibleFilter("b.author_id", "$2")+`
+			AND `+accountVisibleFilter("(SELECT id FROM flow_user WHERE username = $1)", "$2")+`
+	`, username, viewerID)
 	if err != nil {
 		return nil, err
 	}
@@ -355,19 +537,32 @@ func (p *pgBoardStorage) GetUserPublicBoards(ctx context.Context, username strin
 	var boards []domain.Board
 	for rows.Next() {
 		var board domain.Board
+		var coverFlowID sql.NullInt64
+		var rawTags string
 		err := rows.Scan(
 			&board.ID,
 			&board.AuthorID,
 			&board.Name,
 			&board.CreatedAt,
 			&board.IsPrivate,
+			&board.IsSecret,
+			&board.Description,
+			&coverFlowID,
+			&board.Cover,
+			&rawTags,
 			&board.FlowCount,
+			&board.FollowerCount,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		flows, err := p.fetchFirstNFlowsForBoard(ctx, board.ID, userID, previewNum, previewStart)
+		board.CoverFlowID = int(coverFlowID.Int64)
+		if board.Tags, err = parseBoardTags(rawTags); err != nil {
+			return nil, err
+		}
+
+		flows, err := p.fetchFirstNFlowsForBoard(ctx, board.ID, viewerID, previewNum, previewStart)
 		if err != nil {
 			return nil, err
 		}
@@ -386,9 +581,27 @@ func (p *pgBoardStorage) GetUserAllBoards(ctx context.Context, userID, previewNu
 			b.board_name,
 			b.created_at,
 			b.is_private,
+			b.is_secret,
+			b.description,
+			b.cover_flow_id,
+			COALESCE(b.cover_image, cf.media_url, '') AS cover,
+			COALESCE((
+				SELECT json_agg(bt.tag ORDER BY bt.tag)
+				FROM board_tag AS bt
+				WHERE bt.board_id = b.id
+			)::TEXT, '[]') AS tags,
 			b.flow_count,
-			true as is_editable
+			b.follower_count,
+			CASE
+				WHEN b.author_id = $1 THEN 'owner'
+				ELSE (
+					SELECT bc.role FROM board_coauthor AS bc
+					WHERE bc.board_id = b.id AND bc.coauthor_id = $1
+				)
+			END AS role
 		FROM board AS b
+		LEFT JOIN flow AS cf
+			ON cf.id = b.cover_flow_id AND cf.is_private = false
 		WHERE b.author_id = $1 
 			OR EXISTS (
 				SELECT 1 FROM board_coauthor AS bc 
@@ -403,19 +616,34 @@ func (p *pgBoardStorage) GetUserAllBoards(ctx context.Context, userID, previewNu
 	var boards []domain.Board
 	for rows.Next() {
 		var board domain.Board
+		var coverFlowID sql.NullInt64
+		var rawTags string
 		err := rows.Scan(
 			&board.ID,
 			&board.AuthorID,
 			&board.Name,
 			&board.CreatedAt,
 			&board.IsPrivate,
+			&board.IsSecret,
+			&board.Description,
+			&coverFlowID,
+			&board.Cover,
+			&rawTags,
 			&board.FlowCount,
-			&board.IsEditable,
+			&board.FollowerCount,
+			&board.Role,
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		board.CoverFlowID = int(coverFlowID.Int64)
+		if board.Tags, err = parseBoardTags(rawTags); err != nil {
+			return nil, err
+		}
+
+		board.IsEditable = domain.BoardRoleAllows(board.Role, domain.BoardRoleEditor)
+
 		flows, err := p.fetchFirstNFlowsForBoard(ctx, board.ID, userID, previewNum, previewStart)
 		if err != nil {
 			return nil, err
@@ -428,12 +656,20 @@ func (p *pgBoardStorage) GetUserAllBoards(ctx context.Context, userID, previewNu
 	return boards, nil
 }
 
-func (p *pgBoardStorage) GetBoardFlow(ctx context.Context, boardID, userID, page, pageSize int) ([]domain.PinData, error) {
+func (p *pgBoardStorage) GetBoardFlow(ctx context.Context, boardID, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
 	offset := (page - 1) * pageSize
 	if offset < 0 {
 		offset = 0
 	}
 
+	if err := p.checkBoardAccess(ctx, boardID, userID); err != nil {
+		return nil, err
+	}
+
+	return p.fetchFlows(ctx, boardID, sql.NullInt64{}, userID, pageSize, offset, nsfwMode)
+}
+
+func (p *pgBoardStorage) checkBoardAccess(ctx context.Context, boardID, userID int) error {
 	var scanID int
 
 	err := p.db.QueryRowContext(ctx, `
@@ -441,19 +677,26 @@ func (p *pgBoardStorage) GetBoardFlow(ctx context.Context, boardID, userID, page
 		FROM board AS b
 		LEFT JOIN board_coauthor AS bc
 			ON b.id = bc.board_id
-		WHERE b.id = $1 AND (b.is_private = false OR b.author_id = $2 OR bc.coauthor_id = $2)
+		WHERE b.id = $1 AND (
+			b.author_id = $2
+			OR bc.coauthor_id = $2
+			OR (b.is_private = false AND `+accountVisibleFilter("b.author_id", "$2")+`)
+		)
 	`, boardID, userID).Scan(&scanID)
 	if errors.Is(err, sql.ErrNoRows) {
-		return nil, boardService.ErrForbidden
-	}
-	if err != nil {
-		return nil, err
+		return boardService.ErrForbidden
 	}
 
-	return p.fetchFirstNFlowsForBoard(ctx, boardID, userID, pageSize, offset)
+	return err
 }
 
+// превью досок фильтруются по NSFW уже в хендлере
 func (p *pgBoardStorage) fetchFirstNFlowsForBoard(ctx context.Context, boardID, userID, pageSize, offset int) ([]domain.PinData, error) {
+	return p.fetchFlows(ctx, boardID, sql.NullInt64{}, userID, pageSize, offset, "")
+}
+
+// пины доски в ручном порядке, sectionID ограничивает выборку одним разделом
+func (p *pgBoardStorage) fetchFlows(ctx context.Context, boardID int, sectionID sql.NullInt64, userID, pageSize, offset int, nsfwMode string) ([]domain.PinData, error) {
 	rows, err := p.db.QueryContext(ctx, `
 	SELECT DISTINCT 
 		f.id, 
@@ -468,12 +711,16 @@ func (p *pgBoardStorage) fetchFirstNFlowsForBoard(ctx context.Context, boardID,
 		f.width, 
 		f.height,
 		f.is_nsfw,
-		bp.saved_at
+		bp.section_id,
+		bp.rank,
+		`+flowMediaColumn+`
 	FROM flow f
 	JOIN board_post bp ON f.id = bp.flow_id
 	WHERE bp.board_id = $1
+	AND ($5::INT IS NULL OR bp.section_id = $5)
+	AND (f.is_hidden = false OR f.author_id = $2)
     AND (
-        f.is_private = false 
+        (f.is_private = false AND `+accountVisibleFilter("f.author_id", "$2")+`)
         OR f.author_id = $2 
         OR EXISTS (
             SELECT 1 FROM board 
@@ -483,10 +730,10 @@ func (p *pgBoardStorage) fetchFirstNFlowsForBoard(ctx context.Context, boardID,
             SELECT 1 FROM board_coauthor 
             WHERE board_id = bp.board_id AND coauthor_id = $2
         )
-    )
-	ORDER BY bp.saved_at DESC
+    )`+nsfwOrOwnFilter(nsfwMode, "$2")+classifiedFilter(p.hideUnclassified, classifiedOrOwnCondition("$2"))+`
+	ORDER BY bp.rank
 	LIMIT $3 OFFSET $4
-    `, boardID, userID, pageSize, offset)
+    `, boardID, userID, pageSize, offset, sectionID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch flows: %w", err)
 	}
@@ -495,11 +742,13 @@ func (p *pgBoardStorage) fetchFirstNFlowsForBoard(ctx context.Context, boardID,
 	type middlePinData struct {
 		Header      sql.NullString
 		Description sql.NullString
+		SectionID   sql.NullInt64
 	}
 
 	middlePin := middlePinData{}
 	var flows []domain.PinData
-	var savedAt string
+	var flowRank string
+	var rawMedia string
 
 	for rows.Next() {
 		var flow domain.PinData
@@ -516,14 +765,22 @@ func (p *pgBoardStorage) fetchFirstNFlowsForBoard(ctx context.Context, boardID,
 			&flow.Width,
 			&flow.Height,
 			&flow.IsNSFW,
-			&savedAt,
+			&middlePin.SectionID,
+			&flowRank,
+			&rawMedia,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan flow: %w", err)
 		}
 
+		flow.Media, err = parseFlowMedia(rawMedia)
+		if err != nil {
+			return nil, err
+		}
+
 		flow.Header = middlePin.Header.String
 		flow.Description = middlePin.Description.String
+		flow.SectionID = int(middlePin.SectionID.Int64)
 
 		flows = append(flows, flow)
 	}
@@ -552,15 +809,17 @@ func (p *pgBoardStorage) fetchFlowsAndColors(ctx context.Context, boardID, userI
 			f.width,
 			f.height,
 			f.is_nsfw,
-			bp.saved_at
+			bp.rank,
+			`+flowMediaColumn+`
         FROM flow f
         JOIN board_post bp
 			ON f.id = bp.flow_id
 		LEFT JOIN board_coauthor bc
 			ON bp.board_id = bc.board_id
 		WHERE bp.board_id = $1
+			AND (f.is_hidden = false OR f.author_id = $2)
 			AND (
-				f.is_private = false 
+				(f.is_private = false AND `+accountVisibleFilter("f.author_id", "$2")+`)
 				OR f.author_id = $2 
 				OR EXISTS (
 					SELECT 1 FROM board 
@@ -570,8 +829,8 @@ func (p *pgBoardStorage) fetchFlowsAndColors(ctx context.Context, boardID, userI
 					SELECT 1 FROM board_coauthor 
 					WHERE board_id = bp.board_id AND coauthor_id = $2
 				)
-			)
-        ORDER BY bp.saved_at DESC
+			)`+classifiedFilter(p.hideUnclassified, classifiedOrOwnCondition("$2"))+`
+        ORDER BY bp.rank
         LIMIT $3 OFFSET $4
     `, boardID, userID, pageSize, offset)
 	if err != nil {
@@ -586,7 +845,8 @@ func (p *pgBoardStorage) fetchFlowsAndColors(ctx context.Context, boardID, userI
 
 	middlePin := middlePinData{}
 	var flows []domain.PinData
-	var savedAt string
+	var flowRank string
+	var rawMedia string
 
 	for rows.Next() {
 		var flow domain.PinData
@@ -603,12 +863,18 @@ func (p *pgBoardStorage) fetchFlowsAndColors(ctx context.Context, boardID, userI
 			&flow.Width,
 			&flow.Height,
 			&flow.IsNSFW,
-			&savedAt,
+			&flowRank,
+			&rawMedia,
 		)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to scan flow: %w", err)
 		}
 
+		flow.Media, err = parseFlowMedia(rawMedia)
+		if err != nil {
+			return nil, nil, err
+		}
+
 		flow.Header = middlePin.Header.String
 		flow.Description = middlePin.Description.String
 
@@ -637,7 +903,7 @@ func (p *pgBoardStorage) fetchColors(ctx context.Context, boardID, userID int) (
     query := `
 		SELECT DISTINCT 
 			c.color_hex,
-			bp.saved_at
+			bp.rank
 		FROM color c
 		JOIN flow f 
 			ON c.flow_id = f.id
@@ -646,7 +912,8 @@ func (p *pgBoardStorage) fetchColors(ctx context.Context, boardID, userID int) (
 		LEFT JOIN board_coauthor bc 
 			ON bc.board_id = bp.board_id
 		WHERE bp.board_id = $1
-			AND (f.is_private = false 
+			AND (f.is_hidden = false OR f.author_id = $2)
+			AND ((f.is_private = false AND `+accountVisibleFilter("f.author_id", "$2")+`)
 				OR f.author_id = $2 
 				OR EXISTS (
 					SELECT 1 FROM board 
@@ -656,8 +923,8 @@ func (p *pgBoardStorage) fetchColors(ctx context.Context, boardID, userID int) (
 					SELECT 1 FROM board_coauthor 
 					WHERE board_id = bp.board_id AND coauthor_id = $2
 				)
-			)
-		ORDER BY bp.saved_at DESC
+			)`+classifiedFilter(p.hideUnclassified, classifiedOrOwnCondition("$2"))+`
+		ORDER BY bp.rank
 		LIMIT $3
     `
 
@@ -668,11 +935,11 @@ func (p *pgBoardStorage) fetchColors(ctx context.Context, boardID, userID int) (
     defer rows.Close()
 
     var colors []string
-	var savedAt string
+	var flowRank string
 
     for rows.Next() {
         var color sql.NullString
-        if err := rows.Scan(&color, &savedAt); err != nil {
+        if err := rows.Scan(&color, &flowRank); err != nil {
             return nil, fmt.Errorf("failed to scan color: %w", err)
         }
 		if color.String != "" {
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func (p *pgBoardStorage) RecordActivity(ctx context.Context, activity domain.BoardActivity) error {
+	return insertBoardActivity(ctx, p.db, activity)
+}
+
+func (p *pgBoardShrStorage) RecordActivity(ctx context.Context, activity domain.BoardActivity) error {
+	return insertBoardActivity(ctx, p.db, activity)
+}
+
+// запись журнала общая для хранилищ досок и совместного доступа
+func insertBoardActivity(ctx context.Context, db execer, activity domain.BoardActivity) error {
+	details := activity.Details
+	if details == nil {
+		details = map[string]string{}
+	}
+
+	rawDetails, err := json.Marshal(details)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO board_activity (board_id, actor_id, action, flow_id, target_id, details)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, activity.BoardID, nullableID(activity.ActorID), activity.Action,
+		nullableID(activity.FlowID), nullableID(activity.TargetID), rawDetails)
+
+	return err
+}
+
+// GetBoardActivity возвращает журнал доски, начиная с последних записей
+func (p *pgBoardStorage) GetBoardActivity(ctx context.Context, boardID, page, pageSize int) ([]domain.BoardActivity, error) {
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT
+			ba.id,
+			ba.board_id,
+			COALESCE(ba.actor_id, 0),
+			COALESCE(actor.username, ''),
+			ba.action,
+			COALESCE(ba.flow_id, 0),
+			COALESCE(ba.target_id, 0),
+			COALESCE(target.username, ''),
+			ba.details,
+			ba.created_at
+		FROM board_activity AS ba
+		LEFT JOIN flow_user AS actor
+			ON actor.id = ba.actor_id
+		LEFT JOIN flow_user AS target
+			ON target.id = ba.target_id
+		WHERE ba.board_id = $1
+		ORDER BY ba.id DESC
+		LIMIT $2 OFFSET $3
+	`, boardID, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var activities []domain.BoardActivity
+	for rows.Next() {
+		var activity domain.BoardActivity
+		var rawDetails []byte
+		err := rows.Scan(
+			&activity.ID,
+			&activity.BoardID,
+			&activity.ActorID,
+			&activity.ActorUsername,
+			&activity.Action,
+			&activity.FlowID,
+			&activity.TargetID,
+			&activity.TargetUsername,
+			&rawDetails,
+			&activity.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(rawDetails, &activity.Details); err != nil {
+			return nil, err
+		}
+		if len(activity.Details) == 0 {
+			activity.Details = nil
+		}
+
+		activities = append(activities, activity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordActivity_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO board_activity")).
+		WithArgs(1, sql.NullInt64{Int64: 2, Valid: true}, domain.ActivityBoardRenamed,
+			sql.NullInt64{}, sql.NullInt64{}, []byte(`{"new_name":"new","old_name":"old"}`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := storage.RecordActivity(context.Background(), domain.BoardActivity{
+		BoardID: 1,
+		ActorID: 2,
+		Action:  domain.ActivityBoardRenamed,
+		Details: map[string]string{"old_name": "old", "new_name": "new"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetBoardActivity_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	createdAt := time.Now()
+	columns := []string{"id", "board_id", "actor_id", "actor", "action", "flow_id", "target_id", "target", "details", "created_at"}
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM board_activity AS ba")).
+		WithArgs(1, 20, 20).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(5, 1, 2, "alice", domain.ActivityFlowAdded, 7, 0, "", []byte(`{}`), createdAt).
+			AddRow(4, 1, 2, "alice", domain.ActivityCoauthorRemoved, 0, 3, "bob", []byte(`{}`), createdAt))
+
+	activities, err := storage.GetBoardActivity(context.Background(), 1, 2, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.BoardActivity{
+		{ID: 5, BoardID: 1, ActorID: 2, ActorUsername: "alice", Action: domain.ActivityFlowAdded, FlowID: 7, CreatedAt: createdAt},
+		{ID: 4, BoardID: 1, ActorID: 2, ActorUsername: "alice", Action: domain.ActivityCoauthorRemoved, TargetID: 3, TargetUsername: "bob", CreatedAt: createdAt},
+	}, activities)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
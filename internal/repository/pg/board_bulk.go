@@ -0,0 +1,561 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	boardService "github.com/go-park-mail-ru/2025_1_SuperChips/board"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+// BulkAddToBoard добавляет пины в начало доски в порядке запроса.
+// Недоступные пользователю пины и уже сохранённые пропускаются
+func (p *pgBoardStorage) BulkAddToBoard(ctx context.Context, boardID, userID int, flowIDs []int) ([]domain.BulkFlowResult, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	isEditor, err := isBoardEditor(ctx, tx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isEditor {
+		return nil, boardService.ErrForbidden
+	}
+
+	results := make([]domain.BulkFlowResult, len(flowIDs))
+	added := 0
+
+	// с конца, чтобы первый пин запроса оказался первым на доске
+	for i := len(flowIDs) - 1; i >= 0; i-- {
+		flowID := flowIDs[i]
+		results[i] = domain.BulkFlowResult{FlowID: flowID}
+
+		status, err := bulkAddStatus(ctx, tx, boardID, flowID, userID)
+		if err != nil {
+			return nil, err
+		}
+		results[i].Status = status
+		if status != domain.BulkStatusOK {
+			continue
+		}
+
+		if err := insertBoardPost(ctx, tx, boardID, flowID); err != nil {
+			return nil, err
+		}
+		added++
+	}
+
+	if err := adjustFlowCount(ctx, tx, boardID, added); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (p *pgBoardStorage) BulkDeleteFromBoard(ctx context.Context, boardID, userID int, flowIDs []int) ([]domain.BulkFlowResult, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	isEditor, err := isBoardEditor(ctx, tx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isEditor {
+		return nil, boardService.ErrForbidden
+	}
+
+	results := make([]domain.BulkFlowResult, len(flowIDs))
+	removed := 0
+
+	for i, flowID := range flowIDs {
+		results[i] = domain.BulkFlowResult{FlowID: flowID, Status: domain.BulkStatusNotFound}
+
+		result, err := tx.ExecContext(ctx, `
+			DELETE FROM board_post
+			WHERE board_id = $1 AND flow_id = $2
+		`, boardID, flowID)
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rowsAffected > 0 {
+			if err := refreshSaveCount(ctx, tx, flowID); err != nil {
+				return nil, err
+			}
+			results[i].Status = domain.BulkStatusOK
+			removed++
+		}
+	}
+
+	if err := adjustFlowCount(ctx, tx, boardID, -removed); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// BulkMoveFlows переносит пины в начало другой доски, раздел сбрасывается
+func (p *pgBoardStorage) BulkMoveFlows(ctx context.Context, boardID, targetBoardID, userID int, flowIDs []int) ([]domain.BulkFlowResult, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for _, id := range []int{boardID, targetBoardID} {
+		isEditor, err := isBoardEditor(ctx, tx, id, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !isEditor {
+			return nil, boardService.ErrForbidden
+		}
+	}
+
+	results := make([]domain.BulkFlowResult, len(flowIDs))
+	moved := 0
+
+	for i := len(flowIDs) - 1; i >= 0; i-- {
+		flowID := flowIDs[i]
+		results[i] = domain.BulkFlowResult{FlowID: flowID}
+
+		status, err := bulkTransferStatus(ctx, tx, boardID, targetBoardID, flowID)
+		if err != nil {
+			return nil, err
+		}
+		results[i].Status = status
+		if status != domain.BulkStatusOK {
+			continue
+		}
+
+		key, err := flowRankScope.between(ctx, tx, targetBoardID, flowID, 0, 0, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE board_post
+			SET board_id = $1, section_id = NULL, rank = $2
+			WHERE board_id = $3 AND flow_id = $4
+		`, targetBoardID, key, boardID, flowID); err != nil {
+			return nil, err
+		}
+		// у досок могут быть разные авторы
+		if err := refreshSaveCount(ctx, tx, flowID); err != nil {
+			return nil, err
+		}
+		moved++
+	}
+
+	if err := adjustFlowCount(ctx, tx, boardID, -moved); err != nil {
+		return nil, err
+	}
+	if err := adjustFlowCount(ctx, tx, targetBoardID, moved); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// BulkCopyFlows сохраняет пины доски в начало другой доски.
+// Читать исходную доску достаточно, чужие приватные пины не копируются
+func (p *pgBoardStorage) BulkCopyFlows(ctx context.Context, boardID, targetBoardID, userID int, flowIDs []int) ([]domain.BulkFlowResult, error) {
+	if err := p.checkBoardAccess(ctx, boardID, userID); err != nil {
+		return nil, err
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	isEditor, err := isBoardEditor(ctx, tx, targetBoardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isEditor {
+		return nil, boardService.ErrForbidden
+	}
+
+	results := make([]domain.BulkFlowResult, len(flowIDs))
+	copied := 0
+
+	for i := len(flowIDs) - 1; i >= 0; i-- {
+		flowID := flowIDs[i]
+		results[i] = domain.BulkFlowResult{FlowID: flowID}
+
+		status, err := bulkTransferStatus(ctx, tx, boardID, targetBoardID, flowID)
+		if err != nil {
+			return nil, err
+		}
+		if status == domain.BulkStatusOK {
+			if status, err = bulkAddStatus(ctx, tx, targetBoardID, flowID, userID); err != nil {
+				return nil, err
+			}
+		}
+		results[i].Status = status
+		if status != domain.BulkStatusOK {
+			continue
+		}
+
+		if err := insertBoardPost(ctx, tx, targetBoardID, flowID); err != nil {
+			return nil, err
+		}
+		copied++
+	}
+
+	if err := adjustFlowCount(ctx, tx, targetBoardID, copied); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// MergeBoards переносит пины доски в конец целевой с сохранением порядка
+// и удаляет исходную доску. Возвращает перенесённые пины
+func (p *pgBoardStorage) MergeBoards(ctx context.Context, boardID, targetBoardID, userID int) ([]int, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var authorID int
+	err = tx.QueryRowContext(ctx, `
+		SELECT author_id
+		FROM board
+		WHERE id = $1
+		FOR UPDATE
+	`, boardID).Scan(&authorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if authorID != userID {
+		return nil, boardService.ErrForbidden
+	}
+
+	isEditor, err := isBoardEditor(ctx, tx, targetBoardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isEditor {
+		return nil, boardService.ErrForbidden
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT bp.flow_id
+		FROM board_post AS bp
+		WHERE bp.board_id = $1 AND NOT EXISTS (
+			SELECT 1 FROM board_post AS target
+			WHERE target.board_id = $2 AND target.flow_id = bp.flow_id
+		)
+		ORDER BY bp.rank
+	`, boardID, targetBoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var flowIDs []int
+	for rows.Next() {
+		var flowID int
+		if err := rows.Scan(&flowID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		flowIDs = append(flowIDs, flowID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, flowID := range flowIDs {
+		key, err := flowRankScope.between(ctx, tx, targetBoardID, flowID, 0, 0, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE board_post
+			SET board_id = $1, section_id = NULL, rank = $2
+			WHERE board_id = $3 AND flow_id = $4
+		`, targetBoardID, key, boardID, flowID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := adjustFlowCount(ctx, tx, targetBoardID, len(flowIDs)); err != nil {
+		return nil, err
+	}
+
+	// дубликаты удаляются вместе с доской
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM board
+		WHERE id = $1
+	`, boardID); err != nil {
+		return nil, err
+	}
+
+	// все пины исходной доски теперь на целевой
+	if err := refreshBoardSaveCounts(ctx, tx, targetBoardID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return flowIDs, nil
+}
+
+// DuplicateBoard создаёт копию доски у пользователя: разделы, порядок пинов,
+// описание, теги и обложку. Не копируются чужие приватные пины и пины
+// закрытых аккаунтов, на которые пользователь не подписан
+func (p *pgBoardStorage) DuplicateBoard(ctx context.Context, boardID, userID int, name string) (int, error) {
+	if err := p.checkBoardAccess(ctx, boardID, userID); err != nil {
+		return 0, err
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var newBoardID int
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO board (author_id, board_name, is_private, is_secret, description, cover_flow_id, cover_image)
+		SELECT $2, $3, is_private, is_secret, description, cover_flow_id, cover_image
+		FROM board
+		WHERE id = $1
+		ON CONFLICT (author_id, board_name) DO NOTHING
+		RETURNING id
+	`, boardID, userID, name).Scan(&newBoardID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.ErrConflict
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO board_section (board_id, section_name, rank)
+		SELECT $2, section_name, rank
+		FROM board_section
+		WHERE board_id = $1
+	`, boardID, newBoardID); err != nil {
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO board_post (board_id, flow_id, rank, section_id)
+		SELECT $2, bp.flow_id, bp.rank, copy.id
+		FROM board_post AS bp
+		JOIN flow AS f
+			ON f.id = bp.flow_id
+		LEFT JOIN board_section AS source
+			ON source.id = bp.section_id
+		LEFT JOIN board_section AS copy
+			ON copy.board_id = $2 AND copy.section_name = source.section_name
+		WHERE bp.board_id = $1
+			AND (
+				(f.is_private = false AND `+accountVisibleFilter("f.author_id", "$3")+`)
+				OR f.author_id = $3
+			)
+	`, boardID, newBoardID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	copied, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := adjustFlowCount(ctx, tx, newBoardID, int(copied)); err != nil {
+		return 0, err
+	}
+
+	if err := refreshBoardSaveCounts(ctx, tx, newBoardID); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO board_tag (board_id, tag)
+		SELECT $2, tag
+		FROM board_tag
+		WHERE board_id = $1
+	`, boardID, newBoardID); err != nil {
+		return 0, err
+	}
+
+	// обложкой может быть только пин самой доски
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE board
+		SET cover_flow_id = NULL
+		WHERE id = $1 AND cover_flow_id IS NOT NULL AND NOT EXISTS (
+			SELECT 1 FROM board_post
+			WHERE board_id = $1 AND flow_id = board.cover_flow_id
+		)
+	`, newBoardID); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return newBoardID, nil
+}
+
+// bulkAddStatus проверяет, что пин виден пользователю и ещё не сохранён в доску.
+// Пины закрытых аккаунтов доступны только автору и его подписчикам
+func bulkAddStatus(ctx context.Context, tx *sql.Tx, boardID, flowID, userID int) (string, error) {
+	var visible, saved bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT
+			EXISTS (
+				SELECT 1 FROM flow AS f
+				WHERE f.id = $2
+					AND f.is_hidden = false
+					AND (f.is_private = false OR f.author_id = $3)
+					AND `+accountVisibleFilter("f.author_id", "$3")+`
+			),
+			EXISTS (
+				SELECT 1 FROM board_post
+				WHERE board_id = $1 AND flow_id = $2
+			)
+	`, boardID, flowID, userID).Scan(&visible, &saved)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case !visible:
+		return domain.BulkStatusNotFound, nil
+	case saved:
+		return domain.BulkStatusAlreadySaved, nil
+	default:
+		return domain.BulkStatusOK, nil
+	}
+}
+
+// bulkTransferStatus проверяет, что пин есть в исходной доске и нет в целевой
+func bulkTransferStatus(ctx context.Context, tx *sql.Tx, boardID, targetBoardID, flowID int) (string, error) {
+	var onSource, onTarget bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT
+			EXISTS (
+				SELECT 1 FROM board_post
+				WHERE board_id = $1 AND flow_id = $3
+			),
+			EXISTS (
+				SELECT 1 FROM board_post
+				WHERE board_id = $2 AND flow_id = $3
+			)
+	`, boardID, targetBoardID, flowID).Scan(&onSource, &onTarget)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case !onSource:
+		return domain.BulkStatusNotFound, nil
+	case onTarget:
+		return domain.BulkStatusAlreadySaved, nil
+	default:
+		return domain.BulkStatusOK, nil
+	}
+}
+
+// insertBoardPost сохраняет пин в начало доски
+func insertBoardPost(ctx context.Context, tx *sql.Tx, boardID, flowID int) error {
+	key, err := flowRankScope.between(ctx, tx, boardID, flowID, 0, 0, false)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO board_post (board_id, flow_id, rank)
+		VALUES ($1, $2, $3)
+	`, boardID, flowID, key); err != nil {
+		return err
+	}
+
+	return refreshSaveCount(ctx, tx, flowID)
+}
+
+// сохранением считается пин на доске, автор которой не автор пина
+const saveCountQuery = `(
+		SELECT COUNT(*)
+		FROM board_post AS bp
+		JOIN board AS b ON b.id = bp.board_id
+		WHERE bp.flow_id = f.id AND b.author_id <> f.author_id
+	)`
+
+// refreshSaveCount пересчитывает число сохранений пина
+func refreshSaveCount(ctx context.Context, tx *sql.Tx, flowID int) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE flow AS f
+		SET save_count = `+saveCountQuery+`
+		WHERE f.id = $1
+	`, flowID)
+
+	return err
+}
+
+// refreshBoardSaveCounts пересчитывает число сохранений всех пинов доски
+func refreshBoardSaveCounts(ctx context.Context, tx *sql.Tx, boardID int) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE flow AS f
+		SET save_count = `+saveCountQuery+`
+		WHERE f.id IN (SELECT flow_id FROM board_post WHERE board_id = $1)
+	`, boardID)
+
+	return err
+}
+
+func adjustFlowCount(ctx context.Context, tx *sql.Tx, boardID, delta int) error {
+	if delta == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		UPDATE board
+		SET flow_count = GREATEST(flow_count + $2, 0)
+		WHERE id = $1
+	`, boardID, delta)
+
+	return err
+}
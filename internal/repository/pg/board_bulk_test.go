@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	boardService "github.com/go-park-mail-ru/2025_1_SuperChips/board"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkAddToBoard_PerItemResults(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, true)
+	// пины обрабатываются с конца
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM flow")).
+		WithArgs(1, 20, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"visible", "saved"}).AddRow(false, false))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM flow")).
+		WithArgs(1, 10, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"visible", "saved"}).AddRow(true, false))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT MIN(rank) FROM board_post")).
+		WithArgs(1, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"min"}).AddRow(nil))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO board_post (board_id, flow_id, rank)")).
+		WithArgs(1, 10, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE flow AS f SET save_count")).
+		WithArgs(10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SET flow_count = GREATEST(flow_count + $2, 0)")).
+		WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	results, err := storage.BulkAddToBoard(context.Background(), 1, 2, []int{10, 20})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.BulkFlowResult{
+		{FlowID: 10, Status: domain.BulkStatusOK},
+		{FlowID: 20, Status: domain.BulkStatusNotFound},
+	}, results)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBulkMoveFlows_Forbidden(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, true)
+	expectEditor(mock, 4, 2, false)
+	mock.ExpectRollback()
+
+	_, err := storage.BulkMoveFlows(context.Background(), 1, 4, 2, []int{10})
+	assert.ErrorIs(t, err, boardService.ErrForbidden)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBulkDeleteFromBoard_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, true)
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM board_post")).
+		WithArgs(1, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE flow AS f SET save_count")).
+		WithArgs(10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM board_post")).
+		WithArgs(1, 20).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("SET flow_count = GREATEST(flow_count + $2, 0)")).
+		WithArgs(1, -1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	results, err := storage.BulkDeleteFromBoard(context.Background(), 1, 2, []int{10, 20})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BulkStatusOK, results[0].Status)
+	assert.Equal(t, domain.BulkStatusNotFound, results[1].Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMergeBoards_NotOwner(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT author_id FROM board")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id"}).AddRow(3))
+	mock.ExpectRollback()
+
+	_, err := storage.MergeBoards(context.Background(), 1, 4, 2)
+	assert.ErrorIs(t, err, boardService.ErrForbidden)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDuplicateBoard_NameConflict(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT b.id")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO board (author_id, board_name")).
+		WithArgs(1, 2, "копия").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	_, err := storage.DuplicateBoard(context.Background(), 1, 2, "копия")
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDuplicateBoard_SkipsHiddenAccounts(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT b.id")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO board (author_id, board_name")).
+		WithArgs(1, 2, "копия").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO board_section")).
+		WithArgs(1, 5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	// пины закрытого аккаунта копируются только подписчику
+	mock.ExpectExec(regexp.QuoteMeta("WHERE ps.target_id = f.author_id AND ps.user_id = $3")).
+		WithArgs(1, 5, 2).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	_, err := storage.DuplicateBoard(context.Background(), 1, 2, "копия")
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBulkAddToBoard_SkipsHiddenAccounts(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, true)
+	// пин закрытого аккаунта сохраняет только подписчик
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE ps.target_id = f.author_id AND ps.user_id = $3")).
+		WithArgs(1, 10, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"visible", "saved"}).AddRow(false, false))
+	mock.ExpectCommit()
+
+	results, err := storage.BulkAddToBoard(context.Background(), 1, 2, []int{10})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.BulkFlowResult{
+		{FlowID: 10, Status: domain.BulkStatusNotFound},
+	}, results)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
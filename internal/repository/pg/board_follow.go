@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	boardService "github.com/go-park-mail-ru/2025_1_SuperChips/board"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+// FollowBoard подписывает пользователя на публичную доску. Повторная подписка ничего не меняет.
+func (p *pgBoardStorage) FollowBoard(ctx context.Context, boardID, userID int) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var authorID int
+	var isPrivate bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT author_id, is_private
+		FROM board
+		WHERE id = $1
+		FOR UPDATE
+	`, boardID).Scan(&authorID, &isPrivate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	// на приватные и собственные доски подписаться нельзя
+	if isPrivate || authorID == userID {
+		return boardService.ErrForbidden
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO board_follower (board_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (board_id, user_id) DO NOTHING
+	`, boardID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE board
+			SET follower_count = follower_count + 1
+			WHERE id = $1
+		`, boardID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (p *pgBoardStorage) UnfollowBoard(ctx context.Context, boardID, userID int) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM board_follower
+		WHERE board_id = $1 AND user_id = $2
+	`, boardID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE board
+			SET follower_count = follower_count - 1
+			WHERE id = $1
+			AND follower_count > 0
+		`, boardID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (p *pgBoardStorage) GetBoardFollowers(ctx context.Context, boardID, userID, page, pageSize int) ([]domain.PublicUser, error) {
+	if err := p.checkBoardAccess(ctx, boardID, userID); err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT
+			u.username,
+			u.avatar,
+			u.birthday,
+			u.about,
+			u.public_name,
+			u.subscriber_count,
+			u.is_external_avatar
+		FROM board_follower AS bf
+		JOIN flow_user AS u
+			ON u.id = bf.user_id
+		WHERE bf.board_id = $1
+		ORDER BY bf.created_at DESC
+		LIMIT $2 OFFSET $3
+	`, boardID, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []domain.PublicUser
+	for rows.Next() {
+		var user userDB
+		err := rows.Scan(
+			&user.Username,
+			&user.Avatar,
+			&user.Birthday,
+			&user.About,
+			&user.PublicName,
+			&user.SubscriberCount,
+			&user.IsExternalAvatar,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, domain.PublicUser{
+			Username:         user.Username,
+			Avatar:           user.Avatar.String,
+			Birthday:         user.Birthday.Time,
+			About:            user.About.String,
+			PublicName:       user.PublicName,
+			SubscriberCount:  int(user.SubscriberCount.Int64),
+			IsExternalAvatar: user.IsExternalAvatar.Bool,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetFollowedBoardsFlow возвращает пины, добавленные в отслеживаемые доски после подписки,
+// начиная с самых свежих
+func (p *pgBoardStorage) GetFollowedBoardsFlow(ctx context.Context, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT
+			f.id,
+			f.title,
+			f.description,
+			f.author_id,
+			f.is_private,
+			f.media_url,
+			f.width,
+			f.height,
+			f.is_nsfw,
+			fu.username,
+			MAX(bp.saved_at) AS saved_at
+		FROM board_follower AS bf
+		JOIN board AS b
+			ON b.id = bf.board_id AND b.is_private = false
+		JOIN board_post AS bp
+			ON bp.board_id = b.id AND bp.saved_at >= bf.created_at
+		JOIN flow AS f
+			ON f.id = bp.flow_id
+		JOIN flow_user AS fu
+			ON fu.id = f.author_id
+		WHERE bf.user_id = $1
+			AND f.is_private = false
+			AND f.is_hidden = false
+			AND f.author_id <> $1
+			AND `+accountVisibleFilter("b.author_id", "$1")+`
+			AND `+accountVisibleFilter("f.author_id", "$1")+
+		nsfwFilter(nsfwMode)+
+		classifiedFilter(p.hideUnclassified, classifiedCondition)+`
+		GROUP BY f.id, fu.username
+		ORDER BY saved_at DESC, f.id DESC
+		LIMIT $2 OFFSET $3
+	`, userID, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var flows []domain.PinData
+	for rows.Next() {
+		var flowDBRow flowDBSchema
+		var savedAt time.Time
+		err := rows.Scan(
+			&flowDBRow.ID,
+			&flowDBRow.Title,
+			&flowDBRow.Description,
+			&flowDBRow.AuthorId,
+			&flowDBRow.IsPrivate,
+			&flowDBRow.MediaURL,
+			&flowDBRow.Width,
+			&flowDBRow.Height,
+			&flowDBRow.IsNSFW,
+			&flowDBRow.AuthorUsername,
+			&savedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		flows = append(flows, domain.PinData{
+			FlowID:         flowDBRow.ID,
+			Header:         flowDBRow.Title.String,
+			Description:    flowDBRow.Description.String,
+			AuthorID:       flowDBRow.AuthorId,
+			AuthorUsername: flowDBRow.AuthorUsername,
+			IsPrivate:      flowDBRow.IsPrivate,
+			MediaURL:       flowDBRow.MediaURL,
+			Width:          int(flowDBRow.Width.Int64),
+			Height:         int(flowDBRow.Height.Int64),
+			IsNSFW:         flowDBRow.IsNSFW,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return flows, nil
+}
+
+// ClaimFollowersNotification отмечает, что подписчики доски уведомлены, и возвращает их,
+// если с прошлого уведомления прошло не меньше interval. Иначе список пуст.
+// Уведомляют только о пине, который подписчики могут открыть: закрытые, скрытые,
+// черновики и отложенные пины окно уведомлений не занимают
+func (p *pgBoardStorage) ClaimFollowersNotification(ctx context.Context, boardID, flowID, userID int, interval time.Duration) (string, []string, error) {
+	var boardName string
+	err := p.db.QueryRowContext(ctx, `
+		UPDATE board
+		SET followers_notified_at = NOW()
+		WHERE id = $1
+			AND is_private = false
+			AND follower_count > 0
+			AND (
+				followers_notified_at IS NULL
+				OR followers_notified_at <= NOW() - $2 * INTERVAL '1 second'
+			)
+			AND EXISTS (
+				SELECT 1 FROM flow AS f
+				WHERE f.id = $3
+					AND f.is_private = false
+					AND f.is_hidden = false
+					AND f.is_draft = false
+					AND f.publish_at IS NULL
+					AND `+publicAccountFilter("f.author_id")+
+		classifiedFilter(p.hideUnclassified, classifiedCondition)+`
+			)
+		RETURNING board_name
+	`, boardID, int(interval.Seconds()), flowID).Scan(&boardName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT u.username
+		FROM board_follower AS bf
+		JOIN flow_user AS u
+			ON u.id = bf.user_id
+		WHERE bf.board_id = $1 AND bf.user_id <> $2
+	`, boardID, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	defer rows.Close()
+
+	var usernames []string
+	for rows.Next() {
+		var username string
+		if err := rows.Scan(&username); err != nil {
+			return "", nil, err
+		}
+		usernames = append(usernames, username)
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", nil, err
+	}
+
+	return boardName, usernames, nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	boardService "github.com/go-park-mail-ru/2025_1_SuperChips/board"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollowBoard_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT author_id, is_private FROM board")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id", "is_private"}).AddRow(3, false))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO board_follower")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SET follower_count = follower_count + 1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := storage.FollowBoard(context.Background(), 1, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFollowBoard_AlreadyFollowing(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT author_id, is_private FROM board")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id", "is_private"}).AddRow(3, false))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO board_follower")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := storage.FollowBoard(context.Background(), 1, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFollowBoard_PrivateBoard(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT author_id, is_private FROM board")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id", "is_private"}).AddRow(3, true))
+	mock.ExpectRollback()
+
+	err := storage.FollowBoard(context.Background(), 1, 2)
+	assert.ErrorIs(t, err, boardService.ErrForbidden)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnfollowBoard_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM board_follower")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SET follower_count = follower_count - 1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := storage.UnfollowBoard(context.Background(), 1, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimFollowersNotification_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SET followers_notified_at = NOW()")).
+		WithArgs(1, 3600, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"board_name"}).AddRow("Рецепты"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT u.username FROM board_follower")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"username"}).AddRow("alice").AddRow("bob"))
+
+	boardName, followers, err := storage.ClaimFollowersNotification(context.Background(), 1, 5, 2, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, "Рецепты", boardName)
+	assert.Equal(t, []string{"alice", "bob"}, followers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimFollowersNotification_Throttled(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	// закрытый или ещё не опубликованный пин окно уведомлений не занимает
+	mock.ExpectQuery(regexp.QuoteMeta("AND f.is_draft = false AND f.publish_at IS NULL")).
+		WithArgs(1, 3600, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"board_name"}))
+
+	boardName, followers, err := storage.ClaimFollowersNotification(context.Background(), 1, 5, 2, time.Hour)
+	assert.NoError(t, err)
+	assert.Empty(t, boardName)
+	assert.Empty(t, followers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+// GetFlowSaves возвращает публичные доски других пользователей, на которые сохранён пин.
+// Список есть только у публичного пина открытого аккаунта, доски закрытых аккаунтов
+// в нём не показываются
+func (p *pgBoardStorage) GetFlowSaves(ctx context.Context, flowID, page, pageSize int) ([]domain.FlowSave, error) {
+	var isPublic bool
+	err := p.db.QueryRowContext(ctx, `
+		SELECT f.is_private = false AND f.is_hidden = false AND `+publicAccountFilter("f.author_id")+`
+		FROM flow AS f
+		WHERE f.id = $1
+	`, flowID).Scan(&isPublic)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !isPublic) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT b.id, b.board_name, u.username, bp.saved_at
+		FROM board_post AS bp
+		JOIN board AS b
+			ON b.id = bp.board_id
+		JOIN flow AS f
+			ON f.id = bp.flow_id
+		JOIN flow_user AS u
+			ON u.id = b.author_id
+		WHERE bp.flow_id = $1
+			AND b.author_id <> f.author_id
+			AND b.is_private = false
+			AND b.is_secret = false
+			AND `+publicAccountFilter("b.author_id")+`
+		ORDER BY bp.saved_at DESC, b.id DESC
+		LIMIT $2 OFFSET $3
+	`, flowID, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var saves []domain.FlowSave
+	for rows.Next() {
+		var save domain.FlowSave
+		if err := rows.Scan(&save.BoardID, &save.BoardName, &save.Username, &save.SavedAt); err != nil {
+			return nil, err
+		}
+		saves = append(saves, save)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return saves, nil
+}
+
+// GetFlowSaveRecipient возвращает автора пина и имя доски, если автору нужно
+// сообщить о сохранении: пин чужой, а доска публичная. Иначе автор пуст
+func (p *pgBoardStorage) GetFlowSaveRecipient(ctx context.Context, boardID, flowID, userID int) (string, string, error) {
+	var author, boardName string
+	err := p.db.QueryRowContext(ctx, `
+		SELECT u.username, b.board_name
+		FROM flow AS f
+		JOIN flow_user AS u
+			ON u.id = f.author_id
+		JOIN board AS b
+			ON b.id = $1
+		WHERE f.id = $2
+			AND f.author_id <> $3
+			AND b.is_private = false
+			AND b.is_secret = false
+	`, boardID, flowID, userID).Scan(&author, &boardName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	return author, boardName, nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFlowSaves_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	savedAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT f.is_private = false AND f.is_hidden = false AND NOT EXISTS")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"is_public"}).AddRow(true))
+	// доски закрытых аккаунтов в списке не показываются
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE pa.id = b.author_id AND pa.is_private_account")).
+		WithArgs(5, 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "board_name", "username", "saved_at"}).
+			AddRow(1, "Рецепты", "alice", savedAt))
+
+	saves, err := storage.GetFlowSaves(context.Background(), 5, 2, 10)
+	assert.NoError(t, err)
+	assert.Len(t, saves, 1)
+	assert.Equal(t, "Рецепты", saves[0].BoardName)
+	assert.Equal(t, "alice", saves[0].Username)
+	assert.Equal(t, savedAt, saves[0].SavedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetFlowSaves_PrivateFlow(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT f.is_private = false AND f.is_hidden = false AND NOT EXISTS")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"is_public"}).AddRow(false))
+
+	_, err := storage.GetFlowSaves(context.Background(), 5, 1, 10)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetFlowSaveRecipient_OwnFlow(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT u.username, b.board_name FROM flow")).
+		WithArgs(1, 5, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"username", "board_name"}))
+
+	author, boardName, err := storage.GetFlowSaveRecipient(context.Background(), 1, 5, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, author)
+	assert.Empty(t, boardName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteBoard_RemovesSaves(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SET save_count = GREATEST(f.save_count - 1, 0)")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	assert.NoError(t, storage.DeleteBoard(context.Background(), 1, 2))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
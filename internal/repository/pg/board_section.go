@@ -0,0 +1,470 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	boardService "github.com/go-park-mail-ru/2025_1_SuperChips/board"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/rank"
+)
+
+// упорядоченный набор строк: ключи rank уникальны в пределах scopeColumn
+type rankScope struct {
+	table       string
+	scopeColumn string
+	idColumn    string
+}
+
+var (
+	flowRankScope    = rankScope{table: "board_post", scopeColumn: "board_id", idColumn: "flow_id"}
+	sectionRankScope = rankScope{table: "board_section", scopeColumn: "board_id", idColumn: "id"}
+)
+
+// between вычисляет ключ для itemID между соседями afterID и beforeID.
+// без соседей элемент ставится в начало, либо в конец при toEnd.
+// остальные строки не переписываются
+func (s rankScope) between(ctx context.Context, tx *sql.Tx, scopeID, itemID, afterID, beforeID int, toEnd bool) (string, error) {
+	if itemID > 0 && (afterID == itemID || beforeID == itemID) {
+		return "", domain.ErrInvalidPosition
+	}
+
+	var lower, upper sql.NullString
+
+	if afterID > 0 {
+		if err := s.rankOf(ctx, tx, scopeID, afterID, &lower); err != nil {
+			return "", err
+		}
+	}
+	if beforeID > 0 {
+		if err := s.rankOf(ctx, tx, scopeID, beforeID, &upper); err != nil {
+			return "", err
+		}
+	}
+
+	var err error
+	switch {
+	case afterID > 0 && beforeID == 0:
+		err = tx.QueryRowContext(ctx, s.neighbourQuery("MIN", "AND rank > $3"), scopeID, itemID, lower.String).Scan(&upper)
+	case afterID == 0 && beforeID > 0:
+		err = tx.QueryRowContext(ctx, s.neighbourQuery("MAX", "AND rank < $3"), scopeID, itemID, upper.String).Scan(&lower)
+	case afterID == 0 && beforeID == 0 && toEnd:
+		err = tx.QueryRowContext(ctx, s.neighbourQuery("MAX", ""), scopeID, itemID).Scan(&lower)
+	case afterID == 0 && beforeID == 0:
+		err = tx.QueryRowContext(ctx, s.neighbourQuery("MIN", ""), scopeID, itemID).Scan(&upper)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	key, err := rank.Between(lower.String, upper.String)
+	if errors.Is(err, rank.ErrInvalidRange) {
+		return "", domain.ErrInvalidPosition
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+func (s rankScope) rankOf(ctx context.Context, tx *sql.Tx, scopeID, itemID int, dest *sql.NullString) error {
+	err := tx.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT rank
+		FROM %s
+		WHERE %s = $1 AND %s = $2
+	`, s.table, s.scopeColumn, s.idColumn), scopeID, itemID).Scan(dest)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrInvalidPosition
+	}
+
+	return err
+}
+
+func (s rankScope) neighbourQuery(aggregate, condition string) string {
+	return fmt.Sprintf(`
+		SELECT %s(rank)
+		FROM %s
+		WHERE %s = $1 AND %s <> $2 %s
+	`, aggregate, s.table, s.scopeColumn, s.idColumn, condition)
+}
+
+func isBoardEditor(ctx context.Context, tx *sql.Tx, boardID, userID int) (bool, error) {
+	var isEditor bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM board
+				WHERE id = $1 AND author_id = $2
+			UNION
+			SELECT 1 FROM board_coauthor
+				WHERE board_id = $1 AND coauthor_id = $2
+		) AS is_editor
+	`, boardID, userID).Scan(&isEditor)
+
+	return isEditor, err
+}
+
+func nullableID(id int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(id), Valid: id > 0}
+}
+
+func (p *pgBoardStorage) GetSections(ctx context.Context, boardID, userID int) ([]domain.BoardSection, error) {
+	if err := p.checkBoardAccess(ctx, boardID, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT
+			s.id,
+			s.board_id,
+			s.section_name,
+			s.created_at,
+			COUNT(bp.flow_id)
+		FROM board_section s
+		LEFT JOIN board_post bp
+			ON bp.section_id = s.id
+		WHERE s.board_id = $1
+		GROUP BY s.id
+		ORDER BY s.rank
+	`, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sections := []domain.BoardSection{}
+	for rows.Next() {
+		var section domain.BoardSection
+		if err := rows.Scan(
+			&section.ID,
+			&section.BoardID,
+			&section.Name,
+			&section.CreatedAt,
+			&section.FlowCount,
+		); err != nil {
+			return nil, err
+		}
+
+		sections = append(sections, section)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
+
+func (p *pgBoardStorage) CreateSection(ctx context.Context, boardID, userID int, name string) (domain.BoardSection, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.BoardSection{}, err
+	}
+	defer tx.Rollback()
+
+	isEditor, err := isBoardEditor(ctx, tx, boardID, userID)
+	if err != nil {
+		return domain.BoardSection{}, err
+	}
+	if !isEditor {
+		return domain.BoardSection{}, boardService.ErrForbidden
+	}
+
+	// новый раздел встаёт в конец доски
+	key, err := sectionRankScope.between(ctx, tx, boardID, 0, 0, 0, true)
+	if err != nil {
+		return domain.BoardSection{}, err
+	}
+
+	section := domain.BoardSection{
+		BoardID: boardID,
+		Name:    name,
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO board_section (board_id, section_name, rank)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (board_id, section_name) DO NOTHING
+		RETURNING id, created_at
+	`, boardID, name, key).Scan(&section.ID, &section.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.BoardSection{}, domain.ErrConflict
+	}
+	if err != nil {
+		return domain.BoardSection{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.BoardSection{}, err
+	}
+
+	return section, nil
+}
+
+func (p *pgBoardStorage) RenameSection(ctx context.Context, boardID, sectionID, userID int, name string) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	isEditor, err := isBoardEditor(ctx, tx, boardID, userID)
+	if err != nil {
+		return err
+	}
+	if !isEditor {
+		return boardService.ErrForbidden
+	}
+
+	var taken bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM board_section
+			WHERE board_id = $1 AND section_name = $2 AND id <> $3
+		)
+	`, boardID, name, sectionID).Scan(&taken)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return domain.ErrConflict
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE board_section
+		SET section_name = $1
+		WHERE id = $2 AND board_id = $3
+	`, name, sectionID, boardID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrSectionNotFound
+	}
+
+	return tx.Commit()
+}
+
+// пины удалённого раздела остаются в доске без раздела
+func (p *pgBoardStorage) DeleteSection(ctx context.Context, boardID, sectionID, userID int) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	isEditor, err := isBoardEditor(ctx, tx, boardID, userID)
+	if err != nil {
+		return err
+	}
+	if !isEditor {
+		return boardService.ErrForbidden
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM board_section
+		WHERE id = $1 AND board_id = $2
+	`, sectionID, boardID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrSectionNotFound
+	}
+
+	return tx.Commit()
+}
+
+func (p *pgBoardStorage) MoveSection(ctx context.Context, boardID, sectionID, userID int, move domain.SectionMove) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	isEditor, err := isBoardEditor(ctx, tx, boardID, userID)
+	if err != nil {
+		return err
+	}
+	if !isEditor {
+		return boardService.ErrForbidden
+	}
+
+	var id int
+	err = tx.QueryRowContext(ctx, `
+		SELECT id
+		FROM board_section
+		WHERE id = $1 AND board_id = $2
+		FOR UPDATE
+	`, sectionID, boardID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrSectionNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	key, err := sectionRankScope.between(ctx, tx, boardID, sectionID, move.AfterID, move.BeforeID, true)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE board_section
+		SET rank = $1
+		WHERE id = $2
+	`, key, sectionID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MoveFlow переставляет пин внутри доски, переносит между разделами
+// или в другую доску одной транзакцией
+func (p *pgBoardStorage) MoveFlow(ctx context.Context, boardID, flowID, userID int, move domain.FlowMove) error {
+	targetBoardID := move.TargetBoardID
+	if targetBoardID == 0 {
+		targetBoardID = boardID
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, id := range []int{boardID, targetBoardID} {
+		isEditor, err := isBoardEditor(ctx, tx, id, userID)
+		if err != nil {
+			return err
+		}
+		if !isEditor {
+			return boardService.ErrForbidden
+		}
+	}
+
+	var savedFlowID int
+	err = tx.QueryRowContext(ctx, `
+		SELECT flow_id
+		FROM board_post
+		WHERE board_id = $1 AND flow_id = $2
+		FOR UPDATE
+	`, boardID, flowID).Scan(&savedFlowID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if move.SectionID > 0 {
+		var sectionID int
+		err = tx.QueryRowContext(ctx, `
+			SELECT id
+			FROM board_section
+			WHERE id = $1 AND board_id = $2
+		`, move.SectionID, targetBoardID).Scan(&sectionID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSectionNotFound
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	key, err := flowRankScope.between(ctx, tx, targetBoardID, flowID, move.AfterID, move.BeforeID, false)
+	if err != nil {
+		return err
+	}
+
+	if targetBoardID == boardID {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE board_post
+			SET section_id = $1, rank = $2
+			WHERE board_id = $3 AND flow_id = $4
+		`, nullableID(move.SectionID), key, boardID, flowID); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	}
+
+	var alreadySaved bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM board_post
+			WHERE board_id = $1 AND flow_id = $2
+		)
+	`, targetBoardID, flowID).Scan(&alreadySaved)
+	if err != nil {
+		return err
+	}
+	if alreadySaved {
+		return domain.ErrConflict
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE board_post
+		SET board_id = $1, section_id = $2, rank = $3
+		WHERE board_id = $4 AND flow_id = $5
+	`, targetBoardID, nullableID(move.SectionID), key, boardID, flowID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE board
+		SET flow_count = flow_count - 1
+		WHERE id = $1 AND flow_count > 0
+	`, boardID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE board
+		SET flow_count = flow_count + 1
+		WHERE id = $1
+	`, targetBoardID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (p *pgBoardStorage) GetSectionFlow(ctx context.Context, boardID, sectionID, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := p.checkBoardAccess(ctx, boardID, userID); err != nil {
+		return nil, err
+	}
+
+	var id int
+	err := p.db.QueryRowContext(ctx, `
+		SELECT id
+		FROM board_section
+		WHERE id = $1 AND board_id = $2
+	`, sectionID, boardID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrSectionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return p.fetchFlows(ctx, boardID, nullableID(sectionID), userID, pageSize, offset, nsfwMode)
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	boardService "github.com/go-park-mail-ru/2025_1_SuperChips/board"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupBoardSectionMock(t *testing.T) (*pgBoardStorage, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock DB: %v", err)
+	}
+
+	return NewBoardStorage(db, false), mock, func() { db.Close() }
+}
+
+func expectEditor(mock sqlmock.Sqlmock, boardID, userID int, isEditor bool) {
+	mock.ExpectQuery(regexp.QuoteMeta("AS is_editor")).
+		WithArgs(boardID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"is_editor"}).AddRow(isEditor))
+}
+
+func TestCreateSection_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	createdAt := time.Now()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, true)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT MAX(rank) FROM board_section")).
+		WithArgs(1, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow("a3"))
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO board_section")).
+		WithArgs(1, "Кухня", "a4").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(9, createdAt))
+	mock.ExpectCommit()
+
+	section, err := storage.CreateSection(context.Background(), 1, 2, "Кухня")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BoardSection{ID: 9, BoardID: 1, Name: "Кухня", CreatedAt: createdAt}, section)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateSection_Forbidden(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, false)
+	mock.ExpectRollback()
+
+	_, err := storage.CreateSection(context.Background(), 1, 2, "Кухня")
+	assert.ErrorIs(t, err, boardService.ErrForbidden)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMoveSection_BetweenNeighbours(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, true)
+	mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT rank FROM board_section")).
+		WithArgs(1, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow("a0"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT rank FROM board_section")).
+		WithArgs(1, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow("a1"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE board_section SET rank = $1")).
+		WithArgs("a0V", 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := storage.MoveSection(context.Background(), 1, 5, 2, domain.SectionMove{AfterID: 6, BeforeID: 7})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMoveFlow_WithinBoard(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, true)
+	expectEditor(mock, 1, 2, true)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT flow_id FROM board_post")).
+		WithArgs(1, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"flow_id"}).AddRow(10))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM board_section")).
+		WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT rank FROM board_post")).
+		WithArgs(1, 11).
+		WillReturnRows(sqlmock.NewRows([]string{"rank"}).AddRow("a1"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT MIN(rank) FROM board_post")).
+		WithArgs(1, 10, "a1").
+		WillReturnRows(sqlmock.NewRows([]string{"min"}).AddRow("a2"))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE board_post SET section_id = $1, rank = $2")).
+		WithArgs(int64(3), "a1V", 1, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := storage.MoveFlow(context.Background(), 1, 10, 2, domain.FlowMove{SectionID: 3, AfterID: 11})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMoveFlow_ToOtherBoard(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, true)
+	expectEditor(mock, 4, 2, true)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT flow_id FROM board_post")).
+		WithArgs(1, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"flow_id"}).AddRow(10))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT MIN(rank) FROM board_post")).
+		WithArgs(4, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"min"}).AddRow(nil))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS")).
+		WithArgs(4, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE board_post SET board_id = $1")).
+		WithArgs(4, nil, "a0", 1, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SET flow_count = flow_count - 1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SET flow_count = flow_count + 1")).
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := storage.MoveFlow(context.Background(), 1, 10, 2, domain.FlowMove{TargetBoardID: 4})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMoveFlow_AlreadyInTargetBoard(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	expectEditor(mock, 1, 2, true)
+	expectEditor(mock, 4, 2, true)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT flow_id FROM board_post")).
+		WithArgs(1, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"flow_id"}).AddRow(10))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT MIN(rank) FROM board_post")).
+		WithArgs(4, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"min"}).AddRow("a0"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS")).
+		WithArgs(4, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	err := storage.MoveFlow(context.Background(), 1, 10, 2, domain.FlowMove{TargetBoardID: 4})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSectionFlow_HidesNSFWInQuery(t *testing.T) {
+	storage, mock, closeFn := setupBoardSectionMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT b.id FROM board AS b")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM board_section WHERE id = $1 AND board_id = $2")).
+		WithArgs(4, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	// NSFW пины отсекаются до LIMIT, иначе страница приходит неполной
+	mock.ExpectQuery(regexp.QuoteMeta("AND (f.is_nsfw = false OR f.author_id = $2) ORDER BY bp.rank LIMIT $3 OFFSET $4")).
+		WithArgs(1, 2, 10, 10, int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := storage.GetSectionFlow(context.Background(), 1, 4, 2, 2, 10, domain.NSFWModeHide)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
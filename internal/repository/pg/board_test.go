@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"regexp"
 	"testing"
 	"time"
 
@@ -23,7 +24,7 @@ func TestGetUsernameID_Success(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	username := "testuser"
 	userID := 123
@@ -42,7 +43,7 @@ func TestGetUsernameID_NotFound(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	username := "nonexistentuser"
 
@@ -61,7 +62,7 @@ func TestCreateBoard_Success(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	board := &domain.Board{
 		Name:      "Test Board",
@@ -83,7 +84,7 @@ func TestCreateBoard_Conflict(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	board := &domain.Board{
 		Name:      "Test Board",
@@ -105,7 +106,7 @@ func TestDeleteBoard_Success(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	boardID := 1
 	userID := 123
@@ -123,7 +124,7 @@ func TestDeleteBoard_NotFound(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	boardID := 1
 	userID := 123
@@ -142,7 +143,7 @@ func TestAddToBoard_Success(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	boardID := 1
 	userID := 123
@@ -157,7 +158,7 @@ func TestAddToBoard_Success(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"board_id"}).AddRow(boardID))
 	mock.ExpectCommit()
 
-	err := storage.AddToBoard(ctx, boardID, userID, flowID)
+	err := storage.AddToBoard(ctx, boardID, userID, flowID, 0)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -166,7 +167,7 @@ func TestAddToBoard_NotFound(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	boardID := 1
 	userID := 123
@@ -178,7 +179,7 @@ func TestAddToBoard_NotFound(t *testing.T) {
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectRollback()
 
-	err := storage.AddToBoard(ctx, boardID, userID, flowID)
+	err := storage.AddToBoard(ctx, boardID, userID, flowID, 0)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -188,38 +189,70 @@ func TestUpdateBoard_Success(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	boardID := 1
 	userID := 123
-	newName := "Updated Board"
-	isPrivate := true
+	description := "Лучшие рецепты"
+	update := domain.UpdateData{
+		Name:        "Updated Board",
+		IsSecret:    true,
+		Description: &description,
+		Tags:        []string{"кухня"},
+	}
 
-	mock.ExpectExec(`UPDATE board SET board_name = COALESCE\(\$1, board_name\), is_private = COALESCE\(\$2, is_private\) WHERE id = \$3 AND author_id = \$4`).
-		WithArgs(newName, isPrivate, boardID, userID).
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE board SET board_name = COALESCE($1, board_name), is_private = COALESCE($2, is_private), is_secret = $3`)).
+		WithArgs(update.Name, false, true, sql.NullString{String: description, Valid: true}, sql.NullInt64{}, boardID, userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM board_tag`)).
+		WithArgs(boardID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO board_tag`)).
+		WithArgs(boardID, "кухня").
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
-	err := storage.UpdateBoard(ctx, boardID, userID, newName, isPrivate)
+	err := storage.UpdateBoard(ctx, boardID, userID, update)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestUpdateBoard_CoverNotOnBoard(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer db.Close()
+
+	storage := NewBoardStorage(db, false)
+	coverFlowID := 7
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM board_post`)).
+		WithArgs(1, int64(coverFlowID)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectRollback()
+
+	err := storage.UpdateBoard(context.Background(), 1, 123, domain.UpdateData{Name: "Board", CoverFlowID: &coverFlowID})
+	assert.ErrorIs(t, err, domain.ErrInvalidBoardCover)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUpdateBoard_NotFound(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	boardID := 1
 	userID := 123
-	newName := "Updated Board"
-	isPrivate := true
+	update := domain.UpdateData{Name: "Updated Board", IsPrivate: true}
 
-	mock.ExpectExec(`UPDATE board SET board_name = COALESCE\(\$1, board_name\), is_private = COALESCE\(\$2, is_private\) WHERE id = \$3 AND author_id = \$4`).
-		WithArgs(newName, isPrivate, boardID, userID).
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE board SET board_name = COALESCE($1, board_name), is_private = COALESCE($2, is_private)`)).
+		WithArgs(update.Name, true, false, sql.NullString{}, sql.NullInt64{}, boardID, userID).
 		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
 
-	err := storage.UpdateBoard(ctx, boardID, userID, newName, isPrivate)
+	err := storage.UpdateBoard(ctx, boardID, userID, update)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -229,7 +262,7 @@ func TestGetBoard_Success(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	boardID := 1
 	userID := 123
@@ -261,7 +294,7 @@ func TestGetBoard_NotFound(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	boardID := 1
 	userID := 123
@@ -284,7 +317,7 @@ func TestGetUserPublicBoards_Success(t *testing.T) {
     db, mock := setupMockDB(t)
     defer db.Close()
 
-    storage := NewBoardStorage(db)
+    storage := NewBoardStorage(db, false)
     ctx := context.Background()
     username := "testuser"
     previewNum := 5
@@ -300,7 +333,7 @@ func TestGetUserPublicBoards_Success(t *testing.T) {
         WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "created_at", "updated_at", "is_private", "media_url", "like_count", "width", "height", "is_nsfw"}).
             AddRow(1, "Flow Title", "Flow Description", 123, time.Now(), time.Now(), false, "http://example.com/media.jpg", 5, 800, 600, false))
 
-    boards, err := storage.GetUserPublicBoards(ctx, username, previewNum, previewStart)
+    boards, err := storage.GetUserPublicBoards(ctx, username, 0, previewNum, previewStart)
     assert.NoError(t, err)
     assert.NotEmpty(t, boards)
     assert.NoError(t, mock.ExpectationsWereMet())
@@ -310,7 +343,7 @@ func TestGetUserAllBoards_Success(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer db.Close()
 
-	storage := NewBoardStorage(db)
+	storage := NewBoardStorage(db, false)
 	ctx := context.Background()
 	userID := 123
 	previewNum := 5
@@ -336,7 +369,7 @@ func TestGetBoardFlow_Success(t *testing.T) {
     db, mock := setupMockDB(t)
     defer db.Close()
 
-    storage := NewBoardStorage(db)
+    storage := NewBoardStorage(db, false)
     ctx := context.Background()
     boardID := 1
     userID := 123
@@ -352,7 +385,7 @@ func TestGetBoardFlow_Success(t *testing.T) {
         WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "created_at", "updated_at", "is_private", "media_url", "like_count", "width", "height", "is_nsfw"}).
             AddRow(1, "Flow Title", "Flow Description", 123, time.Now(), time.Now(), false, "http://example.com/media.jpg", 5, 800, 600, false))
 
-    flows, err := storage.GetBoardFlow(ctx, boardID, userID, page, pageSize)
+    flows, err := storage.GetBoardFlow(ctx, boardID, userID, page, pageSize, domain.NSFWModeShow)
     assert.NoError(t, err)
     assert.NotEmpty(t, flows)
     assert.NoError(t, mock.ExpectationsWereMet())
@@ -117,9 +117,9 @@ func (p *pgBoardShrStorage) CreateInvitation(ctx context.Context, boardID int, u
 
 	var invitationID int
 	err = tx.QueryRowContext(ctx, `
-		INSERT INTO board_invitation (board_id, link, is_personal, expiration, usage_limit, usage_count) 
-		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id
-	`, boardID, link, len(inviteeIDs) == 0, invitation.TimeLimit, invitation.UsageLimit, 0).Scan(&invitationID)
+		INSERT INTO board_invitation (board_id, link, is_personal, expiration, usage_limit, usage_count, role) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id
+	`, boardID, link, len(inviteeIDs) == 0, invitation.TimeLimit, invitation.UsageLimit, 0, invitation.Role).Scan(&invitationID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return "", domain.ErrConflict
 	}
@@ -198,6 +198,8 @@ func (p *pgBoardShrStorage) GetInvitationLinks(ctx context.Context, boardID int)
 			bi.expiration,
 			bi.usage_limit,
 			bi.usage_count,
+			bi.role,
+			bi.is_paused,
 			fu.username
 		FROM board_invitation AS bi
 		LEFT JOIN invitation_user AS iu
@@ -221,6 +223,8 @@ func (p *pgBoardShrStorage) GetInvitationLinks(ctx context.Context, boardID int)
 			expiration sql.NullTime
 			usageLimit sql.NullInt64
 			usageCount int64
+			role       string
+			isPaused   bool
 			username   sql.NullString
 		}{}
 
@@ -230,6 +234,8 @@ func (p *pgBoardShrStorage) GetInvitationLinks(ctx context.Context, boardID int)
 			&rowData.expiration,
 			&rowData.usageLimit,
 			&rowData.usageCount,
+			&rowData.role,
+			&rowData.isPaused,
 			&rowData.username)
 		if err != nil {
 			return nil, err
@@ -253,6 +259,8 @@ func (p *pgBoardShrStorage) GetInvitationLinks(ctx context.Context, boardID int)
 				newLink.UsageLimit = &rowData.usageLimit.Int64
 			}
 			newLink.UsageCount = rowData.usageCount
+			newLink.Role = rowData.role
+			newLink.IsPaused = rowData.isPaused
 
 			links = append(links, newLink)
 			continue
@@ -294,6 +302,69 @@ func (p *pgBoardShrStorage) IsBoardEditor(ctx context.Context, boardID int, user
 	return isEditor, nil
 }
 
+// GetBoardRole возвращает роль пользователя на доске: owner для автора,
+// роль соавтора либо пустую строку, если пользователь не участник доски
+func (p *pgBoardShrStorage) GetBoardRole(ctx context.Context, boardID int, userID int) (string, error) {
+	var role string
+	err := p.db.QueryRowContext(ctx, `
+		SELECT
+			CASE
+				WHEN b.author_id = $2 THEN 'owner'
+				ELSE COALESCE(bc.role, '')
+			END AS role
+		FROM board AS b
+		LEFT JOIN board_coauthor AS bc
+			ON bc.board_id = b.id AND bc.coauthor_id = $2
+		WHERE b.id = $1
+	`, boardID, userID).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return role, nil
+}
+
+func (p *pgBoardShrStorage) GetCoauthorRole(ctx context.Context, boardID int, coauthorID int) (string, error) {
+	var role string
+	err := p.db.QueryRowContext(ctx, `
+		SELECT role
+		FROM board_coauthor
+		WHERE board_id = $1 AND coauthor_id = $2
+	`, boardID, coauthorID).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", boardshrService.ErrNotCoauthor
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return role, nil
+}
+
+func (p *pgBoardShrStorage) SetCoauthorRole(ctx context.Context, boardID int, coauthorID int, role string) error {
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE board_coauthor
+		SET role = $1
+		WHERE board_id = $2 AND coauthor_id = $3
+	`, role, boardID, coauthorID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return boardshrService.ErrNotCoauthor
+	}
+
+	return nil
+}
+
 func (p *pgBoardShrStorage) GetUsernameFromUserID(ctx context.Context, userID int) (string, error) {
 	row := p.db.QueryRowContext(ctx, `
 		SELECT username FROM flow_user
@@ -319,6 +390,8 @@ func (p *pgBoardShrStorage) GetLinkParams(ctx context.Context, link string) (int
 			bi.expiration,
 			bi.usage_limit,
 			bi.usage_count,
+			bi.role,
+			bi.is_paused,
 			fu.username
 		FROM board_invitation AS bi
 		LEFT JOIN invitation_user AS iu
@@ -344,6 +417,8 @@ func (p *pgBoardShrStorage) GetLinkParams(ctx context.Context, link string) (int
 			expiration sql.NullTime
 			usageLimit sql.NullInt64
 			usageCount int64
+			role       string
+			isPaused   bool
 			username   sql.NullString
 		}{}
 
@@ -354,6 +429,8 @@ func (p *pgBoardShrStorage) GetLinkParams(ctx context.Context, link string) (int
 			&rowData.expiration,
 			&rowData.usageLimit,
 			&rowData.usageCount,
+			&rowData.role,
+			&rowData.isPaused,
 			&rowData.username)
 		if err != nil {
 			return 0, domain.LinkParams{}, err
@@ -374,6 +451,8 @@ func (p *pgBoardShrStorage) GetLinkParams(ctx context.Context, link string) (int
 				linkParams.UsageLimit = &rowData.usageLimit.Int64
 			}
 			linkParams.UsageCount = rowData.usageCount
+			linkParams.Role = rowData.role
+			linkParams.IsPaused = rowData.isPaused
 
 			isFirstRow = false
 			continue
@@ -404,9 +483,13 @@ func (p *pgBoardShrStorage) AddBoardCoauthorByLink(ctx context.Context, boardID
 	}
 	defer tx.Rollback()
 
+	// соавтор получает роль, указанную в приглашении
 	result, err := tx.ExecContext(ctx, `
-		INSERT INTO board_coauthor (board_id, coauthor_id) VALUES ($1, $2)
-	`, boardID, userID)
+		INSERT INTO board_coauthor (board_id, coauthor_id, role)
+		SELECT $1, $2, role
+		FROM board_invitation
+		WHERE board_id = $1 AND link = $3
+	`, boardID, userID, link)
 	if err != nil {
 		return err
 	}
@@ -435,6 +518,15 @@ func (p *pgBoardShrStorage) AddBoardCoauthorByLink(ctx context.Context, boardID
 		return boardshrService.ErrFailCoauthorInsert
 	}
 
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO invitation_join (invitation_id, user_id)
+		SELECT id, $2
+		FROM board_invitation
+		WHERE link = $1
+	`, link, userID); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return err
 	}
@@ -503,7 +595,8 @@ func (p *pgBoardShrStorage) GetCoauthors(ctx context.Context, boardID int) ([]do
 			fu.username, 
 			fu.public_name, 
 			fu.avatar, 
-			fu.is_external_avatar
+			fu.is_external_avatar,
+			bc.role
 		FROM board_coauthor AS bc
 		LEFT JOIN flow_user AS fu
 			ON bc.coauthor_id = fu.id
@@ -523,6 +616,7 @@ func (p *pgBoardShrStorage) GetCoauthors(ctx context.Context, boardID int) ([]do
 			&coauthor.PublicName,
 			&coauthor.Avatar,
 			&isExternalAvatar,
+			&coauthor.Role,
 		); err != nil {
 			return nil, err
 		}
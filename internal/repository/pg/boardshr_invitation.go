@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	boardshrService "github.com/go-park-mail-ru/2025_1_SuperChips/boardshr"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/google/uuid"
+)
+
+func (p *pgBoardShrStorage) SetInvitationPaused(ctx context.Context, boardID int, link string, paused bool) error {
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE board_invitation
+		SET is_paused = $3
+		WHERE board_id = $1 AND link = $2
+	`, boardID, link, paused)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return boardshrService.ErrLinkNotFound
+	}
+
+	return nil
+}
+
+// RegenerateInvitation заменяет ссылку на новую, параметры и история остаются
+func (p *pgBoardShrStorage) RegenerateInvitation(ctx context.Context, boardID int, link string) (string, error) {
+	newLink := uuid.New().String()
+
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE board_invitation
+		SET link = $3
+		WHERE board_id = $1 AND link = $2
+	`, boardID, link, newLink)
+	if err != nil {
+		return "", err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return "", err
+	}
+	if rowsAffected == 0 {
+		return "", boardshrService.ErrLinkNotFound
+	}
+
+	return newLink, nil
+}
+
+// GetInvitationJoins возвращает вступивших по ссылке, начиная с последних
+func (p *pgBoardShrStorage) GetInvitationJoins(ctx context.Context, boardID int, link string) ([]domain.InvitationJoin, error) {
+	var invitationID int
+	err := p.db.QueryRowContext(ctx, `
+		SELECT id
+		FROM board_invitation
+		WHERE board_id = $1 AND link = $2
+	`, boardID, link).Scan(&invitationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, boardshrService.ErrLinkNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT
+			fu.username,
+			fu.avatar,
+			fu.is_external_avatar,
+			ij.joined_at
+		FROM invitation_join AS ij
+		JOIN flow_user AS fu
+			ON fu.id = ij.user_id
+		WHERE ij.invitation_id = $1
+		ORDER BY ij.joined_at DESC
+	`, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	joins := []domain.InvitationJoin{}
+	for rows.Next() {
+		var join domain.InvitationJoin
+		var avatar sql.NullString
+		var isExternalAvatar sql.NullBool
+
+		if err := rows.Scan(&join.Username, &avatar, &isExternalAvatar, &join.JoinedAt); err != nil {
+			return nil, err
+		}
+
+		join.Avatar = avatar.String
+		join.IsExternalAvatar = isExternalAvatar.Bool
+
+		joins = append(joins, join)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return joins, nil
+}
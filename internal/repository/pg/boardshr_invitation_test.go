@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	boardshrService "github.com/go-park-mail-ru/2025_1_SuperChips/boardshr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetInvitationPaused_NotFound(t *testing.T) {
+	storage, mock, closeFn := setupBoardShrMock(t)
+	defer closeFn()
+
+	mock.ExpectExec(regexp.QuoteMeta("SET is_paused = $3")).
+		WithArgs(1, "link", true).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := storage.SetInvitationPaused(context.Background(), 1, "link", true)
+	assert.ErrorIs(t, err, boardshrService.ErrLinkNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRegenerateInvitation_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardShrMock(t)
+	defer closeFn()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE board_invitation SET link = $3")).
+		WithArgs(1, "old", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	link, err := storage.RegenerateInvitation(context.Background(), 1, "old")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "old", link)
+	assert.Len(t, link, 36)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetInvitationJoins_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardShrMock(t)
+	defer closeFn()
+
+	joinedAt := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM board_invitation")).
+		WithArgs(1, "link").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM invitation_join AS ij")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"username", "avatar", "is_external_avatar", "joined_at"}).
+			AddRow("alice", "a.png", false, joinedAt))
+
+	joins, err := storage.GetInvitationJoins(context.Background(), 1, "link")
+	assert.NoError(t, err)
+	assert.Len(t, joins, 1)
+	assert.Equal(t, "alice", joins[0].Username)
+	assert.Equal(t, joinedAt, joins[0].JoinedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetInvitationJoins_LinkNotFound(t *testing.T) {
+	storage, mock, closeFn := setupBoardShrMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM board_invitation")).
+		WithArgs(1, "link").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := storage.GetInvitationJoins(context.Background(), 1, "link")
+	assert.ErrorIs(t, err, boardshrService.ErrLinkNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
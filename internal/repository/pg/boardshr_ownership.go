@@ -0,0 +1,329 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	boardshrService "github.com/go-park-mail-ru/2025_1_SuperChips/boardshr"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+func (p *pgBoardShrStorage) CreateOwnershipTransfer(ctx context.Context, boardID int, fromUserID int, toUserID int) (domain.OwnershipTransfer, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+	defer tx.Rollback()
+
+	// Новое предложение заменяет предыдущее.
+	_, err = tx.ExecContext(ctx, `
+		UPDATE board_ownership_transfer
+		SET status = 'cancelled', resolved_at = NOW()
+		WHERE board_id = $1 AND status = 'pending'
+	`, boardID)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	transfer := domain.OwnershipTransfer{
+		BoardID:    boardID,
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+		Status:     domain.OwnershipTransferPending,
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO board_ownership_transfer (board_id, from_user_id, to_user_id)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`, boardID, fromUserID, toUserID).Scan(&transfer.ID, &transfer.CreatedAt)
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	return transfer, nil
+}
+
+func (p *pgBoardShrStorage) GetPendingOwnershipTransfer(ctx context.Context, boardID int) (domain.OwnershipTransfer, error) {
+	var transfer domain.OwnershipTransfer
+	err := p.db.QueryRowContext(ctx, `
+		SELECT
+			t.id,
+			t.board_id,
+			t.from_user_id,
+			fu.username,
+			t.to_user_id,
+			tu.username,
+			t.status,
+			t.created_at
+		FROM board_ownership_transfer AS t
+		JOIN flow_user AS fu
+			ON fu.id = t.from_user_id
+		JOIN flow_user AS tu
+			ON tu.id = t.to_user_id
+		WHERE t.board_id = $1 AND t.status = 'pending'
+	`, boardID).Scan(
+		&transfer.ID,
+		&transfer.BoardID,
+		&transfer.FromUserID,
+		&transfer.FromUsername,
+		&transfer.ToUserID,
+		&transfer.ToUsername,
+		&transfer.Status,
+		&transfer.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.OwnershipTransfer{}, boardshrService.ErrTransferNotFound
+	}
+	if err != nil {
+		return domain.OwnershipTransfer{}, err
+	}
+
+	return transfer, nil
+}
+
+func (p *pgBoardShrStorage) ResolveOwnershipTransfer(ctx context.Context, transferID int, status string) error {
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE board_ownership_transfer
+		SET status = $1, resolved_at = NOW()
+		WHERE id = $2 AND status = 'pending'
+	`, status, transferID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return boardshrService.ErrTransferNotFound
+	}
+
+	return nil
+}
+
+// AcceptOwnershipTransfer передаёт доску соавтору, прежний автор становится admin.
+func (p *pgBoardShrStorage) AcceptOwnershipTransfer(ctx context.Context, transferID int) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var boardID, fromUserID, toUserID int
+	err = tx.QueryRowContext(ctx, `
+		SELECT board_id, from_user_id, to_user_id
+		FROM board_ownership_transfer
+		WHERE id = $1 AND status = 'pending'
+		FOR UPDATE
+	`, transferID).Scan(&boardID, &fromUserID, &toUserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return boardshrService.ErrTransferNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := handOverBoard(ctx, tx, boardID, fromUserID, toUserID, true); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE board_ownership_transfer
+		SET status = 'accepted', resolved_at = NOW()
+		WHERE id = $1
+	`, transferID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// LeaveBoard передаёт доску самому давнему соавтору, автор покидает доску.
+// Публичные пины автора остаются на доске, приватные убираются, как и у соавторов.
+func (p *pgBoardShrStorage) LeaveBoard(ctx context.Context, boardID int, authorID int) (int, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	successorID, err := findSuccessor(ctx, tx, boardID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := handOverBoard(ctx, tx, boardID, authorID, successorID, false); err != nil {
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM board_post
+		WHERE board_id = $1
+		AND flow_id IN (
+			SELECT f.id FROM flow f
+			WHERE f.author_id = $2
+			AND f.is_private = true
+		)
+	`, boardID, authorID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete private pins: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE board
+		SET flow_count = (
+			SELECT COUNT(*) FROM board_post
+			WHERE board_id = $1
+		)
+		WHERE id = $1
+	`, boardID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update flow count: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return successorID, nil
+}
+
+// handOverBoards передаёт все доски пользователя с соавторами их самым давним
+// соавторам. Доски без соавторов не трогаются и удаляются вместе с аккаунтом.
+// Работает в транзакции вызывающего, чтобы удаление аккаунта и передача досок
+// выполнялись вместе или не выполнялись вовсе.
+func handOverBoards(ctx context.Context, tx *sql.Tx, userID int) (int, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT b.id
+		FROM board AS b
+		WHERE b.author_id = $1
+			AND EXISTS (
+				SELECT 1 FROM board_coauthor AS bc
+				WHERE bc.board_id = b.id
+			)
+		ORDER BY b.id
+	`, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	var boardIDs []int
+	for rows.Next() {
+		var boardID int
+		if err := rows.Scan(&boardID); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		boardIDs = append(boardIDs, boardID)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, boardID := range boardIDs {
+		successorID, err := findSuccessor(ctx, tx, boardID)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := handOverBoard(ctx, tx, boardID, userID, successorID, false); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(boardIDs), nil
+}
+
+// findSuccessor возвращает самого давнего соавтора доски.
+func findSuccessor(ctx context.Context, tx *sql.Tx, boardID int) (int, error) {
+	var successorID int
+	err := tx.QueryRowContext(ctx, `
+		SELECT coauthor_id
+		FROM board_coauthor
+		WHERE board_id = $1
+		ORDER BY created_at, id
+		LIMIT 1
+	`, boardID).Scan(&successorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, boardshrService.ErrNoSuccessor
+	}
+
+	return successorID, err
+}
+
+// handOverBoard меняет автора доски. Если у нового автора уже есть доска
+// с таким же именем, к имени передаваемой доски добавляется её ID.
+func handOverBoard(ctx context.Context, tx *sql.Tx, boardID int, fromUserID int, toUserID int, keepPrevious bool) error {
+	result, err := tx.ExecContext(ctx, `
+		UPDATE board AS b
+		SET author_id = $2,
+			board_name = CASE
+				WHEN EXISTS (
+					SELECT 1 FROM board
+					WHERE author_id = $2 AND board_name = b.board_name
+				) THEN LEFT(b.board_name, 100) || ' (' || b.id || ')'
+				ELSE b.board_name
+			END
+		WHERE b.id = $1 AND b.author_id = $3
+	`, boardID, toUserID, fromUserID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return boardshrService.ErrForbbiden
+	}
+
+	result, err = tx.ExecContext(ctx, `
+		DELETE FROM board_coauthor
+		WHERE board_id = $1 AND coauthor_id = $2
+	`, boardID, toUserID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return boardshrService.ErrNotCoauthor
+	}
+
+	// Незавершённые предложения передачи теряют смысл.
+	_, err = tx.ExecContext(ctx, `
+		UPDATE board_ownership_transfer
+		SET status = 'cancelled', resolved_at = NOW()
+		WHERE board_id = $1 AND status = 'pending'
+	`, boardID)
+	if err != nil {
+		return err
+	}
+
+	if keepPrevious {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO board_coauthor (board_id, coauthor_id, role)
+			VALUES ($1, $2, $3)
+		`, boardID, fromUserID, domain.BoardRoleAdmin)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
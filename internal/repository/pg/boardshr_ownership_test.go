@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	boardshrService "github.com/go-park-mail-ru/2025_1_SuperChips/boardshr"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupBoardShrMock(t *testing.T) (*pgBoardShrStorage, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock DB: %v", err)
+	}
+
+	return NewBoardShrStorage(db), mock, func() { db.Close() }
+}
+
+func expectHandOver(mock sqlmock.Sqlmock, boardID, fromUserID, toUserID int) {
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE board AS b SET author_id = $2")).
+		WithArgs(boardID, toUserID, fromUserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM board_coauthor")).
+		WithArgs(boardID, toUserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE board_ownership_transfer SET status = 'cancelled'")).
+		WithArgs(boardID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func TestAcceptOwnershipTransfer_Success(t *testing.T) {
+	storage, mock, closeFn := setupBoardShrMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM board_ownership_transfer WHERE id = $1 AND status = 'pending' FOR UPDATE")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"board_id", "from_user_id", "to_user_id"}).AddRow(1, 2, 3))
+	expectHandOver(mock, 1, 2, 3)
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO board_coauthor")).
+		WithArgs(1, 2, domain.BoardRoleAdmin).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SET status = 'accepted'")).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := storage.AcceptOwnershipTransfer(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptOwnershipTransfer_NotCoauthorAnymore(t *testing.T) {
+	storage, mock, closeFn := setupBoardShrMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"board_id", "from_user_id", "to_user_id"}).AddRow(1, 2, 3))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE board AS b SET author_id = $2")).
+		WithArgs(1, 3, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM board_coauthor")).
+		WithArgs(1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := storage.AcceptOwnershipTransfer(context.Background(), 7)
+	assert.ErrorIs(t, err, boardshrService.ErrNotCoauthor)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeaveBoard_NoCoauthors(t *testing.T) {
+	storage, mock, closeFn := setupBoardShrMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY created_at, id")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"coauthor_id"}))
+	mock.ExpectRollback()
+
+	_, err := storage.LeaveBoard(context.Background(), 1, 2)
+	assert.ErrorIs(t, err, boardshrService.ErrNoSuccessor)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandOverBoards_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT b.id FROM board AS b WHERE b.author_id = $1")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(5))
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY created_at, id")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"coauthor_id"}).AddRow(3))
+	expectHandOver(mock, 1, 2, 3)
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY created_at, id")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"coauthor_id"}).AddRow(4))
+	expectHandOver(mock, 5, 2, 4)
+
+	tx, err := db.Begin()
+	assert.NoError(t, err)
+
+	count, err := handOverBoards(context.Background(), tx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
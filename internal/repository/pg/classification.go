@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+type ClassificationRepository struct {
+	db *sql.DB
+}
+
+func NewClassificationRepository(db *sql.DB) *ClassificationRepository {
+	return &ClassificationRepository{
+		db: db,
+	}
+}
+
+// ClaimJobs забирает задачи, готовые к выполнению, и задачи, аренда которых истекла
+// (например, если сервис упал во время обработки). SKIP LOCKED позволяет
+// нескольким экземплярам сервиса разбирать очередь параллельно
+func (r *ClassificationRepository) ClaimJobs(ctx context.Context, limit int, lease time.Duration) ([]domain.ClassificationJob, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	UPDATE classification_job
+	SET status = 'processing', attempts = attempts + 1, locked_until = $2, updated_at = NOW()
+	WHERE id IN (
+		SELECT id FROM classification_job
+		WHERE (status = 'pending' AND run_at <= NOW())
+		OR (status = 'processing' AND locked_until < NOW())
+		ORDER BY run_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, flow_id, media_url, attempts, created_at
+	`, limit, time.Now().Add(lease))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []domain.ClassificationJob
+	for rows.Next() {
+		var job domain.ClassificationJob
+		if err := rows.Scan(&job.ID, &job.FlowID, &job.MediaURL, &job.Attempts, &job.CreatedAt); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (r *ClassificationRepository) CompleteJob(ctx context.Context, job domain.ClassificationJob, result domain.Classification) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	status := domain.NSFWStatusSafe
+	if result.IsNSFW {
+		status = domain.NSFWStatusNSFW
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE flow
+	SET is_nsfw = $1, nsfw_status = $2
+	WHERE id = $3
+	`, result.IsNSFW, status, job.FlowID)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range result.Tags {
+		confidence := sql.NullFloat64{Float64: float64(tag.Confidence), Valid: true}
+		if err := insertFlowTag(ctx, tx, uint64(job.FlowID), tag.Name, domain.TagSourceMachine, confidence); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE classification_job
+	SET status = 'done', last_error = '', locked_until = NULL, updated_at = NOW()
+	WHERE id = $1
+	`, job.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ClassificationRepository) RetryJob(ctx context.Context, job domain.ClassificationJob, runAt time.Time, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+	UPDATE classification_job
+	SET status = 'pending', run_at = $1, last_error = $2, locked_until = NULL, updated_at = NOW()
+	WHERE id = $3
+	`, runAt, reason, job.ID)
+
+	return err
+}
+
+func (r *ClassificationRepository) FailJob(ctx context.Context, job domain.ClassificationJob, reason string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE flow
+	SET nsfw_status = 'failed'
+	WHERE id = $1
+	`, job.FlowID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	UPDATE classification_job
+	SET status = 'failed', last_error = $1, locked_until = NULL, updated_at = NOW()
+	WHERE id = $2
+	`, reason, job.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
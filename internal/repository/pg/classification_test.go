@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupClassificationMock(t *testing.T) (*ClassificationRepository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock DB: %v", err)
+	}
+
+	repo := NewClassificationRepository(db)
+	return repo, mock, func() { db.Close() }
+}
+
+func TestClaimJobs_Success(t *testing.T) {
+	repo, mock, closeFn := setupClassificationMock(t)
+	defer closeFn()
+
+	createdAt := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "flow_id", "media_url", "attempts", "created_at"}).
+		AddRow(1, 10, "a.jpg", 1, createdAt).
+		AddRow(2, 11, "b.jpg", 3, createdAt)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE SKIP LOCKED")).
+		WithArgs(10, sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	jobs, err := repo.ClaimJobs(context.Background(), 10, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ClassificationJob{
+		{ID: 1, FlowID: 10, MediaURL: "a.jpg", Attempts: 1, CreatedAt: createdAt},
+		{ID: 2, FlowID: 11, MediaURL: "b.jpg", Attempts: 3, CreatedAt: createdAt},
+	}, jobs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompleteJob_NSFW(t *testing.T) {
+	repo, mock, closeFn := setupClassificationMock(t)
+	defer closeFn()
+
+	job := domain.ClassificationJob{ID: 1, FlowID: 10}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE flow SET is_nsfw = $1, nsfw_status = $2")).
+		WithArgs(true, domain.NSFWStatusNSFW, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SET status = 'done'")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.CompleteJob(context.Background(), job, domain.Classification{IsNSFW: true})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompleteJob_MachineTags(t *testing.T) {
+	repo, mock, closeFn := setupClassificationMock(t)
+	defer closeFn()
+
+	job := domain.ClassificationJob{ID: 1, FlowID: 10}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE flow SET is_nsfw = $1, nsfw_status = $2")).
+		WithArgs(false, domain.NSFWStatusSafe, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO flow_tag")).
+		WithArgs(uint64(10), "beach", domain.TagSourceMachine, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SET status = 'done'")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.CompleteJob(context.Background(), job, domain.Classification{
+		Tags: []domain.MachineTag{{Name: "beach", Confidence: 0.8}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompleteJob_Rollback(t *testing.T) {
+	repo, mock, closeFn := setupClassificationMock(t)
+	defer closeFn()
+
+	job := domain.ClassificationJob{ID: 1, FlowID: 10}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE flow")).
+		WithArgs(false, domain.NSFWStatusSafe, 10).
+		WillReturnError(errors.New("db error"))
+	mock.ExpectRollback()
+
+	err := repo.CompleteJob(context.Background(), job, domain.Classification{})
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRetryJob_Success(t *testing.T) {
+	repo, mock, closeFn := setupClassificationMock(t)
+	defer closeFn()
+
+	runAt := time.Now().Add(time.Minute)
+
+	mock.ExpectExec(regexp.QuoteMeta("SET status = 'pending', run_at = $1, last_error = $2")).
+		WithArgs(runAt, "unavailable", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.RetryJob(context.Background(), domain.ClassificationJob{ID: 1}, runAt, "unavailable")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFailJob_Success(t *testing.T) {
+	repo, mock, closeFn := setupClassificationMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET nsfw_status = 'failed'")).
+		WithArgs(10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SET status = 'failed', last_error = $1")).
+		WithArgs("file not found", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.FailJob(context.Background(), domain.ClassificationJob{ID: 1, FlowID: 10}, "file not found")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 )
@@ -23,8 +24,9 @@ func (p *CommentRepository) CheckPinAccess(ctx context.Context, pinID, userID ui
 	SELECT EXISTS (
 		SELECT 1 FROM flow f
 		WHERE f.id = $1
+		AND (f.is_hidden = false OR f.author_id = $2)
 		AND (
-			f.is_private = false
+			(f.is_private = false AND ` + accountVisibleFilter("f.author_id", "$2") + `)
 			OR f.author_id = $2
 			OR EXISTS (
 				SELECT 1 FROM board_post bp
@@ -56,70 +58,88 @@ func (p *CommentRepository) CheckPinAccess(ctx context.Context, pinID, userID ui
 	return nil
 }
 
-func (r *CommentRepository) GetComments(ctx context.Context, flowID, userID, page, size int) ([]domain.Comment, error) {
-	var isExternalAvatar sql.NullBool
+func (r *CommentRepository) GetComments(ctx context.Context, flowID, userID, page, size int, sort string) ([]domain.Comment, error) {
 	offset := (page - 1) * size
 
 	if err := r.CheckPinAccess(ctx, uint64(flowID), uint64(userID)); err != nil {
 		return nil, err
 	}
 
-	rows, err := r.db.QueryContext(ctx, `
-    SELECT 
-        c.id, 
-        c.author_id, 
-        c.flow_id, 
-        c.contents, 
-        c.like_count, 
-        c.created_at, 
-        fu.username, 
-        fu.avatar, 
-        fu.is_external_avatar,
-        EXISTS (
-            SELECT 1 FROM comment_like cl 
-            WHERE cl.comment_id = c.id AND cl.user_id = $2
-        ) AS is_liked
+	// закреплённый комментарий всегда выводится первым
+	query := fmt.Sprintf(`
+    SELECT %s
     FROM comment c
     JOIN flow_user fu ON fu.id = c.author_id
-    LEFT JOIN flow f ON f.id = c.flow_id
-    WHERE c.flow_id = $1
-    ORDER BY c.created_at DESC
+    JOIN flow f ON f.id = c.flow_id
+    WHERE c.flow_id = $1 AND c.parent_id IS NULL
+    AND %s
+    ORDER BY c.is_pinned DESC, %s
     OFFSET $3
     LIMIT $4
-	`, flowID, userID, offset, size)
+	`, commentColumns, commentVisibility, commentOrder(sort))
+
+	rows, err := r.db.QueryContext(ctx, query, flowID, userID, offset, size)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
+	return scanComments(rows)
+}
+
+func (r *CommentRepository) GetReplies(ctx context.Context, commentID, userID, page, size int, sort string) ([]domain.Comment, error) {
+	offset := (page - 1) * size
+
+	query := fmt.Sprintf(`
+    SELECT %s
+    FROM comment c
+    JOIN flow_user fu ON fu.id = c.author_id
+    JOIN flow f ON f.id = c.flow_id
+    WHERE c.parent_id = $1
+    AND %s
+    ORDER BY %s
+    OFFSET $3
+    LIMIT $4
+	`, commentColumns, commentVisibility, commentOrder(sort))
+
+	rows, err := r.db.QueryContext(ctx, query, commentID, userID, offset, size)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
-	var comments []domain.Comment
 
-	for rows.Next() {
-		var comment domain.Comment
-		if err := rows.Scan(
-			&comment.ID,
-			&comment.AuthorID,
-			&comment.FlowID,
-			&comment.Content,
-			&comment.LikeCount,
-			&comment.Timestamp,
-			&comment.AuthorUsername,
-			&comment.AuthorAvatar,
-			&isExternalAvatar,
-			&comment.IsLiked,
-		); err != nil {
-			return nil, err
-		}
-		comment.AuthorIsExternalAvatar = isExternalAvatar.Bool
+	return scanComments(rows)
+}
 
-		comments = append(comments, comment)
+func (r *CommentRepository) GetComment(ctx context.Context, commentID int) (domain.Comment, error) {
+	var comment domain.Comment
+	var parentID sql.NullInt64
+
+	err := r.db.QueryRowContext(ctx, `
+	SELECT id, flow_id, parent_id, depth, author_id, is_pinned
+	FROM comment
+	WHERE id = $1
+	`, commentID).Scan(
+		&comment.ID,
+		&comment.FlowID,
+		&parentID,
+		&comment.Depth,
+		&comment.AuthorID,
+		&comment.IsPinned,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Comment{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Comment{}, err
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
+	if parentID.Valid {
+		id := int(parentID.Int64)
+		comment.ParentID = &id
 	}
-	
-	return comments, nil
+
+	return comment, nil
 }
 
 func (r *CommentRepository) LikeComment(ctx context.Context, commentID, userID int) (string, error) {
@@ -158,57 +178,299 @@ func (r *CommentRepository) LikeComment(ctx context.Context, commentID, userID i
     return action, nil
 }
 
-func (r *CommentRepository) AddComment(ctx context.Context, flowID, userID int, content string) error {
-	var id int
-	
+func (r *CommentRepository) AddComment(ctx context.Context, flowID, userID int, content string, parentID *int, depth int) (int, error) {
 	if err := r.CheckPinAccess(ctx, uint64(flowID), uint64(userID)); err != nil {
-		return err
+		return 0, err
 	}
 
-	err := r.db.QueryRowContext(ctx, `
-	INSERT INTO comment (author_id, flow_id, contents)
-	SELECT $1, $2, $3
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var id int
+	err = tx.QueryRowContext(ctx, `
+	INSERT INTO comment (author_id, flow_id, contents, parent_id, depth)
+	SELECT $1, $2, $3, $4, $5
 	RETURNING id;
-	`, userID, flowID, content).Scan(&id)
+	`, userID, flowID, content, parentID, depth).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.ErrForbidden
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if parentID != nil {
+		if _, err := tx.ExecContext(ctx, `
+		UPDATE comment
+		SET reply_count = reply_count + 1
+		WHERE id = $1
+		`, *parentID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *CommentRepository) DeleteComment(ctx context.Context, commentID, userID int) error {
+	var pinID int
+	err := r.db.QueryRowContext(ctx, `
+	SELECT flow_id FROM comment WHERE id = $1
+	`, commentID).Scan(&pinID)
 	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	err = r.CheckPinAccess(ctx, uint64(pinID), uint64(userID))
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// удалить комментарий может его автор или автор пина, ответы удаляются каскадно
+	var parentID sql.NullInt64
+	err = tx.QueryRowContext(ctx, `
+	DELETE FROM comment c
+	USING flow f
+	WHERE c.id = $1 AND f.id = c.flow_id
+	AND (c.author_id = $2 OR f.author_id = $2)
+	RETURNING c.parent_id
+	`, commentID, userID).Scan(&parentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrForbidden
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := decrementReplyCount(ctx, tx, parentID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// deleteCommentByID удаляет комментарий без проверки прав (модерация),
+// ответы удаляются каскадно, счетчик ответов родителя уменьшается
+func deleteCommentByID(ctx context.Context, tx *sql.Tx, commentID int) error {
+	var parentID sql.NullInt64
+	err := tx.QueryRowContext(ctx, `
+	DELETE FROM comment
+	WHERE id = $1
+	RETURNING parent_id
+	`, commentID).Scan(&parentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// комментарий уже удален автором
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return decrementReplyCount(ctx, tx, parentID)
+}
+
+func decrementReplyCount(ctx context.Context, tx *sql.Tx, parentID sql.NullInt64) error {
+	if !parentID.Valid {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+	UPDATE comment
+	SET reply_count = reply_count - 1
+	WHERE id = $1 AND reply_count > 0
+	`, parentID.Int64)
+
+	return err
+}
+
+func (r *CommentRepository) EditComment(ctx context.Context, commentID, userID int, content string) error {
+	result, err := r.db.ExecContext(ctx, `
+	UPDATE comment
+	SET contents = $3, is_edited = true, updated_at = NOW()
+	WHERE id = $1 AND author_id = $2
+	`, commentID, userID, content)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
 		return domain.ErrForbidden
 	}
+
+	return nil
+}
+
+func (r *CommentRepository) HideComment(ctx context.Context, commentID int, hidden bool) error {
+	result, err := r.db.ExecContext(ctx, `
+	UPDATE comment
+	SET is_hidden = $2
+	WHERE id = $1
+	`, commentID, hidden)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
+	if rowsAffected == 0 {
+		return domain.ErrNotFound
+	}
 
 	return nil
 }
 
-func (r *CommentRepository) DeleteComment(ctx context.Context, commentID, userID int) error {
-    var pinID int
-    err := r.db.QueryRowContext(ctx, `
-        SELECT flow_id FROM comment WHERE id = $1
-    `, commentID).Scan(&pinID)
-    if errors.Is(err, sql.ErrNoRows) {
-        return domain.ErrNotFound
-    }
-    if err != nil {
-        return err
-    }
+func (r *CommentRepository) IsFollower(ctx context.Context, userID, targetID int) (bool, error) {
+	var exists bool
 
-    err = r.CheckPinAccess(ctx, uint64(pinID), uint64(userID))
-    if err != nil {
-        return err
-    }
+	err := r.db.QueryRowContext(ctx, `
+	SELECT EXISTS (
+		SELECT 1 FROM subscription
+		WHERE user_id = $1 AND target_id = $2
+	)
+	`, userID, targetID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
 
-    var deletedID int
-    err = r.db.QueryRowContext(ctx, `
-        DELETE FROM comment
-        WHERE id = $1 AND author_id = $2
-        RETURNING id
-    `, commentID, userID).Scan(&deletedID)
-    if errors.Is(err, sql.ErrNoRows) {
-        return domain.ErrForbidden
-    }
-    if err != nil {
-        return err
-    }
+	return exists, nil
+}
+
+func (r *CommentRepository) PinComment(ctx context.Context, flowID, commentID int, pinned bool) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// у пина может быть только один закреплённый комментарий
+	if pinned {
+		if _, err := tx.ExecContext(ctx, `
+		UPDATE comment
+		SET is_pinned = false
+		WHERE flow_id = $1 AND is_pinned = true
+		`, flowID); err != nil {
+			return err
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `
+	UPDATE comment
+	SET is_pinned = $3
+	WHERE id = $1 AND flow_id = $2 AND parent_id IS NULL
+	`, commentID, flowID, pinned)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+const commentColumns = `
+        c.id, 
+        c.author_id, 
+        c.flow_id, 
+        c.parent_id, 
+        c.depth, 
+        c.reply_count, 
+        c.is_pinned, 
+        c.is_edited, 
+        c.is_hidden, 
+        c.contents, 
+        c.like_count, 
+        c.created_at, 
+        fu.username, 
+        fu.avatar, 
+        fu.is_external_avatar,
+        EXISTS (
+            SELECT 1 FROM comment_like cl 
+            WHERE cl.comment_id = c.id AND cl.user_id = $2
+        ) AS is_liked`
+
+// скрытые комментарии видят только их автор и автор пина
+const commentVisibility = `(c.is_hidden = false OR c.author_id = $2 OR f.author_id = $2)`
 
-    return nil
+func commentOrder(sort string) string {
+	switch sort {
+	case domain.CommentSortOldest:
+		return "c.created_at ASC"
+	case domain.CommentSortMostLiked:
+		return "c.like_count DESC, c.created_at DESC"
+	default:
+		return "c.created_at DESC"
+	}
+}
+
+func scanComments(rows *sql.Rows) ([]domain.Comment, error) {
+	var comments []domain.Comment
+
+	for rows.Next() {
+		var comment domain.Comment
+		var parentID sql.NullInt64
+		var isExternalAvatar sql.NullBool
+
+		if err := rows.Scan(
+			&comment.ID,
+			&comment.AuthorID,
+			&comment.FlowID,
+			&parentID,
+			&comment.Depth,
+			&comment.ReplyCount,
+			&comment.IsPinned,
+			&comment.IsEdited,
+			&comment.IsHidden,
+			&comment.Content,
+			&comment.LikeCount,
+			&comment.Timestamp,
+			&comment.AuthorUsername,
+			&comment.AuthorAvatar,
+			&isExternalAvatar,
+			&comment.IsLiked,
+		); err != nil {
+			return nil, err
+		}
+		comment.AuthorIsExternalAvatar = isExternalAvatar.Bool
+
+		if parentID.Valid {
+			id := int(parentID.Int64)
+			comment.ParentID = &id
+		}
+
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
 }
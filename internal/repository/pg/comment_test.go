@@ -23,6 +23,17 @@ func setupCommentMock(t *testing.T) (*CommentRepository, sqlmock.Sqlmock, func()
 	return repo, mock, func() { db.Close() }
 }
 
+func expectCommentPinAccess(mock sqlmock.Sqlmock, flowID, userID int) {
+	mock.ExpectQuery(regexp.QuoteMeta(`AS has_access`)).
+		WithArgs(uint64(flowID), uint64(userID)).
+		WillReturnRows(sqlmock.NewRows([]string{"has_access", "pin_exists"}).AddRow(true, true))
+}
+
+var commentRowColumns = []string{
+	"id", "author_id", "flow_id", "parent_id", "depth", "reply_count", "is_pinned", "is_edited", "is_hidden",
+	"contents", "like_count", "created_at", "username", "avatar", "is_external_avatar", "is_liked",
+}
+
 func TestGetComments_Success(t *testing.T) {
 	repo, mock, closeFn := setupCommentMock(t)
 	defer closeFn()
@@ -34,39 +45,17 @@ func TestGetComments_Success(t *testing.T) {
 	size := 10
 	offset := (page - 1) * size
 
-	rows := sqlmock.NewRows([]string{
-		"id", "author_id", "flow_id", "contents", "like_count", "created_at",
-		"username", "avatar", "is_external_avatar", "is_liked",
-	}).
-		AddRow(1, 10, 1, "First comment", 5, time.Now(), "user1", "avatar1.jpg", true, true).
-		AddRow(2, 11, 1, "Second comment", 3, time.Now(), "user2", "avatar2.jpg", false, false)
+	rows := sqlmock.NewRows(commentRowColumns).
+		AddRow(1, 10, 1, nil, 0, 2, true, true, false, "First comment", 5, time.Now(), "user1", "avatar1.jpg", true, true).
+		AddRow(2, 11, 1, nil, 0, 0, false, false, false, "Second comment", 3, time.Now(), "user2", "avatar2.jpg", false, false)
 
-	mock.ExpectQuery(regexp.QuoteMeta(`
-    SELECT 
-        c.id, 
-        c.author_id, 
-        c.flow_id, 
-        c.contents, 
-        c.like_count, 
-        c.created_at, 
-        fu.username, 
-        fu.avatar, 
-        fu.is_external_avatar,
-        EXISTS (
-            SELECT 1 FROM comment_like cl 
-            WHERE cl.comment_id = c.id AND cl.user_id = $2
-        ) AS is_liked
-    FROM comment c
-    JOIN flow_user fu ON fu.id = c.author_id
-    LEFT JOIN flow f ON f.id = c.flow_id
-    WHERE c.flow_id = $1
-    AND (f.is_private = false OR f.author_id = $2)
-    ORDER BY c.created_at DESC
-    OFFSET $3
-    LIMIT $4
-	`)).WithArgs(flowID, userID, offset, size).WillReturnRows(rows)
-
-	comments, err := repo.GetComments(ctx, flowID, userID, page, size)
+	expectCommentPinAccess(mock, flowID, userID)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE c.flow_id = $1 AND c.parent_id IS NULL
+    AND (c.is_hidden = false OR c.author_id = $2 OR f.author_id = $2)
+    ORDER BY c.is_pinned DESC, c.created_at DESC`)).
+		WithArgs(flowID, userID, offset, size).WillReturnRows(rows)
+
+	comments, err := repo.GetComments(ctx, flowID, userID, page, size, domain.CommentSortNewest)
 	assert.NoError(t, err)
 	assert.Len(t, comments, 2)
 
@@ -74,6 +63,10 @@ func TestGetComments_Success(t *testing.T) {
 	assert.Equal(t, 10, comments[0].AuthorID)
 	assert.Equal(t, "First comment", comments[0].Content)
 	assert.Equal(t, 5, comments[0].LikeCount)
+	assert.Equal(t, 2, comments[0].ReplyCount)
+	assert.True(t, comments[0].IsPinned)
+	assert.True(t, comments[0].IsEdited)
+	assert.Nil(t, comments[0].ParentID)
 	assert.Equal(t, "user1", comments[0].AuthorUsername)
 	assert.Equal(t, "avatar1.jpg", comments[0].AuthorAvatar)
 	assert.True(t, comments[0].AuthorIsExternalAvatar)
@@ -82,6 +75,23 @@ func TestGetComments_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetComments_MostLiked(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	ctx := context.Background()
+
+	expectCommentPinAccess(mock, 1, 2)
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY c.is_pinned DESC, c.like_count DESC, c.created_at DESC`)).
+		WithArgs(1, 2, 0, 10).WillReturnRows(sqlmock.NewRows(commentRowColumns))
+
+	comments, err := repo.GetComments(ctx, 1, 2, 1, 10, domain.CommentSortMostLiked)
+	assert.NoError(t, err)
+	assert.Empty(t, comments)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetComments_EmptyResult(t *testing.T) {
 	repo, mock, closeFn := setupCommentMock(t)
 	defer closeFn()
@@ -93,35 +103,11 @@ func TestGetComments_EmptyResult(t *testing.T) {
 	size := 10
 	offset := (page - 1) * size
 
-	mock.ExpectQuery(regexp.QuoteMeta(`
-    SELECT 
-        c.id, 
-        c.author_id, 
-        c.flow_id, 
-        c.contents, 
-        c.like_count, 
-        c.created_at, 
-        fu.username, 
-        fu.avatar, 
-        fu.is_external_avatar,
-        EXISTS (
-            SELECT 1 FROM comment_like cl 
-            WHERE cl.comment_id = c.id AND cl.user_id = $2
-        ) AS is_liked
-    FROM comment c
-    JOIN flow_user fu ON fu.id = c.author_id
-    LEFT JOIN flow f ON f.id = c.flow_id
-    WHERE c.flow_id = $1
-    AND (f.is_private = false OR f.author_id = $2)
-    ORDER BY c.created_at DESC
-    OFFSET $3
-    LIMIT $4
-	`)).WithArgs(flowID, userID, offset, size).WillReturnRows(sqlmock.NewRows([]string{
-		"id", "author_id", "flow_id", "contents", "like_count", "created_at",
-		"username", "avatar", "is_external_avatar", "is_liked",
-	}))
-
-	comments, err := repo.GetComments(ctx, flowID, userID, page, size)
+	expectCommentPinAccess(mock, flowID, userID)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM comment c`)).
+		WithArgs(flowID, userID, offset, size).WillReturnRows(sqlmock.NewRows(commentRowColumns))
+
+	comments, err := repo.GetComments(ctx, flowID, userID, page, size, domain.CommentSortNewest)
 	assert.NoError(t, err)
 	assert.Empty(t, comments)
 
@@ -139,38 +125,53 @@ func TestGetComments_DatabaseError(t *testing.T) {
 	size := 10
 	offset := (page - 1) * size
 
-	mock.ExpectQuery(regexp.QuoteMeta(`
-    SELECT 
-        c.id, 
-        c.author_id, 
-        c.flow_id, 
-        c.contents, 
-        c.like_count, 
-        c.created_at, 
-        fu.username, 
-        fu.avatar, 
-        fu.is_external_avatar,
-        EXISTS (
-            SELECT 1 FROM comment_like cl 
-            WHERE cl.comment_id = c.id AND cl.user_id = $2
-        ) AS is_liked
-    FROM comment c
-    JOIN flow_user fu ON fu.id = c.author_id
-    LEFT JOIN flow f ON f.id = c.flow_id
-    WHERE c.flow_id = $1
-    AND (f.is_private = false OR f.author_id = $2)
-    ORDER BY c.created_at DESC
-    OFFSET $3
-    LIMIT $4
-	`)).WithArgs(flowID, userID, offset, size).WillReturnError(errors.New("database error"))
-
-	comments, err := repo.GetComments(ctx, flowID, userID, page, size)
+	expectCommentPinAccess(mock, flowID, userID)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM comment c`)).
+		WithArgs(flowID, userID, offset, size).WillReturnError(errors.New("database error"))
+
+	comments, err := repo.GetComments(ctx, flowID, userID, page, size, domain.CommentSortNewest)
 	assert.Error(t, err)
 	assert.Nil(t, comments)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetReplies_Success(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	ctx := context.Background()
+
+	rows := sqlmock.NewRows(commentRowColumns).
+		AddRow(5, 10, 1, 1, 1, 0, false, false, false, "Reply", 0, time.Now(), "user1", "", false, false)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE c.parent_id = $1
+    AND (c.is_hidden = false OR c.author_id = $2 OR f.author_id = $2)
+    ORDER BY c.created_at ASC`)).
+		WithArgs(1, 2, 0, 10).WillReturnRows(rows)
+
+	replies, err := repo.GetReplies(ctx, 1, 2, 1, 10, domain.CommentSortOldest)
+	assert.NoError(t, err)
+	assert.Len(t, replies, 1)
+	assert.Equal(t, 1, *replies[0].ParentID)
+	assert.Equal(t, 1, replies[0].Depth)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetComment_NotFound(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM comment`)).
+		WithArgs(1).WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetComment(context.Background(), 1)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestLikeComment_InsertLike(t *testing.T) {
 	repo, mock, closeFn := setupCommentMock(t)
 	defer closeFn()
@@ -297,14 +298,43 @@ func TestAddComment_Success(t *testing.T) {
 	userID := 2
 	content := "Test comment"
 
+	expectCommentPinAccess(mock, flowID, userID)
+	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(`
-	INSERT INTO comment (author_id, flow_id, contents)
-	SELECT $1, $2, $3
+	INSERT INTO comment (author_id, flow_id, contents, parent_id, depth)
+	SELECT $1, $2, $3, $4, $5
 	RETURNING id;
-	`)).WithArgs(userID, flowID, content).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	`)).WithArgs(userID, flowID, content, nil, 0).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
 
-	err := repo.AddComment(ctx, flowID, userID, content)
+	id, err := repo.AddComment(ctx, flowID, userID, content, nil, 0)
 	assert.NoError(t, err)
+	assert.Equal(t, 1, id)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddComment_Reply(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	ctx := context.Background()
+	flowID := 1
+	userID := 2
+	parentID := 7
+	content := "Test reply"
+
+	expectCommentPinAccess(mock, flowID, userID)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO comment`)).
+		WithArgs(userID, flowID, content, &parentID, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(8))
+	mock.ExpectExec(regexp.QuoteMeta(`SET reply_count = reply_count + 1`)).
+		WithArgs(parentID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	id, err := repo.AddComment(ctx, flowID, userID, content, &parentID, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, id)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -318,13 +348,11 @@ func TestAddComment_Forbidden(t *testing.T) {
 	userID := 2
 	content := "Test comment"
 
-	mock.ExpectQuery(regexp.QuoteMeta(`
-	INSERT INTO comment (author_id, flow_id, contents)
-	SELECT $1, $2, $3
-	RETURNING id;
-	`)).WithArgs(userID, flowID, content).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta(`AS has_access`)).
+		WithArgs(uint64(flowID), uint64(userID)).
+		WillReturnRows(sqlmock.NewRows([]string{"has_access", "pin_exists"}).AddRow(false, true))
 
-	err := repo.AddComment(ctx, flowID, userID, content)
+	_, err := repo.AddComment(ctx, flowID, userID, content, nil, 0)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrForbidden, err)
 
@@ -339,11 +367,20 @@ func TestDeleteComment_Success(t *testing.T) {
 	commentID := 1
 	userID := 2
 
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT flow_id FROM comment WHERE id = $1`)).
+		WithArgs(commentID).WillReturnRows(sqlmock.NewRows([]string{"flow_id"}).AddRow(3))
+	expectCommentPinAccess(mock, 3, userID)
+	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(`
-	DELETE FROM comment
-	WHERE id = $1 AND author_id = $2
-	RETURNING id
-	`)).WithArgs(commentID, userID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	DELETE FROM comment c
+	USING flow f
+	WHERE c.id = $1 AND f.id = c.flow_id
+	AND (c.author_id = $2 OR f.author_id = $2)
+	RETURNING c.parent_id
+	`)).WithArgs(commentID, userID).WillReturnRows(sqlmock.NewRows([]string{"parent_id"}).AddRow(5))
+	mock.ExpectExec(regexp.QuoteMeta(`SET reply_count = reply_count - 1`)).
+		WithArgs(int64(5)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
 	err := repo.DeleteComment(ctx, commentID, userID)
 	assert.NoError(t, err)
@@ -359,15 +396,110 @@ func TestDeleteComment_Forbidden(t *testing.T) {
 	commentID := 1
 	userID := 2
 
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT flow_id FROM comment WHERE id = $1`)).
+		WithArgs(commentID).WillReturnRows(sqlmock.NewRows([]string{"flow_id"}).AddRow(3))
+	expectCommentPinAccess(mock, 3, userID)
+	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(`
-	DELETE FROM comment
-	WHERE id = $1 AND author_id = $2
-	RETURNING id
+	DELETE FROM comment c
+	USING flow f
+	WHERE c.id = $1 AND f.id = c.flow_id
+	AND (c.author_id = $2 OR f.author_id = $2)
+	RETURNING c.parent_id
 	`)).WithArgs(commentID, userID).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
 
 	err := repo.DeleteComment(ctx, commentID, userID)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrForbidden, err)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
-}
\ No newline at end of file
+}
+
+func TestPinComment_Success(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`SET is_pinned = false`)).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`SET is_pinned = $3`)).
+		WithArgs(4, 1, true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.PinComment(context.Background(), 1, 4, true)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPinComment_NotFound(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`SET is_pinned = $3`)).
+		WithArgs(4, 1, false).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := repo.PinComment(context.Background(), 1, 4, false)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEditComment_Success(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	mock.ExpectExec(regexp.QuoteMeta(`
+	UPDATE comment
+	SET contents = $3, is_edited = true, updated_at = NOW()
+	WHERE id = $1 AND author_id = $2
+	`)).WithArgs(1, 2, "Edited").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.EditComment(context.Background(), 1, 2, "Edited")
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEditComment_Forbidden(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	mock.ExpectExec(regexp.QuoteMeta(`SET contents = $3, is_edited = true`)).
+		WithArgs(1, 3, "Edited").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.EditComment(context.Background(), 1, 3, "Edited")
+	assert.Equal(t, domain.ErrForbidden, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHideComment_NotFound(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	mock.ExpectExec(regexp.QuoteMeta(`SET is_hidden = $2`)).
+		WithArgs(1, true).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.HideComment(context.Background(), 1, true)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIsFollower(t *testing.T) {
+	repo, mock, closeFn := setupCommentMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM subscription`)).
+		WithArgs(2, 5).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	isFollower, err := repo.IsFollower(context.Background(), 2, 5)
+	assert.NoError(t, err)
+	assert.True(t, isFollower)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+func (repo *SubscriptionStorage) IsPrivateAccount(ctx context.Context, username string) (bool, error) {
+	var isPrivate bool
+	err := repo.db.QueryRowContext(ctx, `
+	SELECT is_private_account
+	FROM flow_user
+	WHERE username = $1
+	`, username).Scan(&isPrivate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, domain.ErrNotFound
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return isPrivate, nil
+}
+
+// CreateFollowRequest отправляет заявку на подписку. Если пользователь уже
+// подписан или заявка уже отправлена, возвращается ErrConflict
+func (repo *SubscriptionStorage) CreateFollowRequest(ctx context.Context, targetUsername string, currentID int) error {
+	var targetID int
+	err := repo.db.QueryRowContext(ctx, `
+	SELECT id FROM flow_user
+	WHERE username = $1
+	`, targetUsername).Scan(&targetID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if currentID == targetID {
+		return domain.ErrValidation
+	}
+
+	res, err := repo.db.ExecContext(ctx, `
+	INSERT INTO subscription_request (user_id, target_id)
+	SELECT $1, $2
+	WHERE NOT EXISTS (
+		SELECT 1 FROM subscription WHERE user_id = $1 AND target_id = $2
+	)
+	ON CONFLICT (user_id, target_id) DO NOTHING
+	`, currentID, targetID)
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrConflict
+	}
+
+	return nil
+}
+
+func (repo *SubscriptionStorage) CancelFollowRequest(ctx context.Context, targetUsername string, currentID int) error {
+	res, err := repo.db.ExecContext(ctx, `
+	DELETE FROM subscription_request
+	WHERE user_id = $1 AND target_id = (SELECT id FROM flow_user WHERE username = $2)
+	`, currentID, targetUsername)
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetFollowRequests возвращает ожидающие заявки, сначала новые
+func (repo *SubscriptionStorage) GetFollowRequests(ctx context.Context, targetID, page, size int) ([]domain.FollowRequest, error) {
+	offset := (page - 1) * size
+	rows, err := repo.db.QueryContext(ctx, `
+	SELECT
+		u.username,
+		u.avatar,
+		u.public_name,
+		u.subscriber_count,
+		u.is_external_avatar,
+		sr.created_at
+	FROM subscription_request AS sr
+	JOIN flow_user AS u ON u.id = sr.user_id
+	WHERE sr.target_id = $1
+	ORDER BY sr.created_at DESC, sr.user_id
+	OFFSET $2
+	LIMIT $3
+	`, targetID, offset, size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := []domain.FollowRequest{}
+	for rows.Next() {
+		var user userDB
+		var createdAt time.Time
+		err := rows.Scan(
+			&user.Username,
+			&user.Avatar,
+			&user.PublicName,
+			&user.SubscriberCount,
+			&user.IsExternalAvatar,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, domain.FollowRequest{
+			User: domain.PublicUser{
+				Username:         user.Username,
+				Avatar:           user.Avatar.String,
+				PublicName:       user.PublicName,
+				SubscriberCount:  int(user.SubscriberCount.Int64),
+				IsExternalAvatar: user.IsExternalAvatar.Bool,
+			},
+			CreatedAt: createdAt,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// AcceptFollowRequest превращает заявку в подписку и возвращает id подписчика
+func (repo *SubscriptionStorage) AcceptFollowRequest(ctx context.Context, targetID int, requesterUsername string) (int, error) {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var requesterID int
+	err = tx.QueryRowContext(ctx, `
+	DELETE FROM subscription_request
+	WHERE target_id = $1 AND user_id = (SELECT id FROM flow_user WHERE username = $2)
+	RETURNING user_id
+	`, targetID, requesterUsername).Scan(&requesterID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.ErrFollowRequestNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tx.ExecContext(ctx, `
+	INSERT INTO subscription (user_id, target_id)
+	VALUES ($1, $2)
+	ON CONFLICT (user_id, target_id) DO NOTHING
+	`, requesterID, targetID)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if count > 0 {
+		if _, err := tx.ExecContext(ctx, `
+		UPDATE flow_user
+		SET subscriber_count = subscriber_count + 1
+		WHERE id = $1
+		`, targetID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return requesterID, nil
+}
+
+func (repo *SubscriptionStorage) DeclineFollowRequest(ctx context.Context, targetID int, requesterUsername string) error {
+	res, err := repo.db.ExecContext(ctx, `
+	DELETE FROM subscription_request
+	WHERE target_id = $1 AND user_id = (SELECT id FROM flow_user WHERE username = $2)
+	`, targetID, requesterUsername)
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrFollowRequestNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupFollowRequestMock(t *testing.T) (*SubscriptionStorage, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock: %v", err)
+	}
+
+	return NewSubscriptionStorage(db), mock, func() { db.Close() }
+}
+
+func TestCreateFollowRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		targetID int
+		inserted int64
+		expected error
+	}{
+		{"Сценарий: новая заявка", 2, 1, nil},
+		{"Сценарий: заявка уже отправлена или есть подписка", 2, 0, domain.ErrConflict},
+		{"Сценарий: заявка самому себе", 1, 0, domain.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeFn := setupFollowRequestMock(t)
+			defer closeFn()
+
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM flow_user WHERE username = $1")).
+				WithArgs("target").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(tt.targetID))
+			if tt.targetID != 1 {
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO subscription_request (user_id, target_id)")).
+					WithArgs(1, tt.targetID).
+					WillReturnResult(sqlmock.NewResult(0, tt.inserted))
+			}
+
+			err := repo.CreateFollowRequest(context.Background(), "target", 1)
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetFollowRequests(t *testing.T) {
+	repo, mock, closeFn := setupFollowRequestMock(t)
+	defer closeFn()
+
+	createdAt := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM subscription_request AS sr")).
+		WithArgs(2, 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"username", "avatar", "public_name", "subscriber_count", "is_external_avatar", "created_at"}).
+			AddRow("requester", "avatar.png", "Requester", int64(3), false, createdAt))
+
+	requests, err := repo.GetFollowRequests(context.Background(), 2, 2, 10)
+	assert.NoError(t, err)
+	assert.Len(t, requests, 1)
+	assert.Equal(t, "requester", requests[0].User.Username)
+	assert.Equal(t, 3, requests[0].User.SubscriberCount)
+	assert.Equal(t, createdAt, requests[0].CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptFollowRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		found    bool
+		inserted int64
+		expected error
+	}{
+		{"Сценарий: заявка одобрена", true, 1, nil},
+		{"Сценарий: подписка уже есть", true, 0, nil},
+		{"Сценарий: заявки нет", false, 0, domain.ErrFollowRequestNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeFn := setupFollowRequestMock(t)
+			defer closeFn()
+
+			mock.ExpectBegin()
+			query := mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM subscription_request")).
+				WithArgs(2, "requester")
+			if !tt.found {
+				query.WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO subscription (user_id, target_id)")).
+					WithArgs(5, 2).
+					WillReturnResult(sqlmock.NewResult(0, tt.inserted))
+				if tt.inserted > 0 {
+					mock.ExpectExec(regexp.QuoteMeta("SET subscriber_count = subscriber_count + 1")).
+						WithArgs(2).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+				mock.ExpectCommit()
+			}
+
+			requesterID, err := repo.AcceptFollowRequest(context.Background(), 2, "requester")
+			assert.ErrorIs(t, err, tt.expected)
+			if tt.found {
+				assert.Equal(t, 5, requesterID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeclineFollowRequest(t *testing.T) {
+	repo, mock, closeFn := setupFollowRequestMock(t)
+	defer closeFn()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM subscription_request")).
+		WithArgs(2, "stranger").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.DeclineFollowRequest(context.Background(), 2, "stranger")
+	assert.ErrorIs(t, err, domain.ErrFollowRequestNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+func (p *pgUserStorage) FindUserByIdentity(ctx context.Context, provider, subject string) (int, string, string, error) {
+	var id int
+	var email string
+	var username string
+	var suspended bool
+
+	err := p.db.QueryRowContext(ctx, `
+	SELECT u.id, u.email, u.username, u.is_suspended
+	FROM user_identity ui
+	JOIN flow_user u ON u.id = ui.user_id
+	WHERE ui.provider = $1 AND ui.subject = $2
+	`, provider, subject).Scan(&id, &email, &username, &suspended)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, "", "", domain.ErrNotFound
+	}
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	// заблокированный модератором не входит ни по паролю, ни через провайдера
+	if suspended {
+		return 0, "", "", domain.ErrAccountSuspended
+	}
+
+	return id, email, username, nil
+}
+
+// AddIdentityUser регистрирует пользователя без пароля и сразу привязывает к нему провайдера
+func (p *pgUserStorage) AddIdentityUser(ctx context.Context, identity domain.OAuthIdentity, username, password string) (uint64, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var id uint64
+
+	err = tx.QueryRowContext(ctx, `
+	WITH conflict_check AS (
+		SELECT id
+		FROM flow_user
+		WHERE email = $3 OR username = $1
+	), reserved_check AS (
+		SELECT user_id
+		FROM username_history
+		WHERE old_username = $1 AND reserved_until > NOW()
+	)
+	INSERT INTO flow_user (username, public_name, email, password, external_id, avatar, is_external_avatar)
+	SELECT $1, $2, $3, $4, $5, $6, $7
+	WHERE NOT EXISTS (SELECT 1 FROM conflict_check)
+	AND NOT EXISTS (SELECT 1 FROM reserved_check)
+	RETURNING id
+	`, username, username, identity.Email, password, identity.Subject, identity.Avatar, identity.Avatar != "").Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.ErrConflict
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tx.ExecContext(ctx, `
+	INSERT INTO user_identity (user_id, provider, subject, email)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT DO NOTHING
+	`, id, identity.Provider, identity.Subject, identity.Email)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, domain.ErrIdentityLinked
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// LinkIdentity привязывает провайдера к существующему аккаунту. Повторная
+// привязка того же внешнего аккаунта ошибкой не считается
+func (p *pgUserStorage) LinkIdentity(ctx context.Context, userID int, identity domain.OAuthIdentity) error {
+	res, err := p.db.ExecContext(ctx, `
+	INSERT INTO user_identity (user_id, provider, subject, email)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT DO NOTHING
+	`, userID, identity.Provider, identity.Subject, identity.Email)
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	var ownerID int
+	err = p.db.QueryRowContext(ctx, `
+	SELECT user_id
+	FROM user_identity
+	WHERE provider = $1 AND subject = $2
+	`, identity.Provider, identity.Subject).Scan(&ownerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// у пользователя уже привязан другой аккаунт этого провайдера
+		return domain.ErrConflict
+	}
+	if err != nil {
+		return err
+	}
+
+	if ownerID != userID {
+		return domain.ErrIdentityLinked
+	}
+
+	return nil
+}
+
+// UnlinkIdentity отвязывает провайдера, если после этого пользователь
+// сможет войти: по паролю или через другого провайдера
+func (p *pgUserStorage) UnlinkIdentity(ctx context.Context, userID int, provider string) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// блокировка строки пользователя не дает параллельно отвязать всех провайдеров
+	var externalID sql.NullString
+	err = tx.QueryRowContext(ctx, `
+	SELECT external_id
+	FROM flow_user
+	WHERE id = $1
+	FOR UPDATE
+	`, userID).Scan(&externalID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	var linked, others int
+	err = tx.QueryRowContext(ctx, `
+	SELECT
+		COUNT(*) FILTER (WHERE provider = $2),
+		COUNT(*) FILTER (WHERE provider <> $2)
+	FROM user_identity
+	WHERE user_id = $1
+	`, userID, provider).Scan(&linked, &others)
+	if err != nil {
+		return err
+	}
+
+	if linked == 0 {
+		return domain.ErrIdentityNotFound
+	}
+
+	hasPassword := externalID.String == ""
+	if !hasPassword && others == 0 {
+		return domain.ErrLastLoginMethod
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	DELETE FROM user_identity
+	WHERE user_id = $1 AND provider = $2
+	`, userID, provider); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (p *pgUserStorage) GetIdentities(ctx context.Context, userID int) ([]domain.LinkedIdentity, error) {
+	rows, err := p.db.QueryContext(ctx, `
+	SELECT provider, email, created_at
+	FROM user_identity
+	WHERE user_id = $1
+	ORDER BY created_at
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := []domain.LinkedIdentity{}
+	for rows.Next() {
+		var identity domain.LinkedIdentity
+		var email sql.NullString
+
+		if err := rows.Scan(&identity.Provider, &email, &identity.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		identity.Email = email.String
+		identities = append(identities, identity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return identities, nil
+}
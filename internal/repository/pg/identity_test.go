@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindUserByIdentity(t *testing.T) {
+	repo, mock, closeFn := setupTwoFactorMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE ui.provider = $1 AND ui.subject = $2")).
+		WithArgs("google", "sub-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "username", "is_suspended"}).
+			AddRow(3, "user@mail.ru", "user", false))
+
+	id, email, username, err := repo.FindUserByIdentity(context.Background(), "google", "sub-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, id)
+	assert.Equal(t, "user@mail.ru", email)
+	assert.Equal(t, "user", username)
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE ui.provider = $1 AND ui.subject = $2")).
+		WithArgs("google", "sub-2").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "username", "is_suspended"}))
+
+	_, _, _, err = repo.FindUserByIdentity(context.Background(), "google", "sub-2")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE ui.provider = $1 AND ui.subject = $2")).
+		WithArgs("vk", "vk-3").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "username", "is_suspended"}).
+			AddRow(4, "banned@vk.com", "banned", true))
+
+	_, _, _, err = repo.FindUserByIdentity(context.Background(), "vk", "vk-3")
+	assert.ErrorIs(t, err, domain.ErrAccountSuspended)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddIdentityUser(t *testing.T) {
+	repo, mock, closeFn := setupTwoFactorMock(t)
+	defer closeFn()
+
+	identity := domain.OAuthIdentity{
+		Provider: "yandex",
+		Subject:  "42",
+		Email:    "user@ya.ru",
+		Avatar:   "https://avatars.yandex.net/get-yapic/1/islands-200",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO flow_user")).
+		WithArgs("user", "user", "user@ya.ru", "hash", "42", identity.Avatar, true).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO user_identity (user_id, provider, subject, email)")).
+		WithArgs(uint64(7), "yandex", "42", "user@ya.ru").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	id, err := repo.AddIdentityUser(context.Background(), identity, "user", "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLinkIdentity(t *testing.T) {
+	identity := domain.OAuthIdentity{Provider: "google", Subject: "sub", Email: "user@gmail.com"}
+
+	tests := []struct {
+		name     string
+		affected int64
+		owner    *int
+		expected error
+	}{
+		{"Сценарий: новая привязка", 1, nil, nil},
+		{"Сценарий: уже привязан к этому пользователю", 0, intPtr(1), nil},
+		{"Сценарий: привязан к другому пользователю", 0, intPtr(2), domain.ErrIdentityLinked},
+		{"Сценарий: у пользователя другой аккаунт провайдера", 0, nil, domain.ErrConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeFn := setupTwoFactorMock(t)
+			defer closeFn()
+
+			mock.ExpectExec(regexp.QuoteMeta("INSERT INTO user_identity (user_id, provider, subject, email)")).
+				WithArgs(1, "google", "sub", "user@gmail.com").
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			if tt.affected == 0 {
+				rows := sqlmock.NewRows([]string{"user_id"})
+				if tt.owner != nil {
+					rows.AddRow(*tt.owner)
+				}
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id FROM user_identity")).
+					WithArgs("google", "sub").
+					WillReturnRows(rows)
+			}
+
+			err := repo.LinkIdentity(context.Background(), 1, identity)
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUnlinkIdentity(t *testing.T) {
+	tests := []struct {
+		name       string
+		externalID interface{}
+		linked     int
+		others     int
+		expected   error
+	}{
+		{"Сценарий: у пользователя есть пароль", nil, 1, 0, nil},
+		{"Сценарий: остается другой провайдер", "vk-id", 1, 1, nil},
+		{"Сценарий: последний способ входа", "vk-id", 1, 0, domain.ErrLastLoginMethod},
+		{"Сценарий: провайдер не привязан", nil, 0, 1, domain.ErrIdentityNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeFn := setupTwoFactorMock(t)
+			defer closeFn()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT external_id FROM flow_user WHERE id = $1 FOR UPDATE")).
+				WithArgs(1).
+				WillReturnRows(sqlmock.NewRows([]string{"external_id"}).AddRow(tt.externalID))
+			mock.ExpectQuery(regexp.QuoteMeta("FROM user_identity WHERE user_id = $1")).
+				WithArgs(1, "vk").
+				WillReturnRows(sqlmock.NewRows([]string{"linked", "others"}).AddRow(tt.linked, tt.others))
+
+			if tt.expected == nil {
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM user_identity WHERE user_id = $1 AND provider = $2")).
+					WithArgs(1, "vk").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			err := repo.UnlinkIdentity(context.Background(), 1, "vk")
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetIdentities(t *testing.T) {
+	repo, mock, closeFn := setupTwoFactorMock(t)
+	defer closeFn()
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT provider, email, created_at FROM user_identity")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"provider", "email", "created_at"}).
+			AddRow("vk", nil, now).
+			AddRow("google", "user@gmail.com", now))
+
+	identities, err := repo.GetIdentities(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.LinkedIdentity{
+		{Provider: "vk", CreatedAt: now},
+		{Provider: "google", Email: "user@gmail.com", CreatedAt: now},
+	}, identities)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func intPtr(v int) *int {
+	return &v
+}
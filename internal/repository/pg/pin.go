@@ -2,6 +2,8 @@ package repository
 
 import (
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	pin "github.com/go-park-mail-ru/2025_1_SuperChips/domain"
@@ -18,9 +20,17 @@ type flowDBSchema struct {
 	IsPrivate      bool
 	IsNSFW         bool
 	LikeCount      int
+	SaveCount      int
 	MediaURL       string
 	Width          sql.NullInt64
 	Height         sql.NullInt64
+	CommentsEnabled       bool
+	CommentsFollowersOnly bool
+	NSFWStatus            string
+	PublishAt             sql.NullTime
+	IsDraft               bool
+	Link                  sql.NullString
+	SourceDomain          sql.NullString
 }
 
 type pgPinStorage struct {
@@ -28,14 +38,17 @@ type pgPinStorage struct {
 	imgDir     string
 	baseURL    string
 	imgStrgURL string
+	// не показывать пины, которые ещё не прошли проверку на NSFW
+	hideUnclassified bool
 }
 
-func NewPGPinStorage(db *sql.DB, imgDir, baseURL string) (*pgPinStorage, error) {
+func NewPGPinStorage(db *sql.DB, imgDir, baseURL string, hideUnclassified bool) (*pgPinStorage, error) {
 	storage := &pgPinStorage{
-		db:         db,
-		imgDir:     imgDir,
-		baseURL:    baseURL,
-		imgStrgURL: baseURL + strings.ReplaceAll(imgDir, ".", ""),
+		db:               db,
+		imgDir:           imgDir,
+		baseURL:          baseURL,
+		imgStrgURL:       baseURL + strings.ReplaceAll(imgDir, ".", ""),
+		hideUnclassified: hideUnclassified,
 	}
 
 	return storage, nil
@@ -45,7 +58,76 @@ func (p *pgPinStorage) assembleMediaURL(fileName string) string {
 	return p.imgStrgURL + "/" + fileName
 }
 
-func (p *pgPinStorage) GetPins(page int, pageSize int) ([]pin.PinData, error) {
+// flowMediaColumn собирает элементы флоу f в JSON-массив по порядку.
+// Приводится к тексту, чтобы работать и в SELECT DISTINCT
+const flowMediaColumn = `COALESCE((
+		SELECT json_agg(json_build_object(
+			'type', fm.media_type,
+			'url', fm.media_url,
+			'width', fm.width,
+			'height', fm.height,
+			'duration_ms', fm.duration_ms,
+			'poster_url', fm.poster_url
+		) ORDER BY fm.position)
+		FROM flow_media fm
+		WHERE fm.flow_id = f.id
+	)::TEXT, '[]') AS media`
+
+func nullableString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func parseFlowMedia(raw string) ([]pin.FlowMedia, error) {
+	var media []pin.FlowMedia
+	if err := json.Unmarshal([]byte(raw), &media); err != nil {
+		return nil, fmt.Errorf("failed to parse flow media: %w", err)
+	}
+
+	return media, nil
+}
+
+// classifiedFilter возвращает условие, скрывающее непроверенные пины,
+// если это включено в конфиге
+func classifiedFilter(hideUnclassified bool, condition string) string {
+	if !hideUnclassified {
+		return ""
+	}
+
+	return " AND " + condition
+}
+
+// classifiedCondition — пин уже проверен, доступность NSFW решает nsfwFilter
+const classifiedCondition = "f.nsfw_status IN ('safe', 'nsfw')"
+
+// classifiedOrOwnCondition — как classifiedCondition, но автор видит свои
+// пины и до окончания проверки
+func classifiedOrOwnCondition(viewerParam string) string {
+	return "(" + classifiedCondition + " OR f.author_id = " + viewerParam + ")"
+}
+
+// nsfwFilter скрывает NSFW пины от пользователей, которым они недоступны
+func nsfwFilter(nsfwMode string) string {
+	if nsfwMode == pin.NSFWModeHide {
+		return " AND f.is_nsfw = false"
+	}
+
+	return ""
+}
+
+// nsfwOrOwnFilter — как nsfwFilter, но свои пины автор видит всегда
+func nsfwOrOwnFilter(nsfwMode, viewerParam string) string {
+	if nsfwMode == pin.NSFWModeHide {
+		return " AND (f.is_nsfw = false OR f.author_id = " + viewerParam + ")"
+	}
+
+	return ""
+}
+
+// flowPopularity — сигнал ранжирования: сохранение весит больше лайка
+const flowPopularity = `(f.like_count + 2 * f.save_count)`
+
+// GetPins отдаёт свежие пины, внутри одного дня сначала популярные
+func (p *pgPinStorage) GetPins(page int, pageSize int, nsfwMode string) ([]pin.PinData, error) {
 	rows, err := p.db.Query(`
 	SELECT 
 		f.id, 
@@ -57,11 +139,15 @@ func (p *pgPinStorage) GetPins(page int, pageSize int) ([]pin.PinData, error) {
 		f.width,
 		f.height,
 		f.is_nsfw,
-		fu.username
+		fu.username,
+		f.save_count,
+		`+flowMediaColumn+`
 	FROM flow f
 	JOIN flow_user fu ON f.author_id = fu.id
-	WHERE f.is_private = false AND f.is_nsfw = false
-	ORDER BY f.created_at DESC
+	WHERE f.is_private = false AND f.is_hidden = false AND `+publicAccountFilter("f.author_id")+
+	nsfwFilter(nsfwMode)+
+	classifiedFilter(p.hideUnclassified, "f.nsfw_status = 'safe'")+`
+	ORDER BY date_trunc('day', f.created_at) DESC, `+flowPopularity+` DESC, f.id DESC
 	LIMIT $1
 	OFFSET $2
 	`, pageSize, (page-1)*pageSize)
@@ -75,9 +161,15 @@ func (p *pgPinStorage) GetPins(page int, pageSize int) ([]pin.PinData, error) {
 
 	for rows.Next() {
 		var flowDBRow flowDBSchema
+		var rawMedia string
 		err := rows.Scan(&flowDBRow.ID, &flowDBRow.Title, &flowDBRow.Description,
 		&flowDBRow.AuthorId, &flowDBRow.IsPrivate, &flowDBRow.MediaURL, &flowDBRow.Width,
-		&flowDBRow.Height, &flowDBRow.IsNSFW, &flowDBRow.AuthorUsername)
+		&flowDBRow.Height, &flowDBRow.IsNSFW, &flowDBRow.AuthorUsername, &flowDBRow.SaveCount, &rawMedia)
+		if err != nil {
+			return nil, err
+		}
+
+		media, err := parseFlowMedia(rawMedia)
 		if err != nil {
 			return nil, err
 		}
@@ -86,12 +178,15 @@ func (p *pgPinStorage) GetPins(page int, pageSize int) ([]pin.PinData, error) {
 			FlowID:         flowDBRow.ID,
 			Description:    flowDBRow.Description.String,
 			Header:         flowDBRow.Title.String,
-			MediaURL:       p.assembleMediaURL(flowDBRow.MediaURL),
+			MediaURL:       flowDBRow.MediaURL,
 			Width: int(flowDBRow.Width.Int64),
 			Height: int(flowDBRow.Height.Int64),
 			IsNSFW: flowDBRow.IsNSFW,
 			AuthorUsername: flowDBRow.AuthorUsername,
+			SaveCount:      flowDBRow.SaveCount,
+			Media:          media,
 		}
+		pin.MapMediaURLs(p.assembleMediaURL)
 		pins = append(pins, pin)
 	}
 
@@ -34,6 +34,8 @@ func TestGetPins(t *testing.T) {
             Height:         0,
             IsNSFW:         false,
             AuthorUsername: "emresha",
+            SaveCount:      2,
+            Media:          []domain.FlowMedia{{Type: domain.MediaTypeImage, URL: "/media_url1"}},
         },
         {
             FlowID:         3,
@@ -46,6 +48,7 @@ func TestGetPins(t *testing.T) {
             Height:         0,
             IsNSFW:         false,
             AuthorUsername: "valekir",
+            Media:          []domain.FlowMedia{},
         },
     }
 
@@ -60,23 +63,29 @@ func TestGetPins(t *testing.T) {
             f.width, 
             f.height, 
             f.is_nsfw, 
-            fu.username 
+            fu.username, 
+            f.save_count, 
+            COALESCE(( SELECT json_agg(json_build_object( 'type', fm.media_type, 'url', fm.media_url, 
+            'width', fm.width, 'height', fm.height, 'duration_ms', fm.duration_ms, 'poster_url', fm.poster_url ) 
+            ORDER BY fm.position) FROM flow_media fm WHERE fm.flow_id = f.id )::TEXT, '[]') AS media 
         FROM flow f 
         JOIN flow_user fu ON f.author_id = fu.id 
-        WHERE f.is_private = false AND f.is_nsfw = false 
-        ORDER BY f.created_at DESC 
+        WHERE f.is_private = false AND f.is_hidden = false 
+        AND NOT EXISTS ( SELECT 1 FROM flow_user AS pa WHERE pa.id = f.author_id AND pa.is_private_account ) 
+        AND f.is_nsfw = false 
+        ORDER BY date_trunc('day', f.created_at) DESC, (f.like_count + 2 * f.save_count) DESC, f.id DESC 
         LIMIT $1 OFFSET $2`,
     )).WithArgs(pageSize, (page-1)*pageSize).
         WillReturnRows(sqlmock.NewRows([]string{
-            "id", "title", "description", "author_id", "is_private", "media_url", "width", "height", "is_nsfw", "username",
+            "id", "title", "description", "author_id", "is_private", "media_url", "width", "height", "is_nsfw", "username", "save_count", "media",
         }).
-            AddRow(1, "title1", "description1", 1, false, "media_url1", 0, 0, false, "emresha").
-            AddRow(3, "title3", "description3", 3, false, "media_url3", 0, 0, false, "valekir"))
+            AddRow(1, "title1", "description1", 1, false, "media_url1", 0, 0, false, "emresha", 2, `[{"type":"image","url":"media_url1","width":0,"height":0,"duration_ms":0,"poster_url":""}]`).
+            AddRow(3, "title3", "description3", 3, false, "media_url3", 0, 0, false, "valekir", 0, "[]"))
 
-    repo, err := pg.NewPGPinStorage(db, "", "")
+    repo, err := pg.NewPGPinStorage(db, "", "", false)
     require.NoError(t, err)
 
-    pins, err := repo.GetPins(page, pageSize)
+    pins, err := repo.GetPins(page, pageSize, domain.NSFWModeHide)
     require.NoError(t, err)
 
     assert.Equal(t, expectedPins, pins)
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	pincrudService "github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
+)
+
+func (p *pgPinStorage) GetDrafts(ctx context.Context, userID uint64, page, pageSize int) ([]domain.PinData, error) {
+	rows, err := p.db.QueryContext(ctx, `
+	SELECT
+		f.id,
+		f.title,
+		f.description,
+		f.media_url,
+		f.width,
+		f.height,
+		fu.username
+	FROM flow f
+	JOIN flow_user fu ON f.author_id = fu.id
+	WHERE f.author_id = $1 AND f.is_draft = true
+	ORDER BY f.created_at DESC, f.id DESC
+	LIMIT $2
+	OFFSET $3
+	`, userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, pincrudService.ErrUntracked
+	}
+	defer rows.Close()
+
+	pins := []domain.PinData{}
+	for rows.Next() {
+		var flowDBRow flowDBSchema
+		if err := rows.Scan(&flowDBRow.ID, &flowDBRow.Title, &flowDBRow.Description,
+			&flowDBRow.MediaURL, &flowDBRow.Width, &flowDBRow.Height,
+			&flowDBRow.AuthorUsername); err != nil {
+			return nil, pincrudService.ErrUntracked
+		}
+
+		pins = append(pins, domain.PinData{
+			FlowID:         flowDBRow.ID,
+			Header:         flowDBRow.Title.String,
+			Description:    flowDBRow.Description.String,
+			AuthorID:       userID,
+			AuthorUsername: flowDBRow.AuthorUsername,
+			MediaURL:       p.assembleMediaURL(flowDBRow.MediaURL),
+			IsPrivate:      true,
+			Width:          int(flowDBRow.Width.Int64),
+			Height:         int(flowDBRow.Height.Int64),
+			IsDraft:        true,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, pincrudService.ErrUntracked
+	}
+
+	return pins, nil
+}
+
+// PublishDraft делает черновик публичным. Дата создания обновляется,
+// чтобы флоу попал в начало ленты.
+func (p *pgPinStorage) PublishDraft(ctx context.Context, pinID, userID uint64) error {
+	res, err := p.db.ExecContext(ctx, `
+	UPDATE flow
+	SET is_draft = false, is_private = false, created_at = NOW()
+	WHERE id = $1 AND author_id = $2 AND is_draft = true
+	`, pinID, userID)
+	if err != nil {
+		return pincrudService.ErrUntracked
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return pincrudService.ErrUntracked
+	}
+	if count < 1 {
+		return pincrudService.ErrNotDraft
+	}
+
+	return nil
+}
@@ -22,19 +22,31 @@ func (p *pgPinStorage) GetPin(ctx context.Context, pinID, userID uint64) (domain
 		f.media_url,
 		fu.username,
 		f.like_count,
+		f.save_count,
 		f.width,
 		f.height,
 		f.is_nsfw,
+		f.comments_enabled,
+		f.comments_followers_only,
+		f.nsfw_status,
+		f.publish_at,
+		f.is_draft,
+		f.link,
+		f.source_domain,
 		CASE 
 			WHEN fl.user_id IS NOT NULL THEN true
 			ELSE false
-		END AS is_liked
+		END AS is_liked,
+		`+flowMediaColumn+`,
+		`+flowTagsColumn+`
 	FROM flow f
 	JOIN flow_user fu ON f.author_id = fu.id
 	LEFT JOIN flow_like fl ON fl.flow_id = f.id AND fl.user_id = $2
 	WHERE f.id = $1
+	AND (f.is_hidden = false OR f.author_id = $2)`+
+	classifiedFilter(p.hideUnclassified, classifiedOrOwnCondition("$2"))+`
 	AND (
-		f.is_private = false
+		(f.is_private = false AND `+accountVisibleFilter("f.author_id", "$2")+`)
 		OR f.author_id = $2
 		OR EXISTS (
 			SELECT 1 FROM board_post bp
@@ -49,10 +61,13 @@ func (p *pgPinStorage) GetPin(ctx context.Context, pinID, userID uint64) (domain
     `, pinID, userID)
 
 	var isLiked bool
+	var rawMedia, rawTags string
 	var flowDBRow flowDBSchema
 	err := row.Scan(&flowDBRow.ID, &flowDBRow.Title, &flowDBRow.Description,
 		&flowDBRow.AuthorId, &flowDBRow.IsPrivate, &flowDBRow.MediaURL,
-		&flowDBRow.AuthorUsername, &flowDBRow.LikeCount, &flowDBRow.Width, &flowDBRow.Height, &flowDBRow.IsNSFW, &isLiked)
+		&flowDBRow.AuthorUsername, &flowDBRow.LikeCount, &flowDBRow.SaveCount, &flowDBRow.Width, &flowDBRow.Height, &flowDBRow.IsNSFW,
+		&flowDBRow.CommentsEnabled, &flowDBRow.CommentsFollowersOnly, &flowDBRow.NSFWStatus, &flowDBRow.PublishAt, &flowDBRow.IsDraft,
+		&flowDBRow.Link, &flowDBRow.SourceDomain, &isLiked, &rawMedia, &rawTags)
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.PinData{}, 0, pincrudService.ErrPinNotFound
 	}
@@ -60,18 +75,46 @@ func (p *pgPinStorage) GetPin(ctx context.Context, pinID, userID uint64) (domain
 		return domain.PinData{}, 0, pincrudService.ErrUntracked
 	}
 
+	media, err := parseFlowMedia(rawMedia)
+	if err != nil {
+		return domain.PinData{}, 0, pincrudService.ErrUntracked
+	}
+
+	tags, err := parseFlowTags(rawTags)
+	if err != nil {
+		return domain.PinData{}, 0, pincrudService.ErrUntracked
+	}
+
 	pin := domain.PinData{
 		FlowID:         flowDBRow.ID,
 		Header:         flowDBRow.Title.String,
+		AuthorID:       flowDBRow.AuthorId,
 		AuthorUsername: flowDBRow.AuthorUsername,
 		Description:    flowDBRow.Description.String,
-		MediaURL:       p.assembleMediaURL(flowDBRow.MediaURL),
+		MediaURL:       flowDBRow.MediaURL,
 		IsPrivate:      flowDBRow.IsPrivate,
 		LikeCount:      flowDBRow.LikeCount,
+		SaveCount:      flowDBRow.SaveCount,
 		IsLiked:        isLiked,
 		Width:          int(flowDBRow.Width.Int64),
 		Height:         int(flowDBRow.Height.Int64),
 		IsNSFW:         flowDBRow.IsNSFW,
+		CommentsEnabled:       flowDBRow.CommentsEnabled,
+		CommentsFollowersOnly: flowDBRow.CommentsFollowersOnly,
+		Media:          media,
+		Link:           flowDBRow.Link.String,
+		SourceDomain:   flowDBRow.SourceDomain.String,
+		Tags:           tags,
+	}
+	pin.MapMediaURLs(p.assembleMediaURL)
+
+	// статус проверки и время публикации интересны только автору
+	if userID == flowDBRow.AuthorId {
+		pin.NSFWStatus = flowDBRow.NSFWStatus
+		pin.IsDraft = flowDBRow.IsDraft
+		if flowDBRow.PublishAt.Valid {
+			pin.PublishAt = &flowDBRow.PublishAt.Time
+		}
 	}
 
 	return pin, flowDBRow.AuthorId, nil
@@ -99,6 +142,37 @@ func (p *pgPinStorage) GetPinCleanMediaURL(ctx context.Context, pinID uint64) (s
 	return mediaURL, authorID, nil
 }
 
+// GetFlowMediaFiles возвращает имена всех файлов элементов флоу, включая постеры видео
+func (p *pgPinStorage) GetFlowMediaFiles(ctx context.Context, pinID uint64) ([]string, error) {
+	rows, err := p.db.QueryContext(ctx, `
+	SELECT media_url, poster_url
+	FROM flow_media
+	WHERE flow_id = $1
+	ORDER BY position
+	`, pinID)
+	if err != nil {
+		return nil, pincrudService.ErrUntracked
+	}
+	defer rows.Close()
+
+	var files []string
+	for rows.Next() {
+		var mediaURL, posterURL string
+		if err := rows.Scan(&mediaURL, &posterURL); err != nil {
+			return nil, pincrudService.ErrUntracked
+		}
+		files = append(files, mediaURL)
+		if posterURL != "" {
+			files = append(files, posterURL)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, pincrudService.ErrUntracked
+	}
+
+	return files, nil
+}
+
 func (p *pgPinStorage) GetFromBoard(ctx context.Context, boardID, userID, flowID int) (domain.PinData, int, error) {
 	row := p.db.QueryRowContext(ctx, `
 		SELECT DISTINCT
@@ -112,10 +186,15 @@ func (p *pgPinStorage) GetFromBoard(ctx context.Context, boardID, userID, flowID
             f.like_count,
 			f.width,
 			f.height,
+			f.is_nsfw,
             CASE 
                 WHEN fl.user_id IS NOT NULL THEN true
                 ELSE false
-            END AS is_liked
+            END AS is_liked,
+			f.save_count,
+			sb.id,
+			sb.board_name,
+			su.username
         FROM flow AS f
 		JOIN board_post AS bp 
 			ON f.id = bp.flow_id
@@ -125,13 +204,21 @@ func (p *pgPinStorage) GetFromBoard(ctx context.Context, boardID, userID, flowID
 			ON f.author_id = fu.id
 		LEFT JOIN flow_like AS fl 
 			ON fl.flow_id = f.id AND fl.user_id = $2
+		LEFT JOIN board AS sb
+			ON sb.id = bp.saved_from_board_id AND sb.is_private = false AND sb.is_secret = false
+		LEFT JOIN flow_user AS su
+			ON su.id = sb.author_id
 		WHERE 
 			f.id = $3
 			AND bp.board_id = $1
+			AND (f.is_hidden = false OR f.author_id = $2)
+			AND `+accountVisibleFilter("f.author_id", "$2")+`
     `, boardID, userID, flowID)
 	
 	var isLiked bool
 	var flowDBRow flowDBSchema
+	var savedFromID sql.NullInt64
+	var savedFromName, savedFromUsername sql.NullString
 	err := row.Scan(
 		&flowDBRow.ID,
 		&flowDBRow.Title,
@@ -143,7 +230,12 @@ func (p *pgPinStorage) GetFromBoard(ctx context.Context, boardID, userID, flowID
 		&flowDBRow.LikeCount,
 		&flowDBRow.Width,
 		&flowDBRow.Height,
-		&isLiked)
+		&flowDBRow.IsNSFW,
+		&isLiked,
+		&flowDBRow.SaveCount,
+		&savedFromID,
+		&savedFromName,
+		&savedFromUsername)
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.PinData{}, 0, pincrudService.ErrPinNotFound
 	}
@@ -162,6 +254,18 @@ func (p *pgPinStorage) GetFromBoard(ctx context.Context, boardID, userID, flowID
 		IsLiked:        isLiked,
 		Width:          int(flowDBRow.Width.Int64),
 		Height:         int(flowDBRow.Height.Int64),
+		IsNSFW:         flowDBRow.IsNSFW,
+		AuthorID:       flowDBRow.AuthorId,
+		SaveCount:      flowDBRow.SaveCount,
+	}
+
+	// исходная доска показывается, пока она публичная
+	if savedFromID.Valid {
+		pin.SavedFrom = &domain.SaveAttribution{
+			BoardID:   int(savedFromID.Int64),
+			BoardName: savedFromName.String,
+			Username:  savedFromUsername.String,
+		}
 	}
 
 	return pin, int(flowDBRow.AuthorId), nil
@@ -188,16 +292,20 @@ func (p *pgPinStorage) DeletePin(ctx context.Context, pinID uint64, userID uint6
 func (p *pgPinStorage) UpdatePin(ctx context.Context, patch domain.PinDataUpdate, userID uint64) error {
 	var fields []string
 	var values []any
+	// условия, при которых правка меняет текст и нужна ревизия
+	var textChanges []string
 	paramCounter := 1
 
 	if patch.Header != nil {
 		fields = append(fields, fmt.Sprintf("%v = $%d", "title", paramCounter))
+		textChanges = append(textChanges, fmt.Sprintf("title IS DISTINCT FROM $%d", paramCounter))
 		values = append(values, *patch.Header)
 		paramCounter++
 	}
 
 	if patch.Description != nil {
 		fields = append(fields, fmt.Sprintf("%v = $%d", "description", paramCounter))
+		textChanges = append(textChanges, fmt.Sprintf("description IS DISTINCT FROM $%d", paramCounter))
 		values = append(values, *patch.Description)
 		paramCounter++
 	}
@@ -208,6 +316,24 @@ func (p *pgPinStorage) UpdatePin(ctx context.Context, patch domain.PinDataUpdate
 		paramCounter++
 	}
 
+	if patch.CommentsEnabled != nil {
+		fields = append(fields, fmt.Sprintf("%v = $%d", "comments_enabled", paramCounter))
+		values = append(values, *patch.CommentsEnabled)
+		paramCounter++
+	}
+
+	if patch.CommentsFollowersOnly != nil {
+		fields = append(fields, fmt.Sprintf("%v = $%d", "comments_followers_only", paramCounter))
+		values = append(values, *patch.CommentsFollowersOnly)
+		paramCounter++
+	}
+
+	if patch.Link != nil {
+		fields = append(fields, fmt.Sprintf("link = $%d, source_domain = $%d", paramCounter, paramCounter+1))
+		values = append(values, nullableString(*patch.Link), nullableString(patch.SourceDomain))
+		paramCounter += 2
+	}
+
 	if len(fields) == 0 {
 		return pincrudService.ErrNoFieldsToUpdate
 	}
@@ -219,6 +345,20 @@ func (p *pgPinStorage) UpdatePin(ctx context.Context, patch domain.PinDataUpdate
 		paramCounter+1,
 	)
 
+	// все части запроса видят флоу до обновления, поэтому ревизия
+	// сохраняет прежний текст в той же операции
+	if len(textChanges) > 0 {
+		sqlQuery = fmt.Sprintf(`WITH revision AS (
+		INSERT INTO flow_revision (flow_id, title, description)
+		SELECT id, title, description FROM flow
+		WHERE id = $%d AND author_id = $%d AND (%s)
+	) `,
+			paramCounter,
+			paramCounter+1,
+			strings.Join(textChanges, " OR "),
+		) + sqlQuery
+	}
+
 	values = append(values, patch.FlowID)
 	values = append(values, userID)
 
@@ -246,10 +386,11 @@ func (p *pgPinStorage) CreatePin(ctx context.Context, data domain.PinDataCreate,
 	defer tx.Rollback()
 
 	row := tx.QueryRowContext(ctx, `
-        INSERT INTO flow (title, description, author_id, is_private, media_url, width, height)
-        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        INSERT INTO flow (title, description, author_id, is_private, media_url, width, height, publish_at, is_draft, link, source_domain)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING id
-    `, data.Header, data.Description, userID, data.IsPrivate, imgName, data.Width, data.Height)
+    `, data.Header, data.Description, userID, data.IsPrivate, imgName, data.Width, data.Height, data.PublishAt, data.IsDraft,
+		nullableString(data.Link), nullableString(data.SourceDomain))
 
 	var pinID uint64
 	err = row.Scan(&pinID)
@@ -257,6 +398,15 @@ func (p *pgPinStorage) CreatePin(ctx context.Context, data domain.PinDataCreate,
 		return 0, err
 	}
 
+	// задача на проверку сохраняется в той же транзакции, чтобы не потеряться при падении сервиса
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO classification_job (flow_id, media_url)
+	VALUES ($1, $2)
+	`, pinID, imgName)
+	if err != nil {
+		return 0, err
+	}
+
 	for i := range data.Colors {
 		_, err := tx.ExecContext(ctx, `
 		INSERT INTO color
@@ -268,9 +418,51 @@ func (p *pgPinStorage) CreatePin(ctx context.Context, data domain.PinDataCreate,
 		}
 	}
 
+	for i, media := range data.Media {
+		_, err := tx.ExecContext(ctx, `
+		INSERT INTO flow_media
+		(flow_id, position, media_type, media_url, width, height, duration_ms, poster_url)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`, pinID, i, media.Type, media.URL, media.Width, media.Height, media.DurationMs, media.PosterURL)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for _, tag := range data.Tags {
+		if err := insertFlowTag(ctx, tx, pinID, tag, domain.TagSourceUser, sql.NullFloat64{}); err != nil {
+			return 0, err
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
 
 	return pinID, nil
 }
+
+// SetUserTags заменяет хэштеги автора, машинные теги не трогает
+func (p *pgPinStorage) SetUserTags(ctx context.Context, pinID uint64, tags []string) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+	DELETE FROM flow_tag
+	WHERE flow_id = $1 AND source = $2
+	`, pinID, domain.TagSourceUser)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if err := insertFlowTag(ctx, tx, pinID, tag, domain.TagSourceUser, sql.NullFloat64{}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
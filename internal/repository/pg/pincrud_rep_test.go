@@ -87,6 +87,23 @@ func TestGetPinCleanMediaURL_Success(t *testing.T) {
 	assert.Equal(t, uint64(2), authorID)
 }
 
+func TestGetFlowMediaFiles(t *testing.T) {
+	mock, storage := setupPinMock(t)
+	defer mock.ExpectClose()
+
+	rows := sqlmock.NewRows([]string{"media_url", "poster_url"}).
+		AddRow("first.jpg", "").
+		AddRow("clip.mp4", "clip.jpg")
+
+	mock.ExpectQuery("SELECT media_url, poster_url FROM flow_media").
+		WithArgs(uint64(1)).
+		WillReturnRows(rows)
+
+	files, err := storage.GetFlowMediaFiles(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first.jpg", "clip.mp4", "clip.jpg"}, files)
+}
+
 func TestDeletePin_Success(t *testing.T) {
 	mock, storage := setupPinMock(t)
 	defer mock.ExpectClose()
@@ -178,9 +195,13 @@ func TestCreatePin_Success(t *testing.T) {
     mock.ExpectBegin()
 
     mock.ExpectQuery("INSERT INTO flow").
-        WithArgs("Test Pin", "Test Description", userID, false, imgName, 400, 400).
+        WithArgs("Test Pin", "Test Description", userID, false, imgName, 400, 400, nil, false, nil, nil).
         WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
+    mock.ExpectExec("INSERT INTO classification_job").
+        WithArgs(1, imgName).
+        WillReturnResult(sqlmock.NewResult(1, 1))
+
     for _, color := range data.Colors {
         mock.ExpectExec("INSERT INTO color").
             WithArgs(1, color).
@@ -203,3 +224,65 @@ func ptrString(s string) *string {
 func ptrBool(b bool) *bool {
 	return &b
 }
+
+func TestUpdatePin_SavesRevision(t *testing.T) {
+	mock, storage := setupPinMock(t)
+	defer mock.ExpectClose()
+
+	var num uint64 = 1
+
+	patch := domain.PinDataUpdate{
+		FlowID:      &num,
+		Description: ptrString("New Description"),
+	}
+
+	mock.ExpectExec(`WITH revision AS \( INSERT INTO flow_revision \(flow_id, title, description\) SELECT id, title, description FROM flow WHERE id = \$2 AND author_id = \$3 AND \(description IS DISTINCT FROM \$1\) \) UPDATE flow SET description = \$1 WHERE id = \$2 AND author_id = \$3`).
+		WithArgs("New Description", uint64(1), uint64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := storage.UpdatePin(context.Background(), patch, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePin_CommentsOnlyWithoutRevision(t *testing.T) {
+	mock, storage := setupPinMock(t)
+	defer mock.ExpectClose()
+
+	var num uint64 = 1
+
+	patch := domain.PinDataUpdate{
+		FlowID:          &num,
+		CommentsEnabled: ptrBool(false),
+	}
+
+	mock.ExpectExec(`^UPDATE flow SET comments_enabled = \$1 WHERE id = \$2 AND author_id = \$3$`).
+		WithArgs(false, uint64(1), uint64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := storage.UpdatePin(context.Background(), patch, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePin_Link(t *testing.T) {
+	mock, storage := setupPinMock(t)
+	defer mock.ExpectClose()
+
+	var num uint64 = 1
+	link := "https://example.com/page"
+
+	patch := domain.PinDataUpdate{
+		FlowID:       &num,
+		Link:         &link,
+		SourceDomain: "example.com",
+	}
+
+	mock.ExpectExec(`^UPDATE flow SET link = \$1, source_domain = \$2 WHERE id = \$3 AND author_id = \$4$`).
+		WithArgs(link, "example.com", uint64(1), uint64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := storage.UpdatePin(context.Background(), patch, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	pincrudService "github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
+)
+
+type flowRevisionDBSchema struct {
+	ID          int
+	Title       sql.NullString
+	Description sql.NullString
+	CreatedAt   sql.NullTime
+}
+
+func (r flowRevisionDBSchema) toDomain() domain.FlowRevision {
+	return domain.FlowRevision{
+		ID:          r.ID,
+		Header:      r.Title.String,
+		Description: r.Description.String,
+		CreatedAt:   r.CreatedAt.Time,
+	}
+}
+
+// GetRevisions возвращает последние ревизии флоу, начиная с самой новой
+func (p *pgPinStorage) GetRevisions(ctx context.Context, pinID uint64, limit int) ([]domain.FlowRevision, error) {
+	rows, err := p.db.QueryContext(ctx, `
+	SELECT id, title, description, created_at
+	FROM flow_revision
+	WHERE flow_id = $1
+	ORDER BY id DESC
+	LIMIT $2
+	`, pinID, limit)
+	if err != nil {
+		return nil, pincrudService.ErrUntracked
+	}
+	defer rows.Close()
+
+	revisions := []domain.FlowRevision{}
+	for rows.Next() {
+		var row flowRevisionDBSchema
+		if err := rows.Scan(&row.ID, &row.Title, &row.Description, &row.CreatedAt); err != nil {
+			return nil, pincrudService.ErrUntracked
+		}
+		revisions = append(revisions, row.toDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, pincrudService.ErrUntracked
+	}
+
+	return revisions, nil
+}
+
+func (p *pgPinStorage) GetRevision(ctx context.Context, pinID uint64, revisionID int) (domain.FlowRevision, error) {
+	var row flowRevisionDBSchema
+	err := p.db.QueryRowContext(ctx, `
+	SELECT id, title, description, created_at
+	FROM flow_revision
+	WHERE id = $1 AND flow_id = $2
+	`, revisionID, pinID).Scan(&row.ID, &row.Title, &row.Description, &row.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.FlowRevision{}, pincrudService.ErrRevisionNotFound
+	}
+	if err != nil {
+		return domain.FlowRevision{}, pincrudService.ErrUntracked
+	}
+
+	return row.toDomain(), nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	pincrudService "github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRevisions(t *testing.T) {
+	mock, storage := setupPinMock(t)
+
+	createdAt := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT id, title, description, created_at FROM flow_revision WHERE flow_id = \$1 ORDER BY id DESC LIMIT \$2`).
+		WithArgs(uint64(7), 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "created_at"}).
+			AddRow(2, "закат", nil, createdAt).
+			AddRow(1, "рассвет", "фото", createdAt))
+
+	revisions, err := storage.GetRevisions(context.Background(), 7, 50)
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, "", revisions[0].Description)
+	assert.Equal(t, "рассвет", revisions[1].Header)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRevision_NotFound(t *testing.T) {
+	mock, storage := setupPinMock(t)
+
+	mock.ExpectQuery(`SELECT id, title, description, created_at FROM flow_revision WHERE id = \$1 AND flow_id = \$2`).
+		WithArgs(3, uint64(7)).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := storage.GetRevision(context.Background(), 7, 3)
+	assert.Equal(t, pincrudService.ErrRevisionNotFound, err)
+}
+
+func TestPublishDraft_NotDraft(t *testing.T) {
+	mock, storage := setupPinMock(t)
+
+	mock.ExpectExec(`UPDATE flow SET is_draft = false, is_private = false, created_at = NOW\(\) WHERE id = \$1 AND author_id = \$2 AND is_draft = true`).
+		WithArgs(uint64(7), uint64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := storage.PublishDraft(context.Background(), 7, 2)
+	assert.Equal(t, pincrudService.ErrNotDraft, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
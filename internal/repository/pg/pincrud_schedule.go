@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	pincrudService "github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
+)
+
+func (p *pgPinStorage) GetScheduledPins(ctx context.Context, userID uint64, page, pageSize int) ([]domain.PinData, error) {
+	rows, err := p.db.QueryContext(ctx, `
+	SELECT
+		f.id,
+		f.title,
+		f.description,
+		f.media_url,
+		f.width,
+		f.height,
+		f.publish_at,
+		fu.username
+	FROM flow f
+	JOIN flow_user fu ON f.author_id = fu.id
+	WHERE f.author_id = $1 AND f.publish_at IS NOT NULL
+	ORDER BY f.publish_at, f.id
+	LIMIT $2
+	OFFSET $3
+	`, userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, pincrudService.ErrUntracked
+	}
+	defer rows.Close()
+
+	pins := []domain.PinData{}
+	for rows.Next() {
+		var flowDBRow flowDBSchema
+		if err := rows.Scan(&flowDBRow.ID, &flowDBRow.Title, &flowDBRow.Description,
+			&flowDBRow.MediaURL, &flowDBRow.Width, &flowDBRow.Height,
+			&flowDBRow.PublishAt, &flowDBRow.AuthorUsername); err != nil {
+			return nil, pincrudService.ErrUntracked
+		}
+
+		publishAt := flowDBRow.PublishAt.Time
+		pins = append(pins, domain.PinData{
+			FlowID:         flowDBRow.ID,
+			Header:         flowDBRow.Title.String,
+			Description:    flowDBRow.Description.String,
+			AuthorID:       userID,
+			AuthorUsername: flowDBRow.AuthorUsername,
+			MediaURL:       p.assembleMediaURL(flowDBRow.MediaURL),
+			IsPrivate:      true,
+			Width:          int(flowDBRow.Width.Int64),
+			Height:         int(flowDBRow.Height.Int64),
+			PublishAt:      &publishAt,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, pincrudService.ErrUntracked
+	}
+
+	return pins, nil
+}
+
+func (p *pgPinStorage) ReschedulePin(ctx context.Context, pinID, userID uint64, publishAt time.Time) error {
+	res, err := p.db.ExecContext(ctx, `
+	UPDATE flow
+	SET publish_at = $1
+	WHERE id = $2 AND author_id = $3 AND publish_at IS NOT NULL
+	`, publishAt, pinID, userID)
+	if err != nil {
+		return pincrudService.ErrUntracked
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return pincrudService.ErrUntracked
+	}
+	// флоу мог быть опубликован планировщиком между проверкой и запросом
+	if count < 1 {
+		return pincrudService.ErrNotScheduled
+	}
+
+	return nil
+}
+
+// CancelSchedule отменяет публикацию, флоу остаётся приватным
+func (p *pgPinStorage) CancelSchedule(ctx context.Context, pinID, userID uint64) error {
+	res, err := p.db.ExecContext(ctx, `
+	UPDATE flow
+	SET publish_at = NULL
+	WHERE id = $1 AND author_id = $2 AND publish_at IS NOT NULL
+	`, pinID, userID)
+	if err != nil {
+		return pincrudService.ErrUntracked
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return pincrudService.ErrUntracked
+	}
+	if count < 1 {
+		return pincrudService.ErrNotScheduled
+	}
+
+	return nil
+}
+
+// PublishDuePins публикует флоу, время которых наступило. Дата создания
+// сдвигается на время публикации, чтобы флоу попал в начало ленты.
+// SKIP LOCKED позволяет запускать несколько экземпляров сервиса.
+func (p *pgPinStorage) PublishDuePins(ctx context.Context, limit int) ([]uint64, error) {
+	rows, err := p.db.QueryContext(ctx, `
+	UPDATE flow
+	SET is_private = false, created_at = publish_at, publish_at = NULL
+	WHERE id IN (
+		SELECT id FROM flow
+		WHERE publish_at <= NOW()
+		ORDER BY publish_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
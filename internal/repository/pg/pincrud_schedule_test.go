@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	pincrudService "github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetScheduledPins(t *testing.T) {
+	mock, storage := setupPinMock(t)
+	storage.imgStrgURL = "https://yourflow.ru/static/img"
+
+	publishAt := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT .* FROM flow f JOIN flow_user fu ON f.author_id = fu.id WHERE f.author_id = \$1 AND f.publish_at IS NOT NULL`).
+		WithArgs(uint64(2), 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "media_url", "width", "height", "publish_at", "username"}).
+			AddRow(7, "Title", "", "a.jpg", 300, 400, publishAt, "user1"))
+
+	pins, err := storage.GetScheduledPins(context.Background(), 2, 2, 10)
+	assert.NoError(t, err)
+	assert.Len(t, pins, 1)
+	assert.Equal(t, uint64(7), pins[0].FlowID)
+	assert.True(t, pins[0].IsPrivate)
+	assert.Equal(t, publishAt, *pins[0].PublishAt)
+	assert.Equal(t, "https://yourflow.ru/static/img/a.jpg", pins[0].MediaURL)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReschedulePin_NotScheduled(t *testing.T) {
+	mock, storage := setupPinMock(t)
+
+	publishAt := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`UPDATE flow SET publish_at = \$1 WHERE id = \$2 AND author_id = \$3 AND publish_at IS NOT NULL`).
+		WithArgs(publishAt, uint64(7), uint64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := storage.ReschedulePin(context.Background(), 7, 2, publishAt)
+	assert.Equal(t, pincrudService.ErrNotScheduled, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelSchedule(t *testing.T) {
+	mock, storage := setupPinMock(t)
+
+	mock.ExpectExec(`UPDATE flow SET publish_at = NULL WHERE id = \$1 AND author_id = \$2 AND publish_at IS NOT NULL`).
+		WithArgs(uint64(7), uint64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := storage.CancelSchedule(context.Background(), 7, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPublishDuePins(t *testing.T) {
+	mock, storage := setupPinMock(t)
+
+	mock.ExpectQuery(`UPDATE flow SET is_private = false, created_at = publish_at, publish_at = NULL WHERE id IN \(.*FOR UPDATE SKIP LOCKED \) RETURNING id`).
+		WithArgs(100).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(4))
+
+	ids, err := storage.PublishDuePins(context.Background(), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
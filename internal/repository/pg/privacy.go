@@ -0,0 +1,20 @@
+package repository
+
+// publicAccountFilter пропускает только авторов с открытым аккаунтом. Ленты,
+// поиск и страницы тегов не показывают закрытые аккаунты никому: их флоу
+// и доски подписчики видят в профиле и на досках
+func publicAccountFilter(authorColumn string) string {
+	return `NOT EXISTS (
+		SELECT 1 FROM flow_user AS pa
+		WHERE pa.id = ` + authorColumn + ` AND pa.is_private_account
+	)`
+}
+
+// accountVisibleFilter пропускает автора, если его аккаунт открыт, если это
+// сам зритель или если зритель - одобренный подписчик закрытого аккаунта
+func accountVisibleFilter(authorColumn, viewerParam string) string {
+	return `(` + authorColumn + ` = ` + viewerParam + ` OR ` + publicAccountFilter(authorColumn) + ` OR EXISTS (
+		SELECT 1 FROM subscription AS ps
+		WHERE ps.target_id = ` + authorColumn + ` AND ps.user_id = ` + viewerParam + `
+	))`
+}
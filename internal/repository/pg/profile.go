@@ -1,8 +1,10 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 )
@@ -22,11 +24,12 @@ func NewPGProfileStorage(db *sql.DB) (*pgProfileStorage, error) {
 func (p *pgProfileStorage) GetUserPublicInfoByEmail(email string) (domain.User, error) {
 	var userDB userDB
 	var externalID sql.NullString
+	var isPrivateAccount bool
 
 	err := p.db.QueryRow(`
-		SELECT id, username, email, avatar, birthday, about, public_name, external_id, is_external_avatar, subscriber_count
+		SELECT id, username, email, avatar, birthday, about, public_name, external_id, is_external_avatar, subscriber_count, is_private_account
 		FROM flow_user WHERE email = $1
-	`, email).Scan(&userDB.ID, &userDB.Username, &userDB.Email, &userDB.Avatar, &userDB.Birthday, &userDB.About, &userDB.PublicName, &externalID, &userDB.IsExternalAvatar, &userDB.SubscriberCount)
+	`, email).Scan(&userDB.ID, &userDB.Username, &userDB.Email, &userDB.Avatar, &userDB.Birthday, &userDB.About, &userDB.PublicName, &externalID, &userDB.IsExternalAvatar, &userDB.SubscriberCount, &isPrivateAccount)
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		return domain.User{}, domain.ErrUserNotFound
 	} else if err != nil {
@@ -44,6 +47,7 @@ func (p *pgProfileStorage) GetUserPublicInfoByEmail(email string) (domain.User,
 		IsExternal: externalID.String != "",
 		IsExternalAvatar: userDB.IsExternalAvatar.Bool,
 		SubscriberCount: int(userDB.SubscriberCount.Int64),
+		IsPrivateAccount: isPrivateAccount,
 	}
 
 	return user, nil
@@ -51,11 +55,12 @@ func (p *pgProfileStorage) GetUserPublicInfoByEmail(email string) (domain.User,
 
 func (p *pgProfileStorage) GetUserPublicInfoByUsername(username string) (domain.User, error) {
 	var userDB userDB
+	var isPrivateAccount bool
 
 	err := p.db.QueryRow(`
-		SELECT id, username, email, avatar, birthday, about, public_name, is_external_avatar, subscriber_count
+		SELECT id, username, email, avatar, birthday, about, public_name, is_external_avatar, subscriber_count, is_private_account
 		FROM flow_user WHERE username = $1
-	`, username).Scan(&userDB.ID, &userDB.Username, &userDB.Email, &userDB.Avatar, &userDB.Birthday, &userDB.About, &userDB.PublicName, &userDB.IsExternalAvatar, &userDB.SubscriberCount)
+	`, username).Scan(&userDB.ID, &userDB.Username, &userDB.Email, &userDB.Avatar, &userDB.Birthday, &userDB.About, &userDB.PublicName, &userDB.IsExternalAvatar, &userDB.SubscriberCount, &isPrivateAccount)
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		return domain.User{}, domain.ErrUserNotFound
 	} else if err != nil {
@@ -72,6 +77,7 @@ func (p *pgProfileStorage) GetUserPublicInfoByUsername(username string) (domain.
 		About:            userDB.About.String,
 		IsExternalAvatar: userDB.IsExternalAvatar.Bool,
 		SubscriberCount:  int(userDB.SubscriberCount.Int64),
+		IsPrivateAccount: isPrivateAccount,
 	}
 
 	return user, nil
@@ -128,3 +134,258 @@ func (p *pgProfileStorage) SetNewPassword(email string, newPassword string) (int
 
 	return id, nil
 }
+
+func (p *pgProfileStorage) GetNSFWSettings(ctx context.Context, userID int) (time.Time, bool, error) {
+	var birthday sql.NullTime
+	var showNSFW bool
+
+	err := p.db.QueryRowContext(ctx, `
+	SELECT birthday, show_nsfw
+	FROM flow_user
+	WHERE id = $1
+	`, userID).Scan(&birthday, &showNSFW)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, false, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	return birthday.Time, showNSFW, nil
+}
+
+func (p *pgProfileStorage) SetShowNSFW(ctx context.Context, userID int, showNSFW bool) error {
+	_, err := p.db.ExecContext(ctx, `
+	UPDATE flow_user
+	SET show_nsfw = $1
+	WHERE id = $2
+	`, showNSFW, userID)
+
+	return err
+}
+
+// GetLastUsernameChange возвращает время последней смены имени или нулевое время
+func (p *pgProfileStorage) GetLastUsernameChange(ctx context.Context, userID int) (time.Time, error) {
+	var changedAt sql.NullTime
+	err := p.db.QueryRowContext(ctx, `
+	SELECT MAX(changed_at)
+	FROM username_history
+	WHERE user_id = $1
+	`, userID).Scan(&changedAt)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return changedAt.Time, nil
+}
+
+// ChangeUsername сохраняет данные профиля вместе с новым именем пользователя
+// в одной транзакции
+func (p *pgProfileStorage) ChangeUsername(ctx context.Context, user domain.User, reservedUntil time.Time) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := renameUser(ctx, tx, int(user.ID), user.Username, reservedUntil); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	UPDATE flow_user
+	SET birthday = $1, about = $2, public_name = $3, email = $4
+	WHERE id = $5
+	`, user.Birthday, user.About, user.PublicName, user.Email, user.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// renameUser переименовывает пользователя и переносит на новое имя сообщения.
+// Чаты и контакты переезжают каскадно, прежнее имя закрепляется за пользователем
+// до reservedUntil
+func renameUser(ctx context.Context, tx *sql.Tx, userID int, newUsername string, reservedUntil time.Time) error {
+	var oldUsername string
+	err := tx.QueryRowContext(ctx, `
+	SELECT username
+	FROM flow_user
+	WHERE id = $1
+	FOR UPDATE
+	`, userID).Scan(&oldUsername)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if oldUsername == newUsername {
+		return nil
+	}
+
+	// своё прежнее имя можно вернуть, чужое - только после окончания резерва
+	var taken bool
+	err = tx.QueryRowContext(ctx, `
+	SELECT EXISTS (
+		SELECT 1 FROM flow_user WHERE username = $1
+	) OR EXISTS (
+		SELECT 1 FROM username_history
+		WHERE old_username = $1 AND user_id <> $2 AND reserved_until > NOW()
+	)
+	`, newUsername, userID).Scan(&taken)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return domain.ErrUsernameAlreadyTaken
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	DELETE FROM username_history
+	WHERE old_username = $1
+	`, newUsername); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	UPDATE flow_user
+	SET username = $1
+	WHERE id = $2
+	`, newUsername, userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	UPDATE message
+	SET sender = CASE WHEN sender = $1 THEN $2 ELSE sender END,
+		recipient = CASE WHEN recipient = $1 THEN $2 ELSE recipient END
+	WHERE sender = $1 OR recipient = $1
+	`, oldUsername, newUsername); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO username_history (old_username, user_id, changed_at, reserved_until)
+	VALUES ($1, $2, NOW(), $3)
+	ON CONFLICT (old_username) DO UPDATE
+	SET user_id = EXCLUDED.user_id, changed_at = EXCLUDED.changed_at, reserved_until = EXCLUDED.reserved_until
+	`, oldUsername, userID, reservedUntil)
+
+	return err
+}
+
+// GetRenamedUsername возвращает текущее имя пользователя по прежнему, пока оно зарезервировано
+func (p *pgProfileStorage) GetRenamedUsername(ctx context.Context, oldUsername string) (string, error) {
+	var username string
+	err := p.db.QueryRowContext(ctx, `
+	SELECT u.username
+	FROM username_history h
+	JOIN flow_user u ON u.id = h.user_id
+	WHERE h.old_username = $1 AND h.reserved_until > NOW()
+	`, oldUsername).Scan(&username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domain.ErrUserNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return username, nil
+}
+
+// GetFollowStatus возвращает отношение зрителя к аккаунту: подписан, ждёт одобрения или нет
+func (p *pgProfileStorage) GetFollowStatus(ctx context.Context, targetID, viewerID int) (string, error) {
+	var following, requested bool
+	err := p.db.QueryRowContext(ctx, `
+	SELECT
+		EXISTS (SELECT 1 FROM subscription WHERE user_id = $2 AND target_id = $1),
+		EXISTS (SELECT 1 FROM subscription_request WHERE user_id = $2 AND target_id = $1)
+	`, targetID, viewerID).Scan(&following, &requested)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case following:
+		return domain.FollowStatusFollowing, nil
+	case requested:
+		return domain.FollowStatusRequested, nil
+	default:
+		return domain.FollowStatusNone, nil
+	}
+}
+
+func (p *pgProfileStorage) GetPrivateAccount(ctx context.Context, userID int) (bool, error) {
+	var isPrivate bool
+	err := p.db.QueryRowContext(ctx, `
+	SELECT is_private_account
+	FROM flow_user
+	WHERE id = $1
+	`, userID).Scan(&isPrivate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return isPrivate, nil
+}
+
+// SetPrivateAccount закрывает или открывает аккаунт. При открытии все
+// ожидающие заявки одобряются так же, как одобрил бы их пользователь
+func (p *pgProfileStorage) SetPrivateAccount(ctx context.Context, userID int, isPrivate bool) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+	UPDATE flow_user
+	SET is_private_account = $1
+	WHERE id = $2
+	`, isPrivate, userID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	if !isPrivate {
+		if _, err := tx.ExecContext(ctx, `
+		WITH approved AS (
+			DELETE FROM subscription_request
+			WHERE target_id = $1
+			RETURNING user_id, target_id
+		), inserted AS (
+			INSERT INTO subscription (user_id, target_id)
+			SELECT user_id, target_id FROM approved
+			ON CONFLICT (user_id, target_id) DO NOTHING
+			RETURNING user_id
+		), contacts AS (
+			INSERT INTO contact (user_username, contact_username)
+			SELECT u.username, t.username
+			FROM inserted AS i
+			JOIN flow_user AS u ON u.id = i.user_id
+			JOIN flow_user AS t ON t.id = $1
+			ON CONFLICT (user_username, contact_username) DO NOTHING
+		)
+		UPDATE flow_user
+		SET subscriber_count = subscriber_count + (SELECT COUNT(*) FROM inserted)
+		WHERE id = $1
+		`, userID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
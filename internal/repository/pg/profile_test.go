@@ -1,6 +1,8 @@
 package repository_test
 
 import (
+	"context"
+	"errors"
 	"regexp"
 	"testing"
 	"time"
@@ -18,10 +20,10 @@ func TestGetUserPublicInfoByEmail(t *testing.T) {
 	defer db.Close()
 
 	email := "test@example.com"
-	rows := sqlmock.NewRows([]string{"id", "username", "email", "avatar", "birthday", "about", "public_name", "external_id", "is_external_avatar", "subscriber_count"}).
-		AddRow(1, "cool_user", email, "avatar_url", nil, nil, "Cool User", 123321321, false, 10)
+	rows := sqlmock.NewRows([]string{"id", "username", "email", "avatar", "birthday", "about", "public_name", "external_id", "is_external_avatar", "subscriber_count", "is_private_account"}).
+		AddRow(1, "cool_user", email, "avatar_url", nil, nil, "Cool User", 123321321, false, 10, false)
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, username, email, avatar, birthday, about, public_name, external_id, is_external_avatar, subscriber_count 
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, username, email, avatar, birthday, about, public_name, external_id, is_external_avatar, subscriber_count, is_private_account 
 	FROM flow_user 
 	WHERE email = $1`)).
 		WithArgs(email).
@@ -55,10 +57,10 @@ func TestGetUserPublicInfoByUsername(t *testing.T) {
 	defer db.Close()
 
 	username := "cool_user"
-	rows := sqlmock.NewRows([]string{"id", "username", "email", "avatar", "birthday", "about", "public_name", "is_external_avatar", "subscriber_count"}).
-		AddRow(1, username, "cool@email.ru", "avatar_url", nil, nil, "Cool User", false, 10)
+	rows := sqlmock.NewRows([]string{"id", "username", "email", "avatar", "birthday", "about", "public_name", "is_external_avatar", "subscriber_count", "is_private_account"}).
+		AddRow(1, username, "cool@email.ru", "avatar_url", nil, nil, "Cool User", false, 10, true)
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, username, email, avatar, birthday, about, public_name, is_external_avatar, subscriber_count 
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, username, email, avatar, birthday, about, public_name, is_external_avatar, subscriber_count, is_private_account 
 	FROM flow_user 
 	WHERE username = $1`)).
 		WithArgs(username).
@@ -78,6 +80,10 @@ func TestGetUserPublicInfoByUsername(t *testing.T) {
 		t.Errorf("expected username %v, got %v", username, user.Username)
 	}
 
+	if !user.IsPrivateAccount {
+		t.Errorf("expected private account")
+	}
+
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %v", err)
 	}
@@ -225,4 +231,143 @@ func TestSetNewPassword(t *testing.T) {
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %v", err)
 	}
-}
\ No newline at end of file
+}
+func TestChangeUsername(t *testing.T) {
+	reservedUntil := time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		newUsername string
+		setup       func(mock sqlmock.Sqlmock)
+		wantErr     error
+	}{
+		{
+			name:        "Сценарий: имя свободно, сообщения переносятся, прежнее имя резервируется",
+			newUsername: "new_name",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT username FROM flow_user WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"username"}).AddRow("old_name"))
+				mock.ExpectQuery(`FROM username_history WHERE old_username = \$1 AND user_id <> \$2 AND reserved_until > NOW\(\)`).
+					WithArgs("new_name", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"taken"}).AddRow(false))
+				mock.ExpectExec(`DELETE FROM username_history WHERE old_username = \$1`).
+					WithArgs("new_name").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`UPDATE flow_user SET username = \$1 WHERE id = \$2`).
+					WithArgs("new_name", 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`UPDATE message SET sender = CASE WHEN sender = \$1 THEN \$2 ELSE sender END`).
+					WithArgs("old_name", "new_name").
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(`INSERT INTO username_history \(old_username, user_id, changed_at, reserved_until\)`).
+					WithArgs("old_name", 1, reservedUntil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`UPDATE flow_user SET birthday = \$1, about = \$2, public_name = \$3, email = \$4 WHERE id = \$5`).
+					WithArgs(sqlmock.AnyArg(), "about", "New Name", "user@mail.ru", 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:        "Сценарий: имя занято или зарезервировано другим пользователем",
+			newUsername: "taken_name",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT username FROM flow_user WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"username"}).AddRow("old_name"))
+				mock.ExpectQuery(`FROM username_history`).
+					WithArgs("taken_name", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"taken"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			wantErr: domain.ErrUsernameAlreadyTaken,
+		},
+		{
+			name:        "Сценарий: имя не изменилось, сохраняются остальные поля",
+			newUsername: "old_name",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT username FROM flow_user WHERE id = \$1 FOR UPDATE`).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"username"}).AddRow("old_name"))
+				mock.ExpectExec(`UPDATE flow_user SET birthday = \$1`).
+					WithArgs(sqlmock.AnyArg(), "about", "New Name", "user@mail.ru", 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setup(mock)
+
+			repo, err := pg.NewPGProfileStorage(db)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			user := domain.User{
+				ID:         1,
+				Username:   tt.newUsername,
+				Email:      "user@mail.ru",
+				About:      "about",
+				PublicName: "New Name",
+			}
+
+			err = repo.ChangeUsername(context.Background(), user, reservedUntil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestGetRenamedUsername(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT u.username FROM username_history h JOIN flow_user u ON u.id = h.user_id WHERE h.old_username = \$1 AND h.reserved_until > NOW\(\)`).
+		WithArgs("old_name").
+		WillReturnRows(sqlmock.NewRows([]string{"username"}).AddRow("new_name"))
+	mock.ExpectQuery(`FROM username_history`).
+		WithArgs("nobody").
+		WillReturnRows(sqlmock.NewRows([]string{"username"}))
+
+	repo, err := pg.NewPGProfileStorage(db)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	username, err := repo.GetRenamedUsername(context.Background(), "old_name")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if username != "new_name" {
+		t.Errorf("expected username new_name, got %v", username)
+	}
+
+	if _, err := repo.GetRenamedUsername(context.Background(), "nobody"); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,364 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+type ReportRepository struct {
+	db *sql.DB
+}
+
+func NewReportRepository(db *sql.DB) *ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
+
+func (r *ReportRepository) AddReport(ctx context.Context, report domain.Report) (int, error) {
+	var id int
+
+	err := r.db.QueryRowContext(ctx, `
+	INSERT INTO report (reporter_id, target_type, target_id, reason)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (reporter_id, target_type, target_id) WHERE status = 'open' DO NOTHING
+	RETURNING id
+	`, report.ReporterID, report.TargetType, report.TargetID, report.Reason).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.ErrReportExists
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// reportFlowVisibility повторяет проверку доступа к флоу при просмотре:
+// пожаловаться можно только на то, что пользователь может открыть
+var reportFlowVisibility = `(f.is_hidden = false OR f.author_id = $2)
+	AND (
+		(f.is_private = false AND ` + accountVisibleFilter("f.author_id", "$2") + `)
+		OR f.author_id = $2
+		OR EXISTS (
+			SELECT 1 FROM board_post bp
+			JOIN board b ON bp.board_id = b.id
+			WHERE bp.flow_id = f.id
+			AND (b.author_id = $2 OR EXISTS (
+				SELECT 1 FROM board_coauthor bc
+				WHERE bc.board_id = b.id AND bc.coauthor_id = $2
+			))
+		)
+	)`
+
+// TargetExists проверяет, что цель жалобы существует и видна пользователю.
+// Иначе по ответу можно узнать о закрытых флоу и досках
+func (r *ReportRepository) TargetExists(ctx context.Context, targetType string, targetID, userID int) (bool, error) {
+	var query string
+	args := []any{targetID}
+
+	switch targetType {
+	case domain.ReportTargetFlow:
+		query = `
+		SELECT EXISTS (
+			SELECT 1 FROM flow f
+			WHERE f.id = $1 AND ` + reportFlowVisibility + `
+		)`
+		args = append(args, userID)
+	case domain.ReportTargetComment:
+		query = `
+		SELECT EXISTS (
+			SELECT 1 FROM comment c
+			JOIN flow f ON f.id = c.flow_id
+			WHERE c.id = $1 AND ` + commentVisibility + ` AND ` + reportFlowVisibility + `
+		)`
+		args = append(args, userID)
+	case domain.ReportTargetBoard:
+		query = `
+		SELECT EXISTS (
+			SELECT 1 FROM board b
+			LEFT JOIN board_coauthor bc ON bc.board_id = b.id AND bc.coauthor_id = $2
+			WHERE b.id = $1
+			AND (b.is_private = false OR b.author_id = $2 OR bc.coauthor_id IS NOT NULL)
+			AND (bc.coauthor_id IS NOT NULL OR ` + accountVisibleFilter("b.author_id", "$2") + `)
+		)`
+		args = append(args, userID)
+	case domain.ReportTargetUser:
+		query = `SELECT EXISTS (SELECT 1 FROM flow_user WHERE id = $1)`
+	case domain.ReportTargetMessage:
+		// пожаловаться на сообщение может только его получатель
+		query = `
+		SELECT EXISTS (
+			SELECT 1 FROM message m
+			JOIN flow_user fu ON fu.username = m.recipient
+			WHERE m.id = $1 AND fu.id = $2
+		)`
+		args = append(args, userID)
+	default:
+		return false, domain.ErrInvalidReportTarget
+	}
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *ReportRepository) IsModerator(ctx context.Context, userID int) (bool, error) {
+	var role string
+
+	err := r.db.QueryRowContext(ctx, `
+	SELECT role FROM flow_user WHERE id = $1
+	`, userID).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return role == domain.UserRoleModerator, nil
+}
+
+func (r *ReportRepository) GetReports(ctx context.Context, status string, page, size int) ([]domain.Report, error) {
+	offset := (page - 1) * size
+
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT
+		r.id,
+		r.target_type,
+		r.target_id,
+		r.reason,
+		r.status,
+		r.resolved_at,
+		r.created_at,
+		reporter.username,
+		COALESCE(moderator.username, '')
+	FROM report r
+	JOIN flow_user reporter ON reporter.id = r.reporter_id
+	LEFT JOIN flow_user moderator ON moderator.id = r.resolved_by
+	WHERE r.status = $1
+	ORDER BY r.created_at ASC
+	OFFSET $2
+	LIMIT $3
+	`, status, offset, size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reports []domain.Report
+
+	for rows.Next() {
+		var report domain.Report
+		var resolvedAt sql.NullTime
+
+		if err := rows.Scan(
+			&report.ID,
+			&report.TargetType,
+			&report.TargetID,
+			&report.Reason,
+			&report.Status,
+			&resolvedAt,
+			&report.CreatedAt,
+			&report.ReporterUsername,
+			&report.ResolvedBy,
+		); err != nil {
+			return nil, err
+		}
+
+		if resolvedAt.Valid {
+			report.ResolvedAt = &resolvedAt.Time
+		}
+
+		reports = append(reports, report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+func (r *ReportRepository) GetReport(ctx context.Context, reportID int) (domain.Report, error) {
+	var report domain.Report
+
+	err := r.db.QueryRowContext(ctx, `
+	SELECT id, reporter_id, target_type, target_id, reason, status, created_at
+	FROM report
+	WHERE id = $1
+	`, reportID).Scan(
+		&report.ID,
+		&report.ReporterID,
+		&report.TargetType,
+		&report.TargetID,
+		&report.Reason,
+		&report.Status,
+		&report.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Report{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Report{}, err
+	}
+
+	return report, nil
+}
+
+func (r *ReportRepository) ResolveReport(ctx context.Context, report domain.Report, moderatorID int, resolution domain.ReportResolution) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	status := domain.ReportStatusResolved
+	if resolution.Action == domain.ModerationActionDismiss {
+		status = domain.ReportStatusDismissed
+	}
+
+	if err := applyModerationAction(ctx, tx, report, resolution.Action); err != nil {
+		return err
+	}
+
+	// закрываем все открытые жалобы на этот же объект
+	if _, err := tx.ExecContext(ctx, `
+	UPDATE report
+	SET status = $1, resolved_by = $2, resolved_at = NOW()
+	WHERE target_type = $3 AND target_id = $4 AND status = 'open'
+	`, status, moderatorID, report.TargetType, report.TargetID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	INSERT INTO moderator_action (moderator_id, report_id, action, target_type, target_id, note)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	`, moderatorID, report.ID, resolution.Action, report.TargetType, report.TargetID, resolution.Note); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ReportRepository) GetModeratorActions(ctx context.Context, page, size int) ([]domain.ModeratorAction, error) {
+	offset := (page - 1) * size
+
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT
+		ma.id,
+		COALESCE(fu.username, ''),
+		ma.report_id,
+		ma.action,
+		ma.target_type,
+		ma.target_id,
+		ma.note,
+		ma.created_at
+	FROM moderator_action ma
+	LEFT JOIN flow_user fu ON fu.id = ma.moderator_id
+	ORDER BY ma.created_at DESC
+	OFFSET $1
+	LIMIT $2
+	`, offset, size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var actions []domain.ModeratorAction
+
+	for rows.Next() {
+		var action domain.ModeratorAction
+		var reportID sql.NullInt64
+
+		if err := rows.Scan(
+			&action.ID,
+			&action.ModeratorUsername,
+			&reportID,
+			&action.Action,
+			&action.TargetType,
+			&action.TargetID,
+			&action.Note,
+			&action.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if reportID.Valid {
+			id := int(reportID.Int64)
+			action.ReportID = &id
+		}
+
+		actions = append(actions, action)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+func applyModerationAction(ctx context.Context, tx *sql.Tx, report domain.Report, action string) error {
+	switch action {
+	case domain.ModerationActionHideFlow:
+		_, err := tx.ExecContext(ctx, `
+		UPDATE flow SET is_hidden = true WHERE id = $1
+		`, report.TargetID)
+		return err
+	case domain.ModerationActionDeleteComment:
+		return deleteCommentByID(ctx, tx, report.TargetID)
+	case domain.ModerationActionSuspendUser:
+		userID, err := getTargetAuthor(ctx, tx, report.TargetType, report.TargetID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		UPDATE flow_user SET is_suspended = true WHERE id = $1
+		`, userID)
+		return err
+	}
+
+	return nil
+}
+
+// getTargetAuthor возвращает id пользователя, ответственного за объект жалобы
+func getTargetAuthor(ctx context.Context, tx *sql.Tx, targetType string, targetID int) (int, error) {
+	var query string
+
+	switch targetType {
+	case domain.ReportTargetUser:
+		return targetID, nil
+	case domain.ReportTargetFlow:
+		query = `SELECT author_id FROM flow WHERE id = $1`
+	case domain.ReportTargetComment:
+		query = `SELECT author_id FROM comment WHERE id = $1`
+	case domain.ReportTargetBoard:
+		query = `SELECT author_id FROM board WHERE id = $1`
+	case domain.ReportTargetMessage:
+		query = `
+		SELECT fu.id FROM message m
+		JOIN flow_user fu ON fu.username = m.sender
+		WHERE m.id = $1`
+	default:
+		return 0, domain.ErrInvalidReportTarget
+	}
+
+	var userID int
+	err := tx.QueryRowContext(ctx, query, targetID).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, domain.ErrNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupReportMock(t *testing.T) (*ReportRepository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock DB: %v", err)
+	}
+
+	repo := NewReportRepository(db)
+	return repo, mock, func() { db.Close() }
+}
+
+func TestAddReport_Success(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	report := domain.Report{ReporterID: 2, TargetType: domain.ReportTargetFlow, TargetID: 5, Reason: "spam"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO report (reporter_id, target_type, target_id, reason)`)).
+		WithArgs(2, domain.ReportTargetFlow, 5, "spam").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	id, err := repo.AddReport(context.Background(), report)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, id)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddReport_Duplicate(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	report := domain.Report{ReporterID: 2, TargetType: domain.ReportTargetFlow, TargetID: 5, Reason: "spam"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`ON CONFLICT (reporter_id, target_type, target_id) WHERE status = 'open' DO NOTHING`)).
+		WithArgs(2, domain.ReportTargetFlow, 5, "spam").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.AddReport(context.Background(), report)
+	assert.ErrorIs(t, err, domain.ErrReportExists)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTargetExists_Message(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`JOIN flow_user fu ON fu.username = m.recipient`)).
+		WithArgs(7, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := repo.TargetExists(context.Background(), domain.ReportTargetMessage, 7, 2)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTargetExists_FlowChecksVisibility(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	// закрытый или скрытый флоу для постороннего не существует
+	mock.ExpectQuery(regexp.QuoteMeta(`AND (f.is_hidden = false OR f.author_id = $2)`)).
+		WithArgs(7, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	exists, err := repo.TargetExists(context.Background(), domain.ReportTargetFlow, 7, 2)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTargetExists_BoardChecksVisibility(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`AND (b.is_private = false OR b.author_id = $2 OR bc.coauthor_id IS NOT NULL)`)).
+		WithArgs(3, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := repo.TargetExists(context.Background(), domain.ReportTargetBoard, 3, 2)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIsModerator(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT role FROM flow_user WHERE id = $1`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow(domain.UserRoleModerator))
+
+	isModerator, err := repo.IsModerator(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.True(t, isModerator)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetReports_Success(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "target_type", "target_id", "reason", "status", "resolved_at", "created_at", "username", "moderator",
+	}).AddRow(1, domain.ReportTargetFlow, 5, "spam", domain.ReportStatusOpen, nil, time.Now(), "reporter", "")
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM report r`)).
+		WithArgs(domain.ReportStatusOpen, 0, 10).
+		WillReturnRows(rows)
+
+	reports, err := repo.GetReports(context.Background(), domain.ReportStatusOpen, 1, 10)
+	assert.NoError(t, err)
+	assert.Len(t, reports, 1)
+	assert.Equal(t, "reporter", reports[0].ReporterUsername)
+	assert.Nil(t, reports[0].ResolvedAt)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveReport_SuspendFlowAuthor(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	report := domain.Report{ID: 1, TargetType: domain.ReportTargetFlow, TargetID: 5}
+	resolution := domain.ReportResolution{Action: domain.ModerationActionSuspendUser, Note: "repeated spam"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT author_id FROM flow WHERE id = $1`)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id"}).AddRow(9))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE flow_user SET is_suspended = true WHERE id = $1`)).
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE report`)).
+		WithArgs(domain.ReportStatusResolved, 3, domain.ReportTargetFlow, 5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO moderator_action`)).
+		WithArgs(3, 1, domain.ModerationActionSuspendUser, domain.ReportTargetFlow, 5, "repeated spam").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := repo.ResolveReport(context.Background(), report, 3, resolution)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveReport_DeleteReply(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	report := domain.Report{ID: 2, TargetType: domain.ReportTargetComment, TargetID: 8}
+	resolution := domain.ReportResolution{Action: domain.ModerationActionDeleteComment}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`DELETE FROM comment WHERE id = $1 RETURNING parent_id`)).
+		WithArgs(8).
+		WillReturnRows(sqlmock.NewRows([]string{"parent_id"}).AddRow(6))
+	// счетчик ответов родителя уменьшается так же, как при удалении автором
+	mock.ExpectExec(regexp.QuoteMeta(`SET reply_count = reply_count - 1`)).
+		WithArgs(int64(6)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE report`)).
+		WithArgs(domain.ReportStatusResolved, 3, domain.ReportTargetComment, 8).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO moderator_action`)).
+		WithArgs(3, 2, domain.ModerationActionDeleteComment, domain.ReportTargetComment, 8, "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := repo.ResolveReport(context.Background(), report, 3, resolution)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveReport_Dismiss(t *testing.T) {
+	repo, mock, closeFn := setupReportMock(t)
+	defer closeFn()
+
+	report := domain.Report{ID: 1, TargetType: domain.ReportTargetComment, TargetID: 4}
+	resolution := domain.ReportResolution{Action: domain.ModerationActionDismiss}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE report`)).
+		WithArgs(domain.ReportStatusDismissed, 3, domain.ReportTargetComment, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO moderator_action`)).
+		WithArgs(3, 1, domain.ModerationActionDismiss, domain.ReportTargetComment, 4, "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := repo.ResolveReport(context.Background(), report, 3, resolution)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -9,17 +9,36 @@ import (
 )
 
 type SearchRepository struct {
-	db *sql.DB
+	db               *sql.DB
+	hideUnclassified bool
 }
 
-func NewSearchRepository(db *sql.DB) *SearchRepository {
+func NewSearchRepository(db *sql.DB, hideUnclassified bool) *SearchRepository {
 	return &SearchRepository{
-		db: db,
+		db:               db,
+		hideUnclassified: hideUnclassified,
 	}
 }
 
-func (s *SearchRepository) SearchPins(ctx context.Context, query string, page, pageSize int) ([]domain.PinData, error) {
+func (s *SearchRepository) SearchPins(ctx context.Context, query, sourceDomain string, tags []string, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
 	offset := (page - 1) * pageSize
+	args := []any{query, pageSize, offset}
+
+	// фильтр по сайту-источнику добавляется только когда он задан
+	domainFilter := ""
+	if sourceDomain != "" {
+		args = append(args, sourceDomain)
+		domainFilter = fmt.Sprintf(" AND f.source_domain = $%d", len(args))
+	}
+
+	// флоу должен иметь все переданные теги
+	tagFilter := ""
+	for _, tag := range tags {
+		args = append(args, tag)
+		tagFilter += fmt.Sprintf(` AND EXISTS (
+		SELECT 1 FROM flow_tag ft JOIN tag t ON t.id = ft.tag_id
+		WHERE ft.flow_id = f.id AND t.name = $%d)`, len(args))
+	}
 
 	queryString := `
     SELECT 
@@ -32,19 +51,21 @@ func (s *SearchRepository) SearchPins(ctx context.Context, query string, page, p
 		f.width,
 		f.height,
 		f.is_nsfw,
-        fu.username
+        fu.username,
+        f.save_count,
+        `+flowMediaColumn+`
     FROM flow f
     JOIN flow_user fu ON f.author_id = fu.id
-    WHERE f.is_private = false
+    WHERE f.is_private = false AND f.is_hidden = false AND ` + publicAccountFilter("f.author_id") + nsfwFilter(nsfwMode) + classifiedFilter(s.hideUnclassified, classifiedCondition) + domainFilter + tagFilter + `
     AND (to_tsvector(f.title || ' ' || f.description) @@ plainto_tsquery($1) OR
 	f.title ILIKE '%' || $1 || '%' OR
 	f.description ILIKE '%' || $1 || '%')
-    ORDER BY f.like_count DESC
+    ORDER BY `+flowPopularity+` DESC
     LIMIT $2
     OFFSET $3
     `
 
-	rows, err := s.db.QueryContext(ctx, queryString, query, pageSize, offset)
+	rows, err := s.db.QueryContext(ctx, queryString, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute search query: %w", err)
 	}
@@ -53,6 +74,7 @@ func (s *SearchRepository) SearchPins(ctx context.Context, query string, page, p
 	var pins []domain.PinData
 	var header sql.NullString
 	var description sql.NullString
+	var rawMedia string
 
 	for rows.Next() {
 		var pin domain.PinData
@@ -67,10 +89,18 @@ func (s *SearchRepository) SearchPins(ctx context.Context, query string, page, p
 			&pin.Height,
 			&pin.IsNSFW,
 			&pin.AuthorUsername,
+			&pin.SaveCount,
+			&rawMedia,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 
+		media, err := parseFlowMedia(rawMedia)
+		if err != nil {
+			return nil, err
+		}
+		pin.Media = media
+
 		pin.Header = header.String
 		pin.Description = description.String
 
@@ -152,7 +182,7 @@ func (s *SearchRepository) SearchBoards(ctx context.Context, query string, page,
 		flow_user
 	ON 
 		board.author_id = flow_user.id
-	WHERE board.is_private = false AND
+	WHERE board.is_private = false AND board.is_secret = false AND `+publicAccountFilter("board.author_id")+` AND
     (
         board.board_name ILIKE '%' || $1 || '%' OR
         to_tsvector(board.board_name) @@ plainto_tsquery($1)
@@ -208,11 +238,14 @@ func (s *SearchRepository) SearchBoards(ctx context.Context, query string, page,
 func (p *SearchRepository) fetchFirstNFlowsForBoard(ctx context.Context, boardID, userID, pageSize, offset int) ([]domain.PinData, error) {
 	rows, err := p.db.QueryContext(ctx, `
         SELECT f.id, f.title, f.description, f.author_id, f.created_at, 
-               f.updated_at, f.is_private, f.media_url, f.like_count
+               f.updated_at, f.is_private, f.media_url, f.like_count, f.is_nsfw,
+               `+flowMediaColumn+`
         FROM flow f
         JOIN board_post bp ON f.id = bp.flow_id
         WHERE bp.board_id = $1
           AND (f.is_private = false OR f.author_id = $2)
+          AND (f.is_hidden = false OR f.author_id = $2)`+
+		classifiedFilter(p.hideUnclassified, classifiedOrOwnCondition("$2"))+`
         ORDER BY bp.saved_at DESC
         LIMIT $3 OFFSET $4
     `, boardID, userID, pageSize, offset)
@@ -229,6 +262,7 @@ func (p *SearchRepository) fetchFirstNFlowsForBoard(ctx context.Context, boardID
 	middlePin := middlePinData{}
 
 	var flows []domain.PinData
+	var rawMedia string
 	for rows.Next() {
 		var flow domain.PinData
 		err := rows.Scan(
@@ -241,11 +275,18 @@ func (p *SearchRepository) fetchFirstNFlowsForBoard(ctx context.Context, boardID
 			&flow.IsPrivate,
 			&flow.MediaURL,
 			&flow.LikeCount,
+			&flow.IsNSFW,
+			&rawMedia,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan flow: %w", err)
 		}
 
+		flow.Media, err = parseFlowMedia(rawMedia)
+		if err != nil {
+			return nil, err
+		}
+
 		flow.Header = middlePin.Header.String
 		flow.Description = middlePin.Description.String
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,7 +19,7 @@ func TestSearchPins(t *testing.T) {
     }
     defer db.Close()
 
-    repo := NewSearchRepository(db)
+    repo := NewSearchRepository(db, false)
 
     t.Run("Success", func(t *testing.T) {
         ctx := context.Background()
@@ -28,17 +29,18 @@ func TestSearchPins(t *testing.T) {
         offset := (page - 1) * pageSize
 
         mock.ExpectQuery(regexp.QuoteMeta(
-            `SELECT f.id, f.title, f.description, f.author_id, f.is_private, f.media_url, f.width, f.height, f.is_nsfw, fu.username 
+            `SELECT f.id, f.title, f.description, f.author_id, f.is_private, f.media_url, f.width, f.height, f.is_nsfw, fu.username, f.save_count, `+flowMediaColumn+`
             FROM flow f JOIN flow_user fu ON f.author_id = fu.id 
-            WHERE f.is_private = false AND 
-            (to_tsvector(f.title || ' ' || f.description) @@ plainto_tsquery($1) OR f.title ILIKE '%' || $1 || '%' OR f.description ILIKE '%' || $1 || '%') 
-            ORDER BY f.like_count DESC LIMIT $2 OFFSET $3`,
+            WHERE f.is_private = false AND f.is_hidden = false AND `+publicAccountFilter("f.author_id")+`
+            AND (to_tsvector(f.title || ' ' || f.description) @@ plainto_tsquery($1) OR f.title ILIKE '%' || $1 || '%' OR f.description ILIKE '%' || $1 || '%') 
+            ORDER BY (f.like_count + 2 * f.save_count) DESC LIMIT $2 OFFSET $3`,
         )).WithArgs(query, pageSize, offset).
-            WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "is_private", "media_url", "width", "height", "is_nsfw", "username"}).
-                AddRow(1, "Pin 1", "Description 1", 101, false, "http://example.com/image1.jpg", 800, 600, false, "user1").
-                AddRow(2, "Pin 2", "Description 2", 102, false, "http://example.com/image2.jpg", 1024, 768, true, "user2"))
+            WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "is_private", "media_url", "width", "height", "is_nsfw", "username", "save_count", "media"}).
+                AddRow(1, "Pin 1", "Description 1", 101, false, "http://example.com/image1.jpg", 800, 600, false, "user1", 3,
+                    `[{"type":"image","url":"image1.jpg","width":800,"height":600,"duration_ms":0,"poster_url":""},{"type":"video","url":"clip.mp4","width":720,"height":1280,"duration_ms":4200,"poster_url":"clip.jpg"}]`).
+                AddRow(2, "Pin 2", "Description 2", 102, false, "http://example.com/image2.jpg", 1024, 768, true, "user2", 0, "[]"))
 
-        pins, err := repo.SearchPins(ctx, query, page, pageSize)
+        pins, err := repo.SearchPins(ctx, query, "", nil, page, pageSize, domain.NSFWModeShow)
 
         assert.NoError(t, err)
         assert.Len(t, pins, 2)
@@ -52,6 +54,12 @@ func TestSearchPins(t *testing.T) {
         assert.Equal(t, 600, pins[0].Height)
         assert.False(t, pins[0].IsNSFW)
         assert.Equal(t, "user1", pins[0].AuthorUsername)
+        assert.Equal(t, 3, pins[0].SaveCount)
+        assert.Equal(t, []domain.FlowMedia{
+            {Type: domain.MediaTypeImage, URL: "image1.jpg", Width: 800, Height: 600},
+            {Type: domain.MediaTypeVideo, URL: "clip.mp4", Width: 720, Height: 1280, DurationMs: 4200, PosterURL: "clip.jpg"},
+        }, pins[0].Media)
+        assert.Empty(t, pins[1].Media)
         assert.NoError(t, mock.ExpectationsWereMet())
     })
 
@@ -63,15 +71,15 @@ func TestSearchPins(t *testing.T) {
         offset := (page - 1) * pageSize
 
         mock.ExpectQuery(regexp.QuoteMeta(
-            `SELECT f.id, f.title, f.description, f.author_id, f.is_private, f.media_url, f.width, f.height, f.is_nsfw, fu.username 
+            `SELECT f.id, f.title, f.description, f.author_id, f.is_private, f.media_url, f.width, f.height, f.is_nsfw, fu.username, f.save_count, `+flowMediaColumn+`
             FROM flow f JOIN flow_user fu ON f.author_id = fu.id 
-            WHERE f.is_private = false AND 
-            (to_tsvector(f.title || ' ' || f.description) @@ plainto_tsquery($1) OR f.title ILIKE '%' || $1 || '%' OR f.description ILIKE '%' || $1 || '%') 
-            ORDER BY f.like_count DESC LIMIT $2 OFFSET $3`,
+            WHERE f.is_private = false AND f.is_hidden = false AND `+publicAccountFilter("f.author_id")+`
+            AND (to_tsvector(f.title || ' ' || f.description) @@ plainto_tsquery($1) OR f.title ILIKE '%' || $1 || '%' OR f.description ILIKE '%' || $1 || '%') 
+            ORDER BY (f.like_count + 2 * f.save_count) DESC LIMIT $2 OFFSET $3`,
         )).WithArgs(query, pageSize, offset).
-            WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "is_private", "media_url", "width", "height", "is_nsfw", "username"}))
+            WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "is_private", "media_url", "width", "height", "is_nsfw", "username", "save_count", "media"}))
 
-        pins, err := repo.SearchPins(ctx, query, page, pageSize)
+        pins, err := repo.SearchPins(ctx, query, "", nil, page, pageSize, domain.NSFWModeShow)
 
         assert.NoError(t, err)
         assert.Empty(t, pins)
@@ -86,15 +94,15 @@ func TestSearchPins(t *testing.T) {
         offset := (page - 1) * pageSize
 
         mock.ExpectQuery(regexp.QuoteMeta(
-            `SELECT f.id, f.title, f.description, f.author_id, f.is_private, f.media_url, f.width, f.height, f.is_nsfw, fu.username 
+            `SELECT f.id, f.title, f.description, f.author_id, f.is_private, f.media_url, f.width, f.height, f.is_nsfw, fu.username, f.save_count, `+flowMediaColumn+`
             FROM flow f JOIN flow_user fu ON f.author_id = fu.id 
-            WHERE f.is_private = false AND 
-            (to_tsvector(f.title || ' ' || f.description) @@ plainto_tsquery($1) OR f.title ILIKE '%' || $1 || '%' OR f.description ILIKE '%' || $1 || '%') 
-            ORDER BY f.like_count DESC LIMIT $2 OFFSET $3`,
+            WHERE f.is_private = false AND f.is_hidden = false AND `+publicAccountFilter("f.author_id")+`
+            AND (to_tsvector(f.title || ' ' || f.description) @@ plainto_tsquery($1) OR f.title ILIKE '%' || $1 || '%' OR f.description ILIKE '%' || $1 || '%') 
+            ORDER BY (f.like_count + 2 * f.save_count) DESC LIMIT $2 OFFSET $3`,
         )).WithArgs(query, pageSize, offset).
             WillReturnError(errors.New("database error"))
 
-        pins, err := repo.SearchPins(ctx, query, page, pageSize)
+        pins, err := repo.SearchPins(ctx, query, "", nil, page, pageSize, domain.NSFWModeShow)
 
         assert.Error(t, err)
         assert.Empty(t, pins)
@@ -102,6 +110,26 @@ func TestSearchPins(t *testing.T) {
     })
 }
 
+func TestSearchPins_DomainAndTags(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := NewSearchRepository(db, false)
+
+	mock.ExpectQuery(`AND f\.source_domain = \$4 AND EXISTS \( SELECT 1 FROM flow_tag ft JOIN tag t ON t\.id = ft\.tag_id WHERE ft\.flow_id = f\.id AND t\.name = \$5\) AND EXISTS \(.* AND t\.name = \$6\)`).
+		WithArgs("", 10, 0, "example.com", "море", "закат").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "is_private", "media_url", "width", "height", "is_nsfw", "username", "save_count", "media"}).
+			AddRow(1, "Pin", "", 2, false, "a.jpg", 100, 100, false, "user1", 0, "[]"))
+
+	pins, err := repo.SearchPins(context.Background(), "", "example.com", []string{"море", "закат"}, 1, 10, domain.NSFWModeShow)
+	assert.NoError(t, err)
+	assert.Len(t, pins, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestSearchUsers(t *testing.T) {
     db, mock, err := sqlmock.New()
     if err != nil {
@@ -109,7 +137,7 @@ func TestSearchUsers(t *testing.T) {
     }
     defer db.Close()
 
-    repo := NewSearchRepository(db)
+    repo := NewSearchRepository(db, false)
 
     t.Run("Success", func(t *testing.T) {
         ctx := context.Background()
@@ -193,7 +221,7 @@ func TestSearchBoards(t *testing.T) {
     }
     defer db.Close()
 
-    repo := NewSearchRepository(db)
+    repo := NewSearchRepository(db, false)
 
     t.Run("Success", func(t *testing.T) {
         ctx := context.Background()
@@ -208,7 +236,7 @@ func TestSearchBoards(t *testing.T) {
             `SELECT board.id, board.author_id, board.board_name, board.created_at, board.is_private, board.flow_count, flow_user.username 
 			FROM board 
 			INNER JOIN flow_user ON board.author_id = flow_user.id 
-			WHERE board.is_private = false 
+			WHERE board.is_private = false AND board.is_secret = false AND `+publicAccountFilter("board.author_id")+`
 			AND ( board.board_name ILIKE '%' || $1 || '%' OR to_tsvector(board.board_name) @@ plainto_tsquery($1) ) LIMIT $2 OFFSET $3`,
 		)).WithArgs(query, pageSize, offset).
             WillReturnRows(sqlmock.NewRows([]string{"id", "author_id", "board_name", "created_at", "is_private", "flow_count", "username"}).
@@ -216,18 +244,19 @@ func TestSearchBoards(t *testing.T) {
                 AddRow(2, 102, "Board 2", time.Now(), false, 3, "user2"))
 
         mock.ExpectQuery(regexp.QuoteMeta(
-            `SELECT f.id, f.title, f.description, f.author_id, f.created_at, f.updated_at, f.is_private, f.media_url, f.like_count 
+            `SELECT f.id, f.title, f.description, f.author_id, f.created_at, f.updated_at, f.is_private, f.media_url, f.like_count, f.is_nsfw, `+flowMediaColumn+`
 			FROM flow f 
 			JOIN board_post bp 
 			ON f.id = bp.flow_id 
 			WHERE bp.board_id = $1 
 			AND (f.is_private = false OR f.author_id = $2) 
+			AND (f.is_hidden = false OR f.author_id = $2) 
 			ORDER BY bp.saved_at DESC LIMIT $3 OFFSET $4`,
         )).WithArgs(1, 0, previewNum, previewStart).
-            WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "created_at", "updated_at", "is_private", "media_url", "like_count"}).
-                AddRow(101, "Flow 1", "Description 1", 101, time.Now(), time.Now(), false, "http://example.com/flow1.jpg", 10)).
-            WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "created_at", "updated_at", "is_private", "media_url", "like_count"}).
-                AddRow(102, "Flow 2", "Description 2", 102, time.Now(), time.Now(), false, "http://example.com/flow2.jpg", 5))
+            WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "created_at", "updated_at", "is_private", "media_url", "like_count", "is_nsfw", "media"}).
+                AddRow(101, "Flow 1", "Description 1", 101, time.Now(), time.Now(), false, "http://example.com/flow1.jpg", 10, false, "[]")).
+            WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "author_id", "created_at", "updated_at", "is_private", "media_url", "like_count", "is_nsfw", "media"}).
+                AddRow(102, "Flow 2", "Description 2", 102, time.Now(), time.Now(), false, "http://example.com/flow2.jpg", 5, false, "[]"))
 
         boards, err := repo.SearchBoards(ctx, query, page, pageSize, previewNum, previewStart)
         assert.NoError(t, err)
@@ -257,7 +286,7 @@ func TestSearchBoards(t *testing.T) {
             `SELECT board.id, board.author_id, board.board_name, board.created_at, board.is_private, board.flow_count, flow_user.username 
 			FROM board INNER JOIN flow_user 
 			ON board.author_id = flow_user.id 
-			WHERE board.is_private = false AND ( board.board_name ILIKE '%' || $1 || '%' OR to_tsvector(board.board_name) @@ plainto_tsquery($1) ) LIMIT $2 OFFSET $3`,
+			WHERE board.is_private = false AND board.is_secret = false AND `+publicAccountFilter("board.author_id")+` AND ( board.board_name ILIKE '%' || $1 || '%' OR to_tsvector(board.board_name) @@ plainto_tsquery($1) ) LIMIT $2 OFFSET $3`,
         )).WithArgs(query, pageSize, offset).
             WillReturnRows(sqlmock.NewRows([]string{"id", "author_id", "board_name", "created_at", "is_private", "flow_count", "username"}))
 
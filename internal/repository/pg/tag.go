@@ -0,0 +1,351 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+// flowTagsColumn собирает теги флоу f в JSON-массив: сначала хэштеги автора
+const flowTagsColumn = `COALESCE((
+		SELECT json_agg(json_build_object(
+			'name', t.name,
+			'source', ft.source,
+			'confidence', ft.confidence
+		) ORDER BY ft.source DESC, t.name)
+		FROM flow_tag ft
+		JOIN tag t ON t.id = ft.tag_id
+		WHERE ft.flow_id = f.id
+	)::TEXT, '[]') AS tags`
+
+func parseFlowTags(raw string) ([]domain.FlowTag, error) {
+	var tags []struct {
+		Name       string   `json:"name"`
+		Source     string   `json:"source"`
+		Confidence *float32 `json:"confidence"`
+	}
+	if err := json.Unmarshal([]byte(raw), &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse flow tags: %w", err)
+	}
+
+	var flowTags []domain.FlowTag
+	for _, tag := range tags {
+		flowTag := domain.FlowTag{
+			Name:   tag.Name,
+			Source: tag.Source,
+		}
+		if tag.Confidence != nil {
+			flowTag.Confidence = *tag.Confidence
+		}
+		flowTags = append(flowTags, flowTag)
+	}
+
+	return flowTags, nil
+}
+
+// insertFlowTag создаёт тег, если его ещё нет, и привязывает к флоу.
+// Повторная привязка того же источника обновляет уверенность.
+func insertFlowTag(ctx context.Context, tx *sql.Tx, flowID uint64, name, source string, confidence sql.NullFloat64) error {
+	_, err := tx.ExecContext(ctx, `
+	WITH t AS (
+		INSERT INTO tag (name)
+		VALUES ($2)
+		ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id
+	)
+	INSERT INTO flow_tag (flow_id, tag_id, source, confidence)
+	SELECT $1, id, $3, $4 FROM t
+	ON CONFLICT (flow_id, tag_id, source) DO UPDATE SET confidence = EXCLUDED.confidence
+	`, flowID, name, source, confidence)
+
+	return err
+}
+
+// условие видимости флоу на страницах тегов и в ленте тем
+var publicFlowFilter = `f.is_private = false AND f.is_hidden = false AND ` + publicAccountFilter("f.author_id")
+
+type TagRepository struct {
+	db               *sql.DB
+	hideUnclassified bool
+}
+
+func NewTagRepository(db *sql.DB, hideUnclassified bool) *TagRepository {
+	return &TagRepository{
+		db:               db,
+		hideUnclassified: hideUnclassified,
+	}
+}
+
+// GetTag возвращает тег с числом публичных флоу и отметкой о подписке
+func (r *TagRepository) GetTag(ctx context.Context, name string, userID int) (domain.Tag, error) {
+	var tag domain.Tag
+	err := r.db.QueryRowContext(ctx, `
+	SELECT
+		t.name,
+		(
+			SELECT COUNT(DISTINCT ft.flow_id)
+			FROM flow_tag ft
+			JOIN flow f ON f.id = ft.flow_id
+			WHERE ft.tag_id = t.id AND `+publicFlowFilter+
+		classifiedFilter(r.hideUnclassified, classifiedCondition)+`
+		) AS flow_count,
+		EXISTS (
+			SELECT 1 FROM tag_follower tf
+			WHERE tf.tag_id = t.id AND tf.user_id = $2
+		) AS is_followed
+	FROM tag t
+	WHERE t.name = $1
+	`, name, userID).Scan(&tag.Name, &tag.FlowCount, &tag.IsFollowed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Tag{}, domain.ErrNotFound
+	}
+	if err != nil {
+		return domain.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+// GetTagFlows возвращает публичные флоу с тегом, начиная с самых популярных
+func (r *TagRepository) GetTagFlows(ctx context.Context, name string, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT
+		f.id,
+		f.title,
+		f.description,
+		f.author_id,
+		f.is_private,
+		f.media_url,
+		f.width,
+		f.height,
+		f.is_nsfw,
+		fu.username,
+		`+flowMediaColumn+`
+	FROM flow f
+	JOIN flow_user fu ON fu.id = f.author_id
+	WHERE `+publicFlowFilter+nsfwFilter(nsfwMode)+classifiedFilter(r.hideUnclassified, classifiedCondition)+`
+	AND EXISTS (
+		SELECT 1 FROM flow_tag ft
+		JOIN tag t ON t.id = ft.tag_id
+		WHERE ft.flow_id = f.id AND t.name = $1
+	)
+	ORDER BY `+flowPopularity+` DESC, f.id DESC
+	LIMIT $2 OFFSET $3
+	`, name, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTagFlows(rows)
+}
+
+// Autocomplete подсказывает теги по началу названия, самые используемые первыми
+func (r *TagRepository) Autocomplete(ctx context.Context, prefix string, limit int) ([]domain.Tag, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT t.name, COUNT(DISTINCT ft.flow_id) AS flow_count
+	FROM tag t
+	JOIN flow_tag ft ON ft.tag_id = t.id
+	JOIN flow f ON f.id = ft.flow_id
+	WHERE t.name LIKE $1 || '%' AND `+publicFlowFilter+`
+	GROUP BY t.id
+	ORDER BY flow_count DESC, t.name
+	LIMIT $2
+	`, escapeLike(prefix), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []domain.Tag
+	for rows.Next() {
+		var tag domain.Tag
+		if err := rows.Scan(&tag.Name, &tag.FlowCount); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// FollowTag подписывает пользователя на тему. Повторная подписка ничего не меняет.
+func (r *TagRepository) FollowTag(ctx context.Context, name string, userID int) error {
+	result, err := r.db.ExecContext(ctx, `
+	INSERT INTO tag_follower (tag_id, user_id)
+	SELECT id, $2 FROM tag WHERE name = $1
+	ON CONFLICT (tag_id, user_id) DO NOTHING
+	`, name, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// ноль строк бывает и при повторной подписке, поэтому проверяем, есть ли тег
+	if rowsAffected == 0 {
+		var exists bool
+		err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM tag WHERE name = $1)
+		`, name).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return domain.ErrNotFound
+		}
+	}
+
+	return nil
+}
+
+func (r *TagRepository) UnfollowTag(ctx context.Context, name string, userID int) error {
+	_, err := r.db.ExecContext(ctx, `
+	DELETE FROM tag_follower
+	WHERE user_id = $2
+	AND tag_id = (SELECT id FROM tag WHERE name = $1)
+	`, name, userID)
+
+	return err
+}
+
+func (r *TagRepository) GetFollowedTags(ctx context.Context, userID int) ([]domain.Tag, error) {
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT t.name
+	FROM tag_follower tf
+	JOIN tag t ON t.id = tf.tag_id
+	WHERE tf.user_id = $1
+	ORDER BY tf.created_at DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []domain.Tag
+	for rows.Next() {
+		tag := domain.Tag{IsFollowed: true}
+		if err := rows.Scan(&tag.Name); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// GetFollowedTagsFlow возвращает свежие флоу по темам, на которые подписан пользователь.
+// Свои флоу пользователь в этой ленте не видит.
+func (r *TagRepository) GetFollowedTagsFlow(ctx context.Context, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT
+		f.id,
+		f.title,
+		f.description,
+		f.author_id,
+		f.is_private,
+		f.media_url,
+		f.width,
+		f.height,
+		f.is_nsfw,
+		fu.username,
+		`+flowMediaColumn+`
+	FROM flow f
+	JOIN flow_user fu ON fu.id = f.author_id
+	WHERE `+publicFlowFilter+nsfwFilter(nsfwMode)+classifiedFilter(r.hideUnclassified, classifiedCondition)+`
+	AND f.author_id <> $1
+	AND EXISTS (
+		SELECT 1 FROM flow_tag ft
+		JOIN tag_follower tf ON tf.tag_id = ft.tag_id
+		WHERE ft.flow_id = f.id AND tf.user_id = $1
+	)
+	ORDER BY f.created_at DESC, f.id DESC
+	LIMIT $2 OFFSET $3
+	`, userID, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTagFlows(rows)
+}
+
+func scanTagFlows(rows *sql.Rows) ([]domain.PinData, error) {
+	var flows []domain.PinData
+	for rows.Next() {
+		var flowDBRow flowDBSchema
+		var rawMedia string
+		err := rows.Scan(
+			&flowDBRow.ID,
+			&flowDBRow.Title,
+			&flowDBRow.Description,
+			&flowDBRow.AuthorId,
+			&flowDBRow.IsPrivate,
+			&flowDBRow.MediaURL,
+			&flowDBRow.Width,
+			&flowDBRow.Height,
+			&flowDBRow.IsNSFW,
+			&flowDBRow.AuthorUsername,
+			&rawMedia,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		media, err := parseFlowMedia(rawMedia)
+		if err != nil {
+			return nil, err
+		}
+
+		flows = append(flows, domain.PinData{
+			FlowID:         flowDBRow.ID,
+			Header:         flowDBRow.Title.String,
+			Description:    flowDBRow.Description.String,
+			AuthorID:       flowDBRow.AuthorId,
+			AuthorUsername: flowDBRow.AuthorUsername,
+			IsPrivate:      flowDBRow.IsPrivate,
+			MediaURL:       flowDBRow.MediaURL,
+			Width:          int(flowDBRow.Width.Int64),
+			Height:         int(flowDBRow.Height.Int64),
+			IsNSFW:         flowDBRow.IsNSFW,
+			Media:          media,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return flows, nil
+}
+
+// escapeLike экранирует символы шаблона LIKE в пользовательском вводе
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
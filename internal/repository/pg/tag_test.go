@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTagMock(t *testing.T) (*TagRepository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock DB: %v", err)
+	}
+
+	repo := NewTagRepository(db, false)
+	return repo, mock, func() { db.Close() }
+}
+
+func TestGetTag_NotFound(t *testing.T) {
+	repo, mock, closeFn := setupTagMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM tag t WHERE t.name = $1")).
+		WithArgs("cats", 2).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetTag(context.Background(), "cats", 2)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFollowTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		inserted int64
+		exists   bool
+		expected error
+	}{
+		{"Сценарий: подписка", 1, true, nil},
+		{"Сценарий: повторная подписка", 0, true, nil},
+		{"Сценарий: тега нет", 0, false, domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeFn := setupTagMock(t)
+			defer closeFn()
+
+			mock.ExpectExec(regexp.QuoteMeta("INSERT INTO tag_follower")).
+				WithArgs("cats", 2).
+				WillReturnResult(sqlmock.NewResult(0, tt.inserted))
+			if tt.inserted == 0 {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS (SELECT 1 FROM tag WHERE name = $1)")).
+					WithArgs("cats").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(tt.exists))
+			}
+
+			err := repo.FollowTag(context.Background(), "cats", 2)
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAutocomplete_EscapesPattern(t *testing.T) {
+	repo, mock, closeFn := setupTagMock(t)
+	defer closeFn()
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE t.name LIKE $1 || '%'")).
+		WithArgs(`a\_b`, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "flow_count"}).AddRow("a_bc", 4))
+
+	tags, err := repo.Autocomplete(context.Background(), "a_b", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Tag{{Name: "a_bc", FlowCount: 4}}, tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestParseFlowTags(t *testing.T) {
+	tags, err := parseFlowTags(`[{"name":"море","source":"user","confidence":null},{"name":"beach","source":"machine","confidence":0.75}]`)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.FlowTag{
+		{Name: "море", Source: domain.TagSourceUser},
+		{Name: "beach", Source: domain.TagSourceMachine, Confidence: 0.75},
+	}, tags)
+}
+
+func TestGetTagFlows_HidesUnclassified(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock DB: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewTagRepository(db, true)
+
+	mock.ExpectQuery(regexp.QuoteMeta("AND f.nsfw_status IN ('safe', 'nsfw')")).
+		WithArgs("cats", 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	flows, err := repo.GetTagFlows(context.Background(), "cats", 1, 10, "")
+	assert.NoError(t, err)
+	assert.Empty(t, flows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
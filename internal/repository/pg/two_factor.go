@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+func (p *pgUserStorage) GetTwoFactorState(ctx context.Context, userID int) (domain.TwoFactorState, error) {
+	var state domain.TwoFactorState
+	var secret sql.NullString
+	var lockedUntil sql.NullTime
+
+	err := p.db.QueryRowContext(ctx, `
+	SELECT
+		u.email,
+		u.totp_secret,
+		u.totp_enabled,
+		u.totp_last_step,
+		u.totp_locked_until,
+		(SELECT COUNT(*) FROM recovery_code rc WHERE rc.user_id = u.id AND rc.used_at IS NULL)
+	FROM flow_user u
+	WHERE u.id = $1
+	`, userID).Scan(
+		&state.Email,
+		&secret,
+		&state.Enabled,
+		&state.LastStep,
+		&lockedUntil,
+		&state.RecoveryCodesLeft,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.TwoFactorState{}, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return domain.TwoFactorState{}, err
+	}
+
+	state.Secret = secret.String
+	if lockedUntil.Valid {
+		state.LockedUntil = lockedUntil.Time
+	}
+
+	return state, nil
+}
+
+// SetTwoFactorSecret сохраняет новый секрет до подтверждения. Если второй
+// фактор уже включён, секрет не меняется
+func (p *pgUserStorage) SetTwoFactorSecret(ctx context.Context, userID int, secret string) error {
+	res, err := p.db.ExecContext(ctx, `
+	UPDATE flow_user
+	SET totp_secret = $2, totp_last_step = 0
+	WHERE id = $1 AND NOT totp_enabled
+	`, userID, secret)
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	return nil
+}
+
+// EnableTwoFactor включает второй фактор и выдаёт новые коды восстановления
+func (p *pgUserStorage) EnableTwoFactor(ctx context.Context, userID int, step int64, codeHashes []string) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+	UPDATE flow_user
+	SET totp_enabled = TRUE, totp_last_step = $2, totp_failed_attempts = 0
+	WHERE id = $1 AND NOT totp_enabled AND totp_secret IS NOT NULL
+	`, userID, step)
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, codeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (p *pgUserStorage) ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, codeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userID int, codeHashes []string) error {
+	if _, err := tx.ExecContext(ctx, `
+	DELETE FROM recovery_code
+	WHERE user_id = $1
+	`, userID); err != nil {
+		return err
+	}
+
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(ctx, `
+		INSERT INTO recovery_code (user_id, code_hash)
+		VALUES ($1, $2)
+		`, userID, hash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DisableTwoFactor выключает второй фактор и удаляет секрет и коды восстановления
+func (p *pgUserStorage) DisableTwoFactor(ctx context.Context, userID int) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+	UPDATE flow_user
+	SET totp_enabled = FALSE, totp_secret = NULL, totp_last_step = 0,
+		totp_failed_attempts = 0, totp_locked_until = NULL
+	WHERE id = $1
+	`, userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	DELETE FROM recovery_code
+	WHERE user_id = $1
+	`, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UseTOTPStep отмечает интервал кода использованным. Код того же или более
+// раннего интервала повторно не принимается
+func (p *pgUserStorage) UseTOTPStep(ctx context.Context, userID int, step int64) error {
+	res, err := p.db.ExecContext(ctx, `
+	UPDATE flow_user
+	SET totp_last_step = $2, totp_failed_attempts = 0
+	WHERE id = $1 AND totp_last_step < $2
+	`, userID, step)
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// UseRecoveryCode гасит код восстановления; каждый код работает один раз
+func (p *pgUserStorage) UseRecoveryCode(ctx context.Context, userID int, codeHash string) error {
+	res, err := p.db.ExecContext(ctx, `
+	WITH used AS (
+		UPDATE recovery_code
+		SET used_at = NOW()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+		RETURNING user_id
+	)
+	UPDATE flow_user
+	SET totp_failed_attempts = 0
+	FROM used
+	WHERE flow_user.id = used.user_id
+	`, userID, codeHash)
+	if err != nil {
+		return err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// RecordTwoFactorFailure считает неверный код и после maxAttempts подряд
+// блокирует ввод кодов до lockUntil
+func (p *pgUserStorage) RecordTwoFactorFailure(ctx context.Context, userID, maxAttempts int, lockUntil time.Time) error {
+	_, err := p.db.ExecContext(ctx, `
+	UPDATE flow_user
+	SET
+		totp_failed_attempts = CASE WHEN totp_failed_attempts + 1 >= $2 THEN 0 ELSE totp_failed_attempts + 1 END,
+		totp_locked_until = CASE WHEN totp_failed_attempts + 1 >= $2 THEN $3 ELSE totp_locked_until END
+	WHERE id = $1
+	`, userID, maxAttempts, lockUntil)
+
+	return err
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTwoFactorMock(t *testing.T) (*pgUserStorage, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock: %v", err)
+	}
+
+	repo, _ := NewPGUserStorage(db)
+	return repo, mock, func() { db.Close() }
+}
+
+func TestGetTwoFactorState(t *testing.T) {
+	repo, mock, closeFn := setupTwoFactorMock(t)
+	defer closeFn()
+
+	lockedUntil := time.Now().Add(time.Minute)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM flow_user u WHERE u.id = $1")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"email", "totp_secret", "totp_enabled", "totp_last_step", "totp_locked_until", "count"}).
+			AddRow("user@mail.ru", "SECRET", true, int64(42), lockedUntil, 8))
+
+	state, err := repo.GetTwoFactorState(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.TwoFactorState{
+		Email:             "user@mail.ru",
+		Secret:            "SECRET",
+		Enabled:           true,
+		LastStep:          42,
+		LockedUntil:       lockedUntil,
+		RecoveryCodesLeft: 8,
+	}, state)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEnableTwoFactor(t *testing.T) {
+	repo, mock, closeFn := setupTwoFactorMock(t)
+	defer closeFn()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SET totp_enabled = TRUE")).
+		WithArgs(1, int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM recovery_code WHERE user_id = $1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO recovery_code (user_id, code_hash)")).
+		WithArgs(1, "hash1").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO recovery_code (user_id, code_hash)")).
+		WithArgs(1, "hash2").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	err := repo.EnableTwoFactor(context.Background(), 1, 100, []string{"hash1", "hash2"})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseTwoFactorCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		recovery bool
+		affected int64
+		expected error
+	}{
+		{"Сценарий: новый интервал кода", false, 1, nil},
+		{"Сценарий: повтор кода", false, 0, domain.ErrInvalidTwoFactorCode},
+		{"Сценарий: код восстановления", true, 1, nil},
+		{"Сценарий: код восстановления уже использован", true, 0, domain.ErrInvalidTwoFactorCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, closeFn := setupTwoFactorMock(t)
+			defer closeFn()
+
+			var err error
+			if tt.recovery {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE recovery_code SET used_at = NOW()")).
+					WithArgs(1, "hash").
+					WillReturnResult(sqlmock.NewResult(0, tt.affected))
+				err = repo.UseRecoveryCode(context.Background(), 1, "hash")
+			} else {
+				mock.ExpectExec(regexp.QuoteMeta("WHERE id = $1 AND totp_last_step < $2")).
+					WithArgs(1, int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, tt.affected))
+				err = repo.UseTOTPStep(context.Background(), 1, 100)
+			}
+
+			assert.ErrorIs(t, err, tt.expected)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
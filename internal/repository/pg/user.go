@@ -44,10 +44,15 @@ func (p *pgUserStorage) AddUser(ctx context.Context, userInfo domain.User) (uint
 		SELECT id
 		FROM flow_user
 		WHERE username = $1 OR email = $4
+	), reserved_check AS (
+		SELECT user_id
+		FROM username_history
+		WHERE old_username = $1 AND reserved_until > NOW()
 	)
 	INSERT INTO flow_user (username, avatar, public_name, email, password)
 	SELECT $1, $2, $3, $4, $5
 	WHERE NOT EXISTS (SELECT 1 FROM conflict_check)
+	AND NOT EXISTS (SELECT 1 FROM reserved_check)
 	RETURNING id;
     `, userInfo.Username, userInfo.Avatar, userInfo.Username, userInfo.Email, userInfo.Password).Scan(&id)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -67,7 +72,7 @@ func (p *pgUserStorage) GetHash(ctx context.Context, email, password string) (ui
 	err := p.db.QueryRowContext(ctx, `
         SELECT id, password, username
 		FROM flow_user
-		WHERE email = $1
+		WHERE email = $1 AND is_suspended = false
     `, email).Scan(&id, &hashedPassword, &username)
 	if errors.Is(err, sql.ErrNoRows) {
 		return 0, "", "", domain.ErrInvalidCredentials
@@ -119,13 +124,25 @@ func (p *pgUserStorage) GetUserId(ctx context.Context, email string) (uint64, er
 	return id, nil
 }
 
+// CheckImgPermission проверяет доступ к любому файлу флоу: обложке,
+// элементам карусели, видео и их превью из flow_media
 func (p *pgUserStorage) CheckImgPermission(ctx context.Context, imageName string, userID int) (bool, error) {
     query := `
-    SELECT EXISTS (
-        SELECT 1 FROM flow f
+    WITH matched AS (
+        SELECT f.id, f.author_id, f.is_private, f.is_hidden
+        FROM flow f
         WHERE f.media_url = $1
+        OR EXISTS (
+            SELECT 1 FROM flow_media fm
+            WHERE fm.flow_id = f.id
+            AND (fm.media_url = $1 OR fm.poster_url = $1)
+        )
+    )
+    SELECT EXISTS (
+        SELECT 1 FROM matched f
+        WHERE (f.is_hidden = false OR f.author_id = $2)
         AND (
-            f.is_private = false
+            (f.is_private = false AND ` + accountVisibleFilter("f.author_id", "$2") + `)
             OR f.author_id = $2
             OR EXISTS (
                 SELECT 1 FROM board_post bp
@@ -138,7 +155,7 @@ func (p *pgUserStorage) CheckImgPermission(ctx context.Context, imageName string
             )
         )
     ) AS has_access,
-    EXISTS (SELECT 1 FROM flow WHERE media_url = $1) AS image_exists
+    EXISTS (SELECT 1 FROM matched) AS image_exists
     `
 
     var hasAccess, imageExists bool
@@ -153,46 +170,3 @@ func (p *pgUserStorage) CheckImgPermission(ctx context.Context, imageName string
 
     return hasAccess, nil
 }
-
-func (p *pgUserStorage) FindExternalServiceUser(ctx context.Context, email string, externalID string) (int, string, string, error) {
-	var id int
-	var gotEmail string
-	var username string
-
-	err := p.db.QueryRowContext(ctx, `
-	SELECT id, email, username
-	FROM flow_user
-	WHERE external_id = $1
-	AND email = $2`, externalID, email).Scan(&id, &gotEmail, &username)
-	if errors.Is(err, sql.ErrNoRows) {
-		return 0, "", "", domain.ErrNotFound
-	}
-	if err != nil {
-		return 0, "", "", err
-	}
-
-	return id, gotEmail, username, nil
-}
-
-func (p *pgUserStorage) AddExternalUser(ctx context.Context, email, username, password, avatarURL string, externalID string) (uint64, error) {
-	var id uint64
-
-	err := p.db.QueryRowContext(ctx, `
-	WITH conflict_check AS (
-		SELECT id
-		FROM flow_user
-		WHERE email = $3 OR username = $1
-	)
-	INSERT INTO flow_user (username, public_name, email, password, external_id, avatar, is_external_avatar)
-	SELECT $1, $2, $3, $4, $5, $6, $7
-	WHERE NOT EXISTS (SELECT 1 FROM conflict_check)
-	RETURNING id;
-    `, username, username, email, password, externalID, avatarURL, true).Scan(&id)
-	if errors.Is(err, sql.ErrNoRows) {
-		return 0, domain.ErrConflict
-	} else if err != nil {
-		return 0, err
-	}
-
-	return id, nil
-}
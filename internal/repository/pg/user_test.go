@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"regexp"
 	"testing"
 	"time"
 
@@ -39,10 +40,15 @@ func TestAddUser_Success(t *testing.T) {
         SELECT id
         FROM flow_user
         WHERE username = \$1 OR email = \$4
+    \), reserved_check AS \(
+        SELECT user_id
+        FROM username_history
+        WHERE old_username = \$1 AND reserved_until > NOW\(\)
     \)
     INSERT INTO flow_user \(username, avatar, public_name, email, password\)
     SELECT \$1, \$2, \$3, \$4, \$5
     WHERE NOT EXISTS \(SELECT 1 FROM conflict_check\)
+    AND NOT EXISTS \(SELECT 1 FROM reserved_check\)
     RETURNING id;
         `).
         WithArgs(userInfo.Username, userInfo.Avatar, userInfo.Username, userInfo.Email, userInfo.Password).
@@ -191,3 +197,18 @@ func TestGetUserId_UserNotFound(t *testing.T) {
     assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+
+func TestCheckImgPermission_CarouselItem(t *testing.T) {
+    mock, storage := setupMock(t)
+    defer mock.ExpectClose()
+
+    // второй элемент карусели лежит только в flow_media, а не в flow.media_url
+    mock.ExpectQuery(regexp.QuoteMeta(`WHERE fm.flow_id = f.id AND (fm.media_url = $1 OR fm.poster_url = $1)`)).
+        WithArgs("carousel_2.jpg", 5).
+        WillReturnRows(sqlmock.NewRows([]string{"has_access", "image_exists"}).AddRow(true, true))
+
+    hasAccess, err := storage.CheckImgPermission(context.Background(), "carousel_2.jpg", 5)
+    assert.NoError(t, err)
+    assert.True(t, hasAccess)
+    assert.NoError(t, mock.ExpectationsWereMet())
+}
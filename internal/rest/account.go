@@ -0,0 +1,247 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+type AccountService interface {
+	RequestDeletion(ctx context.Context, userID int, req domain.AccountDeletionRequest) (domain.AccountDeletionStatus, error)
+	CancelDeletion(ctx context.Context, userID int) error
+	GetDeletionStatus(ctx context.Context, userID int) (domain.AccountDeletionStatus, error)
+	RequestExport(ctx context.Context, userID int) (domain.DataExport, error)
+	GetExport(ctx context.Context, userID, exportID int) (domain.DataExport, error)
+	GetExportFile(ctx context.Context, userID, exportID int) (string, error)
+}
+
+type AccountHandler struct {
+	Service           AccountService
+	ContextExpiration time.Duration
+}
+
+// DeleteAccount godoc
+//	@Summary		Request account deletion
+//	@Description	Schedules deletion of the account with all flows, boards, comments, likes, chats and files after a grace period. The user confirms with the username and password (VK accounts only with the username). Until then the deletion can be cancelled
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			confirmation	body	domain.AccountDeletionRequest	true	"username and password"
+//	@Success		202		string	serverResponse.Data			"deletion date"
+//	@Failure		400		string	serverResponse.Description	"username does not match"
+//	@Failure		401		string	serverResponse.Description	"wrong password"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile [delete]
+func (h *AccountHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	var req domain.AccountDeletionRequest
+	if err := DecodeData(w, r.Body, &req); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	status, err := h.Service.RequestDeletion(ctx, claims.UserID, req)
+	if err != nil {
+		handleAccountError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "Accepted",
+		Data:        status,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusAccepted)
+}
+
+// GetDeletionStatus godoc
+//	@Summary		Get account deletion status
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/deletion [get]
+func (h *AccountHandler) GetDeletionStatus(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	status, err := h.Service.GetDeletionStatus(ctx, claims.UserID)
+	if err != nil {
+		handleAccountError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        status,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// CancelDeletion godoc
+//	@Summary		Cancel account deletion
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Success		200		string	serverResponse.Description	"OK"
+//	@Failure		404		string	serverResponse.Description	"deletion is not scheduled"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/deletion [delete]
+func (h *AccountHandler) CancelDeletion(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	if err := h.Service.CancelDeletion(ctx, claims.UserID); err != nil {
+		handleAccountError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// RequestExport godoc
+//	@Summary		Request data export
+//	@Description	Queues a ZIP archive with the profile, flows with original media, boards, comments and messages. If an archive is already being built, it is returned instead
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Success		202		string	serverResponse.Data			"export job"
+//	@Failure		409		string	serverResponse.Description	"export is already queued"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/export [post]
+func (h *AccountHandler) RequestExport(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	export, err := h.Service.RequestExport(ctx, claims.UserID)
+	if err != nil {
+		handleAccountError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "Accepted",
+		Data:        withDownloadURL(export),
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusAccepted)
+}
+
+// GetExport godoc
+//	@Summary		Get data export status
+//	@Description	Returns the export job; download_url is set once the archive is ready
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			export_id	path	int							true	"export id"
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"invalid export id"
+//	@Failure		404		string	serverResponse.Description	"export not found"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/export/{export_id} [get]
+func (h *AccountHandler) GetExport(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	exportID, err := strconv.Atoi(r.PathValue("export_id"))
+	if err != nil || exportID <= 0 {
+		HttpErrorToJson(w, "invalid export id", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	export, err := h.Service.GetExport(ctx, claims.UserID, exportID)
+	if err != nil {
+		handleAccountError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        withDownloadURL(export),
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// DownloadExport godoc
+//	@Summary		Download data export
+//	@Produce		application/zip
+//	@Security		jwt_auth
+//	@Param			export_id	path	int							true	"export id"
+//	@Success		200		{file}	binary						"ZIP archive"
+//	@Failure		400		string	serverResponse.Description	"invalid export id"
+//	@Failure		404		string	serverResponse.Description	"export not found"
+//	@Failure		409		string	serverResponse.Description	"export is not ready"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/export/{export_id}/download [get]
+func (h *AccountHandler) DownloadExport(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	exportID, err := strconv.Atoi(r.PathValue("export_id"))
+	if err != nil || exportID <= 0 {
+		HttpErrorToJson(w, "invalid export id", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	path, err := h.Service.GetExportFile(ctx, claims.UserID, exportID)
+	if err != nil {
+		handleAccountError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="flow-export-%d.zip"`, exportID))
+	w.Header().Set("Cache-Control", "private, no-store")
+	http.ServeFile(w, r, path)
+}
+
+func withDownloadURL(export domain.DataExport) domain.DataExport {
+	if export.Status == domain.ExportReady {
+		export.DownloadURL = fmt.Sprintf("/api/v1/profile/export/%d/download", export.ID)
+	}
+
+	return export
+}
+
+func handleAccountError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrDeletionNotConfirmed):
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrInvalidCredentials):
+		HttpErrorToJson(w, "wrong password", http.StatusUnauthorized)
+	case errors.Is(err, domain.ErrDeletionNotScheduled):
+		HttpErrorToJson(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrNotFound), errors.Is(err, domain.ErrUserNotFound):
+		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	case errors.Is(err, domain.ErrExportNotReady):
+		HttpErrorToJson(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrConflict):
+		HttpErrorToJson(w, "export is already queued", http.StatusConflict)
+	default:
+		log.Printf("account error: %v", err)
+		HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
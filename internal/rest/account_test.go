@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	mocks "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/account/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockAccountService(ctrl)
+	handler := AccountHandler{
+		Service:           mockService,
+		ContextExpiration: time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 7}
+	req := domain.AccountDeletionRequest{Username: "alice", Password: "secret"}
+
+	t.Run("Scheduled", func(t *testing.T) {
+		deleteAfter := time.Now().Add(time.Hour)
+		mockService.EXPECT().RequestDeletion(gomock.Any(), 7, req).
+			Return(domain.AccountDeletionStatus{Scheduled: true, DeleteAfter: &deleteAfter}, nil)
+
+		r := httptest.NewRequest(http.MethodDelete, "/api/v1/profile", strings.NewReader(`{"username":"alice","password":"secret"}`))
+		r = r.WithContext(context.WithValue(r.Context(), auth.ClaimsContextKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.DeleteAccount(rr, r)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"delete_after"`)
+	})
+
+	t.Run("Wrong password", func(t *testing.T) {
+		mockService.EXPECT().RequestDeletion(gomock.Any(), 7, req).
+			Return(domain.AccountDeletionStatus{}, domain.ErrInvalidCredentials)
+
+		r := httptest.NewRequest(http.MethodDelete, "/api/v1/profile", strings.NewReader(`{"username":"alice","password":"secret"}`))
+		r = r.WithContext(context.WithValue(r.Context(), auth.ClaimsContextKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.DeleteAccount(rr, r)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestGetExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockAccountService(ctrl)
+	handler := AccountHandler{
+		Service:           mockService,
+		ContextExpiration: time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 7}
+
+	t.Run("Ready", func(t *testing.T) {
+		mockService.EXPECT().GetExport(gomock.Any(), 7, 3).
+			Return(domain.DataExport{ID: 3, Status: domain.ExportReady, FileName: "secret.zip"}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/profile/export/3", nil)
+		r.SetPathValue("export_id", "3")
+		r = r.WithContext(context.WithValue(r.Context(), auth.ClaimsContextKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.GetExport(rr, r)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"download_url":"/api/v1/profile/export/3/download"`)
+		assert.NotContains(t, rr.Body.String(), "secret.zip")
+	})
+
+	t.Run("Not ready", func(t *testing.T) {
+		mockService.EXPECT().GetExportFile(gomock.Any(), 7, 3).Return("", domain.ErrExportNotReady)
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/profile/export/3/download", nil)
+		r.SetPathValue("export_id", "3")
+		r = r.WithContext(context.WithValue(r.Context(), auth.ClaimsContextKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.DownloadExport(rr, r)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Invalid id", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/profile/export/x", nil)
+		r.SetPathValue("export_id", "x")
+		r = r.WithContext(context.WithValue(r.Context(), auth.ClaimsContextKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.GetExport(rr, r)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
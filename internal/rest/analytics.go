@@ -0,0 +1,197 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+// EventRecorder принимает события для статистики авторов. Запись
+// асинхронная, поэтому обработчики не ждут базу
+type EventRecorder interface {
+	Record(event domain.AnalyticsEvent)
+}
+
+type AnalyticsService interface {
+	GetAnalytics(ctx context.Context, userID int, query domain.AnalyticsQuery) (domain.CreatorAnalytics, error)
+}
+
+type AnalyticsHandler struct {
+	Service           AnalyticsService
+	Events            EventRecorder
+	ContextExpiration time.Duration
+}
+
+const (
+	analyticsDateLayout = "2006-01-02"
+	// период по умолчанию — последние 30 дней
+	defaultAnalyticsDays = 30
+	defaultAnalyticsTop  = 10
+)
+
+// RecordEvent записывает событие, если статистика подключена
+func RecordEvent(events EventRecorder, event domain.AnalyticsEvent) {
+	if events == nil {
+		return
+	}
+
+	events.Record(event)
+}
+
+// RecordImpressions записывает показ каждого флоу страницы
+func RecordImpressions(events EventRecorder, flows []domain.PinData, viewerID uint64) {
+	if events == nil {
+		return
+	}
+
+	now := time.Now()
+	for _, flow := range flows {
+		events.Record(domain.AnalyticsEvent{
+			Type:      domain.EventImpression,
+			FlowID:    flow.FlowID,
+			ViewerID:  int(viewerID),
+			CreatedAt: now,
+		})
+	}
+}
+
+// GetAnalytics godoc
+//	@Summary		Get creator analytics
+//	@Description	Returns daily statistics of the user's flows for a date range, totals and top flows. With flow_id only this flow is counted
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			from	query	string						false	"first day, YYYY-MM-DD, 30 days ago by default"	example("?from=2025-05-01")
+//	@Param			to		query	string						false	"last day, YYYY-MM-DD, today by default"		example("?to=2025-05-31")
+//	@Param			flow_id	query	int							false	"statistics of one flow"
+//	@Param			sort	query	string						false	"top flows order: impressions, closeups, saves, likes, comments, outbound_clicks"
+//	@Param			top		query	int							false	"number of top flows, 0-50, 10 by default"
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"bad request"
+//	@Failure		404		string	serverResponse.Description	"flow not found"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/analytics [get]
+func (h *AnalyticsHandler) GetAnalytics(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	query, err := parseAnalyticsQuery(r)
+	if err != nil {
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	analytics, err := h.Service.GetAnalytics(ctx, claims.UserID, query)
+	if err != nil {
+		handleAnalyticsError(w, err)
+		return
+	}
+
+	for i := range analytics.TopFlows {
+		analytics.TopFlows[i].Escape()
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        analytics,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// RecordOutboundClick godoc
+//	@Summary		Record a click on the flow source link
+//	@Description	Called by the client before opening the page the flow was saved from
+//	@Produce		json
+//	@Param			flow_id	path	int							true	"flow id"
+//	@Success		200		string	serverResponse.Description	"OK"
+//	@Failure		400		string	serverResponse.Description	"invalid flow id"
+//	@Router			/api/v1/flows/{flow_id}/clicks [post]
+func (h *AnalyticsHandler) RecordOutboundClick(w http.ResponseWriter, r *http.Request) {
+	flowID, err := strconv.ParseUint(r.PathValue("flow_id"), 10, 64)
+	if err != nil || flowID == 0 {
+		HttpErrorToJson(w, "invalid flow id", http.StatusBadRequest)
+		return
+	}
+
+	RecordEvent(h.Events, domain.AnalyticsEvent{
+		Type:     domain.EventOutboundClick,
+		FlowID:   flowID,
+		ViewerID: int(viewerID(r)),
+	})
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+func parseAnalyticsQuery(r *http.Request) (domain.AnalyticsQuery, error) {
+	values := r.URL.Query()
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	query := domain.AnalyticsQuery{
+		From:   today.AddDate(0, 0, -(defaultAnalyticsDays - 1)),
+		To:     today,
+		SortBy: domain.AnalyticsSortImpressions,
+		Top:    defaultAnalyticsTop,
+	}
+
+	if from := values.Get("from"); from != "" {
+		date, err := time.Parse(analyticsDateLayout, from)
+		if err != nil {
+			return domain.AnalyticsQuery{}, domain.ErrInvalidDateRange
+		}
+		query.From = date
+	}
+
+	if to := values.Get("to"); to != "" {
+		date, err := time.Parse(analyticsDateLayout, to)
+		if err != nil {
+			return domain.AnalyticsQuery{}, domain.ErrInvalidDateRange
+		}
+		query.To = date
+	}
+
+	if flowID := values.Get("flow_id"); flowID != "" {
+		id, err := strconv.ParseUint(flowID, 10, 64)
+		if err != nil || id == 0 {
+			return domain.AnalyticsQuery{}, errors.New("invalid flow_id")
+		}
+		query.FlowID = id
+	}
+
+	if sort := values.Get("sort"); sort != "" {
+		query.SortBy = sort
+	}
+
+	if top := values.Get("top"); top != "" {
+		n, err := strconv.Atoi(top)
+		if err != nil || n < 0 || n > domain.MaxAnalyticsTopFlows {
+			return domain.AnalyticsQuery{}, errors.New("invalid top")
+		}
+		query.Top = n
+	}
+
+	return query, nil
+}
+
+func handleAnalyticsError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidDateRange), errors.Is(err, domain.ErrInvalidAnalyticsSort):
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrNotFound):
+		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	default:
+		log.Printf("analytics error: %v", err)
+		HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
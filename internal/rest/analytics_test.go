@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	mocks "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/analytics/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAnalytics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockAnalyticsService(ctrl)
+	handler := AnalyticsHandler{
+		Service:           mockService,
+		ContextExpiration: time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 7}
+
+	t.Run("Defaults", func(t *testing.T) {
+		mockService.EXPECT().
+			GetAnalytics(gomock.Any(), 7, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ int, query domain.AnalyticsQuery) (domain.CreatorAnalytics, error) {
+				assert.Equal(t, defaultAnalyticsDays-1, int(query.To.Sub(query.From).Hours()/24))
+				assert.Equal(t, domain.AnalyticsSortImpressions, query.SortBy)
+				assert.Equal(t, defaultAnalyticsTop, query.Top)
+				return domain.CreatorAnalytics{TopFlows: []domain.TopFlow{{FlowID: 1, Header: "<b>cat</b>"}}}, nil
+			})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/analytics", nil)
+		req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.GetAnalytics(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NotContains(t, rr.Body.String(), "<b>")
+	})
+
+	t.Run("Bad date", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/analytics?from=01.05.2025", nil)
+		req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.GetAnalytics(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Not author", func(t *testing.T) {
+		mockService.EXPECT().
+			GetAnalytics(gomock.Any(), 7, gomock.Any()).
+			Return(domain.CreatorAnalytics{}, domain.ErrNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/analytics?flow_id=4", nil)
+		req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.GetAnalytics(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestRecordOutboundClick(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEvents := mocks.NewMockEventRecorder(ctrl)
+	handler := AnalyticsHandler{Events: mockEvents}
+
+	mockEvents.EXPECT().Record(gomock.Any()).Do(func(event domain.AnalyticsEvent) {
+		assert.Equal(t, domain.EventOutboundClick, event.Type)
+		assert.Equal(t, uint64(3), event.FlowID)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/flows/3/clicks", nil)
+	req.SetPathValue("flow_id", "3")
+	rr := httptest.NewRecorder()
+
+	handler.RecordOutboundClick(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/flows/x/clicks", nil)
+	req.SetPathValue("flow_id", "x")
+	rr = httptest.NewRecorder()
+
+	handler.RecordOutboundClick(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/configs"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/csrf"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/oauth"
 	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
 	gen "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/auth"
 	"google.golang.org/grpc/codes"
@@ -22,6 +23,8 @@ type AuthHandler struct {
 	UserService     gen.AuthClient
 	JWTManager      auth.JWTManager
 	ContextDuration time.Duration
+	OAuth           *oauth.Registry
+	OAuthSigner     *oauth.Signer
 }
 
 var (
@@ -36,6 +39,7 @@ var (
 //	@Param			email		body	string		true	"user email"	example("user@mail.ru")
 //	@Param			password	body	string		true	"user password"	example("abcdefgh1234")
 //	@Success		200			string	Description	"OK"
+//	@Success		202			string	Data		"two-factor code required, see /api/v1/auth/login/2fa"
 //	@Failure		400			string	Description	"Bad Request"
 //	@Failure		403			string	Description	"invalid credentials"
 //	@Failure		500			string	Description	"Internal server error"
@@ -60,6 +64,11 @@ func (app AuthHandler) LoginHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if grpcResp.TwoFactorRequired {
+		app.requireTwoFactor(w, data.Email, grpcResp.Username, uint64(grpcResp.ID))
+		return
+	}
+
 	if err := app.setCookieJWT(w, app.Config, data.Email, grpcResp.Username, uint64(grpcResp.ID)); err != nil {
 		handleAuthError(w, err)
 		return
@@ -207,6 +216,11 @@ func (app AuthHandler) ExternalLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if grpcResp.TwoFactorRequired {
+		app.requireTwoFactor(w, grpcResp.Email, grpcResp.Username, uint64(grpcResp.ID))
+		return
+	}
+
 	if err := app.setCookieJWT(w, app.Config, grpcResp.Email, grpcResp.Username, uint64(grpcResp.ID)); err != nil {
 		handleAuthError(w, err)
 		return
@@ -369,6 +383,10 @@ func CheckAuth(r *http.Request, manager auth.JWTManager) (*auth.Claims, error) {
 		return nil, err
 	}
 
+	if err := manager.ValidateSession(r.Context(), claims.UserID, claims.Username); err != nil {
+		return nil, err
+	}
+
 	return claims, nil
 }
 
@@ -419,6 +437,10 @@ func handleGRPCAuthError(w http.ResponseWriter, err error) {
 			HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 		case codes.InvalidArgument:
 			HttpErrorToJson(w, st.Message(), http.StatusBadRequest)
+		case codes.FailedPrecondition:
+			HttpErrorToJson(w, st.Message(), http.StatusConflict)
+		case codes.ResourceExhausted:
+			HttpErrorToJson(w, st.Message(), http.StatusTooManyRequests)
 		default:
 			HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		}
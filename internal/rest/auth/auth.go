@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -12,6 +13,9 @@ import (
 
 const AuthToken = "auth_token"
 
+// аудитория токена, выданного после пароля, но до второго фактора
+const pendingAudience = "2fa-pending"
+
 var (
 	ErrInvalidUser    = errors.New("invalid user")
 	ErrSigningJWT     = errors.New("failed to sign JWT")
@@ -26,10 +30,18 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
+// SessionValidator проверяет, что владелец действующего токена всё ещё
+// может работать с API: не заблокирован модератором, не удалён и не сменил
+// имя, на которое выписан токен
+type SessionValidator interface {
+	ValidateSession(ctx context.Context, userID int, username string) error
+}
+
 type JWTManager struct {
 	secret     []byte
 	expiration time.Duration
 	issuer     string
+	sessions   SessionValidator
 }
 
 func NewJWTManager(cfg configs.Config) *JWTManager {
@@ -40,12 +52,36 @@ func NewJWTManager(cfg configs.Config) *JWTManager {
 	}
 }
 
+// SetSessionValidator включает проверку пользователя на каждый запрос:
+// блокировка должна действовать и на уже выданные токены
+func (mngr *JWTManager) SetSessionValidator(v SessionValidator) {
+	mngr.sessions = v
+}
+
+func (mngr *JWTManager) ValidateSession(ctx context.Context, userID int, username string) error {
+	if mngr.sessions == nil {
+		return nil
+	}
+
+	return mngr.sessions.ValidateSession(ctx, userID, username)
+}
+
 func (mngr *JWTManager) CreateJWT(email, username string, userID int) (string, error) {
+	return mngr.createJWT(email, username, userID, mngr.expiration, nil)
+}
+
+// CreatePendingJWT выдаёт короткоживущий токен второго шага входа. Для
+// доступа к API он не подходит: ParseJWTToken его отвергает
+func (mngr *JWTManager) CreatePendingJWT(email, username string, userID int, ttl time.Duration) (string, error) {
+	return mngr.createJWT(email, username, userID, ttl, jwt.ClaimStrings{pendingAudience})
+}
+
+func (mngr *JWTManager) createJWT(email, username string, userID int, ttl time.Duration, audience jwt.ClaimStrings) (string, error) {
 	if userID == 0 {
 		return "", ErrInvalidUser
 	}
 
-	expiration := time.Now().Add(mngr.expiration)
+	expiration := time.Now().Add(ttl)
 	claims := &Claims{
 		UserID: int(userID),
 		Email:  email,
@@ -53,6 +89,7 @@ func (mngr *JWTManager) CreateJWT(email, username string, userID int) (string, e
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiration),
 			Issuer:    mngr.issuer,
+			Audience:  audience,
 			ID:        uuid.New().String(),
 		},
 	}
@@ -67,12 +104,31 @@ func (mngr *JWTManager) CreateJWT(email, username string, userID int) (string, e
 }
 
 func (mngr *JWTManager) ParseJWTToken(tokenString string) (*Claims, error) {
+	claims, err := mngr.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	// токен второго шага входа не даёт доступа к API
+	if len(claims.Audience) != 0 {
+		return nil, ErrorJWTParse
+	}
+
+	return claims, nil
+}
+
+// ParsePendingJWT принимает только токен второго шага входа
+func (mngr *JWTManager) ParsePendingJWT(tokenString string) (*Claims, error) {
+	return mngr.parse(tokenString, jwt.WithAudience(pendingAudience))
+}
+
+func (mngr *JWTManager) parse(tokenString string, opts ...jwt.ParserOption) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return mngr.secret, nil
-	})
+	}, opts...)
 
 	if err != nil || !token.Valid {
 		return nil, ErrorExpiredToken
@@ -139,4 +139,28 @@ func TestParseJWTToken(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestPendingJWT(t *testing.T) {
+	mngr := NewJWTManager(configs.Config{
+		JWTSecret:      []byte("valid-secret-32-chars-long-123456"),
+		ExpirationTime: time.Hour,
+	})
+
+	pending, err := mngr.CreatePendingJWT("valid@example.com", "cooluser", 1, time.Minute)
+	require.NoError(t, err)
+
+	// токен второго шага не годится для доступа к API
+	_, err = mngr.ParseJWTToken(pending)
+	require.ErrorIs(t, err, ErrorJWTParse)
+
+	claims, err := mngr.ParsePendingJWT(pending)
+	require.NoError(t, err)
+	require.Equal(t, 1, claims.UserID)
+	require.Equal(t, "cooluser", claims.Username)
+
+	session, err := mngr.CreateJWT("valid@example.com", "cooluser", 1)
+	require.NoError(t, err)
+
+	_, err = mngr.ParsePendingJWT(session)
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package rest
 import (
 	"context"
 	"errors"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
@@ -17,21 +18,42 @@ import (
 )
 
 type BoardService interface {
-	CreateBoard(ctx context.Context, board domain.Board, username string, userID int) (int, error)                    // создание доски
-	DeleteBoard(ctx context.Context, boardID, userID int) error                                                       // удаление доски
-	UpdateBoard(ctx context.Context, boardID, userID int, newName string, isPrivate bool) error                       // обновление доски
-	AddToBoard(ctx context.Context, boardID, userID, flowID int) error                                                // добавить пин в доску
-	GetFromBoard(ctx context.Context, boardID, userID, flowID int, authorized bool) (domain.PinData, error)                                              // получить пин из доски
-	DeleteFromBoard(ctx context.Context, boardID, userID, flowID int) error                                           // удалить пин из доски
-	GetBoard(ctx context.Context, boardID, userID int, authorized bool) (domain.Board, error)                         // получить доску
-	GetUserPublicBoards(ctx context.Context, username string) ([]domain.Board, error)                                 // получить публичные доски пользователя
-	GetUserAllBoards(ctx context.Context, userID int) ([]domain.Board, error)                                         // получить все доски пользователя
-	GetBoardFlow(ctx context.Context, boardID, userID, page, pageSize int, authorized bool) ([]domain.PinData, error) // получить пины доски
+	CreateBoard(ctx context.Context, board domain.Board, username string, userID int) (int, error)                                     // создание доски
+	DeleteBoard(ctx context.Context, boardID, userID int) error                                                                        // удаление доски
+	UpdateBoard(ctx context.Context, boardID, userID int, update domain.UpdateData) error                                              // обновление доски
+	SetBoardCover(ctx context.Context, boardID, userID int, file io.Reader, filename string) (string, error)                           // загрузить обложку доски
+	AddToBoard(ctx context.Context, boardID, userID, flowID, savedFromBoardID int) error                                               // добавить пин в доску
+	GetFromBoard(ctx context.Context, boardID, userID, flowID int, authorized bool) (domain.PinData, error)                            // получить пин из доски
+	DeleteFromBoard(ctx context.Context, boardID, userID, flowID int) error                                                            // удалить пин из доски
+	GetBoard(ctx context.Context, boardID, userID int, authorized bool) (domain.Board, error)                                          // получить доску
+	GetUserPublicBoards(ctx context.Context, username string, viewerID int) ([]domain.Board, error)                                    // получить публичные доски пользователя
+	GetUserAllBoards(ctx context.Context, userID int) ([]domain.Board, error)                                                          // получить все доски пользователя
+	GetBoardFlow(ctx context.Context, boardID, userID, page, pageSize int, authorized bool, nsfwMode string) ([]domain.PinData, error) // получить пины доски
+	GetSections(ctx context.Context, boardID, userID int) ([]domain.BoardSection, error)                                               // получить разделы доски
+	CreateSection(ctx context.Context, boardID, userID int, name string) (domain.BoardSection, error)                                  // создать раздел
+	RenameSection(ctx context.Context, boardID, sectionID, userID int, name string) error                                              // переименовать раздел
+	DeleteSection(ctx context.Context, boardID, sectionID, userID int) error                                                           // удалить раздел
+	MoveSection(ctx context.Context, boardID, sectionID, userID int, move domain.SectionMove) error                                    // переставить раздел
+	MoveFlow(ctx context.Context, boardID, flowID, userID int, move domain.FlowMove) error                                             // переместить пин
+	GetSectionFlow(ctx context.Context, boardID, sectionID, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error)     // получить пины раздела
+	FollowBoard(ctx context.Context, boardID, userID int) error                                                                        // подписаться на доску
+	UnfollowBoard(ctx context.Context, boardID, userID int) error                                                                      // отписаться от доски
+	GetBoardFollowers(ctx context.Context, boardID, userID, page, pageSize int) ([]domain.PublicUser, error)                           // получить подписчиков доски
+	GetFollowedBoardsFlow(ctx context.Context, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error)                  // получить новые пины отслеживаемых досок
+	FollowersToNotify(ctx context.Context, boardID, flowID, userID int) (string, []string, error)                                      // получить подписчиков для уведомления о новом пине
+	GetBoardActivity(ctx context.Context, boardID, userID, page, pageSize int) ([]domain.BoardActivity, error)                         // получить журнал доски
+	BulkFlows(ctx context.Context, boardID, userID int, req domain.BulkFlowRequest) ([]domain.BulkFlowResult, error)                   // действие над несколькими пинами
+	MergeBoards(ctx context.Context, boardID, targetBoardID, userID int) error                                                         // слить доску в другую
+	DuplicateBoard(ctx context.Context, boardID, userID int, name string) (int, error)                                                 // создать копию доски
+	GetFlowSaves(ctx context.Context, flowID, page, pageSize int) ([]domain.FlowSave, error)                                           // получить доски, на которые сохранён пин
+	FlowSaveToNotify(ctx context.Context, boardID, flowID, userID int) (string, string, error)                                         // получить автора пина для уведомления о сохранении
 }
 
 type BoardHandler struct {
-	BoardService    BoardService
-	ContextDeadline time.Duration
+	BoardService     BoardService
+	ContextDeadline  time.Duration
+	NotificationChan chan<- domain.WebMessage
+	Events           EventRecorder
 }
 
 // CreateBoard godoc
@@ -161,7 +183,7 @@ func (b *BoardHandler) DeleteBoard(w http.ResponseWriter, r *http.Request) {
 //	@Security		jwt_auth
 //	@Param			id	path		int				true	"Board ID"
 //
-// //	@Param			flow	body		BoardRequest	true	"Flow ID to add"
+// //	@Param			flow	body		BoardRequest	true	"Flow ID to add and the board it was saved from"
 //
 //	@Success		200	{object}	ServerResponse	"Flow added successfully"
 //	@Failure		400	{object}	ServerResponse	"Invalid request data"
@@ -196,12 +218,26 @@ func (b *BoardHandler) AddToBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = b.BoardService.AddToBoard(ctx, boardID, claims.UserID, request.FlowID)
+	if !v.Check(request.SavedFromBoardID >= 0, "saved_from_board_id", "cannot be negative") {
+		HttpErrorToJson(w, v.GetError("saved_from_board_id").Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = b.BoardService.AddToBoard(ctx, boardID, claims.UserID, request.FlowID, request.SavedFromBoardID)
 	if err != nil {
 		handleBoardError(w, err)
 		return
 	}
 
+	go b.notifyBoardFollowers(boardID, request.FlowID, claims)
+	go b.notifyFlowSaved(boardID, request.FlowID, claims)
+
+	RecordEvent(b.Events, domain.AnalyticsEvent{
+		Type:     domain.EventSave,
+		FlowID:   uint64(request.FlowID),
+		ViewerID: claims.UserID,
+	})
+
 	resp := ServerResponse{
 		Description: "OK",
 	}
@@ -275,11 +311,16 @@ func (b *BoardHandler) GetFromBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !data.ApplyNSFWMode(domain.NSFWModeFromContext(r.Context()), uint64(userID)) {
+		HttpErrorToJson(w, domain.ErrNSFWAgeRestricted.Error(), http.StatusForbidden)
+		return
+	}
+
 	response := ServerResponse{
 		Description: "OK",
 		Data:        data,
 	}
-	
+
 	ServerGenerateJSONResponse(w, response, http.StatusOK)
 }
 
@@ -342,13 +383,13 @@ func (b *BoardHandler) DeleteFromBoard(w http.ResponseWriter, r *http.Request) {
 // UpdateBoard godoc
 //
 //	@Summary		Update board details
-//	@Description	Updates board name and privacy settings
+//	@Description	Updates board name, visibility, description, cover flow and tags
 //	@Tags			boards
 //	@Accept			json
 //	@Produce		json
 //	@Security		jwt_auth
 //	@Param			board_id	path		int				true	"Board ID to update"
-//	@Param			updateData	body		object			true	"update data: new name and is_private"
+//	@Param			updateData	body		domain.UpdateData	true	"update data"
 //	@Success		200			{object}	ServerResponse	"Board updated successfully"
 //	@Failure		400			{object}	ServerResponse	"Invalid request data"
 //	@Failure		401			{object}	ServerResponse	"Unauthorized"
@@ -393,13 +434,7 @@ func (b *BoardHandler) UpdateBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = b.BoardService.UpdateBoard(
-		ctx,
-		boardID,
-		claims.UserID,
-		updateData.Name,
-		updateData.IsPrivate,
-	)
+	err = b.BoardService.UpdateBoard(ctx, boardID, claims.UserID, updateData)
 	if err != nil {
 		handleBoardError(w, err)
 		return
@@ -463,6 +498,8 @@ func (b *BoardHandler) GetBoard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	board.Preview = domain.FilterNSFW(board.Preview, domain.NSFWModeFromContext(r.Context()), uint64(userID))
+
 	board.Escape()
 
 	resp := ServerResponse{
@@ -496,12 +533,14 @@ func (b *BoardHandler) GetUserPublic(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(ctx, b.ContextDeadline)
 	defer cancel()
 
-	boards, err := b.BoardService.GetUserPublicBoards(ctx, username)
+	boards, err := b.BoardService.GetUserPublicBoards(ctx, username, int(viewerID(r)))
 	if err != nil {
 		handleBoardError(w, err)
 		return
 	}
 
+	domain.FilterBoardsNSFW(boards, domain.NSFWModeFromContext(r.Context()), viewerID(r))
+
 	domain.EscapeBoards(boards)
 
 	resp := ServerResponse{
@@ -540,6 +579,8 @@ func (b *BoardHandler) GetUserAllBoards(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	domain.FilterBoardsNSFW(boards, domain.NSFWModeFromContext(r.Context()), uint64(claims.UserID))
+
 	domain.EscapeBoards(boards)
 
 	resp := ServerResponse{
@@ -614,12 +655,17 @@ func (b *BoardHandler) GetBoardFlows(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	flows, err := b.BoardService.GetBoardFlow(ctx, boardID, userID, page, pageSize, authorized)
+	nsfwMode := domain.NSFWModeFromContext(r.Context())
+
+	flows, err := b.BoardService.GetBoardFlow(ctx, boardID, userID, page, pageSize, authorized, nsfwMode)
 	if err != nil {
 		handleBoardError(w, err)
 		return
 	}
 
+	// недоступные пины отсеяны в запросе, здесь только размываем
+	flows = domain.FilterNSFW(flows, nsfwMode, uint64(userID))
+
 	domain.EscapeFlows(flows)
 
 	resp := ServerResponse{
@@ -644,6 +690,19 @@ func handleBoardError(w http.ResponseWriter, err error) {
 	case errors.Is(err, board.ErrForbidden):
 		HttpErrorToJson(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
 		return
+	case errors.Is(err, domain.ErrInvalidPosition):
+		HttpErrorToJson(w, "invalid position", http.StatusBadRequest)
+		return
+	case errors.Is(err, domain.ErrInvalidVisibility),
+		errors.Is(err, domain.ErrDescriptionTooLong),
+		errors.Is(err, domain.ErrInvalidBoardTags),
+		errors.Is(err, domain.ErrInvalidBoardCover),
+		errors.Is(err, domain.ErrInvalidBulkRequest):
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, domain.ErrSectionNotFound):
+		HttpErrorToJson(w, "section not found", http.StatusNotFound)
+		return
 	case errors.Is(err, repository.ErrNotFound):
 		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 		return
@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+// GetBoardActivity godoc
+//
+//	@Summary		Get board activity log
+//	@Description	Returns a pageSized number of board activity entries, newest first. Available to the author and coauthors
+//	@Tags			boards
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int												true	"Board ID"
+//	@Param			page		query		int												true	"Page number"
+//	@Param			size		query		int												true	"Page size"
+//	@Success		200			{object}	ServerResponse{data=[]domain.BoardActivity}	"Board activity"
+//	@Failure		400			{object}	ServerResponse									"Invalid request parameters"
+//	@Failure		403			{object}	ServerResponse									"Forbidden - not author or coauthor"
+//	@Failure		404			{object}	ServerResponse									"Board not found"
+//	@Failure		500			{object}	ServerResponse									"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/activity [get]
+func (b *BoardHandler) GetBoardActivity(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	page, size, err := getQueryPagination(w, r)
+	if err != nil {
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	activities, err := b.BoardService.GetBoardActivity(ctx, boardID, claims.UserID, page, size)
+	if err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	domain.EscapeActivities(activities)
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        activities,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/validator"
+)
+
+// BulkFlows godoc
+//
+//	@Summary		Bulk flow operations
+//	@Description	Adds, removes, moves or copies up to 50 flows in one transaction. Result is returned for every flow
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int											true	"Board ID"
+//	@Param			request		body		domain.BulkFlowRequest						true	"Action, flows and target board for move/copy"
+//	@Success		200			{object}	ServerResponse{data=[]domain.BulkFlowResult}	"Per-flow results"
+//	@Failure		400			{object}	ServerResponse								"Invalid request"
+//	@Failure		403			{object}	ServerResponse								"Forbidden - insufficient role"
+//	@Failure		500			{object}	ServerResponse								"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/flows/bulk [post]
+func (b *BoardHandler) BulkFlows(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	var req domain.BulkFlowRequest
+	if err := DecodeData(w, r.Body, &req); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	results, err := b.BoardService.BulkFlows(ctx, boardID, claims.UserID, req)
+	if err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        results,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// MergeBoards godoc
+//
+//	@Summary		Merge board into another
+//	@Description	Moves all flows of the board to the end of the target board and deletes the board
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int					true	"Board ID"
+//	@Param			request		body		domain.MergeRequest	true	"Target board"
+//	@Success		200			{object}	ServerResponse		"OK"
+//	@Failure		400			{object}	ServerResponse		"Invalid request"
+//	@Failure		403			{object}	ServerResponse		"Forbidden - not board owner"
+//	@Failure		404			{object}	ServerResponse		"Board not found"
+//	@Failure		500			{object}	ServerResponse		"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/merge [post]
+func (b *BoardHandler) MergeBoards(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	var req domain.MergeRequest
+	if err := DecodeData(w, r.Body, &req); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	if err := b.BoardService.MergeBoards(ctx, boardID, req.TargetBoardID, claims.UserID); err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// DuplicateBoard godoc
+//
+//	@Summary		Duplicate board
+//	@Description	Creates a copy of the board with sections, flow order, description and tags for the current user
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int						true	"Board ID"
+//	@Param			request		body		domain.DuplicateRequest	true	"Name of the copy"
+//	@Success		201			{object}	ServerResponse			"Board ID of the copy"
+//	@Failure		400			{object}	ServerResponse			"Invalid name"
+//	@Failure		403			{object}	ServerResponse			"Forbidden - private board"
+//	@Failure		409			{object}	ServerResponse			"Board with this name already exists"
+//	@Failure		500			{object}	ServerResponse			"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/duplicate [post]
+func (b *BoardHandler) DuplicateBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	var req domain.DuplicateRequest
+	if err := DecodeData(w, r.Body, &req); err != nil {
+		return
+	}
+
+	v := validator.New()
+
+	if !v.Check(req.Name != "", "name", "cannot be empty") {
+		HttpErrorToJson(w, v.GetError("name").Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !v.Check(len([]rune(req.Name)) < 64, "name", "cannot be longer 64") {
+		HttpErrorToJson(w, v.GetError("name").Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	id, err := b.BoardService.DuplicateBoard(ctx, boardID, claims.UserID, req.Name)
+	if err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	type boardId struct {
+		BoardID int `json:"board_id"`
+	}
+
+	resp := ServerResponse{
+		Description: "Created",
+		Data:        boardId{BoardID: id},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusCreated)
+}
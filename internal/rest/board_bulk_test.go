@@ -0,0 +1,110 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	mocks "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/board/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBulkFlows_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 111}
+	body := []byte(`{"action":"copy","flow_ids":[5,6],"target_board_id":20}`)
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{board_id}/flows/bulk", body, nil)
+	req.SetPathValue("board_id", "10")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		BulkFlows(gomock.Any(), 10, claims.UserID, domain.BulkFlowRequest{Action: domain.BulkActionCopy, FlowIDs: []int{5, 6}, TargetBoardID: 20}).
+		Return([]domain.BulkFlowResult{
+			{FlowID: 5, Status: domain.BulkStatusOK},
+			{FlowID: 6, Status: domain.BulkStatusAlreadySaved},
+		}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.BulkFlows(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d; got %d", http.StatusOK, rr.Code)
+	}
+
+	var resp serverResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed decoding response: %v", err)
+	}
+
+	var results []domain.BulkFlowResult
+	if err := json.Unmarshal(resp.Data, &results); err != nil {
+		t.Fatalf("failed decoding data: %v", err)
+	}
+	if len(results) != 2 || results[1].Status != domain.BulkStatusAlreadySaved {
+		t.Errorf("unexpected results: %+v", results)
+	}
+}
+
+func TestBulkFlows_InvalidRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 111}
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{board_id}/flows/bulk", []byte(`{"action":"pin","flow_ids":[5]}`), nil)
+	req.SetPathValue("board_id", "10")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		BulkFlows(gomock.Any(), 10, claims.UserID, gomock.Any()).
+		Return(nil, domain.ErrInvalidBulkRequest)
+
+	rr := httptest.NewRecorder()
+	handler.BulkFlows(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d; got %d", http.StatusBadRequest, rr.Code)
+	}
+}
+
+func TestDuplicateBoard_Conflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 111}
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{board_id}/duplicate", []byte(`{"name":"Рецепты"}`), nil)
+	req.SetPathValue("board_id", "10")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		DuplicateBoard(gomock.Any(), 10, claims.UserID, "Рецепты").
+		Return(0, domain.ErrConflict)
+
+	rr := httptest.NewRecorder()
+	handler.DuplicateBoard(rr, req)
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("expected status %d; got %d", http.StatusConflict, rr.Code)
+	}
+}
@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+const maxBoardCoverSize = (1 << 20) * 5 // 5 мб
+
+// UploadBoardCover godoc
+//
+//	@Summary		Upload board cover
+//	@Description	Uploads an image and makes it the board cover instead of the cover flow
+//	@Tags			boards
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int				true	"Board ID"
+//	@Param			image		formData	file			true	"Cover image"
+//	@Success		201			{object}	ServerResponse	"Cover uploaded"
+//	@Failure		400			{object}	ServerResponse	"Invalid image"
+//	@Failure		403			{object}	ServerResponse	"Forbidden - not board owner"
+//	@Failure		413			{object}	ServerResponse	"Image is too large"
+//	@Failure		500			{object}	ServerResponse	"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/cover [post]
+func (b *BoardHandler) UploadBoardCover(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	if err := r.ParseMultipartForm(maxBoardCoverSize); err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	file, handler, err := r.FormFile("image")
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	if handler.Size > maxBoardCoverSize {
+		HttpErrorToJson(w, "image is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	buffer := make([]byte, 512)
+	if _, err := file.Read(buffer); err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	detected := http.DetectContentType(buffer)
+	contentType := handler.Header.Get("Content-Type")
+
+	if !strings.HasPrefix(detected, strings.Split(contentType, ";")[0]) {
+		HttpErrorToJson(w, "image extension and type are mismatched", http.StatusBadRequest)
+		return
+	}
+
+	if _, ok := allowedTypes[contentType]; !ok {
+		HttpErrorToJson(w, "image type is not allowed", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := file.Seek(0, 0); err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	filename := filepath.Base(handler.Filename)
+	if filepath.Ext(filename) == "" {
+		HttpErrorToJson(w, "invalid file extension", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	url, err := b.BoardService.SetBoardCover(ctx, boardID, claims.UserID, file, filename)
+	if err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	type coverURL struct {
+		MediaURL string `json:"media_url"`
+	}
+
+	resp := ServerResponse{
+		Description: "Created",
+		Data:        coverURL{MediaURL: url},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusCreated)
+}
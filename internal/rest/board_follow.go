@@ -0,0 +1,174 @@
+package rest
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+const BoardFlowType = "board_flow"
+
+// FollowBoard godoc
+//
+//	@Summary		Follow a board
+//	@Description	Subscribes the user to new flows of a public board
+//	@Tags			boards
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int				true	"Board ID"
+//	@Success		200			{object}	ServerResponse	"OK"
+//	@Failure		400			{object}	ServerResponse	"Invalid board ID"
+//	@Failure		403			{object}	ServerResponse	"Forbidden - private or own board"
+//	@Failure		404			{object}	ServerResponse	"Board not found"
+//	@Failure		500			{object}	ServerResponse	"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/follow [post]
+func (b *BoardHandler) FollowBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	if err := b.BoardService.FollowBoard(ctx, boardID, claims.UserID); err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// UnfollowBoard godoc
+//
+//	@Summary		Unfollow a board
+//	@Tags			boards
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int				true	"Board ID"
+//	@Success		200			{object}	ServerResponse	"OK"
+//	@Failure		400			{object}	ServerResponse	"Invalid board ID"
+//	@Failure		500			{object}	ServerResponse	"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/follow [delete]
+func (b *BoardHandler) UnfollowBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	if err := b.BoardService.UnfollowBoard(ctx, boardID, claims.UserID); err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// GetBoardFollowers godoc
+//
+//	@Summary		Get board followers
+//	@Description	Returns a pageSized number of users following the board
+//	@Tags			boards
+//	@Produce		json
+//	@Param			board_id	path		int											true	"Board ID"
+//	@Param			page		query		int											true	"Page number"
+//	@Param			size		query		int											true	"Page size"
+//	@Success		200			{object}	ServerResponse{data=[]domain.PublicUser}	"Board followers"
+//	@Failure		400			{object}	ServerResponse								"Invalid request parameters"
+//	@Failure		403			{object}	ServerResponse								"Forbidden - private board"
+//	@Failure		500			{object}	ServerResponse								"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/followers [get]
+func (b *BoardHandler) GetBoardFollowers(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	page, size, err := getQueryPagination(w, r)
+	if err != nil {
+		return
+	}
+
+	var userID int
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if ok && claims != nil {
+		userID = claims.UserID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	followers, err := b.BoardService.GetBoardFollowers(ctx, boardID, userID, page, size)
+	if err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	for i := range followers {
+		followers[i].Escape()
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        followers,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// notifyBoardFollowers сообщает подписчикам доски о новом пине.
+// Частоту уведомлений ограничивает сервис, ошибки не влияют на ответ.
+func (b *BoardHandler) notifyBoardFollowers(boardID, flowID int, claims *auth.Claims) {
+	if b.NotificationChan == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	boardName, followers, err := b.BoardService.FollowersToNotify(ctx, boardID, flowID, claims.UserID)
+	if err != nil {
+		log.Printf("failed to get board followers to notify: %v", err)
+		return
+	}
+
+	for _, follower := range followers {
+		b.NotificationChan <- domain.WebMessage{
+			Type: NotificationType,
+			Content: domain.Notification{
+				Type:             BoardFlowType,
+				CreatedAt:        time.Now(),
+				SenderUsername:   claims.Username,
+				ReceiverUsername: follower,
+				AdditionalData: domain.BoardFlowAdded{
+					BoardID:   boardID,
+					BoardName: boardName,
+					FlowID:    flowID,
+				},
+			},
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/board"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	mocks "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/board/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFollowBoard_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 111}
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{board_id}/follow", nil, nil)
+	req.SetPathValue("board_id", "10")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		FollowBoard(gomock.Any(), 10, claims.UserID).
+		Return(nil)
+
+	rr := httptest.NewRecorder()
+	handler.FollowBoard(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d; got %d", http.StatusOK, rr.Code)
+	}
+}
+
+func TestFollowBoard_Forbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 111}
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{board_id}/follow", nil, nil)
+	req.SetPathValue("board_id", "10")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		FollowBoard(gomock.Any(), 10, claims.UserID).
+		Return(board.ErrForbidden)
+
+	rr := httptest.NewRecorder()
+	handler.FollowBoard(rr, req)
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d; got %d", http.StatusForbidden, rr.Code)
+	}
+}
+
+func TestGetBoardFollowers_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	req := newTestRequest(http.MethodGet, "/api/v1/boards/{board_id}/followers?page=1&size=10", nil, nil)
+	req.SetPathValue("board_id", "10")
+
+	mockBoardService.EXPECT().
+		GetBoardFollowers(gomock.Any(), 10, 0, 1, 10).
+		Return([]domain.PublicUser{{Username: "alice"}}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.GetBoardFollowers(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d; got %d", http.StatusOK, rr.Code)
+	}
+
+	var resp serverResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed decoding response: %v", err)
+	}
+
+	var followers []domain.PublicUser
+	if err := json.Unmarshal(resp.Data, &followers); err != nil {
+		t.Fatalf("failed decoding data: %v", err)
+	}
+	if len(followers) != 1 || followers[0].Username != "alice" {
+		t.Errorf("unexpected followers: %+v", followers)
+	}
+}
+
+func TestAddToBoard_NotifiesFollowers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notifications := make(chan domain.WebMessage, 2)
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:     mockBoardService,
+		ContextDeadline:  2 * time.Second,
+		NotificationChan: notifications,
+	}
+
+	claims := &auth.Claims{UserID: 111, Username: "author"}
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{id}/flows", []byte(`{"flow_id":5}`), nil)
+	req.SetPathValue("id", "10")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		AddToBoard(gomock.Any(), 10, claims.UserID, 5, 0).
+		Return(nil)
+	mockBoardService.EXPECT().
+		FollowersToNotify(gomock.Any(), 10, 5, claims.UserID).
+		Return("Рецепты", []string{"alice", "bob"}, nil)
+	// пин свой, автора уведомлять не нужно
+	mockBoardService.EXPECT().
+		FlowSaveToNotify(gomock.Any(), 10, 5, claims.UserID).
+		Return("", "", nil).
+		AnyTimes()
+
+	rr := httptest.NewRecorder()
+	handler.AddToBoard(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d; got %d", http.StatusOK, rr.Code)
+	}
+
+	for _, receiver := range []string{"alice", "bob"} {
+		select {
+		case msg := <-notifications:
+			notification, ok := msg.Content.(domain.Notification)
+			if !ok {
+				t.Fatalf("unexpected notification content: %T", msg.Content)
+			}
+			if notification.Type != BoardFlowType || notification.ReceiverUsername != receiver {
+				t.Errorf("unexpected notification: %+v", notification)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("notification was not sent")
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+const FlowSavedType = "flow_saved"
+
+// GetFlowSaves godoc
+//
+//	@Summary		Get boards a flow is saved to
+//	@Description	Returns a pageSized number of public boards of other users the flow is saved to, newest first
+//	@Tags			boards
+//	@Produce		json
+//	@Param			flow_id	path		int										true	"Flow ID"
+//	@Param			page	query		int										true	"Page number"
+//	@Param			size	query		int										true	"Page size"
+//	@Success		200		{object}	ServerResponse{data=[]domain.FlowSave}	"Boards with the flow"
+//	@Failure		400		{object}	ServerResponse							"Invalid request parameters"
+//	@Failure		404		{object}	ServerResponse							"Flow not found or not public"
+//	@Failure		500		{object}	ServerResponse							"Internal server error"
+//	@Router			/api/v1/flows/{flow_id}/saves [get]
+func (b *BoardHandler) GetFlowSaves(w http.ResponseWriter, r *http.Request) {
+	flowID, err := strconv.Atoi(r.PathValue("flow_id"))
+	if err != nil || flowID <= 0 {
+		HttpErrorToJson(w, "Invalid flow ID", http.StatusBadRequest)
+		return
+	}
+
+	page, size, err := getQueryPagination(w, r)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	saves, err := b.BoardService.GetFlowSaves(ctx, flowID, page, size)
+	if err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	if saves == nil {
+		saves = []domain.FlowSave{}
+	}
+
+	for i := range saves {
+		saves[i].Escape()
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        saves,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// notifyFlowSaved сообщает автору пина, что его сохранили на публичную доску.
+// Ошибки не влияют на ответ.
+func (b *BoardHandler) notifyFlowSaved(boardID, flowID int, claims *auth.Claims) {
+	if b.NotificationChan == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	author, boardName, err := b.BoardService.FlowSaveToNotify(ctx, boardID, flowID, claims.UserID)
+	if err != nil {
+		log.Printf("failed to get flow author to notify: %v", err)
+		return
+	}
+	if author == "" {
+		return
+	}
+
+	b.NotificationChan <- domain.WebMessage{
+		Type: NotificationType,
+		Content: domain.Notification{
+			Type:             FlowSavedType,
+			CreatedAt:        time.Now(),
+			SenderUsername:   claims.Username,
+			ReceiverUsername: author,
+			AdditionalData: domain.FlowSaved{
+				FlowID:    flowID,
+				BoardID:   boardID,
+				BoardName: boardName,
+			},
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	repository "github.com/go-park-mail-ru/2025_1_SuperChips/internal/repository/pg"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	mocks "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/board/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetFlowSaves_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	req := newTestRequest(http.MethodGet, "/api/v1/flows/{flow_id}/saves?page=1&size=10", nil, nil)
+	req.SetPathValue("flow_id", "5")
+
+	mockBoardService.EXPECT().
+		GetFlowSaves(gomock.Any(), 5, 1, 10).
+		Return([]domain.FlowSave{{BoardID: 1, BoardName: "<b>Рецепты</b>", Username: "alice"}}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.GetFlowSaves(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d; got %d", http.StatusOK, rr.Code)
+	}
+	if strings.Contains(rr.Body.String(), "<b>") {
+		t.Errorf("board name is not escaped: %s", rr.Body.String())
+	}
+}
+
+func TestGetFlowSaves_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	req := newTestRequest(http.MethodGet, "/api/v1/flows/{flow_id}/saves?page=1&size=10", nil, nil)
+	req.SetPathValue("flow_id", "5")
+
+	mockBoardService.EXPECT().
+		GetFlowSaves(gomock.Any(), 5, 1, 10).
+		Return(nil, repository.ErrNotFound)
+
+	rr := httptest.NewRecorder()
+	handler.GetFlowSaves(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d; got %d", http.StatusNotFound, rr.Code)
+	}
+}
+
+func TestAddToBoard_NotifiesAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notifications := make(chan domain.WebMessage, 1)
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:     mockBoardService,
+		ContextDeadline:  2 * time.Second,
+		NotificationChan: notifications,
+	}
+
+	claims := &auth.Claims{UserID: 111, Username: "saver"}
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{id}/flows", []byte(`{"flow_id":5,"saved_from_board_id":7}`), nil)
+	req.SetPathValue("id", "10")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		AddToBoard(gomock.Any(), 10, claims.UserID, 5, 7).
+		Return(nil)
+	mockBoardService.EXPECT().
+		FollowersToNotify(gomock.Any(), 10, 5, claims.UserID).
+		Return("", nil, nil).
+		AnyTimes()
+	mockBoardService.EXPECT().
+		FlowSaveToNotify(gomock.Any(), 10, 5, claims.UserID).
+		Return("author", "Рецепты", nil)
+
+	rr := httptest.NewRecorder()
+	handler.AddToBoard(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d; got %d", http.StatusOK, rr.Code)
+	}
+
+	select {
+	case msg := <-notifications:
+		notification, ok := msg.Content.(domain.Notification)
+		if !ok {
+			t.Fatalf("unexpected notification content: %T", msg.Content)
+		}
+		if notification.Type != FlowSavedType || notification.ReceiverUsername != "author" {
+			t.Errorf("unexpected notification: %+v", notification)
+		}
+		data, err := json.Marshal(notification.AdditionalData)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), `"board_name":"Рецепты"`) {
+			t.Errorf("unexpected notification data: %s", data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("notification was not sent")
+	}
+}
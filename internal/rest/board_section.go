@@ -0,0 +1,403 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/validator"
+)
+
+// GetSections godoc
+//
+//	@Summary		Get board sections
+//	@Description	Returns sections of a board in manual order
+//	@Tags			boards
+//	@Produce		json
+//	@Param			board_id	path		int												true	"Board ID"
+//	@Success		200			{object}	ServerResponse{data=[]domain.BoardSection}	"Board sections"
+//	@Failure		400			{object}	ServerResponse									"Invalid board ID"
+//	@Failure		403			{object}	ServerResponse									"Forbidden - private board"
+//	@Failure		500			{object}	ServerResponse									"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/sections [get]
+func (b *BoardHandler) GetSections(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	var userID int
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if ok && claims != nil {
+		userID = claims.UserID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	sections, err := b.BoardService.GetSections(ctx, boardID, userID)
+	if err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	domain.EscapeSections(sections)
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        sections,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// CreateSection godoc
+//
+//	@Summary		Create board section
+//	@Description	Creates a named section at the end of the board
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int											true	"Board ID"
+//	@Param			section		body		domain.SectionRequest						true	"Section name"
+//	@Success		201			{object}	ServerResponse{data=domain.BoardSection}	"Section created"
+//	@Failure		400			{object}	ServerResponse								"Invalid request data"
+//	@Failure		401			{object}	ServerResponse								"Unauthorized"
+//	@Failure		403			{object}	ServerResponse								"Forbidden - not board editor"
+//	@Failure		409			{object}	ServerResponse								"Section already exists"
+//	@Failure		500			{object}	ServerResponse								"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/sections [post]
+func (b *BoardHandler) CreateSection(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	var request domain.SectionRequest
+	if err := DecodeData(w, r.Body, &request); err != nil {
+		return
+	}
+
+	name, ok := validateSectionName(w, request.Name)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	section, err := b.BoardService.CreateSection(ctx, boardID, claims.UserID, name)
+	if err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	section.Escape()
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        section,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusCreated)
+}
+
+// RenameSection godoc
+//
+//	@Summary		Rename board section
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int						true	"Board ID"
+//	@Param			section_id	path		int						true	"Section ID"
+//	@Param			section		body		domain.SectionRequest	true	"New section name"
+//	@Success		200			{object}	ServerResponse			"Section renamed"
+//	@Failure		400			{object}	ServerResponse			"Invalid request data"
+//	@Failure		401			{object}	ServerResponse			"Unauthorized"
+//	@Failure		403			{object}	ServerResponse			"Forbidden - not board editor"
+//	@Failure		404			{object}	ServerResponse			"Section not found"
+//	@Failure		409			{object}	ServerResponse			"Section already exists"
+//	@Failure		500			{object}	ServerResponse			"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/sections/{section_id} [put]
+func (b *BoardHandler) RenameSection(w http.ResponseWriter, r *http.Request) {
+	boardID, sectionID, ok := parseSectionPath(w, r)
+	if !ok {
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	var request domain.SectionRequest
+	if err := DecodeData(w, r.Body, &request); err != nil {
+		return
+	}
+
+	name, ok := validateSectionName(w, request.Name)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	if err := b.BoardService.RenameSection(ctx, boardID, sectionID, claims.UserID, name); err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// DeleteSection godoc
+//
+//	@Summary		Delete board section
+//	@Description	Deletes a section, its flows stay on the board without a section
+//	@Tags			boards
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int				true	"Board ID"
+//	@Param			section_id	path		int				true	"Section ID"
+//	@Success		200			{object}	ServerResponse	"Section deleted"
+//	@Failure		400			{object}	ServerResponse	"Invalid request data"
+//	@Failure		401			{object}	ServerResponse	"Unauthorized"
+//	@Failure		403			{object}	ServerResponse	"Forbidden - not board editor"
+//	@Failure		404			{object}	ServerResponse	"Section not found"
+//	@Failure		500			{object}	ServerResponse	"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/sections/{section_id} [delete]
+func (b *BoardHandler) DeleteSection(w http.ResponseWriter, r *http.Request) {
+	boardID, sectionID, ok := parseSectionPath(w, r)
+	if !ok {
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	if err := b.BoardService.DeleteSection(ctx, boardID, sectionID, claims.UserID); err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// MoveSection godoc
+//
+//	@Summary		Reorder board section
+//	@Description	Places a section between its new neighbours, only the moved section is rewritten
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int					true	"Board ID"
+//	@Param			section_id	path		int					true	"Section ID"
+//	@Param			position	body		domain.SectionMove	true	"New neighbours"
+//	@Success		200			{object}	ServerResponse		"Section moved"
+//	@Failure		400			{object}	ServerResponse		"Invalid position"
+//	@Failure		401			{object}	ServerResponse		"Unauthorized"
+//	@Failure		403			{object}	ServerResponse		"Forbidden - not board editor"
+//	@Failure		404			{object}	ServerResponse		"Section not found"
+//	@Failure		500			{object}	ServerResponse		"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/sections/{section_id}/move [post]
+func (b *BoardHandler) MoveSection(w http.ResponseWriter, r *http.Request) {
+	boardID, sectionID, ok := parseSectionPath(w, r)
+	if !ok {
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	var move domain.SectionMove
+	if err := DecodeData(w, r.Body, &move); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	if err := b.BoardService.MoveSection(ctx, boardID, sectionID, claims.UserID, move); err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// GetSectionFlows godoc
+//
+//	@Summary		Get flows of a board section
+//	@Tags			boards
+//	@Produce		json
+//	@Param			board_id	path		int										true	"Board ID"
+//	@Param			section_id	path		int										true	"Section ID"
+//	@Param			page		query		int										false	"Page number"
+//	@Param			size		query		int										true	"Page size"
+//	@Success		200			{object}	ServerResponse{data=[]domain.PinData}	"Section flows"
+//	@Failure		400			{object}	ServerResponse							"Invalid request parameters"
+//	@Failure		403			{object}	ServerResponse							"Forbidden - access denied"
+//	@Failure		404			{object}	ServerResponse							"Section not found"
+//	@Failure		500			{object}	ServerResponse							"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/sections/{section_id}/flows [get]
+func (b *BoardHandler) GetSectionFlows(w http.ResponseWriter, r *http.Request) {
+	boardID, sectionID, ok := parseSectionPath(w, r)
+	if !ok {
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil {
+		page = 0
+	}
+
+	pageSize, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	v := validator.New()
+
+	if !v.Check(pageSize >= 1 && pageSize <= 30, "page size", "cannot be less than one and more than 30") {
+		HttpErrorToJson(w, v.GetError("page size").Error(), http.StatusBadRequest)
+		return
+	}
+
+	var userID int
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if ok && claims != nil {
+		userID = claims.UserID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	nsfwMode := domain.NSFWModeFromContext(r.Context())
+
+	flows, err := b.BoardService.GetSectionFlow(ctx, boardID, sectionID, userID, page, pageSize, nsfwMode)
+	if err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	flows = domain.FilterNSFW(flows, nsfwMode, uint64(userID))
+
+	domain.EscapeFlows(flows)
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        flows,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// MoveFlow godoc
+//
+//	@Summary		Move flow
+//	@Description	Reorders a flow, moves it to another section or to another board in one call
+//	@Tags			boards
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			board_id	path		int				true	"Source board ID"
+//	@Param			id			path		int				true	"Flow ID"
+//	@Param			position	body		domain.FlowMove	true	"Target board, section and neighbours"
+//	@Success		200			{object}	ServerResponse	"Flow moved"
+//	@Failure		400			{object}	ServerResponse	"Invalid position"
+//	@Failure		401			{object}	ServerResponse	"Unauthorized"
+//	@Failure		403			{object}	ServerResponse	"Forbidden - not board editor"
+//	@Failure		404			{object}	ServerResponse	"Flow or section not found"
+//	@Failure		409			{object}	ServerResponse	"Flow is already saved to the target board"
+//	@Failure		500			{object}	ServerResponse	"Internal server error"
+//	@Router			/api/v1/boards/{board_id}/flows/{id}/move [post]
+func (b *BoardHandler) MoveFlow(w http.ResponseWriter, r *http.Request) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return
+	}
+
+	flowID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || flowID <= 0 {
+		HttpErrorToJson(w, "Invalid flow ID", http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	var move domain.FlowMove
+	if err := DecodeData(w, r.Body, &move); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	if err := b.BoardService.MoveFlow(ctx, boardID, flowID, claims.UserID, move); err != nil {
+		handleBoardError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+func parseSectionPath(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	boardID, err := strconv.Atoi(r.PathValue("board_id"))
+	if err != nil || boardID <= 0 {
+		HttpErrorToJson(w, "Invalid board ID", http.StatusBadRequest)
+		return 0, 0, false
+	}
+
+	sectionID, err := strconv.Atoi(r.PathValue("section_id"))
+	if err != nil || sectionID <= 0 {
+		HttpErrorToJson(w, "Invalid section ID", http.StatusBadRequest)
+		return 0, 0, false
+	}
+
+	return boardID, sectionID, true
+}
+
+func validateSectionName(w http.ResponseWriter, name string) (string, bool) {
+	name = strings.TrimSpace(name)
+
+	v := validator.New()
+
+	if !v.Check(name != "", "name", "cannot be empty") {
+		HttpErrorToJson(w, v.GetError("name").Error(), http.StatusBadRequest)
+		return "", false
+	}
+
+	if !v.Check(len([]rune(name)) < 64, "name", "cannot be longer 64") {
+		HttpErrorToJson(w, v.GetError("name").Error(), http.StatusBadRequest)
+		return "", false
+	}
+
+	return name, true
+}
@@ -0,0 +1,167 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	mocks "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/board/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateSection_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 111}
+	payloadBytes, err := json.Marshal(domain.SectionRequest{Name: "  Кухня  "})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{board_id}/sections", payloadBytes, nil)
+	req.SetPathValue("board_id", "10")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		CreateSection(gomock.Any(), 10, claims.UserID, "Кухня").
+		Return(domain.BoardSection{ID: 5, BoardID: 10, Name: "Кухня"}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.CreateSection(rr, req)
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status %d; got %d", http.StatusCreated, rr.Code)
+	}
+
+	var resp serverResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed decoding response: %v", err)
+	}
+
+	var section domain.BoardSection
+	if err := json.Unmarshal(resp.Data, &section); err != nil {
+		t.Fatalf("failed decoding data: %v", err)
+	}
+	if section.ID != 5 {
+		t.Errorf("expected section id 5; got %d", section.ID)
+	}
+}
+
+func TestCreateSection_EmptyName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := &BoardHandler{
+		BoardService:    mocks.NewMockBoardService(ctrl),
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 111}
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{board_id}/sections", []byte(`{"name":"   "}`), nil)
+	req.SetPathValue("board_id", "10")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	rr := httptest.NewRecorder()
+	handler.CreateSection(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d; got %d", http.StatusBadRequest, rr.Code)
+	}
+}
+
+func TestDeleteSection_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 111}
+	req := newTestRequest(http.MethodDelete, "/api/v1/boards/{board_id}/sections/{section_id}", nil, nil)
+	req.SetPathValue("board_id", "10")
+	req.SetPathValue("section_id", "7")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		DeleteSection(gomock.Any(), 10, 7, claims.UserID).
+		Return(domain.ErrSectionNotFound)
+
+	rr := httptest.NewRecorder()
+	handler.DeleteSection(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d; got %d", http.StatusNotFound, rr.Code)
+	}
+}
+
+func TestMoveFlow_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 222}
+	move := domain.FlowMove{TargetBoardID: 20, SectionID: 3, AfterID: 41}
+	payloadBytes, err := json.Marshal(move)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{board_id}/flows/{id}/move", payloadBytes, nil)
+	req.SetPathValue("board_id", "10")
+	req.SetPathValue("id", "40")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		MoveFlow(gomock.Any(), 10, 40, claims.UserID, move).
+		Return(nil)
+
+	rr := httptest.NewRecorder()
+	handler.MoveFlow(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d; got %d", http.StatusOK, rr.Code)
+	}
+}
+
+func TestMoveFlow_InvalidPosition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoardService := mocks.NewMockBoardService(ctrl)
+	handler := &BoardHandler{
+		BoardService:    mockBoardService,
+		ContextDeadline: 2 * time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 222}
+	req := newTestRequest(http.MethodPost, "/api/v1/boards/{board_id}/flows/{id}/move", []byte(`{"after_id":41,"before_id":39}`), nil)
+	req.SetPathValue("board_id", "10")
+	req.SetPathValue("id", "40")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockBoardService.EXPECT().
+		MoveFlow(gomock.Any(), 10, 40, claims.UserID, domain.FlowMove{AfterID: 41, BeforeID: 39}).
+		Return(domain.ErrInvalidPosition)
+
+	rr := httptest.NewRecorder()
+	handler.MoveFlow(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d; got %d", http.StatusBadRequest, rr.Code)
+	}
+}
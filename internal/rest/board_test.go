@@ -135,7 +135,7 @@ func TestAddToBoard_Success(t *testing.T) {
 	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
 
 	mockBoardService.EXPECT().
-		AddToBoard(gomock.Any(), 200, claims.UserID, boardReq.FlowID).
+		AddToBoard(gomock.Any(), 200, claims.UserID, boardReq.FlowID, 0).
 		Return(nil)
 
 	rr := httptest.NewRecorder()
@@ -198,7 +198,7 @@ func TestUpdateBoard_Success(t *testing.T) {
 	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
 
 	mockBoardService.EXPECT().
-		UpdateBoard(gomock.Any(), 400, claims.UserID, "Updated Board", true).
+		UpdateBoard(gomock.Any(), 400, claims.UserID, domain.UpdateData{Name: "Updated Board", IsPrivate: true}).
 		Return(nil)
 
 	rr := httptest.NewRecorder()
@@ -275,7 +275,7 @@ func TestGetUserPublic_Success(t *testing.T) {
 	}
 
 	mockBoardService.EXPECT().
-		GetUserPublicBoards(gomock.Any(), "publicuser").
+		GetUserPublicBoards(gomock.Any(), "publicuser", 0).
 		Return(dummyBoards, nil)
 
 	rr := httptest.NewRecorder()
@@ -336,7 +336,7 @@ func TestGetBoardFlows_Success(t *testing.T) {
 		{FlowID: 2},
 	}
 	mockBoardService.EXPECT().
-		GetBoardFlow(gomock.Any(), 700, claims.UserID, page, size, true).
+		GetBoardFlow(gomock.Any(), 700, claims.UserID, page, size, true, domain.NSFWModeHide).
 		Return(dummyFlows, nil)
 
 	rr := httptest.NewRecorder()
@@ -12,7 +12,7 @@ import (
 
 // RefuseCoauthoring godoc
 //	@Summary		Refuse coauthoring
-//	@Description	Refuse coauthoring of the board (user must be coauthor of the board)
+//	@Description	Refuse coauthoring of the board. If the author leaves, the board is handed over to the longest-standing coauthor
 //	@Tags			Board sharing [coauthor]
 //	@Produce		json
 //	@Security		jwt_auth
@@ -22,6 +22,7 @@ import (
 //	@Success		200			{object}	ServerResponse	"User has stopped being a coauthor"
 //	@Failure		400			{object}	ServerResponse	"Invalid request parameters"
 //	@Failure		401			{object}	ServerResponse	"Unauthorized"
+//	@Failure		400			{object}	ServerResponse	"Author can't leave a board without coauthors"
 //	@Failure		403			{object}	ServerResponse	"Forbidden - access denied"
 //	@Failure		500			{object}	ServerResponse	"Internal server error"
 //
@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/validator"
+)
+
+// ChangeCoauthorRole godoc
+//	@Summary		Change coauthor role
+//	@Description	Change role of the coauthor (viewer, contributor, editor, admin). User must be author or admin of the board and can manage only roles below their own
+//	@Tags			Board sharing [author]
+//	@Produce		json
+//	@Security		jwt_auth
+//
+//	@Param			board_id	path		int				true	"ID of the board"
+//	@Param			name		body		string			true	"Username of coauthor"
+//	@Param			role		body		string			true	"New role"
+//
+//	@Success		200			{object}	ServerResponse	"Role has been successfully changed"
+//	@Failure		400			{object}	ServerResponse	"Invalid request parameters"
+//	@Failure		401			{object}	ServerResponse	"Unauthorized"
+//	@Failure		403			{object}	ServerResponse	"Forbidden - access denied"
+//	@Failure		404			{object}	ServerResponse	"User is not a coauthor of the board"
+//	@Failure		500			{object}	ServerResponse	"Internal server error"
+//
+//	@Router			/api/v1/boards/{board_id}/coauthors [put]
+func (b *BoardShrHandler) ChangeCoauthorRole(w http.ResponseWriter, r *http.Request) {
+	boardIDStr := r.PathValue("board_id")
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	userID := claims.UserID
+
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, b.ContextDeadline)
+	defer cancel()
+
+	var body domain.CoauthorRoleUpdate
+	if err := rest.DecodeData(w, r.Body, &body); err != nil {
+		return
+	}
+
+	v := validator.New()
+
+	if !v.Check(boardID > 0 && userID >= 0, "id", "board id cannot be less or equal to zero or user id cannot be less than zero") {
+		rest.HttpErrorToJson(w, v.GetError("id").Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !v.Check(body.Name != "" && body.Role != "", "body", "name and role cannot be empty") {
+		rest.HttpErrorToJson(w, v.GetError("body").Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = b.BoardShrService.ChangeCoauthorRole(ctx, boardID, userID, body.Name, body.Role)
+	if err != nil {
+		handleBoardShrError(w, err)
+		return
+	}
+
+	resp := rest.ServerResponse{
+		Description: "OK",
+	}
+
+	rest.ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
@@ -6,6 +6,7 @@ import (
 
 	boardshrService "github.com/go-park-mail-ru/2025_1_SuperChips/boardshr"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	repository "github.com/go-park-mail-ru/2025_1_SuperChips/internal/repository/pg"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
 )
 
@@ -29,6 +30,12 @@ func handleBoardShrError(w http.ResponseWriter, err error) {
 	case errors.Is(err, boardshrService.ErrForbbiden):
 		rest.HttpErrorToJson(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
 		return
+	case errors.Is(err, boardshrService.ErrLinkPaused):
+		rest.HttpErrorToJson(w, "link is paused", http.StatusForbidden)
+		return
+	case errors.Is(err, boardshrService.ErrInvalidQRFormat):
+		rest.HttpErrorToJson(w, "format must be png or svg", http.StatusBadRequest)
+		return
 	case errors.Is(err, boardshrService.ErrLinkExpired):
 		rest.HttpErrorToJson(w, http.StatusText(http.StatusGone), http.StatusGone)
 		return
@@ -41,6 +48,18 @@ func handleBoardShrError(w http.ResponseWriter, err error) {
 	case errors.Is(err, boardshrService.ErrAuthorRefuseEditing):
 		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
 		return
+	case errors.Is(err, domain.ErrInvalidBoardRole):
+		rest.HttpErrorToJson(w, "invalid role", http.StatusBadRequest)
+		return
+	case errors.Is(err, boardshrService.ErrNotCoauthor):
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	case errors.Is(err, boardshrService.ErrTransferNotFound):
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	case errors.Is(err, repository.ErrNotFound):
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
 	default:
 		rest.HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
@@ -10,8 +10,17 @@ type BoardShrServicer interface {
 	CreateInvitation(ctx context.Context, boardID int, userID int, invitation domain.Invitaion) (string, []string, error)
 	DeleteInvitation(ctx context.Context, boardID int, userID int, link string) error
 	GetInvitationLinks(ctx context.Context, boardID int, userID int) ([]domain.LinkParams, error)
+	SetInvitationPaused(ctx context.Context, boardID int, userID int, link string, paused bool) error
+	RegenerateInvitation(ctx context.Context, boardID int, userID int, link string) (string, error)
+	GetInvitationJoins(ctx context.Context, boardID int, userID int, link string) ([]domain.InvitationJoin, error)
+	GetInvitationQR(ctx context.Context, boardID int, userID int, link string, format string) ([]byte, error)
 	UseInvitationLink(ctx context.Context, userID int, link string) (int, error)
 	RefuseCoauthoring(ctx context.Context, boardID int, userID int) error
 	GetCoauthors(ctx context.Context, boardID int, userID int) (domain.Contact, []domain.Contact, error)
 	DeleteCoauthor(ctx context.Context, boardID int, userID int, coauthorName string) error
+	ChangeCoauthorRole(ctx context.Context, boardID int, userID int, coauthorName string, role string) error
+	ProposeOwnershipTransfer(ctx context.Context, boardID int, userID int, coauthorName string) (domain.OwnershipTransfer, error)
+	GetOwnershipTransfer(ctx context.Context, boardID int, userID int) (domain.OwnershipTransfer, error)
+	AcceptOwnershipTransfer(ctx context.Context, boardID int, userID int) error
+	RejectOwnershipTransfer(ctx context.Context, boardID int, userID int) error
 }
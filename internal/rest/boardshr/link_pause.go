@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/validator"
+)
+
+// SetInvitationPaused godoc
+//	@Summary		Pause or resume link
+//	@Description	Paused link keeps its parameters but doesn't let new coauthors join (user must be author or admin of the board)
+//	@Tags			Board sharing [author]
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//
+//	@Param			board_id	path		int					true	"ID of the board"
+//	@Param			link		path		string				true	"Invitation link"
+//	@Param			request		body		domain.LinkPause	true	"Whether the link is paused"
+//
+//	@Success		200			{object}	ServerResponse		"Link status has been successfully changed"
+//	@Failure		400			{object}	ServerResponse		"Invalid request parameters"
+//	@Failure		401			{object}	ServerResponse		"Unauthorized"
+//	@Failure		403			{object}	ServerResponse		"Forbidden - access denied"
+//	@Failure		404			{object}	ServerResponse		"Link not found"
+//	@Failure		500			{object}	ServerResponse		"Internal server error"
+//
+//	@Router			/api/v1/boards/{board_id}/invites/{link}/pause [put]
+func (b *BoardShrHandler) SetInvitationPaused(w http.ResponseWriter, r *http.Request) {
+	boardIDStr := r.PathValue("board_id")
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	link := r.PathValue("link")
+	if link == "" {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	userID := claims.UserID
+
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, b.ContextDeadline)
+	defer cancel()
+
+	var pause domain.LinkPause
+	if err := rest.DecodeData(w, r.Body, &pause); err != nil {
+		return
+	}
+
+	v := validator.New()
+
+	if !v.Check(boardID > 0 && userID >= 0, "id", "board id cannot be less or equal to zero or user id cannot be less than zero") {
+		rest.HttpErrorToJson(w, v.GetError("id").Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = b.BoardShrService.SetInvitationPaused(ctx, boardID, userID, link, pause.IsPaused)
+	if err != nil {
+		handleBoardShrError(w, err)
+		return
+	}
+
+	resp := rest.ServerResponse{
+		Description: "OK",
+	}
+
+	rest.ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
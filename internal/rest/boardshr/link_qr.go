@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	service "github.com/go-park-mail-ru/2025_1_SuperChips/boardshr"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/validator"
+)
+
+var qrContentTypes = map[string]string{
+	service.QRFormatPNG: "image/png",
+	service.QRFormatSVG: "image/svg+xml",
+}
+
+// GetInvitationQR godoc
+//	@Summary		Get link QR code
+//	@Description	Renders QR code with the invitation link as PNG (default) or SVG (user must be author or admin of the board)
+//	@Tags			Board sharing [author]
+//	@Produce		png
+//	@Produce		image/svg+xml
+//	@Security		jwt_auth
+//
+//	@Param			board_id	path		int				true	"ID of the board"
+//	@Param			link		path		string			true	"Invitation link"
+//	@Param			format		query		string			false	"png or svg"
+//
+//	@Success		200			{file}		binary			"QR code image"
+//	@Failure		400			{object}	ServerResponse	"Invalid request parameters"
+//	@Failure		401			{object}	ServerResponse	"Unauthorized"
+//	@Failure		403			{object}	ServerResponse	"Forbidden - access denied"
+//	@Failure		404			{object}	ServerResponse	"Link not found"
+//	@Failure		500			{object}	ServerResponse	"Internal server error"
+//
+//	@Router			/api/v1/boards/{board_id}/invites/{link}/qr [get]
+func (b *BoardShrHandler) GetInvitationQR(w http.ResponseWriter, r *http.Request) {
+	boardIDStr := r.PathValue("board_id")
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	link := r.PathValue("link")
+	if link == "" {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = service.QRFormatPNG
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	userID := claims.UserID
+
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, b.ContextDeadline)
+	defer cancel()
+
+	v := validator.New()
+
+	if !v.Check(boardID > 0 && userID >= 0, "id", "board id cannot be less or equal to zero or user id cannot be less than zero") {
+		rest.HttpErrorToJson(w, v.GetError("id").Error(), http.StatusBadRequest)
+		return
+	}
+
+	image, err := b.BoardShrService.GetInvitationQR(ctx, boardID, userID, link, format)
+	if err != nil {
+		handleBoardShrError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", qrContentTypes[format])
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write(image)
+}
@@ -0,0 +1,75 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/validator"
+)
+
+// RegenerateInvitation godoc
+//	@Summary		Regenerate link
+//	@Description	Replaces invitation link with a new one keeping its parameters and join history; old link stops working (user must be author or admin of the board)
+//	@Tags			Board sharing [author]
+//	@Produce		json
+//	@Security		jwt_auth
+//
+//	@Param			board_id	path		int											true	"ID of the board"
+//	@Param			link		path		string										true	"Invitation link"
+//
+//	@Success		200			{object}	ServerResponse{data=object{link=string}}	"Link has been successfully regenerated"
+//	@Failure		400			{object}	ServerResponse								"Invalid request parameters"
+//	@Failure		401			{object}	ServerResponse								"Unauthorized"
+//	@Failure		403			{object}	ServerResponse								"Forbidden - access denied"
+//	@Failure		404			{object}	ServerResponse								"Link not found"
+//	@Failure		500			{object}	ServerResponse								"Internal server error"
+//
+//	@Router			/api/v1/boards/{board_id}/invites/{link}/regenerate [post]
+func (b *BoardShrHandler) RegenerateInvitation(w http.ResponseWriter, r *http.Request) {
+	boardIDStr := r.PathValue("board_id")
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	link := r.PathValue("link")
+	if link == "" {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	userID := claims.UserID
+
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, b.ContextDeadline)
+	defer cancel()
+
+	v := validator.New()
+
+	if !v.Check(boardID > 0 && userID >= 0, "id", "board id cannot be less or equal to zero or user id cannot be less than zero") {
+		rest.HttpErrorToJson(w, v.GetError("id").Error(), http.StatusBadRequest)
+		return
+	}
+
+	newLink, err := b.BoardShrService.RegenerateInvitation(ctx, boardID, userID, link)
+	if err != nil {
+		handleBoardShrError(w, err)
+		return
+	}
+
+	type DataReturn struct {
+		Link string `json:"link"`
+	}
+
+	resp := rest.ServerResponse{
+		Description: "OK",
+		Data:        DataReturn{Link: newLink},
+	}
+
+	rest.ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
@@ -0,0 +1,196 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/validator"
+)
+
+// ProposeOwnershipTransfer godoc
+//	@Summary		Propose ownership transfer
+//	@Description	Propose to hand over the board to a coauthor (user must be author of the board). A new proposal replaces the previous one
+//	@Tags			Board sharing [author]
+//	@Produce		json
+//	@Security		jwt_auth
+//
+//	@Param			board_id	path		int											true	"ID of the board"
+//	@Param			name		body		string										true	"Username of coauthor"
+//
+//	@Success		201			{object}	ServerResponse{data=domain.OwnershipTransfer}	"Transfer has been proposed"
+//	@Failure		400			{object}	ServerResponse								"Invalid request parameters"
+//	@Failure		401			{object}	ServerResponse								"Unauthorized"
+//	@Failure		403			{object}	ServerResponse								"Forbidden - access denied"
+//	@Failure		404			{object}	ServerResponse								"User is not a coauthor of the board"
+//	@Failure		500			{object}	ServerResponse								"Internal server error"
+//
+//	@Router			/api/v1/boards/{board_id}/ownership [post]
+func (b *BoardShrHandler) ProposeOwnershipTransfer(w http.ResponseWriter, r *http.Request) {
+	boardID, userID, ok := parseOwnershipRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var body domain.BodyWithUsername
+	if err := rest.DecodeData(w, r.Body, &body); err != nil {
+		return
+	}
+	if body.Name == "" {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	transfer, err := b.BoardShrService.ProposeOwnershipTransfer(ctx, boardID, userID, body.Name)
+	if err != nil {
+		handleBoardShrError(w, err)
+		return
+	}
+
+	resp := rest.ServerResponse{
+		Description: "OK",
+		Data:        transfer,
+	}
+
+	rest.ServerGenerateJSONResponse(w, resp, http.StatusCreated)
+}
+
+// GetOwnershipTransfer godoc
+//	@Summary		Get pending ownership transfer
+//	@Description	Get pending ownership transfer of the board (user must be its author or addressee)
+//	@Tags			Board sharing [coauthor]
+//	@Produce		json
+//	@Security		jwt_auth
+//
+//	@Param			board_id	path		int											true	"ID of the board"
+//
+//	@Success		200			{object}	ServerResponse{data=domain.OwnershipTransfer}	"Pending transfer"
+//	@Failure		400			{object}	ServerResponse								"Invalid request parameters"
+//	@Failure		401			{object}	ServerResponse								"Unauthorized"
+//	@Failure		404			{object}	ServerResponse								"No pending transfer"
+//	@Failure		500			{object}	ServerResponse								"Internal server error"
+//
+//	@Router			/api/v1/boards/{board_id}/ownership [get]
+func (b *BoardShrHandler) GetOwnershipTransfer(w http.ResponseWriter, r *http.Request) {
+	boardID, userID, ok := parseOwnershipRequest(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	transfer, err := b.BoardShrService.GetOwnershipTransfer(ctx, boardID, userID)
+	if err != nil {
+		handleBoardShrError(w, err)
+		return
+	}
+
+	resp := rest.ServerResponse{
+		Description: "OK",
+		Data:        transfer,
+	}
+
+	rest.ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// AcceptOwnershipTransfer godoc
+//	@Summary		Accept ownership transfer
+//	@Description	Become the author of the board. The previous author stays as a coauthor with the admin role
+//	@Tags			Board sharing [coauthor]
+//	@Produce		json
+//	@Security		jwt_auth
+//
+//	@Param			board_id	path		int				true	"ID of the board"
+//
+//	@Success		200			{object}	ServerResponse	"Ownership has been transferred"
+//	@Failure		400			{object}	ServerResponse	"Invalid request parameters"
+//	@Failure		401			{object}	ServerResponse	"Unauthorized"
+//	@Failure		404			{object}	ServerResponse	"No pending transfer"
+//	@Failure		500			{object}	ServerResponse	"Internal server error"
+//
+//	@Router			/api/v1/boards/{board_id}/ownership/accept [post]
+func (b *BoardShrHandler) AcceptOwnershipTransfer(w http.ResponseWriter, r *http.Request) {
+	boardID, userID, ok := parseOwnershipRequest(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	if err := b.BoardShrService.AcceptOwnershipTransfer(ctx, boardID, userID); err != nil {
+		handleBoardShrError(w, err)
+		return
+	}
+
+	resp := rest.ServerResponse{
+		Description: "OK",
+	}
+
+	rest.ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// RejectOwnershipTransfer godoc
+//	@Summary		Cancel or decline ownership transfer
+//	@Description	Author cancels the pending transfer, addressee declines it
+//	@Tags			Board sharing [coauthor]
+//	@Produce		json
+//	@Security		jwt_auth
+//
+//	@Param			board_id	path		int				true	"ID of the board"
+//
+//	@Success		200			{object}	ServerResponse	"Transfer has been cancelled or declined"
+//	@Failure		400			{object}	ServerResponse	"Invalid request parameters"
+//	@Failure		401			{object}	ServerResponse	"Unauthorized"
+//	@Failure		404			{object}	ServerResponse	"No pending transfer"
+//	@Failure		500			{object}	ServerResponse	"Internal server error"
+//
+//	@Router			/api/v1/boards/{board_id}/ownership [delete]
+func (b *BoardShrHandler) RejectOwnershipTransfer(w http.ResponseWriter, r *http.Request) {
+	boardID, userID, ok := parseOwnershipRequest(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.ContextDeadline)
+	defer cancel()
+
+	if err := b.BoardShrService.RejectOwnershipTransfer(ctx, boardID, userID); err != nil {
+		handleBoardShrError(w, err)
+		return
+	}
+
+	resp := rest.ServerResponse{
+		Description: "OK",
+	}
+
+	rest.ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+func parseOwnershipRequest(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	boardIDStr := r.PathValue("board_id")
+	boardID, err := strconv.Atoi(boardIDStr)
+	if err != nil {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return 0, 0, false
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	userID := claims.UserID
+
+	v := validator.New()
+
+	if !v.Check(boardID > 0 && userID >= 0, "id", "board id cannot be less or equal to zero or user id cannot be less than zero") {
+		rest.HttpErrorToJson(w, v.GetError("id").Error(), http.StatusBadRequest)
+		return 0, 0, false
+	}
+
+	return boardID, userID, true
+}
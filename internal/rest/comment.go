@@ -9,18 +9,24 @@ import (
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
 )
 
 type CommentService interface {
-	GetComments(ctx context.Context, flowID, userID, page, size int) ([]domain.Comment, error)
+	GetComments(ctx context.Context, flowID, userID, page, size int, sort string) ([]domain.Comment, error)
+	GetReplies(ctx context.Context, flowID, commentID, userID, page, size int, sort string) ([]domain.Comment, error)
 	LikeComment(ctx context.Context, flowID, commentID, userID int) (string, error)
-	AddComment(ctx context.Context, flowID, userID int, content string) error
+	AddComment(ctx context.Context, flowID, userID int, content string, parentID *int) (int, error)
 	DeleteComment(ctx context.Context, commentID, userID int) error
+	EditComment(ctx context.Context, flowID, commentID, userID int, content string) error
+	HideComment(ctx context.Context, flowID, commentID, userID int, hidden bool) error
+	PinComment(ctx context.Context, flowID, commentID, userID int, pinned bool) error
 }
 
 type CommentHandler struct {
 	Service           CommentService
 	ContextExpiration time.Duration
+	Events            EventRecorder
 }
 
 func (h *CommentHandler) GetComments(w http.ResponseWriter, r *http.Request) {
@@ -42,10 +48,16 @@ func (h *CommentHandler) GetComments(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	sort, err := domain.ParseCommentSort(r.URL.Query().Get("sort"))
+	if err != nil {
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
 	defer cancel()
 
-	comments, err := h.Service.GetComments(ctx, flowID, userID, page, size)
+	comments, err := h.Service.GetComments(ctx, flowID, userID, page, size, sort)
 	if err != nil {
 		handleCommentError(w, err)
 		return
@@ -66,6 +78,68 @@ func (h *CommentHandler) GetComments(w http.ResponseWriter, r *http.Request) {
 	ServerGenerateJSONResponse(w, resp, status)
 }
 
+func (h *CommentHandler) GetReplies(w http.ResponseWriter, r *http.Request) {
+	userID := 0
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if ok {
+		userID = claims.UserID
+	}
+
+	page, size, err := getQueryPagination(w, r)
+	if err != nil {
+		return
+	}
+
+	flowIDStr := r.PathValue("flow_id")
+	flowID, err := strconv.Atoi(flowIDStr)
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	commentIDStr := r.PathValue("comment_id")
+	commentID, err := strconv.Atoi(commentIDStr)
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	// ответы по умолчанию выводятся в хронологическом порядке
+	sortParam := r.URL.Query().Get("sort")
+	if sortParam == "" {
+		sortParam = domain.CommentSortOldest
+	}
+
+	sort, err := domain.ParseCommentSort(sortParam)
+	if err != nil {
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	replies, err := h.Service.GetReplies(ctx, flowID, commentID, userID, page, size, sort)
+	if err != nil {
+		handleCommentError(w, err)
+		return
+	}
+
+	status := http.StatusOK
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data: replies,
+	}
+
+	if len(replies) == 0 {
+		status = http.StatusNotFound
+		resp.Description = http.StatusText(http.StatusNotFound)
+	}
+
+	ServerGenerateJSONResponse(w, resp, status)
+}
+
 func (h *CommentHandler) LikeComment(w http.ResponseWriter, r *http.Request) {
 	flowIDStr := r.PathValue("flow_id")
 	flowID, err := strconv.Atoi(flowIDStr)
@@ -132,13 +206,27 @@ func (h *CommentHandler) AddComment(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
 	defer cancel()
 
-	if err := h.Service.AddComment(ctx, flowID, claims.UserID, comment.Content); err != nil {
+	id, err := h.Service.AddComment(ctx, flowID, claims.UserID, comment.Content, comment.ParentID)
+	if err != nil {
 		handleCommentError(w, err)
 		return
 	}
 
+	RecordEvent(h.Events, domain.AnalyticsEvent{
+		Type:     domain.EventComment,
+		FlowID:   uint64(flowID),
+		ViewerID: claims.UserID,
+	})
+
+	type commentID struct {
+		ID int `json:"comment_id"`
+	}
+
 	resp := ServerResponse{
 		Description: "Created",
+		Data: commentID{
+			ID: id,
+		},
 	}
 
 	ServerGenerateJSONResponse(w, resp, http.StatusCreated)
@@ -169,10 +257,139 @@ func (h *CommentHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
 	ServerGenerateJSONResponse(w, resp, http.StatusOK)
 }
 
+func (h *CommentHandler) EditComment(w http.ResponseWriter, r *http.Request) {
+	var comment domain.Comment
+
+	if err := DecodeData(w, r.Body, &comment); err != nil {
+		return
+	}
+
+	flowIDStr := r.PathValue("flow_id")
+	flowID, err := strconv.Atoi(flowIDStr)
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	commentIDStr := r.PathValue("comment_id")
+	commentID, err := strconv.Atoi(commentIDStr)
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if err := comment.Validate(); err != nil {
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	if err := h.Service.EditComment(ctx, flowID, commentID, claims.UserID, comment.Content); err != nil {
+		handleCommentError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+func (h *CommentHandler) HideComment(w http.ResponseWriter, r *http.Request) {
+	h.setCommentHidden(w, r, true)
+}
+
+func (h *CommentHandler) UnhideComment(w http.ResponseWriter, r *http.Request) {
+	h.setCommentHidden(w, r, false)
+}
+
+func (h *CommentHandler) setCommentHidden(w http.ResponseWriter, r *http.Request, hidden bool) {
+	flowIDStr := r.PathValue("flow_id")
+	flowID, err := strconv.Atoi(flowIDStr)
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	commentIDStr := r.PathValue("comment_id")
+	commentID, err := strconv.Atoi(commentIDStr)
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	if err := h.Service.HideComment(ctx, flowID, commentID, claims.UserID, hidden); err != nil {
+		handleCommentError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+func (h *CommentHandler) PinComment(w http.ResponseWriter, r *http.Request) {
+	h.setCommentPinned(w, r, true)
+}
+
+func (h *CommentHandler) UnpinComment(w http.ResponseWriter, r *http.Request) {
+	h.setCommentPinned(w, r, false)
+}
+
+func (h *CommentHandler) setCommentPinned(w http.ResponseWriter, r *http.Request, pinned bool) {
+	flowIDStr := r.PathValue("flow_id")
+	flowID, err := strconv.Atoi(flowIDStr)
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	commentIDStr := r.PathValue("comment_id")
+	commentID, err := strconv.Atoi(commentIDStr)
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	if err := h.Service.PinComment(ctx, flowID, commentID, claims.UserID, pinned); err != nil {
+		handleCommentError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
 func handleCommentError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, domain.ErrForbidden):
 		HttpErrorToJson(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+	case errors.Is(err, domain.ErrCommentsDisabled), errors.Is(err, domain.ErrCommentsFollowers):
+		HttpErrorToJson(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, domain.ErrNotFound), errors.Is(err, pincrud.ErrPinNotFound):
+		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	case errors.Is(err, domain.ErrCommentDepthLimit), errors.Is(err, domain.ErrCommentFlowMismatch):
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
 	default:
 		HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 	}
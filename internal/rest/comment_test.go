@@ -21,8 +21,13 @@ type MockCommentService struct {
 	mock.Mock
 }
 
-func (m *MockCommentService) GetComments(ctx context.Context, flowID, userID, page, size int) ([]domain.Comment, error) {
-	args := m.Called(ctx, flowID, userID, page, size)
+func (m *MockCommentService) GetComments(ctx context.Context, flowID, userID, page, size int, sort string) ([]domain.Comment, error) {
+	args := m.Called(ctx, flowID, userID, page, size, sort)
+	return args.Get(0).([]domain.Comment), args.Error(1)
+}
+
+func (m *MockCommentService) GetReplies(ctx context.Context, flowID, commentID, userID, page, size int, sort string) ([]domain.Comment, error) {
+	args := m.Called(ctx, flowID, commentID, userID, page, size, sort)
 	return args.Get(0).([]domain.Comment), args.Error(1)
 }
 
@@ -31,9 +36,9 @@ func (m *MockCommentService) LikeComment(ctx context.Context, flowID, commentID,
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockCommentService) AddComment(ctx context.Context, flowID, userID int, content string) error {
-	args := m.Called(ctx, flowID, userID, content)
-	return args.Error(0)
+func (m *MockCommentService) AddComment(ctx context.Context, flowID, userID int, content string, parentID *int) (int, error) {
+	args := m.Called(ctx, flowID, userID, content, parentID)
+	return args.Int(0), args.Error(1)
 }
 
 func (m *MockCommentService) DeleteComment(ctx context.Context, commentID, userID int) error {
@@ -41,6 +46,21 @@ func (m *MockCommentService) DeleteComment(ctx context.Context, commentID, userI
 	return args.Error(0)
 }
 
+func (m *MockCommentService) EditComment(ctx context.Context, flowID, commentID, userID int, content string) error {
+	args := m.Called(ctx, flowID, commentID, userID, content)
+	return args.Error(0)
+}
+
+func (m *MockCommentService) HideComment(ctx context.Context, flowID, commentID, userID int, hidden bool) error {
+	args := m.Called(ctx, flowID, commentID, userID, hidden)
+	return args.Error(0)
+}
+
+func (m *MockCommentService) PinComment(ctx context.Context, flowID, commentID, userID int, pinned bool) error {
+	args := m.Called(ctx, flowID, commentID, userID, pinned)
+	return args.Error(0)
+}
+
 func TestCommentHandler_GetComments(t *testing.T) {
     tests := []struct {
         name           string
@@ -74,6 +94,11 @@ func TestCommentHandler_GetComments(t *testing.T) {
             url:            "/flows/1/comments?page=1&size=invalid",
             expectedStatus: http.StatusBadRequest,
         },
+        {
+            name:           "Invalid sort",
+            url:            "/flows/1/comments?page=1&size=10&sort=random",
+            expectedStatus: http.StatusBadRequest,
+        },
         {
             name:           "Not found",
             url:            "/flows/1/comments?page=1&size=10",
@@ -111,7 +136,7 @@ func TestCommentHandler_GetComments(t *testing.T) {
                     if tt.name == "Invalid page" || tt.name == "Invalid size" {
                         // Skip mock setup for invalid pagination cases
                     } else {
-                        mockService.On("GetComments", mock.Anything, flowID, tt.userID, page, size).
+                        mockService.On("GetComments", mock.Anything, flowID, tt.userID, page, size, domain.CommentSortNewest).
                             Return(tt.mockComments, tt.mockError)
                     }
                 }
@@ -285,6 +310,16 @@ func TestCommentHandler_AddComment(t *testing.T) {
 			mockError:      domain.ErrForbidden,
 			expectedStatus: http.StatusForbidden,
 		},
+		{
+			name:   "Comments disabled",
+			url:    "/flows/1/comments",
+			userID: 2,
+			comment: domain.Comment{
+				Content: "Test comment",
+			},
+			mockError:      domain.ErrCommentsDisabled,
+			expectedStatus: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -304,8 +339,8 @@ func TestCommentHandler_AddComment(t *testing.T) {
 			// Extract flowID from URL for mock setup if it's a valid number
 			flowIDStr := req.URL.Path[len("/flows/"):][:len(req.URL.Path[len("/flows/"):])-len("/comments")]
 			if flowID, err := strconv.Atoi(flowIDStr); err == nil && tt.comment.Content != "" {
-				mockService.On("AddComment", mock.Anything, flowID, tt.userID, tt.comment.Content).
-					Return(tt.mockError)
+				mockService.On("AddComment", mock.Anything, flowID, tt.userID, tt.comment.Content, tt.comment.ParentID).
+					Return(1, tt.mockError)
 			}
 
 			w := httptest.NewRecorder()
@@ -331,6 +366,286 @@ func TestCommentHandler_AddComment(t *testing.T) {
 	}
 }
 
+func TestCommentHandler_AddReply(t *testing.T) {
+	parentID := 3
+
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Depth limit",
+			mockError:      domain.ErrCommentDepthLimit,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Parent not found",
+			mockError:      domain.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockCommentService)
+			handler := CommentHandler{
+				Service:           mockService,
+				ContextExpiration: time.Second,
+			}
+
+			comment := domain.Comment{
+				Content:  "Reply",
+				ParentID: &parentID,
+			}
+
+			mockService.On("AddComment", mock.Anything, 1, 2, "Reply", &parentID).
+				Return(4, tt.mockError)
+
+			body, _ := json.Marshal(comment)
+			req := httptest.NewRequest(http.MethodPost, "/flows/1/comments", bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 2}))
+
+			w := httptest.NewRecorder()
+
+			router := http.NewServeMux()
+			router.HandleFunc("POST /flows/{flow_id}/comments", handler.AddComment)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentHandler_GetReplies(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		mockReplies    []domain.Comment
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			url:  "/flows/1/comments/3/replies?page=1&size=10",
+			mockReplies: []domain.Comment{
+				{ID: 4, FlowID: 1, Depth: 1, Content: "Reply"},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid comment ID",
+			url:            "/flows/1/comments/invalid/replies?page=1&size=10",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "No replies",
+			url:            "/flows/1/comments/3/replies?page=1&size=10",
+			mockReplies:    []domain.Comment{},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Forbidden",
+			url:            "/flows/1/comments/3/replies?page=1&size=10",
+			mockError:      domain.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockCommentService)
+			handler := CommentHandler{
+				Service:           mockService,
+				ContextExpiration: time.Second,
+			}
+
+			if tt.mockReplies != nil || tt.mockError != nil {
+				mockService.On("GetReplies", mock.Anything, 1, 3, 0, 1, 10, domain.CommentSortOldest).
+					Return(tt.mockReplies, tt.mockError)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			w := httptest.NewRecorder()
+
+			router := http.NewServeMux()
+			router.HandleFunc("GET /flows/{flow_id}/comments/{comment_id}/replies", handler.GetReplies)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentHandler_PinComment(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		pinned         bool
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Pin",
+			method:         http.MethodPost,
+			pinned:         true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unpin",
+			method:         http.MethodDelete,
+			pinned:         false,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Not flow author",
+			method:         http.MethodPost,
+			pinned:         true,
+			mockError:      domain.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockCommentService)
+			handler := CommentHandler{
+				Service:           mockService,
+				ContextExpiration: time.Second,
+			}
+
+			mockService.On("PinComment", mock.Anything, 1, 3, 2, tt.pinned).Return(tt.mockError)
+
+			req := httptest.NewRequest(tt.method, "/flows/1/comments/3/pin", nil)
+			req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 2}))
+
+			w := httptest.NewRecorder()
+
+			router := http.NewServeMux()
+			router.HandleFunc("POST /flows/{flow_id}/comments/{comment_id}/pin", handler.PinComment)
+			router.HandleFunc("DELETE /flows/{flow_id}/comments/{comment_id}/pin", handler.UnpinComment)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentHandler_EditComment(t *testing.T) {
+	tests := []struct {
+		name           string
+		content        string
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			content:        "Edited comment",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Empty content",
+			content:        "",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Not author",
+			content:        "Edited comment",
+			mockError:      domain.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockCommentService)
+			handler := CommentHandler{
+				Service:           mockService,
+				ContextExpiration: time.Second,
+			}
+
+			if tt.content != "" {
+				mockService.On("EditComment", mock.Anything, 1, 3, 2, tt.content).Return(tt.mockError)
+			}
+
+			body, _ := json.Marshal(domain.Comment{Content: tt.content})
+			req := httptest.NewRequest(http.MethodPut, "/flows/1/comments/3", bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 2}))
+
+			w := httptest.NewRecorder()
+
+			router := http.NewServeMux()
+			router.HandleFunc("PUT /flows/{flow_id}/comments/{comment_id}", handler.EditComment)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentHandler_HideComment(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		hidden         bool
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Hide",
+			method:         http.MethodPost,
+			hidden:         true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unhide",
+			method:         http.MethodDelete,
+			hidden:         false,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Not flow author",
+			method:         http.MethodPost,
+			hidden:         true,
+			mockError:      domain.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockCommentService)
+			handler := CommentHandler{
+				Service:           mockService,
+				ContextExpiration: time.Second,
+			}
+
+			mockService.On("HideComment", mock.Anything, 1, 3, 2, tt.hidden).Return(tt.mockError)
+
+			req := httptest.NewRequest(tt.method, "/flows/1/comments/3/hide", nil)
+			req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 2}))
+
+			w := httptest.NewRecorder()
+
+			router := http.NewServeMux()
+			router.HandleFunc("POST /flows/{flow_id}/comments/{comment_id}/hide", handler.HideComment)
+			router.HandleFunc("DELETE /flows/{flow_id}/comments/{comment_id}/hide", handler.UnhideComment)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
 func TestCommentHandler_DeleteComment(t *testing.T) {
 	tests := []struct {
 		name           string
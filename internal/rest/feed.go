@@ -2,6 +2,7 @@ package rest
 
 import (
 	"context"
+	"log"
 	"net/http"
 	"strconv"
 	"time"
@@ -12,15 +13,31 @@ import (
 )
 
 type PinServiceInterface interface {
-	GetPins(page int, pageSize int) ([]domain.PinData, error)
+	GetPins(page int, pageSize int, nsfwMode string) ([]domain.PinData, error)
+}
+
+// FollowedBoardsService отдаёт новые пины из досок, на которые подписан пользователь
+type FollowedBoardsService interface {
+	GetFollowedBoardsFlow(ctx context.Context, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error)
+}
+
+// FollowedTopicsService отдаёт свежие флоу по темам, на которые подписан пользователь
+type FollowedTopicsService interface {
+	GetFollowedTagsFlow(ctx context.Context, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error)
 }
 
 type PinsHandler struct {
 	Config            configs.Config
 	FeedClient        gen.FeedClient
 	ContextExpiration time.Duration
+	FollowedBoards    FollowedBoardsService
+	FollowedTopics    FollowedTopicsService
+	Events            EventRecorder
 }
 
+// доля страницы ленты, которую занимают пины из отслеживаемых досок и тем
+const followedFeedShare = 4
+
 // FeedHandler godoc
 //	@Summary		Get Pins
 //	@Description	Returns a pageSized number of pins
@@ -40,19 +57,44 @@ func (app PinsHandler) FeedHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	nsfwMode := domain.NSFWModeFromContext(r.Context())
+
 	ctx, cancel := context.WithTimeout(context.Background(), app.ContextExpiration)
 	defer cancel()
 
 	grpcResp, err := app.FeedClient.GetPins(ctx, &gen.GetPinsRequest{
 		Page: int64(page),
 		PageSize: int64(pageSize),
+		NsfwMode: nsfwMode,
 	})
 	if err != nil {
 		HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
-	pagedImages := grpcToNormal(grpcResp.Pins)
+	pins := grpcToNormal(grpcResp.Pins)
+
+	// авторизованный пользователь видит в начале страницы новые пины отслеживаемых досок,
+	// за ними — флоу по темам, на которые он подписан
+	followedSize := max(pageSize/followedFeedShare, 1)
+	if userID := viewerID(r); userID != 0 && app.FollowedTopics != nil {
+		followed, err := app.FollowedTopics.GetFollowedTagsFlow(ctx, int(userID), page, followedSize, nsfwMode)
+		if err != nil {
+			log.Printf("failed to get followed topics flows: %v", err)
+		} else {
+			pins = mergeFollowedFlows(followed, pins)
+		}
+	}
+	if userID := viewerID(r); userID != 0 && app.FollowedBoards != nil {
+		followed, err := app.FollowedBoards.GetFollowedBoardsFlow(ctx, int(userID), page, followedSize, nsfwMode)
+		if err != nil {
+			log.Printf("failed to get followed boards flows: %v", err)
+		} else {
+			pins = mergeFollowedFlows(followed, pins)
+		}
+	}
+
+	pagedImages := domain.FilterNSFW(pins, nsfwMode, viewerID(r))
 
 	if len(pagedImages) == 0 {
 		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
@@ -61,6 +103,8 @@ func (app PinsHandler) FeedHandler(w http.ResponseWriter, r *http.Request) {
 
 	domain.EscapeFlows(pagedImages)
 
+	RecordImpressions(app.Events, pagedImages, viewerID(r))
+
 	response := ServerResponse{
 		Data: pagedImages,
 	}
@@ -76,6 +120,28 @@ func parsePageQueryParam(pageStr string) int {
 	return page
 }
 
+// mergeFollowedFlows ставит отслеживаемые пины перед остальными, убирая повторы
+func mergeFollowedFlows(followed, pins []domain.PinData) []domain.PinData {
+	if len(followed) == 0 {
+		return pins
+	}
+
+	seen := make(map[uint64]struct{}, len(followed))
+	merged := make([]domain.PinData, 0, len(followed)+len(pins))
+	for _, pin := range followed {
+		seen[pin.FlowID] = struct{}{}
+		merged = append(merged, pin)
+	}
+
+	for _, pin := range pins {
+		if _, ok := seen[pin.FlowID]; !ok {
+			merged = append(merged, pin)
+		}
+	}
+
+	return merged
+}
+
 func grpcToNormal(grpcPins []*gen.Pin) []domain.PinData {
 	var pins []domain.PinData
 	for i := range grpcPins {
@@ -92,10 +158,29 @@ func grpcToNormal(grpcPins []*gen.Pin) []domain.PinData {
 			UpdatedAt: grpcPin.UpdatedAt,
 			IsLiked: grpcPin.IsLiked,
 			LikeCount: int(grpcPin.LikeCount),
+			SaveCount: int(grpcPin.SaveCount),
 			Width: int(grpcPin.Width),
 			Height: int(grpcPin.Height),
+			IsNSFW: grpcPin.IsNsfw,
+			Media: grpcToMedia(grpcPin.Media),
 		})
 	}
 
 	return pins
 }
+
+func grpcToMedia(grpcMedia []*gen.Media) []domain.FlowMedia {
+	var media []domain.FlowMedia
+	for _, item := range grpcMedia {
+		media = append(media, domain.FlowMedia{
+			Type:       item.Type,
+			URL:        item.Url,
+			Width:      int(item.Width),
+			Height:     int(item.Height),
+			DurationMs: int(item.DurationMs),
+			PosterURL:  item.PosterUrl,
+		})
+	}
+
+	return media
+}
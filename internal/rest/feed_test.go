@@ -144,6 +144,7 @@ func TestPinsHandler_FeedHandler(t *testing.T) {
                     GetPins(gomock.Any(), &gen.GetPinsRequest{
                         Page:     int64(tt.page),
                         PageSize: int64(tt.pageSize),
+                        NsfwMode: domain.NSFWModeHide,
                     }).
                     Return(&gen.GetPinsResponse{
                         Pins: PinsToGrpc(tt.mockRepoReturn),
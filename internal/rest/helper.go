@@ -4,6 +4,7 @@ import (
 	"io"
 	"net/http"
 
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
 	"github.com/mailru/easyjson"
 )
 
@@ -55,3 +56,13 @@ func HttpErrorToJson(w http.ResponseWriter, err string, status int) {
 
 	ServerGenerateJSONResponse(w, response, status)
 }
+
+// viewerID возвращает id авторизованного пользователя или 0 для анонима
+func viewerID(r *http.Request) uint64 {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok || claims == nil {
+		return 0
+	}
+
+	return uint64(claims.UserID)
+}
@@ -19,6 +19,7 @@ type LikeHandler struct {
 	LikeService      LikeService
 	ContextTimeout   time.Duration
 	NotificationChan chan<- domain.WebMessage
+	Events           EventRecorder
 }
 
 // LikeFlow godoc
@@ -51,6 +52,14 @@ func (h *LikeHandler) LikeFlow(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if action == "liked" {
+		RecordEvent(h.Events, domain.AnalyticsEvent{
+			Type:     domain.EventLike,
+			FlowID:   uint64(likePin.PinID),
+			ViewerID: claims.UserID,
+		})
+	}
+
 	if claims.Username != authorUsername && action == "liked" {
 		h.NotificationChan <- domain.WebMessage{
 			Type: NotificationType,
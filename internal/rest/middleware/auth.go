@@ -2,8 +2,10 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
 	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
 )
@@ -34,6 +36,19 @@ func AuthMiddleware(jwtManager *auth.JWTManager, block bool) func(http.HandlerFu
 				return
 			}
 
+			if err := jwtManager.ValidateSession(r.Context(), claims.UserID, claims.Username); err != nil {
+				if block {
+					if errors.Is(err, domain.ErrAccountSuspended) {
+						rest.HttpErrorToJson(w, err.Error(), http.StatusForbidden)
+						return
+					}
+					rest.HttpErrorToJson(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			ctx := context.WithValue(r.Context(), auth.ClaimsContextKey, claims)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		}
@@ -1,12 +1,14 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/configs"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
@@ -154,3 +156,55 @@ func TestAuthMiddleware(t *testing.T) {
     }
 }
 
+
+type suspendedSessions struct{}
+
+func (suspendedSessions) ValidateSession(ctx context.Context, userID int, username string) error {
+    return domain.ErrAccountSuspended
+}
+
+func TestAuthMiddleware_SuspendedUser(t *testing.T) {
+    cfg := configs.Config{
+        JWTSecret:      []byte("test-secret"),
+        ExpirationTime: 1 * time.Hour,
+    }
+    jwtManager := auth.NewJWTManager(cfg)
+    jwtManager.SetSessionValidator(suspendedSessions{})
+
+    token, err := jwtManager.CreateJWT("test@example.com", "hi", 123)
+    assert.NoError(t, err)
+
+    tests := []struct {
+        name           string
+        block          bool
+        expectedStatus int
+        expectNext     bool
+    }{
+        {"block=true", true, http.StatusForbidden, false},
+        {"block=false", false, http.StatusOK, true},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            var nextCalled bool
+            var hasClaims bool
+
+            nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                nextCalled = true
+                _, hasClaims = r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+                w.WriteHeader(http.StatusOK)
+            })
+
+            req := httptest.NewRequest("GET", "/", nil)
+            req.AddCookie(&http.Cookie{Name: auth.AuthToken, Value: token})
+            rr := httptest.NewRecorder()
+
+            AuthMiddleware(jwtManager, tt.block)(nextHandler).ServeHTTP(rr, req)
+
+            assert.Equal(t, tt.expectedStatus, rr.Code)
+            assert.Equal(t, tt.expectNext, nextCalled)
+            // действующий токен заблокированного пользователя не дает доступа
+            assert.False(t, hasClaims)
+        })
+    }
+}
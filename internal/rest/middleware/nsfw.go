@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+type NSFWModeResolver interface {
+	GetNSFWMode(ctx context.Context, userID int) (string, error)
+}
+
+// Миделваре определяет режим показа NSFW пинов для пользователя и кладёт его в контекст.
+// Должно стоять после AuthMiddleware, для неавторизованных пользователей NSFW пины скрываются.
+func NSFWMiddleware(resolver NSFWModeResolver) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mode := domain.NSFWModeHide
+
+			claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+			if ok && claims != nil {
+				resolved, err := resolver.GetNSFWMode(r.Context(), claims.UserID)
+				if err != nil {
+					log.Printf("resolve nsfw mode error: %v", err)
+				} else {
+					mode = resolved
+				}
+			}
+
+			ctx := domain.ContextWithNSFWMode(r.Context(), mode)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+	}
+}
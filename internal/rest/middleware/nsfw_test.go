@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubNSFWResolver struct {
+	mode string
+	err  error
+}
+
+func (s stubNSFWResolver) GetNSFWMode(ctx context.Context, userID int) (string, error) {
+	return s.mode, s.err
+}
+
+func TestNSFWMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolver stubNSFWResolver
+		claims   *auth.Claims
+		expected string
+	}{
+		{
+			name:     "anonymous user",
+			resolver: stubNSFWResolver{mode: domain.NSFWModeShow},
+			expected: domain.NSFWModeHide,
+		},
+		{
+			name:     "authorized user",
+			resolver: stubNSFWResolver{mode: domain.NSFWModeBlur},
+			claims:   &auth.Claims{UserID: 1},
+			expected: domain.NSFWModeBlur,
+		},
+		{
+			name:     "resolver error",
+			resolver: stubNSFWResolver{mode: domain.NSFWModeShow, err: errors.New("db error")},
+			claims:   &auth.Claims{UserID: 1},
+			expected: domain.NSFWModeHide,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mode string
+			next := func(w http.ResponseWriter, r *http.Request) {
+				mode = domain.NSFWModeFromContext(r.Context())
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/feed", nil)
+			if tt.claims != nil {
+				req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, tt.claims))
+			}
+
+			NSFWMiddleware(tt.resolver)(next).ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(t, tt.expected, mode)
+		})
+	}
+}
@@ -0,0 +1,402 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/csrf"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/oauth"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	gen "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OAuthProviders godoc
+//	@Summary		List login providers
+//	@Description	Returns names of external login providers enabled on the server
+//	@Produce		json
+//	@Success		200	string	serverResponse.Data	"OK"
+//	@Router			/api/v1/auth/oauth/providers [get]
+func (app AuthHandler) OAuthProviders(w http.ResponseWriter, r *http.Request) {
+	resp := ServerResponse{
+		Description: "OK",
+		Data: domain.OAuthProviders{
+			Providers: app.OAuth.Names(),
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// OAuthAuthorize godoc
+//	@Summary		Start login with an external provider
+//	@Description	Returns the provider authorization URL (authorization code + PKCE) and stores state in a short-lived cookie
+//	@Produce		json
+//	@Param			provider	path	string						true	"provider name"	example("google")
+//	@Success		200			string	serverResponse.Data			"OK"
+//	@Failure		404			string	serverResponse.Description	"unknown login provider"
+//	@Failure		500			string	serverResponse.Description	"Internal server error"
+//	@Router			/api/v1/auth/oauth/{provider}/authorize [post]
+func (app AuthHandler) OAuthAuthorize(w http.ResponseWriter, r *http.Request) {
+	app.startOAuth(w, r.PathValue("provider"), domain.OAuthModeLogin, 0)
+}
+
+// OAuthCallback godoc
+//	@Summary		Finish login or linking with an external provider
+//	@Description	Exchanges the code returned by the provider. Logs the user in, links the provider to the current account, or returns a registration token for a new user
+//	@Accept			json
+//	@Produce		json
+//	@Param			provider	path	string						true	"provider name"	example("google")
+//	@Param			data		body	domain.OAuthCallback		true	"code and state from the provider redirect"
+//	@Success		200			string	serverResponse.Data			"OK"
+//	@Success		202			string	serverResponse.Data			"two-factor code required, see /api/v1/auth/login/2fa"
+//	@Failure		400			string	serverResponse.Description	"invalid or expired oauth state"
+//	@Failure		403			string	serverResponse.Description	"Forbidden"
+//	@Failure		404			string	serverResponse.Data			"registration required"
+//	@Failure		409			string	serverResponse.Description	"this account is already linked to another user"
+//	@Failure		422			string	serverResponse.Description	"login provider did not share a verified email"
+//	@Failure		502			string	serverResponse.Description	"login provider request failed"
+//	@Router			/api/v1/auth/oauth/{provider}/callback [post]
+func (app AuthHandler) OAuthCallback(w http.ResponseWriter, r *http.Request) {
+	var data domain.OAuthCallback
+	if err := DecodeData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	providerName := r.PathValue("provider")
+
+	provider, err := app.OAuth.Get(providerName)
+	if err != nil {
+		handleOAuthError(w, err)
+		return
+	}
+
+	cookie, err := r.Cookie(oauth.StateCookie)
+	if err != nil {
+		handleOAuthError(w, domain.ErrInvalidOAuthState)
+		return
+	}
+
+	// state одноразовый: cookie удаляется при любом исходе
+	app.setOAuthStateCookie(w, "", -time.Hour)
+
+	state, err := app.OAuthSigner.ParseState(cookie.Value, providerName, data.State)
+	if err != nil {
+		handleOAuthError(w, err)
+		return
+	}
+
+	if data.Code == "" {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	// привязку завершает тот же пользователь, который ее начал
+	if state.Mode == domain.OAuthModeLink && int(viewerID(r)) != state.UserID {
+		HttpErrorToJson(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	redirectURI := app.oauthRedirectURI(providerName)
+
+	accessToken, err := provider.Exchange(ctx, redirectURI, state.Verifier, data)
+	if err != nil {
+		handleOAuthError(w, err)
+		return
+	}
+
+	identity, err := provider.Identity(ctx, accessToken)
+	if err != nil {
+		handleOAuthError(w, err)
+		return
+	}
+
+	if state.Mode == domain.OAuthModeLink {
+		if _, err := app.UserService.LinkIdentity(ctx, &gen.LinkIdentityRequest{
+			ID:       int64(state.UserID),
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+			Email:    identity.Email,
+		}); err != nil {
+			handleGRPCAuthError(w, err)
+			return
+		}
+
+		ServerGenerateJSONResponse(w, ServerResponse{Description: "linked"}, http.StatusOK)
+		return
+	}
+
+	grpcResp, err := app.UserService.LoginOAuthUser(ctx, &gen.LoginOAuthUserRequest{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			app.requireOAuthRegistration(w, identity)
+			return
+		}
+		handleGRPCAuthError(w, err)
+		return
+	}
+
+	if grpcResp.TwoFactorRequired {
+		app.requireTwoFactor(w, grpcResp.Email, grpcResp.Username, uint64(grpcResp.ID))
+		return
+	}
+
+	app.startSession(w, grpcResp.Email, grpcResp.Username, uint64(grpcResp.ID), http.StatusOK)
+}
+
+// OAuthRegister godoc
+//	@Summary		Register with an external provider
+//	@Description	Creates an account for the provider identity from /callback. The email is always the one verified by the provider
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body	domain.OAuthRegistration	true	"registration token and username"
+//	@Success		201		string	serverResponse.Data			"Created"
+//	@Failure		400		string	serverResponse.Description	"Bad Request"
+//	@Failure		409		string	serverResponse.Description	"Conflict"
+//	@Router			/api/v1/auth/oauth/register [post]
+func (app AuthHandler) OAuthRegister(w http.ResponseWriter, r *http.Request) {
+	var data domain.OAuthRegistration
+	if err := DecodeData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	identity, err := app.OAuthSigner.ParseRegistration(data.RegistrationToken)
+	if err != nil {
+		handleOAuthError(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	grpcResp, err := app.UserService.AddOAuthUser(ctx, &gen.AddOAuthUserRequest{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+		Username: data.Username,
+		Avatar:   identity.Avatar,
+	})
+	if err != nil {
+		handleGRPCAuthError(w, err)
+		return
+	}
+
+	app.startSession(w, identity.Email, data.Username, uint64(grpcResp.ID), http.StatusCreated)
+}
+
+// GetIdentities godoc
+//	@Summary		List linked login providers
+//	@Description	Returns external providers linked to the current account
+//	@Produce		json
+//	@Success		200	string	serverResponse.Data			"OK"
+//	@Failure		401	string	serverResponse.Description	"Unauthorized"
+//	@Router			/api/v1/profile/identities [get]
+func (app AuthHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	grpcResp, err := app.UserService.GetIdentities(ctx, &gen.IdentitiesRequest{
+		ID: int64(claims.UserID),
+	})
+	if err != nil {
+		handleGRPCAuthError(w, err)
+		return
+	}
+
+	identities := make([]domain.LinkedIdentity, 0, len(grpcResp.Identities))
+	for _, identity := range grpcResp.Identities {
+		identities = append(identities, domain.LinkedIdentity{
+			Provider:  identity.Provider,
+			Email:     identity.Email,
+			CreatedAt: time.Unix(identity.CreatedAt, 0),
+		})
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data: domain.LinkedIdentities{
+			Identities: identities,
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// LinkIdentity godoc
+//	@Summary		Start linking an external provider
+//	@Description	Returns the provider authorization URL. The link is completed by /api/v1/auth/oauth/{provider}/callback
+//	@Produce		json
+//	@Param			provider	path	string						true	"provider name"	example("yandex")
+//	@Success		200			string	serverResponse.Data			"OK"
+//	@Failure		401			string	serverResponse.Description	"Unauthorized"
+//	@Failure		404			string	serverResponse.Description	"unknown login provider"
+//	@Router			/api/v1/profile/identities/{provider} [post]
+func (app AuthHandler) LinkIdentity(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	app.startOAuth(w, r.PathValue("provider"), domain.OAuthModeLink, claims.UserID)
+}
+
+// UnlinkIdentity godoc
+//	@Summary		Unlink an external provider
+//	@Description	Unlinks the provider unless it is the only way to log in
+//	@Produce		json
+//	@Param			provider	path	string						true	"provider name"	example("vk")
+//	@Success		200			string	serverResponse.Description	"unlinked"
+//	@Failure		401			string	serverResponse.Description	"Unauthorized"
+//	@Failure		404			string	serverResponse.Description	"login provider is not linked"
+//	@Failure		409			string	serverResponse.Description	"cannot unlink the only way to log in"
+//	@Router			/api/v1/profile/identities/{provider} [delete]
+func (app AuthHandler) UnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	if _, err := app.UserService.UnlinkIdentity(ctx, &gen.UnlinkIdentityRequest{
+		ID:       int64(claims.UserID),
+		Provider: r.PathValue("provider"),
+	}); err != nil {
+		handleGRPCAuthError(w, err)
+		return
+	}
+
+	ServerGenerateJSONResponse(w, ServerResponse{Description: "unlinked"}, http.StatusOK)
+}
+
+func (app AuthHandler) startOAuth(w http.ResponseWriter, providerName, mode string, userID int) {
+	provider, err := app.OAuth.Get(providerName)
+	if err != nil {
+		handleOAuthError(w, err)
+		return
+	}
+
+	state, err := oauth.NewState()
+	if err != nil {
+		handleAuthError(w, err)
+		return
+	}
+
+	verifier, err := oauth.NewCodeVerifier()
+	if err != nil {
+		handleAuthError(w, err)
+		return
+	}
+
+	token, err := app.OAuthSigner.CreateState(oauth.StateClaims{
+		Provider: providerName,
+		State:    state,
+		Verifier: verifier,
+		Mode:     mode,
+		UserID:   userID,
+	})
+	if err != nil {
+		handleAuthError(w, err)
+		return
+	}
+
+	app.setOAuthStateCookie(w, token, domain.OAuthStateTTL)
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data: domain.OAuthAuthorization{
+			AuthorizationURL: provider.AuthCodeURL(app.oauthRedirectURI(providerName), state, verifier),
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+func (app AuthHandler) requireOAuthRegistration(w http.ResponseWriter, identity domain.OAuthIdentity) {
+	// подтвердить введенную вручную почту нечем, поэтому регистрируем
+	// только с почтой, которую подтвердил провайдер
+	if identity.Email == "" {
+		handleOAuthError(w, domain.ErrOAuthNoEmail)
+		return
+	}
+
+	token, err := app.OAuthSigner.CreateRegistration(identity)
+	if err != nil {
+		handleAuthError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "registration required",
+		Data: domain.OAuthRegistrationRequired{
+			RegistrationToken: token,
+			Email:             identity.Email,
+			ExpiresIn:         int(domain.OAuthRegistrationTTL.Seconds()),
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusNotFound)
+}
+
+// startSession выставляет cookie сессии и CSRF после успешного входа
+func (app AuthHandler) startSession(w http.ResponseWriter, email, username string, userID uint64, statusCode int) {
+	if err := app.setCookieJWT(w, app.Config, email, username, userID); err != nil {
+		handleAuthError(w, err)
+		return
+	}
+
+	token, err := csrf.GenerateCSRF()
+	if err != nil {
+		handleAuthError(w, err)
+		return
+	}
+
+	app.setCookieCSRF(w, app.Config, token)
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data: domain.CSRFResponse{
+			CSRFToken: token,
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, statusCode)
+}
+
+func (app AuthHandler) oauthRedirectURI(provider string) string {
+	return app.Config.OAuthRedirectURL + "/" + provider
+}
+
+func (app AuthHandler) setOAuthStateCookie(w http.ResponseWriter, value string, ttl time.Duration) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauth.StateCookie,
+		Value:    value,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   app.Config.CookieSecure,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  time.Now().Add(ttl),
+	})
+}
+
+func handleOAuthError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrUnknownProvider):
+		HttpErrorToJson(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrInvalidOAuthState):
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrOAuthNoEmail):
+		HttpErrorToJson(w, err.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, oauth.ErrProviderCall):
+		HttpErrorToJson(w, oauth.ErrProviderCall.Error(), http.StatusBadGateway)
+	default:
+		handleAuthError(w, err)
+	}
+}
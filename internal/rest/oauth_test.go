@@ -0,0 +1,314 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/oauth"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	mock_user "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/auth/grpc"
+	gen "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/auth"
+	tu "github.com/go-park-mail-ru/2025_1_SuperChips/test_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newFakeOIDC поднимает локальный OIDC провайдер, который выдает токен
+// только при верном code_verifier для последнего code_challenge. Пустой email —
+// провайдер не отдал подтвержденную почту
+func newFakeOIDC(t *testing.T, email string) (*httptest.Server, *string) {
+	challenge := new(string)
+	var server *httptest.Server
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"userinfo_endpoint":      server.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if oauth.CodeChallenge(r.PostForm.Get("code_verifier")) != *challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "access"})
+	})
+	mux.HandleFunc("GET /userinfo", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"sub":            "user-1",
+			"email":          email,
+			"email_verified": email != "",
+		})
+	})
+
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, challenge
+}
+
+func newOAuthApp(t *testing.T, client gen.AuthClient, email string) (rest.AuthHandler, *string) {
+	server, challenge := newFakeOIDC(t, email)
+
+	provider, err := oauth.DiscoverOIDCProvider(context.Background(), server.Client(), "fake", server.URL, "client", "secret")
+	require.NoError(t, err)
+
+	cfg := tu.TestConfig
+	cfg.OAuthRedirectURL = "https://yourflow.ru/oauth"
+
+	app := rest.AuthHandler{
+		Config:          cfg,
+		UserService:     client,
+		JWTManager:      *auth.NewJWTManager(cfg),
+		ContextDuration: time.Second,
+		OAuth:           oauth.NewRegistry(provider),
+		OAuthSigner:     oauth.NewSigner(cfg.JWTSecret),
+	}
+
+	return app, challenge
+}
+
+// authorize начинает вход и возвращает cookie со state и сам state
+func authorize(t *testing.T, handler http.HandlerFunc, req *http.Request, challenge *string) (*http.Cookie, string) {
+	req.SetPathValue("provider", "fake")
+	rr := httptest.NewRecorder()
+
+	handler(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var resp struct {
+		Data domain.OAuthAuthorization `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+
+	authURL, err := url.Parse(resp.Data.AuthorizationURL)
+	require.NoError(t, err)
+	assert.Equal(t, "https://yourflow.ru/oauth/fake", authURL.Query().Get("redirect_uri"))
+
+	*challenge = authURL.Query().Get("code_challenge")
+
+	for _, c := range rr.Result().Cookies() {
+		if c.Name == oauth.StateCookie {
+			return c, authURL.Query().Get("state")
+		}
+	}
+
+	t.Fatal("state cookie not set")
+	return nil, ""
+}
+
+func callback(app rest.AuthHandler, cookie *http.Cookie, state string, claims *auth.Claims) *httptest.ResponseRecorder {
+	body := tu.Marshal(domain.OAuthCallback{Code: "code", State: state})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/oauth/fake/callback", strings.NewReader(body))
+	req.SetPathValue("provider", "fake")
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	if claims != nil {
+		req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+	}
+
+	rr := httptest.NewRecorder()
+	app.OAuthCallback(rr, req)
+
+	return rr
+}
+
+func TestOAuthLoginAndRegistration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserService := mock_user.NewMockAuthClient(ctrl)
+	app, challenge := newOAuthApp(t, mockUserService, "user@example.com")
+
+	cookie, state := authorize(t, app.OAuthAuthorize, httptest.NewRequest(http.MethodPost, "/api/v1/auth/oauth/fake/authorize", nil), challenge)
+
+	mockUserService.EXPECT().
+		LoginOAuthUser(gomock.Any(), &gen.LoginOAuthUserRequest{Provider: "fake", Subject: "user-1"}).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+
+	rr := callback(app, cookie, state, nil)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	var resp struct {
+		Data domain.OAuthRegistrationRequired `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "user@example.com", resp.Data.Email)
+
+	mockUserService.EXPECT().
+		AddOAuthUser(gomock.Any(), &gen.AddOAuthUserRequest{
+			Provider: "fake",
+			Subject:  "user-1",
+			Email:    "user@example.com",
+			Username: "newuser",
+		}).
+		Return(&gen.AddExternalUserResponse{ID: 7}, nil)
+
+	body := tu.Marshal(domain.OAuthRegistration{
+		RegistrationToken: resp.Data.RegistrationToken,
+		Username:          "newuser",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/oauth/register", strings.NewReader(body))
+	rr = httptest.NewRecorder()
+
+	app.OAuthRegister(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.True(t, hasAuthCookie(rr))
+}
+
+func TestOAuthCallback_NoVerifiedEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserService := mock_user.NewMockAuthClient(ctrl)
+	app, challenge := newOAuthApp(t, mockUserService, "")
+
+	cookie, state := authorize(t, app.OAuthAuthorize, httptest.NewRequest(http.MethodPost, "/api/v1/auth/oauth/fake/authorize", nil), challenge)
+
+	mockUserService.EXPECT().
+		LoginOAuthUser(gomock.Any(), &gen.LoginOAuthUserRequest{Provider: "fake", Subject: "user-1"}).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+
+	rr := callback(app, cookie, state, nil)
+
+	// неподтвержденную почту не принимаем, токен регистрации не выдается
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	assert.NotContains(t, rr.Body.String(), "registration_token")
+}
+
+func TestOAuthCallback_Login(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserService := mock_user.NewMockAuthClient(ctrl)
+	app, challenge := newOAuthApp(t, mockUserService, "user@example.com")
+
+	cookie, state := authorize(t, app.OAuthAuthorize, httptest.NewRequest(http.MethodPost, "/api/v1/auth/oauth/fake/authorize", nil), challenge)
+
+	mockUserService.EXPECT().
+		LoginOAuthUser(gomock.Any(), &gen.LoginOAuthUserRequest{Provider: "fake", Subject: "user-1"}).
+		Return(&gen.LoginExternalUserResponse{ID: 3, Email: "user@example.com", Username: "user"}, nil)
+
+	rr := callback(app, cookie, state, nil)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.True(t, hasAuthCookie(rr))
+}
+
+func TestOAuthCallback_InvalidState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserService := mock_user.NewMockAuthClient(ctrl)
+	app, challenge := newOAuthApp(t, mockUserService, "user@example.com")
+
+	cookie, state := authorize(t, app.OAuthAuthorize, httptest.NewRequest(http.MethodPost, "/api/v1/auth/oauth/fake/authorize", nil), challenge)
+
+	tests := []struct {
+		name   string
+		cookie *http.Cookie
+		state  string
+	}{
+		{"Сценарий: нет cookie", nil, state},
+		{"Сценарий: подмененный state", cookie, state + "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := callback(app, tt.cookie, tt.state, nil)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.False(t, hasAuthCookie(rr))
+		})
+	}
+}
+
+func TestOAuthLinkIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserService := mock_user.NewMockAuthClient(ctrl)
+	app, challenge := newOAuthApp(t, mockUserService, "user@example.com")
+
+	claims := &auth.Claims{UserID: 5}
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/profile/identities/fake", nil)
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	cookie, state := authorize(t, app.LinkIdentity, req, challenge)
+
+	t.Run("Сценарий: завершает другой пользователь", func(t *testing.T) {
+		rr := callback(app, cookie, state, &auth.Claims{UserID: 6})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Сценарий: привязка", func(t *testing.T) {
+		mockUserService.EXPECT().
+			LinkIdentity(gomock.Any(), &gen.LinkIdentityRequest{
+				ID:       5,
+				Provider: "fake",
+				Subject:  "user-1",
+				Email:    "user@example.com",
+			}).
+			Return(&gen.LinkIdentityResponse{Linked: true}, nil)
+
+		rr := callback(app, cookie, state, claims)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestUnlinkIdentity(t *testing.T) {
+	tests := []struct {
+		name      string
+		grpcErr   error
+		expStatus int
+	}{
+		{"Сценарий: успешно", nil, http.StatusOK},
+		{"Сценарий: последний способ входа", status.Error(codes.FailedPrecondition, domain.ErrLastLoginMethod.Error()), http.StatusConflict},
+		{"Сценарий: не привязан", status.Error(codes.NotFound, domain.ErrIdentityNotFound.Error()), http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserService := mock_user.NewMockAuthClient(ctrl)
+			mockUserService.EXPECT().
+				UnlinkIdentity(gomock.Any(), &gen.UnlinkIdentityRequest{ID: 5, Provider: "vk"}).
+				Return(&gen.UnlinkIdentityResponse{Unlinked: tt.grpcErr == nil}, tt.grpcErr)
+
+			app := rest.AuthHandler{
+				UserService:     mockUserService,
+				ContextDuration: time.Second,
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/profile/identities/vk", nil)
+			req.SetPathValue("provider", "vk")
+			req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 5}))
+			rr := httptest.NewRecorder()
+
+			app.UnlinkIdentity(rr, req)
+
+			assert.Equal(t, tt.expStatus, rr.Code)
+		})
+	}
+}
@@ -1,15 +1,15 @@
 package rest
 
 import (
-	"bytes"
-	"encoding/json"
 	"errors"
 	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
@@ -26,24 +26,90 @@ var allowedTypes = map[string]bool{
 	"image/gif": true,
 }
 
+var allowedVideoTypes = map[string]bool{
+	"video/mp4":  true,
+	"video/webm": true,
+}
+
 const (
-	maxPinSize = 1024 * 1024 * 10 // 10 mb
+	maxPinSize   = 1024 * 1024 * 10 // 10 mb
+	maxVideoSize = 1024 * 1024 * 50 // 50 mb
 )
 
+type uploadError struct {
+	message string
+	status  int
+}
+
+// openUpload открывает файл из формы и сверяет заявленный тип с содержимым
+func openUpload(fileHeader *multipart.FileHeader) (pincrud.MediaUpload, *uploadError) {
+	contentType := fileHeader.Header.Get("Content-Type")
+	isVideo := allowedVideoTypes[contentType]
+	if !isVideo && !allowedTypes[contentType] {
+		return pincrud.MediaUpload{}, &uploadError{"media type is not allowed", http.StatusBadRequest}
+	}
+
+	if (isVideo && fileHeader.Size > maxVideoSize) || (!isVideo && fileHeader.Size > maxPinSize) {
+		return pincrud.MediaUpload{}, &uploadError{"media file is too big", http.StatusRequestEntityTooLarge}
+	}
+
+	if filepath.Ext(filepath.Base(fileHeader.Filename)) == "" {
+		return pincrud.MediaUpload{}, &uploadError{"invalid file extension", http.StatusBadRequest}
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return pincrud.MediaUpload{}, &uploadError{http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError}
+	}
+
+	buffer := make([]byte, 512)
+	n, err := file.Read(buffer)
+	if err != nil && !errors.Is(err, io.EOF) {
+		file.Close()
+		return pincrud.MediaUpload{}, &uploadError{http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError}
+	}
+
+	detected := http.DetectContentType(buffer[:n])
+	if !strings.HasPrefix(detected, strings.Split(contentType, ";")[0]) {
+		file.Close()
+		return pincrud.MediaUpload{}, &uploadError{"media extension and type are mismatched", http.StatusBadRequest}
+	}
+
+	if _, err := file.Seek(0, 0); err != nil {
+		file.Close()
+		return pincrud.MediaUpload{}, &uploadError{http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError}
+	}
+
+	return pincrud.MediaUpload{
+		File:        file,
+		Header:      fileHeader,
+		ContentType: contentType,
+	}, nil
+}
+
 // CreateHandler godoc
 //	@Summary		Create pin if user if user is authorized
-//	@Description	Returns JSON with result description
+//	@Description	Returns JSON with result description. Accepts either a single image or up to 10 ordered media files (images, MP4 or WebM videos up to 60 seconds)
 //	@Produce		json
-//	@Param			image		formData	file						true	"pin image"
+//	@Param			image		formData	file						false	"pin image"
+//	@Param			media		formData	file						false	"ordered carousel items, repeatable"
 //	@Param			header		formData	string						false	"text header"
 //	@Param			description	formData	string						false	"text description"
 //	@Param			is_private	formData	bool						false	"privacy setting"
+//	@Param			publish_at	formData	string						false	"scheduled publication time in RFC 3339"
+//	@Param			is_draft	formData	bool						false	"save as draft without publishing"
+//	@Param			link		formData	string						false	"source page url"
 //	@Success		201			string		serverResponse.Data			"OK"
 //	@Failure		400			string		serverResponse.Description	"failed to parse the request body"
-//	@Failure		400			string		serverResponse.Description	"image not present in the request body"
+//	@Failure		400			string		serverResponse.Description	"media not present in the request body"
 //	@Failure		400			string		serverResponse.Description	"failed to parse the form-data field [is_private]"
 //	@Failure		400			string		serverResponse.Description	"invalid image extension"
+//	@Failure		400			string		serverResponse.Description	"publish time must be in the future and not later than a year"
+//	@Failure		400			string		serverResponse.Description	"invalid video file"
+//	@Failure		400			string		serverResponse.Description	"video is too long"
+//	@Failure		400			string		serverResponse.Description	"link must be an absolute http or https url"
 //	@Failure		401			string		serverResponse.Description	"user is not authorized"
+//	@Failure		413			string		serverResponse.Description	"media file is too big"
 //	@Failure		500			string		serverResponse.Description	"untracked error: ${error}"
 //	@Router			/api/v1/flows [post]
 func (app PinCRUDHandler) CreateHandler(w http.ResponseWriter, r *http.Request) {
@@ -60,50 +126,36 @@ func (app PinCRUDHandler) CreateHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	file, handler, err := r.FormFile("image")
-	if err != nil {
-		rest.HttpErrorToJson(w, "image not present in the request body", http.StatusBadRequest)
-		return
+	// поле image осталось от флоу с одной картинкой
+	fileHeaders := r.MultipartForm.File["media"]
+	if len(fileHeaders) == 0 {
+		fileHeaders = r.MultipartForm.File["image"]
 	}
-	defer file.Close()
-
-	if handler.Size > maxPinSize {
-		rest.HttpErrorToJson(w, "image is too big", http.StatusRequestEntityTooLarge)
+	if len(fileHeaders) == 0 {
+		rest.HttpErrorToJson(w, "media not present in the request body", http.StatusBadRequest)
 		return
 	}
-
-	buffer := make([]byte, 512)
-	_, err = file.Read(buffer)
-	if err != nil {
-		rest.HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	if len(fileHeaders) > domain.MaxFlowMedia {
+		rest.HttpErrorToJson(w, pincrud.ErrInvalidMediaCount.Error(), http.StatusBadRequest)
 		return
 	}
 
-	detected := http.DetectContentType(buffer)
-	contentType := handler.Header.Get("Content-Type")
-
-	if !strings.HasPrefix(detected, strings.Split(contentType, ";")[0]) {
-		rest.HttpErrorToJson(w, "image extension and type are mismatched", http.StatusBadRequest)
-		return
-	}
-
-	if _, err := file.Seek(0, 0); err != nil {
-		rest.HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-		return
-	}
+	uploads := make([]pincrud.MediaUpload, 0, len(fileHeaders))
+	defer func() {
+		for _, upload := range uploads {
+			upload.File.Close()
+		}
+	}()
 
-	if _, ok := allowedTypes[contentType]; !ok {
-		rest.HttpErrorToJson(w, "image type is not allowed", http.StatusBadRequest)
-		return
+	for _, fileHeader := range fileHeaders {
+		upload, uploadErr := openUpload(fileHeader)
+		if uploadErr != nil {
+			rest.HttpErrorToJson(w, uploadErr.message, uploadErr.status)
+			return
+		}
+		uploads = append(uploads, upload)
 	}
 
-	filename := filepath.Base(handler.Filename)
-    ext := filepath.Ext(filename)
-    if ext == "" {
-        rest.HttpErrorToJson(w, "invalid file extension", http.StatusBadRequest)
-        return
-    }
-
 	data := domain.PinDataCreate{
 		Header:      "",
 		Description: "",
@@ -124,23 +176,50 @@ func (app PinCRUDHandler) CreateHandler(w http.ResponseWriter, r *http.Request)
 		}
 		data.IsPrivate = boolValue
 	}
+	if r.PostFormValue("is_draft") != "" {
+		boolValue, err := strconv.ParseBool(r.PostFormValue("is_draft"))
+		if err != nil {
+			rest.HttpErrorToJson(w, "failed to parse the form-data field [is_draft]", http.StatusBadRequest)
+			return
+		}
+		data.IsDraft = boolValue
+	}
+	if r.PostFormValue("link") != "" {
+		data.Link = r.PostFormValue("link")
+	}
+	if r.PostFormValue("publish_at") != "" {
+		publishAt, err := time.Parse(time.RFC3339, r.PostFormValue("publish_at"))
+		if err != nil {
+			rest.HttpErrorToJson(w, "failed to parse the form-data field [publish_at]", http.StatusBadRequest)
+			return
+		}
+		data.PublishAt = &publishAt
+	}
 
-	pinID, name, err := app.PinService.CreatePin(r.Context(), data, file, handler, contentType, userID)
+	pinID, _, err := app.PinService.CreatePin(r.Context(), data, uploads, userID)
 	if errors.Is(err, pincrud.ErrInvalidImageExt) {
 		rest.HttpErrorToJson(w, "invalid image extension", http.StatusBadRequest)
 		return
 	}
+	if errors.Is(err, pincrud.ErrInvalidPublishAt) || errors.Is(err, pincrud.ErrInvalidLink) {
+		rest.HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, pincrud.ErrInvalidMediaCount) || errors.Is(err, pincrud.ErrVideoUnsupported) ||
+		errors.Is(err, pincrud.ErrVideoTooLong) {
+		rest.HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, pincrud.ErrInvalidVideo) {
+		rest.HttpErrorToJson(w, pincrud.ErrInvalidVideo.Error(), http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		log.Printf("create flow err: %v", err)
 		rest.HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
 
-	if err := sendRequestToCV(name); err != nil {
-		log.Printf("sending request to cv error: %v", err)
-		// no return
-	}
-
 	type DataReturn struct {
 		FlowID uint64 `json:"flow_id"`
 	}
@@ -151,42 +230,3 @@ func (app PinCRUDHandler) CreateHandler(w http.ResponseWriter, r *http.Request)
 	}
 	rest.ServerGenerateJSONResponse(w, response, http.StatusCreated)
 }
-
-func sendRequestToCV(filename string) error {
-	type Filename struct {
-		Filename string `json:"filename"`
-	}
-
-	pinFilename := Filename{
-		Filename: filename,
-	}
-
-	filenameBytes, err := json.Marshal(pinFilename)
-	if err != nil {
-		log.Printf("Error making request to cv: %v", err)
-		return err
-	} 
-
-	req, err := http.NewRequest("POST", "http://cv:8050/classify", bytes.NewBuffer(filenameBytes))
-	if err != nil {
-		log.Printf("Error creating request: %v", err)
-		return err
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		log.Printf("Error sending request: %v", err)
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusAccepted {
-		body, _ := io.ReadAll(resp.Body)
-		log.Printf("Unexpected response status: %d, body: %s", resp.StatusCode, string(body))
-	}
-
-	return nil
-}
\ No newline at end of file
@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+// GetDraftsHandler godoc
+//	@Summary		Get current user's drafts
+//	@Description	Returns unpublished flows, newest first
+//	@Produce		json
+//	@Param			page	query	int							true	"page number"
+//	@Param			size	query	int							true	"page size (1-30)"
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"invalid query parameters"
+//	@Failure		401		string	serverResponse.Description	"user is not authorized"
+//	@Failure		500		string	serverResponse.Description	"untracked error: ${error}"
+//	@Router			/api/v1/flows/drafts [get]
+func (app PinCRUDHandler) GetDraftsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok {
+		rest.HttpErrorToJson(w, "user is not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	page, pageSize, ok := parsePage(w, r)
+	if !ok {
+		return
+	}
+
+	pins, err := app.PinService.GetDrafts(r.Context(), uint64(claims.UserID), page, pageSize)
+	if err != nil {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	response := rest.ServerResponse{
+		Description: "OK",
+		Data:        pins,
+	}
+	rest.ServerGenerateJSONResponse(w, response, http.StatusOK)
+}
+
+// PublishDraftHandler godoc
+//	@Summary		Publish draft
+//	@Description	Makes the draft public and moves it to the top of the feed
+//	@Produce		json
+//	@Param			flow_id	path	int							true	"flow ID"
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"invalid path parameter [flow_id]"
+//	@Failure		401		string	serverResponse.Description	"user is not authorized"
+//	@Failure		403		string	serverResponse.Description	"access to private pin is forbidden"
+//	@Failure		404		string	serverResponse.Description	"no pin with given id"
+//	@Failure		409		string	serverResponse.Description	"flow is not a draft"
+//	@Failure		500		string	serverResponse.Description	"untracked error: ${error}"
+//	@Router			/api/v1/flows/{flow_id}/publish [post]
+func (app PinCRUDHandler) PublishDraftHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok {
+		rest.HttpErrorToJson(w, "user is not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	pinID, err := parsePinID(r.PathValue("flow_id"))
+	if err != nil {
+		rest.HttpErrorToJson(w, "invalid path parameter [flow_id]", http.StatusBadRequest)
+		return
+	}
+
+	err = app.PinService.PublishDraft(r.Context(), pinID, uint64(claims.UserID))
+	if err != nil {
+		handleFlowError(w, err)
+		return
+	}
+
+	response := rest.ServerResponse{
+		Description: "OK",
+	}
+	rest.ServerGenerateJSONResponse(w, response, http.StatusOK)
+}
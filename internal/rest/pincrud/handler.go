@@ -1,8 +1,12 @@
 package rest
 
-import "github.com/go-park-mail-ru/2025_1_SuperChips/configs"
+import (
+	"github.com/go-park-mail-ru/2025_1_SuperChips/configs"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+)
 
 type PinCRUDHandler struct {
 	Config     configs.Config
 	PinService PinCRUDServicer
+	Events     rest.EventRecorder
 }
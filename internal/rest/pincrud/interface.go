@@ -2,9 +2,10 @@ package rest
 
 import (
 	"context"
-	"mime/multipart"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
 )
 
 type PinCRUDServicer interface {
@@ -12,5 +13,13 @@ type PinCRUDServicer interface {
 	GetAnyPin(ctx context.Context, pinID uint64, userID uint64) (domain.PinData, error)
 	DeletePin(ctx context.Context, pinID uint64, userID uint64) error
 	UpdatePin(ctx context.Context, data domain.PinDataUpdate, userID uint64) error
-	CreatePin(ctx context.Context, data domain.PinDataCreate, file multipart.File, header *multipart.FileHeader, extension string, userID uint64) (uint64, string, error)
+	CreatePin(ctx context.Context, data domain.PinDataCreate, uploads []pincrud.MediaUpload, userID uint64) (uint64, string, error)
+	CreatePinFromURL(ctx context.Context, req domain.PinFromURL, userID uint64) (uint64, error)
+	GetScheduledPins(ctx context.Context, userID uint64, page, pageSize int) ([]domain.PinData, error)
+	ReschedulePin(ctx context.Context, pinID, userID uint64, publishAt time.Time) error
+	CancelSchedule(ctx context.Context, pinID, userID uint64) error
+	GetDrafts(ctx context.Context, userID uint64, page, pageSize int) ([]domain.PinData, error)
+	PublishDraft(ctx context.Context, pinID, userID uint64) error
+	GetRevisions(ctx context.Context, pinID, userID uint64) ([]domain.FlowRevision, error)
+	RevertRevision(ctx context.Context, pinID uint64, revisionID int, userID uint64) error
 }
@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+// CreateFromURLHandler godoc
+//	@Summary		Create flow from a web page
+//	@Description	Fetches the page, takes its OpenGraph title, description and image and creates a flow linked to the page
+//	@Accept			json
+//	@Produce		json
+//	@Param			url			body	string						true	"page url"
+//	@Param			header		body	string						false	"overrides the page title"
+//	@Param			description	body	string						false	"overrides the page description"
+//	@Param			is_private	body	bool						false	"privacy setting"
+//	@Success		201			string	serverResponse.Data			"OK"
+//	@Failure		400			string	serverResponse.Description	"link must be an absolute http or https url"
+//	@Failure		401			string	serverResponse.Description	"user is not authorized"
+//	@Failure		422			string	serverResponse.Description	"page has no usable preview image"
+//	@Failure		502			string	serverResponse.Description	"failed to fetch the link"
+//	@Failure		500			string	serverResponse.Description	"untracked error: ${error}"
+//	@Router			/api/v1/flows/from-url [post]
+func (app PinCRUDHandler) CreateFromURLHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok {
+		rest.HttpErrorToJson(w, "user is not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	var data domain.PinFromURL
+	if err := rest.DecodeData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	pinID, err := app.PinService.CreatePinFromURL(r.Context(), data, uint64(claims.UserID))
+	if err != nil {
+		handleFlowError(w, err)
+		return
+	}
+
+	type DataReturn struct {
+		FlowID uint64 `json:"flow_id"`
+	}
+
+	response := rest.ServerResponse{
+		Description: "OK",
+		Data:        DataReturn{FlowID: pinID},
+	}
+	rest.ServerGenerateJSONResponse(w, response, http.StatusCreated)
+}
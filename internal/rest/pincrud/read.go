@@ -51,6 +51,17 @@ func (app PinCRUDHandler) ReadHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var userID uint64
+	if isAuthorized {
+		userID = uint64(claims.UserID)
+	}
+
+	// NSFW пин недоступен анонимам и несовершеннолетним, в том числе ботам для превью ссылок
+	if !data.ApplyNSFWMode(domain.NSFWModeFromContext(r.Context()), userID) {
+		rest.HttpErrorToJson(w, domain.ErrNSFWAgeRestricted.Error(), http.StatusForbidden)
+		return
+	}
+
 	userAgent := r.Header.Get("User-Agent")
 	botUserAgents := []string{
 		"facebookexternalhit",
@@ -88,6 +99,13 @@ func (app PinCRUDHandler) ReadHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// превью ссылок ботами не считается просмотром
+	rest.RecordEvent(app.Events, domain.AnalyticsEvent{
+		Type:     domain.EventCloseup,
+		FlowID:   data.FlowID,
+		ViewerID: int(userID),
+	})
+
 	response := rest.ServerResponse{
 		Description: "OK",
 		Data:        data,
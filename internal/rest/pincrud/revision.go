@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+// GetRevisionsHandler godoc
+//	@Summary		Get flow edit history
+//	@Description	Returns last 50 revisions, newest first. Each revision contains text before the edit and word diffs of header and description
+//	@Produce		json
+//	@Param			flow_id	path	int							true	"flow ID"
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"invalid path parameter [flow_id]"
+//	@Failure		401		string	serverResponse.Description	"user is not authorized"
+//	@Failure		403		string	serverResponse.Description	"access to private pin is forbidden"
+//	@Failure		404		string	serverResponse.Description	"no pin with given id"
+//	@Failure		500		string	serverResponse.Description	"untracked error: ${error}"
+//	@Router			/api/v1/flows/{flow_id}/revisions [get]
+func (app PinCRUDHandler) GetRevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok {
+		rest.HttpErrorToJson(w, "user is not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	pinID, err := parsePinID(r.PathValue("flow_id"))
+	if err != nil {
+		rest.HttpErrorToJson(w, "invalid path parameter [flow_id]", http.StatusBadRequest)
+		return
+	}
+
+	revisions, err := app.PinService.GetRevisions(r.Context(), pinID, uint64(claims.UserID))
+	if err != nil {
+		handleFlowError(w, err)
+		return
+	}
+
+	response := rest.ServerResponse{
+		Description: "OK",
+		Data:        revisions,
+	}
+	rest.ServerGenerateJSONResponse(w, response, http.StatusOK)
+}
+
+// RevertRevisionHandler godoc
+//	@Summary		Revert flow text to revision
+//	@Description	Restores header and description. Current text is saved as a new revision
+//	@Produce		json
+//	@Param			flow_id		path	int							true	"flow ID"
+//	@Param			revision_id	path	int							true	"revision ID"
+//	@Success		200			string	serverResponse.Data			"OK"
+//	@Failure		400			string	serverResponse.Description	"invalid path parameter"
+//	@Failure		401			string	serverResponse.Description	"user is not authorized"
+//	@Failure		403			string	serverResponse.Description	"access to private pin is forbidden"
+//	@Failure		404			string	serverResponse.Description	"no revision with given id"
+//	@Failure		500			string	serverResponse.Description	"untracked error: ${error}"
+//	@Router			/api/v1/flows/{flow_id}/revisions/{revision_id}/revert [post]
+func (app PinCRUDHandler) RevertRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok {
+		rest.HttpErrorToJson(w, "user is not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	pinID, err := parsePinID(r.PathValue("flow_id"))
+	if err != nil {
+		rest.HttpErrorToJson(w, "invalid path parameter [flow_id]", http.StatusBadRequest)
+		return
+	}
+
+	revisionID, err := strconv.Atoi(r.PathValue("revision_id"))
+	if err != nil || revisionID <= 0 {
+		rest.HttpErrorToJson(w, "invalid path parameter [revision_id]", http.StatusBadRequest)
+		return
+	}
+
+	err = app.PinService.RevertRevision(r.Context(), pinID, revisionID, uint64(claims.UserID))
+	if err != nil {
+		handleFlowError(w, err)
+		return
+	}
+
+	response := rest.ServerResponse{
+		Description: "OK",
+	}
+	rest.ServerGenerateJSONResponse(w, response, http.StatusOK)
+}
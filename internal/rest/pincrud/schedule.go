@@ -0,0 +1,127 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+// GetScheduledHandler godoc
+//	@Summary		Get current user's scheduled flows
+//	@Description	Returns flows waiting for publication, nearest first
+//	@Produce		json
+//	@Param			page	query	int							true	"page number"
+//	@Param			size	query	int							true	"page size (1-30)"
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"invalid query parameters"
+//	@Failure		401		string	serverResponse.Description	"user is not authorized"
+//	@Failure		500		string	serverResponse.Description	"untracked error: ${error}"
+//	@Router			/api/v1/flows/scheduled [get]
+func (app PinCRUDHandler) GetScheduledHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok {
+		rest.HttpErrorToJson(w, "user is not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	page, pageSize, ok := parsePage(w, r)
+	if !ok {
+		return
+	}
+
+	pins, err := app.PinService.GetScheduledPins(r.Context(), uint64(claims.UserID), page, pageSize)
+	if err != nil {
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	response := rest.ServerResponse{
+		Description: "OK",
+		Data:        pins,
+	}
+	rest.ServerGenerateJSONResponse(w, response, http.StatusOK)
+}
+
+// RescheduleHandler godoc
+//	@Summary		Change publication time of scheduled flow
+//	@Description	Returns JSON with result description
+//	@Accept			json
+//	@Produce		json
+//	@Param			flow_id		path	int							true	"flow ID"
+//	@Param			publish_at	body	string						true	"new publication time in RFC 3339"
+//	@Success		200			string	serverResponse.Data			"OK"
+//	@Failure		400			string	serverResponse.Description	"publish time must be in the future and not later than a year"
+//	@Failure		401			string	serverResponse.Description	"user is not authorized"
+//	@Failure		403			string	serverResponse.Description	"access to private pin is forbidden"
+//	@Failure		404			string	serverResponse.Description	"no pin with given id"
+//	@Failure		409			string	serverResponse.Description	"flow is not scheduled"
+//	@Failure		500			string	serverResponse.Description	"untracked error: ${error}"
+//	@Router			/api/v1/flows/{flow_id}/schedule [put]
+func (app PinCRUDHandler) RescheduleHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok {
+		rest.HttpErrorToJson(w, "user is not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	pinID, err := parsePinID(r.PathValue("flow_id"))
+	if err != nil {
+		rest.HttpErrorToJson(w, "invalid path parameter [flow_id]", http.StatusBadRequest)
+		return
+	}
+
+	var data domain.PinSchedule
+	if err := rest.DecodeData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	err = app.PinService.ReschedulePin(r.Context(), pinID, uint64(claims.UserID), data.PublishAt)
+	if err != nil {
+		handleFlowError(w, err)
+		return
+	}
+
+	response := rest.ServerResponse{
+		Description: "OK",
+	}
+	rest.ServerGenerateJSONResponse(w, response, http.StatusOK)
+}
+
+// CancelScheduleHandler godoc
+//	@Summary		Cancel scheduled publication
+//	@Description	Flow stays private and can be published manually
+//	@Produce		json
+//	@Param			flow_id	path	int							true	"flow ID"
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		401		string	serverResponse.Description	"user is not authorized"
+//	@Failure		403		string	serverResponse.Description	"access to private pin is forbidden"
+//	@Failure		404		string	serverResponse.Description	"no pin with given id"
+//	@Failure		409		string	serverResponse.Description	"flow is not scheduled"
+//	@Failure		500		string	serverResponse.Description	"untracked error: ${error}"
+//	@Router			/api/v1/flows/{flow_id}/schedule [delete]
+func (app PinCRUDHandler) CancelScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok {
+		rest.HttpErrorToJson(w, "user is not authorized", http.StatusUnauthorized)
+		return
+	}
+
+	pinID, err := parsePinID(r.PathValue("flow_id"))
+	if err != nil {
+		rest.HttpErrorToJson(w, "invalid path parameter [flow_id]", http.StatusBadRequest)
+		return
+	}
+
+	err = app.PinService.CancelSchedule(r.Context(), pinID, uint64(claims.UserID))
+	if err != nil {
+		handleFlowError(w, err)
+		return
+	}
+
+	response := rest.ServerResponse{
+		Description: "OK",
+	}
+	rest.ServerGenerateJSONResponse(w, response, http.StatusOK)
+}
@@ -18,12 +18,17 @@ import (
 //	@Param			header		body	string						false	"text header"
 //	@Param			description	body	string						false	"text description"
 //	@Param			is_private	body	bool						false	"privacy setting"
+//	@Param			comments_enabled	body	bool				false	"whether comments are allowed"
+//	@Param			comments_followers_only	body	bool			false	"allow comments only from author's followers"
+//	@Param			link		body	string						false	"source page url, empty string removes it"
 //	@Success		200			string	serverResponse.Data			"OK"
 //	@Failure		400			string	serverResponse.Description	"required field is missing [flow_id]"
 //	@Failure		401			string	serverResponse.Description	"user is not authorized"
 //	@Failure		400			string	serverResponse.Description	"no fields to update"
+//	@Failure		400			string	serverResponse.Description	"link must be an absolute http or https url"
 //	@Failure		403			string	serverResponse.Description	"access to private pin is forbidden"
 //	@Failure		404			string	serverResponse.Description	"no pin with given id"
+//	@Failure		409			string	serverResponse.Description	"draft must be published before making it public"
 //	@Failure		500			string	serverResponse.Description	"untracked error: ${error}"
 //	@Router			/api/v1/flows [put]
 func (app PinCRUDHandler) UpdateHandler(w http.ResponseWriter, r *http.Request) {
@@ -52,6 +57,14 @@ func (app PinCRUDHandler) UpdateHandler(w http.ResponseWriter, r *http.Request)
 		rest.HttpErrorToJson(w, "no pin with given id", http.StatusNotFound)
 		return
 	}
+	if errors.Is(err, pincrud.ErrDraftPrivacy) {
+		rest.HttpErrorToJson(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if errors.Is(err, pincrud.ErrInvalidLink) {
+		rest.HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		rest.HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
@@ -1,7 +1,55 @@
 package rest
 
-import "strconv"
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/pincrud"
+)
 
 func parsePinID(idStr string) (uint64, error) {
 	return strconv.ParseUint(idStr, 10, 64)
 }
+
+// parsePage читает page и size из запроса и сам отвечает ошибкой
+func parsePage(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		rest.HttpErrorToJson(w, "invalid query parameter [page]", http.StatusBadRequest)
+		return 0, 0, false
+	}
+
+	pageSize, err := strconv.Atoi(r.URL.Query().Get("size"))
+	if err != nil || pageSize < 1 || pageSize > 30 {
+		rest.HttpErrorToJson(w, "invalid query parameter [size]", http.StatusBadRequest)
+		return 0, 0, false
+	}
+
+	return page, pageSize, true
+}
+
+func handleFlowError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, pincrud.ErrInvalidPublishAt),
+		errors.Is(err, pincrud.ErrInvalidLink):
+		rest.HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, pincrud.ErrNoPreviewImage):
+		rest.HttpErrorToJson(w, pincrud.ErrNoPreviewImage.Error(), http.StatusUnprocessableEntity)
+	case errors.Is(err, pincrud.ErrLinkFetch):
+		rest.HttpErrorToJson(w, pincrud.ErrLinkFetch.Error(), http.StatusBadGateway)
+	case errors.Is(err, pincrud.ErrForbidden):
+		rest.HttpErrorToJson(w, "access to private pin is forbidden", http.StatusForbidden)
+	case errors.Is(err, pincrud.ErrPinNotFound):
+		rest.HttpErrorToJson(w, "no pin with given id", http.StatusNotFound)
+	case errors.Is(err, pincrud.ErrRevisionNotFound):
+		rest.HttpErrorToJson(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, pincrud.ErrNotScheduled),
+		errors.Is(err, pincrud.ErrNotDraft),
+		errors.Is(err, pincrud.ErrDraftPrivacy):
+		rest.HttpErrorToJson(w, err.Error(), http.StatusConflict)
+	default:
+		rest.HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
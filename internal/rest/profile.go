@@ -1,10 +1,12 @@
 package rest
 
 import (
+	"context"
 	"errors"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"path/filepath"
 	"strings"
 	"time"
@@ -26,7 +28,6 @@ var allowedTypes = map[string]bool{
 	"image/gif":  true,
 }
 
-
 //easyjson:json
 type passwordChange struct {
 	OldPassword string `json:"old_password"`
@@ -48,16 +49,24 @@ type ProfileService interface {
 	SaveUserAvatar(email string, avatar string) error
 	UpdateUserData(user domain.User, oldEmail string) error
 	ChangeUserPassword(email, oldPassword, newPassword string) (int, error)
+	GetNSFWSettings(ctx context.Context, userID int) (domain.NSFWSettings, error)
+	UpdateNSFWSettings(ctx context.Context, userID int, showNSFW bool) (domain.NSFWSettings, error)
+	ChangeUsername(ctx context.Context, user domain.User) error
+	GetRenamedUsername(ctx context.Context, oldUsername string) (string, error)
+	RestrictPrivateProfile(ctx context.Context, user domain.User, viewerID int) (domain.User, error)
+	GetAccountPrivacy(ctx context.Context, userID int) (domain.AccountPrivacy, error)
+	UpdateAccountPrivacy(ctx context.Context, userID int, isPrivate bool) (domain.AccountPrivacy, error)
 }
 
 type ProfileHandler struct {
-	ProfileService ProfileService
-	JwtManager     auth.JWTManager
-	AvatarFolder   string        // где будут хранится аватары относительно staticFolder
-	StaticFolder   string        // где будут хранится статические файлы
-	BaseUrl        string        // url для получения аватара
-	ExpirationTime time.Duration // время жизни куки
-	CookieSecure   bool          // флаг, что куки должны быть только по https
+	ProfileService    ProfileService
+	JwtManager        auth.JWTManager
+	AvatarFolder      string        // где будут хранится аватары относительно staticFolder
+	StaticFolder      string        // где будут хранится статические файлы
+	BaseUrl           string        // url для получения аватара
+	ExpirationTime    time.Duration // время жизни куки
+	CookieSecure      bool          // флаг, что куки должны быть только по https
+	ContextExpiration time.Duration
 }
 
 func (h *ProfileHandler) CurrentUserProfileHandler(w http.ResponseWriter, r *http.Request) {
@@ -90,11 +99,27 @@ func (h *ProfileHandler) PublicProfileHandler(w http.ResponseWriter, r *http.Req
 	}
 
 	user, err := h.ProfileService.GetUserPublicInfoByUsername(username)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		h.redirectRenamedUser(w, r, username)
+		return
+	}
 	if err != nil {
 		handleProfileError(w, err)
 		return
 	}
 
+	// закрытый аккаунт показывает подробности только подписчикам
+	if user.IsPrivateAccount {
+		ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+		defer cancel()
+
+		user, err = h.ProfileService.RestrictPrivateProfile(ctx, user, int(viewerID(r)))
+		if err != nil {
+			handleProfileError(w, err)
+			return
+		}
+	}
+
 	user.Escape()
 
 	response := ServerResponse{
@@ -104,6 +129,22 @@ func (h *ProfileHandler) PublicProfileHandler(w http.ResponseWriter, r *http.Req
 	ServerGenerateJSONResponse(w, response, http.StatusOK)
 }
 
+// redirectRenamedUser перенаправляет с прежнего имени пользователя на текущее,
+// пока прежнее имя зарезервировано
+func (h *ProfileHandler) redirectRenamedUser(w http.ResponseWriter, r *http.Request, oldUsername string) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	username, err := h.ProfileService.GetRenamedUsername(ctx, oldUsername)
+	if err != nil {
+		handleProfileError(w, domain.ErrUserNotFound)
+		return
+	}
+
+	// резерв временный, поэтому редирект не постоянный
+	http.Redirect(w, r, "/api/v1/users/"+url.PathEscape(username), http.StatusFound)
+}
+
 func (h *ProfileHandler) UserAvatarHandler(w http.ResponseWriter, r *http.Request) {
 	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
 	if !ok || claims == nil {
@@ -242,6 +283,7 @@ func (h *ProfileHandler) PatchUserProfileHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	oldUsername := existingUser.Username
 	if updateReq.Username != nil {
 		existingUser.Username = *updateReq.Username
 	}
@@ -263,17 +305,28 @@ func (h *ProfileHandler) PatchUserProfileHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
-	if err := h.ProfileService.UpdateUserData(existingUser, claims.Email); err != nil {
+	// смена имени переносит чаты, контакты и сообщения, остальные поля
+	// сохраняются в той же транзакции
+	usernameChanged := existingUser.Username != oldUsername
+	if usernameChanged {
+		ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+		defer cancel()
+
+		if err := h.ProfileService.ChangeUsername(ctx, existingUser); err != nil {
+			handleProfileError(w, err)
+			return
+		}
+	} else if err := h.ProfileService.UpdateUserData(existingUser, claims.Email); err != nil {
 		handleProfileError(w, err)
 		return
 	}
 
-	if updateReq.Email != nil && *updateReq.Email != claims.Email {
+	if (updateReq.Email != nil && *updateReq.Email != claims.Email) || usernameChanged {
 		conf := configs.Config{
 			ExpirationTime: h.ExpirationTime,
 			CookieSecure:   h.CookieSecure,
 		}
-		if err := updateAuthToken(w, h.JwtManager, conf, existingUser.Email, claims.Username, int(existingUser.ID)); err != nil {
+		if err := updateAuthToken(w, h.JwtManager, conf, existingUser.Email, existingUser.Username, int(existingUser.ID)); err != nil {
 			handleProfileError(w, err)
 			return
 		}
@@ -282,6 +335,153 @@ func (h *ProfileHandler) PatchUserProfileHandler(w http.ResponseWriter, r *http.
 	ServerGenerateJSONResponse(w, ServerResponse{Description: "OK"}, http.StatusOK)
 }
 
+// GetNSFWSettingsHandler godoc
+//
+//	@Summary		Get sensitive content settings
+//	@Description	Returns whether the current user has enabled sensitive content and the resulting display mode
+//	@Tags			profile
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Success		200	{object}	ServerResponse{data=domain.NSFWSettings}	"Settings"
+//	@Failure		401	{object}	ServerResponse								"Unauthorized"
+//	@Failure		500	{object}	ServerResponse								"Internal server error"
+//	@Router			/api/v1/profile/nsfw [get]
+func (h *ProfileHandler) GetNSFWSettingsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok || claims == nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	settings, err := h.ProfileService.GetNSFWSettings(ctx, claims.UserID)
+	if err != nil {
+		handleProfileError(w, err)
+		return
+	}
+
+	ServerGenerateJSONResponse(w, ServerResponse{Description: "OK", Data: settings}, http.StatusOK)
+}
+
+// UpdateNSFWSettingsHandler godoc
+//
+//	@Summary		Update sensitive content settings
+//	@Description	Enables or disables showing sensitive content. Only users aged 18 or older (by birthday) can enable it
+//	@Tags			profile
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			settings	body		domain.NSFWSettingsUpdate					true	"New settings"
+//	@Success		200			{object}	ServerResponse{data=domain.NSFWSettings}	"Updated settings"
+//	@Failure		400			{object}	ServerResponse								"Invalid request"
+//	@Failure		401			{object}	ServerResponse								"Unauthorized"
+//	@Failure		403			{object}	ServerResponse								"User is under age or has no birthday"
+//	@Failure		500			{object}	ServerResponse								"Internal server error"
+//	@Router			/api/v1/profile/nsfw [put]
+func (h *ProfileHandler) UpdateNSFWSettingsHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok || claims == nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var update domain.NSFWSettingsUpdate
+	if err := DecodeData(w, r.Body, &update); err != nil {
+		return
+	}
+
+	if update.ShowNSFW == nil {
+		HttpErrorToJson(w, "field [show_nsfw] is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	settings, err := h.ProfileService.UpdateNSFWSettings(ctx, claims.UserID, *update.ShowNSFW)
+	if err != nil {
+		handleProfileError(w, err)
+		return
+	}
+
+	ServerGenerateJSONResponse(w, ServerResponse{Description: "OK", Data: settings}, http.StatusOK)
+}
+
+// GetAccountPrivacyHandler godoc
+//
+//	@Summary		Get account privacy
+//	@Description	Returns whether the current account is private
+//	@Tags			profile
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Success		200	{object}	ServerResponse{data=domain.AccountPrivacy}	"Settings"
+//	@Failure		401	{object}	ServerResponse								"Unauthorized"
+//	@Failure		500	{object}	ServerResponse								"Internal server error"
+//	@Router			/api/v1/profile/privacy [get]
+func (h *ProfileHandler) GetAccountPrivacyHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok || claims == nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	privacy, err := h.ProfileService.GetAccountPrivacy(ctx, claims.UserID)
+	if err != nil {
+		handleProfileError(w, err)
+		return
+	}
+
+	ServerGenerateJSONResponse(w, ServerResponse{Description: "OK", Data: privacy}, http.StatusOK)
+}
+
+// UpdateAccountPrivacyHandler godoc
+//
+//	@Summary		Update account privacy
+//	@Description	Makes the account private or public. Flows and boards of a private account are visible only to approved followers, new followers have to send a follow request. Making the account public approves all pending requests
+//	@Tags			profile
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			settings	body		domain.AccountPrivacyUpdate					true	"New settings"
+//	@Success		200			{object}	ServerResponse{data=domain.AccountPrivacy}	"Updated settings"
+//	@Failure		400			{object}	ServerResponse								"Invalid request"
+//	@Failure		401			{object}	ServerResponse								"Unauthorized"
+//	@Failure		500			{object}	ServerResponse								"Internal server error"
+//	@Router			/api/v1/profile/privacy [put]
+func (h *ProfileHandler) UpdateAccountPrivacyHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	if !ok || claims == nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var update domain.AccountPrivacyUpdate
+	if err := DecodeData(w, r.Body, &update); err != nil {
+		return
+	}
+
+	if update.IsPrivateAccount == nil {
+		HttpErrorToJson(w, "field [is_private_account] is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	privacy, err := h.ProfileService.UpdateAccountPrivacy(ctx, claims.UserID, *update.IsPrivateAccount)
+	if err != nil {
+		handleProfileError(w, err)
+		return
+	}
+
+	ServerGenerateJSONResponse(w, ServerResponse{Description: "OK", Data: privacy}, http.StatusOK)
+}
+
 func handleProfileError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, multipart.ErrMessageTooLarge):
@@ -292,8 +492,14 @@ func handleProfileError(w http.ResponseWriter, err error) {
 		HttpErrorToJson(w, "invalid email", http.StatusBadRequest)
 	case errors.Is(err, domain.ErrInvalidUsername):
 		HttpErrorToJson(w, "invalid username", http.StatusBadRequest)
+	case errors.Is(err, domain.ErrUsernameAlreadyTaken):
+		HttpErrorToJson(w, "username is already taken", http.StatusConflict)
+	case errors.Is(err, domain.ErrUsernameChangeTooSoon):
+		HttpErrorToJson(w, err.Error(), http.StatusTooManyRequests)
 	case errors.Is(err, domain.ErrInvalidBirthday):
 		HttpErrorToJson(w, "invalid birthday", http.StatusBadRequest)
+	case errors.Is(err, domain.ErrNSFWAgeRestricted):
+		HttpErrorToJson(w, err.Error(), http.StatusForbidden)
 	case errors.Is(err, domain.ErrNoPassword):
 		HttpErrorToJson(w, "cannot use empty password", http.StatusBadRequest)
 	case errors.Is(err, domain.ErrPasswordTooLong):
@@ -155,6 +155,24 @@ func TestCurrentUserProfileHandler_PATCH(t *testing.T) {
 			ExpectedCode: 200,
 			ExpectedBody: `{"description":"OK"}`,
 		},
+		{
+			Name:         "Change username",
+			Method:       "PATCH",
+			Body:         `{"username":"JaneDoe"}`,
+			URL:          base,
+			Token:        "yes",
+			ExpectedCode: 200,
+			ExpectedBody: `{"description":"OK"}`,
+		},
+		{
+			Name:         "Change username too soon",
+			Method:       "PATCH",
+			Body:         `{"username":"JaneDoe"}`,
+			URL:          base,
+			Token:        "yes",
+			ExpectedCode: 429,
+			ExpectedBody: `{"description":"username was changed recently"}`,
+		},
 		{
 			Name:         "bad request body",
 			Method:       "PATCH",
@@ -190,6 +208,28 @@ func TestCurrentUserProfileHandler_PATCH(t *testing.T) {
 					Username: "JohnDoe",
 				}, nil)
 				mockService.EXPECT().UpdateUserData(gomock.Any(), "email@email.ru").Return(nil)
+			case "Change username":
+				mockService.EXPECT().GetUserPublicInfoByEmail("email@email.ru").Return(domain.User{
+					ID:       1,
+					Email:    "email@email.ru",
+					Birthday: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+					Username: "JohnDoe",
+				}, nil)
+				mockService.EXPECT().ChangeUsername(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, user domain.User) error {
+						if user.Username != "JaneDoe" {
+							t.Errorf("expected username JaneDoe, got %s", user.Username)
+						}
+						return nil
+					})
+			case "Change username too soon":
+				mockService.EXPECT().GetUserPublicInfoByEmail("email@email.ru").Return(domain.User{
+					ID:       1,
+					Email:    "email@email.ru",
+					Birthday: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+					Username: "JohnDoe",
+				}, nil)
+				mockService.EXPECT().ChangeUsername(gomock.Any(), gomock.Any()).Return(domain.ErrUsernameChangeTooSoon)
 			case "patch validation error":
 				mockService.EXPECT().GetUserPublicInfoByEmail("email@email.ru").Return(domain.User{
 					ID:       1,
@@ -250,6 +290,22 @@ func TestPublicProfileHandler(t *testing.T) {
 			ExpectedCode: 404,
 			ExpectedBody: `{"description":"user not found"}`,
 		},
+		{
+			Name:         "Private profile",
+			Method:       "GET",
+			URL:          "/profile/secret",
+			Token:        "yes",
+			ExpectedCode: 200,
+			ExpectedBody: `"follow_status":"requested","content_hidden":true`,
+		},
+		{
+			Name:         "Renamed user",
+			Method:       "GET",
+			URL:          "/profile/oldname",
+			Token:        "yes",
+			ExpectedCode: 302,
+			ExpectedBody: `/api/v1/users/johndoe`,
+		},
 		{
 			Name:         "Invalid method",
 			Method:       "POST",
@@ -287,6 +343,17 @@ func TestPublicProfileHandler(t *testing.T) {
 				}, nil)
 			case "Non-existent user":
 				mockService.EXPECT().GetUserPublicInfoByUsername("unknown").Return(domain.User{}, domain.ErrUserNotFound)
+				mockService.EXPECT().GetRenamedUsername(gomock.Any(), "unknown").Return("", domain.ErrUserNotFound)
+			case "Private profile":
+				private := domain.User{Username: "secret", IsPrivateAccount: true}
+				hidden := private
+				hidden.FollowStatus = domain.FollowStatusRequested
+				hidden.ContentHidden = true
+				mockService.EXPECT().GetUserPublicInfoByUsername("secret").Return(private, nil)
+				mockService.EXPECT().RestrictPrivateProfile(gomock.Any(), private, gomock.Any()).Return(hidden, nil)
+			case "Renamed user":
+				mockService.EXPECT().GetUserPublicInfoByUsername("oldname").Return(domain.User{}, domain.ErrUserNotFound)
+				mockService.EXPECT().GetRenamedUsername(gomock.Any(), "oldname").Return("johndoe", nil)
 			}
 
 			rr := httptest.NewRecorder()
@@ -572,3 +639,147 @@ func createMultipartFormData(t *testing.T, filename, contentType string, size ..
 
 	return body, writer.FormDataContentType()
 }
+
+func TestUpdateNSFWSettingsHandler(t *testing.T) {
+	claims, err := generateJWTToken(string(conf.JWTSecret))
+	if err != nil {
+		t.Fatalf("failed to generate JWT token: %v", err)
+	}
+
+	testCases := []TestCase{
+		{
+			Name:         "Valid request",
+			Body:         `{"show_nsfw":true}`,
+			Token:        "yes",
+			ExpectedCode: 200,
+			ExpectedBody: `{"description":"OK","data":{"show_nsfw":true,"is_adult":true,"mode":"show"}}`,
+		},
+		{
+			Name:         "Under age",
+			Body:         `{"show_nsfw":true}`,
+			Token:        "yes",
+			ExpectedCode: 403,
+			ExpectedBody: `{"description":"sensitive content is available only to adult users"}`,
+		},
+		{
+			Name:         "Missing field",
+			Body:         `{}`,
+			Token:        "yes",
+			ExpectedCode: 400,
+			ExpectedBody: `{"description":"field [show_nsfw] is required"}`,
+		},
+		{
+			Name:         "Unauthorized request",
+			Body:         `{"show_nsfw":true}`,
+			ExpectedCode: 401,
+			ExpectedBody: `{"description":"Unauthorized"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockService := mock_rest.NewMockProfileService(ctrl)
+
+			switch tc.Name {
+			case "Valid request":
+				mockService.EXPECT().UpdateNSFWSettings(gomock.Any(), 1, true).Return(domain.NSFWSettings{
+					ShowNSFW: true,
+					IsAdult:  true,
+					Mode:     domain.NSFWModeShow,
+				}, nil)
+			case "Under age":
+				mockService.EXPECT().UpdateNSFWSettings(gomock.Any(), 1, true).Return(domain.NSFWSettings{}, domain.ErrNSFWAgeRestricted)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/profile/nsfw", strings.NewReader(tc.Body))
+			if tc.Token != "" {
+				ctx := context.WithValue(req.Context(), auth.ClaimsContextKey, &claims)
+				req = req.WithContext(ctx)
+			}
+
+			handler := rest.ProfileHandler{
+				ProfileService:    mockService,
+				ContextExpiration: time.Second,
+			}
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.UpdateNSFWSettingsHandler).ServeHTTP(rr, req)
+
+			if rr.Code != tc.ExpectedCode {
+				t.Errorf("expected code %d, got %d", tc.ExpectedCode, rr.Code)
+			}
+			if strings.TrimSpace(rr.Body.String()) != tc.ExpectedBody {
+				t.Errorf("expected body %s, got %s", tc.ExpectedBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestUpdateAccountPrivacyHandler(t *testing.T) {
+	claims, err := generateJWTToken(string(conf.JWTSecret))
+	if err != nil {
+		t.Fatalf("failed to generate JWT token: %v", err)
+	}
+
+	testCases := []TestCase{
+		{
+			Name:         "Make account private",
+			Body:         `{"is_private_account":true}`,
+			Token:        "yes",
+			ExpectedCode: 200,
+			ExpectedBody: `{"description":"OK","data":{"is_private_account":true}}`,
+		},
+		{
+			Name:         "Missing field",
+			Body:         `{}`,
+			Token:        "yes",
+			ExpectedCode: 400,
+			ExpectedBody: `{"description":"field [is_private_account] is required"}`,
+		},
+		{
+			Name:         "Unauthorized request",
+			Body:         `{"is_private_account":true}`,
+			ExpectedCode: 401,
+			ExpectedBody: `{"description":"Unauthorized"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockService := mock_rest.NewMockProfileService(ctrl)
+
+			if tc.Name == "Make account private" {
+				mockService.EXPECT().UpdateAccountPrivacy(gomock.Any(), 1, true).Return(domain.AccountPrivacy{
+					IsPrivateAccount: true,
+				}, nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/profile/privacy", strings.NewReader(tc.Body))
+			if tc.Token != "" {
+				ctx := context.WithValue(req.Context(), auth.ClaimsContextKey, &claims)
+				req = req.WithContext(ctx)
+			}
+
+			handler := rest.ProfileHandler{
+				ProfileService:    mockService,
+				ContextExpiration: time.Second,
+			}
+
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.UpdateAccountPrivacyHandler).ServeHTTP(rr, req)
+
+			if rr.Code != tc.ExpectedCode {
+				t.Errorf("expected code %d, got %d", tc.ExpectedCode, rr.Code)
+			}
+			if strings.TrimSpace(rr.Body.String()) != tc.ExpectedBody {
+				t.Errorf("expected body %s, got %s", tc.ExpectedBody, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+type ReportService interface {
+	CreateReport(ctx context.Context, report domain.Report) (int, error)
+	GetReports(ctx context.Context, userID int, status string, page, size int) ([]domain.Report, error)
+	ResolveReport(ctx context.Context, userID, reportID int, resolution domain.ReportResolution) error
+	GetModeratorActions(ctx context.Context, userID, page, size int) ([]domain.ModeratorAction, error)
+}
+
+type ReportHandler struct {
+	Service           ReportService
+	ContextExpiration time.Duration
+}
+
+// CreateReport godoc
+//	@Summary		Report a flow, comment, board, user or chat message
+//	@Description	Creates a report, only one open report per target is allowed for a user
+//	@Accept			json
+//	@Produce		json
+//	@Param			target_type	body	string						true	"flow, comment, board, user or message"
+//	@Param			target_id	body	int							true	"target ID"
+//	@Param			reason		body	string						true	"report reason"
+//	@Success		201			string	serverResponse.Data			"Created"
+//	@Failure		400			string	serverResponse.Description	"bad request"
+//	@Failure		404			string	serverResponse.Description	"target not found"
+//	@Failure		409			string	serverResponse.Description	"report already exists"
+//	@Router			/api/v1/reports [post]
+func (h *ReportHandler) CreateReport(w http.ResponseWriter, r *http.Request) {
+	var report domain.Report
+
+	if err := DecodeData(w, r.Body, &report); err != nil {
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+	report.ReporterID = claims.UserID
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	id, err := h.Service.CreateReport(ctx, report)
+	if err != nil {
+		handleReportError(w, err)
+		return
+	}
+
+	type reportID struct {
+		ID int `json:"report_id"`
+	}
+
+	resp := ServerResponse{
+		Description: "Created",
+		Data: reportID{
+			ID: id,
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusCreated)
+}
+
+// GetReports godoc
+//	@Summary		Get reports for moderation
+//	@Description	Returns a page of reports with the given status, oldest first. Moderators only
+//	@Produce		json
+//	@Param			status	query	string						false	"open, resolved or dismissed"	example("?status=open")
+//	@Param			page	query	int							true	"requested page"				example("?page=3")
+//	@Param			size	query	int							true	"requested size"				example("?size=15")
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		403		string	serverResponse.Description	"forbidden"
+//	@Failure		404		string	serverResponse.Description	"not found"
+//	@Router			/api/v1/admin/reports [get]
+func (h *ReportHandler) GetReports(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	page, size, err := getQueryPagination(w, r)
+	if err != nil {
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	if status == "" {
+		status = domain.ReportStatusOpen
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	reports, err := h.Service.GetReports(ctx, claims.UserID, status, page, size)
+	if err != nil {
+		handleReportError(w, err)
+		return
+	}
+
+	for i := range reports {
+		reports[i].Escape()
+	}
+
+	code := http.StatusOK
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        reports,
+	}
+
+	if len(reports) == 0 {
+		code = http.StatusNotFound
+		resp.Description = http.StatusText(http.StatusNotFound)
+	}
+
+	ServerGenerateJSONResponse(w, resp, code)
+}
+
+// ResolveReport godoc
+//	@Summary		Resolve a report
+//	@Description	Applies a moderation action to the report target and closes all open reports on it. Moderators only
+//	@Accept			json
+//	@Produce		json
+//	@Param			report_id	path	int							true	"report ID"
+//	@Param			action		body	string						true	"dismiss, hide_flow, delete_comment or suspend_user"
+//	@Param			note		body	string						false	"moderator note"
+//	@Success		200			string	serverResponse.Data			"OK"
+//	@Failure		400			string	serverResponse.Description	"action is not applicable to report target"
+//	@Failure		403			string	serverResponse.Description	"forbidden"
+//	@Failure		404			string	serverResponse.Description	"not found"
+//	@Failure		409			string	serverResponse.Description	"report is already resolved"
+//	@Router			/api/v1/admin/reports/{report_id}/resolve [post]
+func (h *ReportHandler) ResolveReport(w http.ResponseWriter, r *http.Request) {
+	reportIDStr := r.PathValue("report_id")
+	reportID, err := strconv.Atoi(reportIDStr)
+	if err != nil {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var resolution domain.ReportResolution
+
+	if err := DecodeData(w, r.Body, &resolution); err != nil {
+		return
+	}
+
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	if err := h.Service.ResolveReport(ctx, claims.UserID, reportID, resolution); err != nil {
+		handleReportError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// GetModeratorActions godoc
+//	@Summary		Get moderator audit log
+//	@Description	Returns a page of moderator actions, newest first. Moderators only
+//	@Produce		json
+//	@Param			page	query	int							true	"requested page"	example("?page=3")
+//	@Param			size	query	int							true	"requested size"	example("?size=15")
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		403		string	serverResponse.Description	"forbidden"
+//	@Failure		404		string	serverResponse.Description	"not found"
+//	@Router			/api/v1/admin/audit [get]
+func (h *ReportHandler) GetModeratorActions(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	page, size, err := getQueryPagination(w, r)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	actions, err := h.Service.GetModeratorActions(ctx, claims.UserID, page, size)
+	if err != nil {
+		handleReportError(w, err)
+		return
+	}
+
+	status := http.StatusOK
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        actions,
+	}
+
+	if len(actions) == 0 {
+		status = http.StatusNotFound
+		resp.Description = http.StatusText(http.StatusNotFound)
+	}
+
+	ServerGenerateJSONResponse(w, resp, status)
+}
+
+func handleReportError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidReportTarget),
+		errors.Is(err, domain.ErrInvalidReportReason),
+		errors.Is(err, domain.ErrInvalidReportStatus),
+		errors.Is(err, domain.ErrInvalidModerationAction):
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrValidation):
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrForbidden):
+		HttpErrorToJson(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+	case errors.Is(err, domain.ErrNotFound):
+		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	case errors.Is(err, domain.ErrReportExists), errors.Is(err, domain.ErrReportResolved):
+		HttpErrorToJson(w, err.Error(), http.StatusConflict)
+	default:
+		HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,217 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReportService struct {
+	mock.Mock
+}
+
+func (m *MockReportService) CreateReport(ctx context.Context, report domain.Report) (int, error) {
+	args := m.Called(ctx, report)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockReportService) GetReports(ctx context.Context, userID int, status string, page, size int) ([]domain.Report, error) {
+	args := m.Called(ctx, userID, status, page, size)
+	return args.Get(0).([]domain.Report), args.Error(1)
+}
+
+func (m *MockReportService) ResolveReport(ctx context.Context, userID, reportID int, resolution domain.ReportResolution) error {
+	args := m.Called(ctx, userID, reportID, resolution)
+	return args.Error(0)
+}
+
+func (m *MockReportService) GetModeratorActions(ctx context.Context, userID, page, size int) ([]domain.ModeratorAction, error) {
+	args := m.Called(ctx, userID, page, size)
+	return args.Get(0).([]domain.ModeratorAction), args.Error(1)
+}
+
+func TestReportHandler_CreateReport(t *testing.T) {
+	tests := []struct {
+		name           string
+		report         domain.Report
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			report:         domain.Report{TargetType: domain.ReportTargetFlow, TargetID: 5, Reason: "spam"},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Duplicate",
+			report:         domain.Report{TargetType: domain.ReportTargetFlow, TargetID: 5, Reason: "spam"},
+			mockError:      domain.ErrReportExists,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Invalid target",
+			report:         domain.Report{TargetType: "planet", TargetID: 5, Reason: "spam"},
+			mockError:      domain.ErrInvalidReportTarget,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Target not found",
+			report:         domain.Report{TargetType: domain.ReportTargetComment, TargetID: 100, Reason: "rude"},
+			mockError:      domain.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockReportService)
+			handler := ReportHandler{
+				Service:           mockService,
+				ContextExpiration: time.Second,
+			}
+
+			expected := tt.report
+			expected.ReporterID = 2
+			mockService.On("CreateReport", mock.Anything, expected).Return(1, tt.mockError)
+
+			body, _ := json.Marshal(tt.report)
+			req := httptest.NewRequest(http.MethodPost, "/reports", bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 2}))
+
+			w := httptest.NewRecorder()
+			handler.CreateReport(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReportHandler_GetReports(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		status         string
+		mockReports    []domain.Report
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:   "Success",
+			url:    "/admin/reports?page=1&size=10",
+			status: domain.ReportStatusOpen,
+			mockReports: []domain.Report{
+				{ID: 1, TargetType: domain.ReportTargetFlow, TargetID: 5, Reason: "spam", Status: domain.ReportStatusOpen},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Not moderator",
+			url:            "/admin/reports?page=1&size=10&status=resolved",
+			status:         domain.ReportStatusResolved,
+			mockReports:    nil,
+			mockError:      domain.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Empty",
+			url:            "/admin/reports?page=1&size=10",
+			status:         domain.ReportStatusOpen,
+			mockReports:    []domain.Report{},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockReportService)
+			handler := ReportHandler{
+				Service:           mockService,
+				ContextExpiration: time.Second,
+			}
+
+			mockService.On("GetReports", mock.Anything, 2, tt.status, 1, 10).Return(tt.mockReports, tt.mockError)
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 2}))
+
+			w := httptest.NewRecorder()
+			handler.GetReports(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReportHandler_ResolveReport(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		resolution     domain.ReportResolution
+		mockError      error
+		expectedStatus int
+	}{
+		{
+			name:           "Success",
+			url:            "/admin/reports/1/resolve",
+			resolution:     domain.ReportResolution{Action: domain.ModerationActionHideFlow},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid report ID",
+			url:            "/admin/reports/abc/resolve",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Wrong action",
+			url:            "/admin/reports/1/resolve",
+			resolution:     domain.ReportResolution{Action: domain.ModerationActionDeleteComment},
+			mockError:      domain.ErrInvalidModerationAction,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Already resolved",
+			url:            "/admin/reports/1/resolve",
+			resolution:     domain.ReportResolution{Action: domain.ModerationActionDismiss},
+			mockError:      domain.ErrReportResolved,
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockReportService)
+			handler := ReportHandler{
+				Service:           mockService,
+				ContextExpiration: time.Second,
+			}
+
+			if tt.expectedStatus != http.StatusBadRequest || tt.mockError != nil {
+				mockService.On("ResolveReport", mock.Anything, 2, 1, tt.resolution).Return(tt.mockError)
+			}
+
+			body, _ := json.Marshal(tt.resolution)
+			req := httptest.NewRequest(http.MethodPost, tt.url, bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 2}))
+
+			w := httptest.NewRecorder()
+
+			router := http.NewServeMux()
+			router.HandleFunc("POST /admin/reports/{report_id}/resolve", handler.ResolveReport)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -9,17 +9,22 @@ import (
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/validator"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/hashtag"
 )
 
 type SearchService interface {
-	SearchPins(ctx context.Context, query string, page, pageSize int) ([]domain.PinData, error)
+	SearchPins(ctx context.Context, query, sourceDomain string, tags []string, page, pageSize int, nsfwMode string) ([]domain.PinData, error)
 	SearchUsers(ctx context.Context, query string, page, pageSize int) ([]domain.PublicUser, error)
 	SearchBoards(ctx context.Context, query string, page, pageSize int) ([]domain.Board, error) 
 }
 
+// сколько тегов можно передать в поиске флоу
+const maxSearchTags = 5
+
 type SearchHandler struct {
 	Service SearchService
 	ContextTimeout time.Duration
+	Events EventRecorder
 }
 
 // SearchPins godoc
@@ -29,6 +34,8 @@ type SearchHandler struct {
 //	@Param			page	path	int							true	"requested page"		example("?page=3")
 //	@Param			size	path	int							true	"requested page size"	example("?size=15")
 //	@Param			query	path	string						true	"search query"			example("?query=kittens")
+//	@Param			domain	path	string						false	"source site of the flows"	example("?domain=example.com")
+//	@Param			tag		path	string						false	"tag the flows must have, can be repeated"	example("?tag=cats&tag=art")
 //	@Success		200		string	serverResponse.Data			"OK"
 //	@Failure		400		string	serverResponse.Description	"bad request"
 //	@Failure		404		string	serverResponse.Description	"page not found"
@@ -38,7 +45,18 @@ func (s *SearchHandler) SearchPins(w http.ResponseWriter, r *http.Request) {
 	v := validator.New()
 
 	query := r.URL.Query().Get("query")
-	v.Check(query != "", "query", "cannot be empty")
+	sourceDomain := domain.NormalizeSourceDomain(r.URL.Query().Get("domain"))
+
+	var tags []string
+	for _, raw := range r.URL.Query()["tag"] {
+		tag, ok := hashtag.Normalize(raw)
+		v.Check(ok, "tag", "invalid tag")
+		tags = append(tags, tag)
+	}
+	v.Check(len(tags) <= maxSearchTags, "tag", "too many tags")
+
+	// с фильтром по домену или тегам запрос может быть пустым
+	v.Check(query != "" || sourceDomain != "" || len(tags) > 0, "query", "cannot be empty")
 
 	page := r.URL.Query().Get("page")
 	pageInt, err := strconv.Atoi(page)
@@ -63,21 +81,27 @@ func (s *SearchHandler) SearchPins(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	nsfwMode := domain.NSFWModeFromContext(r.Context())
+
 	ctx, cancel := context.WithTimeout(context.Background(), s.ContextTimeout)
 	defer cancel()
 
-	pins, err := s.Service.SearchPins(ctx, query, pageInt, pageSizeInt)
+	pins, err := s.Service.SearchPins(ctx, query, sourceDomain, tags, pageInt, pageSizeInt, nsfwMode)
 	if err != nil {
 		log.Printf("search pin error: %v", err)
 		handleSearchError(w, err)
 		return
 	}
 
+	pins = domain.FilterNSFW(pins, nsfwMode, viewerID(r))
+
 	if len(pins) == 0 {
 		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 		return
 	}
 
+	RecordImpressions(s.Events, pins, viewerID(r))
+
 	resp := ServerResponse{
 		Description: "OK",
 		Data: pins,
@@ -136,6 +160,8 @@ func (s *SearchHandler) SearchBoards(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	domain.FilterBoardsNSFW(boards, domain.NSFWModeFromContext(r.Context()), viewerID(r))
+
 	if len(boards) == 0 {
 		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 		return
@@ -30,7 +30,7 @@ func TestSearchPins(t *testing.T) {
 		pageSize := 10
 
 		mockSearchService.EXPECT().
-			SearchPins(gomock.Any(), query, page, pageSize).
+			SearchPins(gomock.Any(), query, "", nil, page, pageSize, domain.NSFWModeHide).
 			Return([]domain.PinData{
 				{Header: "Pin 1", MediaURL: "image1.jpg"},
 				{Header: "Pin 2", MediaURL: "image2.jpg"},
@@ -56,13 +56,35 @@ func TestSearchPins(t *testing.T) {
 		assert.Contains(t, rr.Body.String(), "cannot be empty")
 	})
 
+	t.Run("Tag filter without query", func(t *testing.T) {
+		mockSearchService.EXPECT().
+			SearchPins(gomock.Any(), "", "", []string{"котики", "art"}, 1, 10, domain.NSFWModeHide).
+			Return([]domain.PinData{{Header: "Pin 1"}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/search/pins?tag=%23Котики&tag=art&page=1&size=10", nil)
+		rr := httptest.NewRecorder()
+
+		handler.SearchPins(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Validation Error - Invalid Tag", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/search/pins?tag=a-b&page=1&size=10", nil)
+		rr := httptest.NewRecorder()
+
+		handler.SearchPins(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
 	t.Run("Empty Results", func(t *testing.T) {
 		query := "kittens"
 		page := 1
 		pageSize := 10
 
 		mockSearchService.EXPECT().
-			SearchPins(gomock.Any(), query, page, pageSize).
+			SearchPins(gomock.Any(), query, "", nil, page, pageSize, domain.NSFWModeHide).
 			Return([]domain.PinData{}, nil)
 
 		req := httptest.NewRequest(http.MethodGet, "/api/v1/search/pins?query=kittens&page=1&size=10", nil)
@@ -80,7 +102,7 @@ func TestSearchPins(t *testing.T) {
 		pageSize := 10
 
 		mockSearchService.EXPECT().
-			SearchPins(gomock.Any(), query, page, pageSize).
+			SearchPins(gomock.Any(), query, "", nil, page, pageSize, domain.NSFWModeHide).
 			Return(nil, errors.New("database error"))
 
 		req := httptest.NewRequest(http.MethodGet, "/api/v1/search/pins?query=kittens&page=1&size=10", nil)
@@ -16,11 +16,18 @@ import (
 type SubscriptionService interface {
 	GetUserFollowers(ctx context.Context, id, page, size int) ([]domain.PublicUser, error)
 	GetUserFollowing(ctx context.Context, id, page, size int) ([]domain.PublicUser, error)
-	CreateSubscription(ctx context.Context, username, targetUsername string, currentID int) error
+	CreateSubscription(ctx context.Context, username, targetUsername string, currentID int) (string, error)
 	DeleteSubscription(ctx context.Context, targetUsername string, currentID int) error
+	GetFollowRequests(ctx context.Context, targetID, page, size int) ([]domain.FollowRequest, error)
+	AcceptFollowRequest(ctx context.Context, username string, targetID int, requesterUsername string) (int, error)
+	DeclineFollowRequest(ctx context.Context, targetID int, requesterUsername string) error
 }
 
-const SubscriptionType = "subscription"
+const (
+	SubscriptionType   = "subscription"
+	FollowRequestType  = "follow_request"
+	FollowAcceptedType = "follow_accepted"
+)
 
 //easyjson:json
 type SubscriptionData struct {
@@ -31,6 +38,7 @@ type SubscriptionHandler struct {
 	ContextExpiration   time.Duration
 	SubscriptionService SubscriptionService
 	NotificationChan    chan<- domain.WebMessage
+	Events              EventRecorder
 }
 
 // GetUserFollowers godoc
@@ -105,11 +113,12 @@ func (h *SubscriptionHandler) GetUserFollowing(w http.ResponseWriter, r *http.Re
 
 // AddSubscription godoc
 //	@Summary		Subscribe to target user
-//	@Description	Tries to subscribe the user to the target user
+//	@Description	Tries to subscribe the user to the target user. A private account gets a follow request instead
 //	@Accept			json
 //	@Produce		json
 //	@Param			target_user	body	string		true	"target user's username"	example("cool_guy")
-//	@Success		200			string	Description	"OK"
+//	@Success		201			string	Description	"Created"
+//	@Success		202			string	Description	"Requested"
 //	@Failure		400			string	Description	"Bad Request"
 //	@Failure		403			string	Description	"Unauthorized"
 //	@Failure		500			string	Description	"Internal server error"
@@ -131,24 +140,34 @@ func (h *SubscriptionHandler) CreateSubscription(w http.ResponseWriter, r *http.
 	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
 	defer cancel()
 
-	if err := h.SubscriptionService.CreateSubscription(ctx, claims.Username, subData.TargetUsername, claims.UserID); err != nil {
+	status, err := h.SubscriptionService.CreateSubscription(ctx, claims.Username, subData.TargetUsername, claims.UserID)
+	if err != nil {
 		log.Printf("create sub err: %v", err)
 		handleSubscriptionError(w, err)
 		return
 	}
 
+	// закрытый аккаунт получает заявку, подписка появится после одобрения
+	if status == domain.FollowStatusRequested {
+		h.notify(FollowRequestType, claims.Username, subData.TargetUsername)
+
+		resp := ServerResponse{
+			Description: "Requested",
+		}
+
+		ServerGenerateJSONResponse(w, resp, http.StatusAccepted)
+		return
+	}
+
+	RecordEvent(h.Events, domain.AnalyticsEvent{
+		Type:            domain.EventFollow,
+		AccountUsername: subData.TargetUsername,
+		ViewerID:        claims.UserID,
+	})
+
 	// send notification
 	if claims.Username != subData.TargetUsername {
-		h.NotificationChan <- domain.WebMessage{
-			Type: NotificationType,
-			Content: domain.Notification{
-				Type:             SubscriptionType,
-				CreatedAt:        time.Now(),
-				SenderUsername:   claims.Username,
-				ReceiverUsername: subData.TargetUsername,
-				AdditionalData:   nil,
-			},
-		}
+		h.notify(SubscriptionType, claims.Username, subData.TargetUsername)
 	}
 
 	resp := ServerResponse{
@@ -192,13 +211,134 @@ func (h *SubscriptionHandler) DeleteSubscription(w http.ResponseWriter, r *http.
 		return
 	}
 
+	RecordEvent(h.Events, domain.AnalyticsEvent{
+		Type:            domain.EventUnfollow,
+		AccountUsername: subData.TargetUsername,
+		ViewerID:        claims.UserID,
+	})
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// GetFollowRequests godoc
+//	@Summary		Get pending follow requests
+//	@Description	Returns a pageSized number of requests to follow the user's private account, newest first
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			page	query	int							true	"requested page"	example("?page=3")
+//	@Param			size	query	int							true	"requested size"	example("?size=15")
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"bad request"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/follow-requests [get]
+func (h *SubscriptionHandler) GetFollowRequests(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	page, size, err := getQueryPagination(w, r)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	requests, err := h.SubscriptionService.GetFollowRequests(ctx, claims.UserID, page, size)
+	if err != nil {
+		handleSubscriptionError(w, err)
+		return
+	}
+
 	resp := ServerResponse{
 		Description: "OK",
+		Data:        domain.FollowRequests(requests),
 	}
 
 	ServerGenerateJSONResponse(w, resp, http.StatusOK)
 }
 
+// AcceptFollowRequest godoc
+//	@Summary		Accept follow request
+//	@Description	Turns the pending request into a subscription
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			username	path	string						true	"requester's username"
+//	@Success		200			string	serverResponse.Description	"OK"
+//	@Failure		404			string	serverResponse.Description	"follow request not found"
+//	@Failure		500			string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/follow-requests/{username} [post]
+func (h *SubscriptionHandler) AcceptFollowRequest(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	requester := r.PathValue("username")
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	requesterID, err := h.SubscriptionService.AcceptFollowRequest(ctx, claims.Username, claims.UserID, requester)
+	if err != nil {
+		handleSubscriptionError(w, err)
+		return
+	}
+
+	RecordEvent(h.Events, domain.AnalyticsEvent{
+		Type:            domain.EventFollow,
+		AccountUsername: claims.Username,
+		ViewerID:        requesterID,
+	})
+
+	h.notify(FollowAcceptedType, claims.Username, requester)
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// DeclineFollowRequest godoc
+//	@Summary		Decline follow request
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			username	path	string						true	"requester's username"
+//	@Success		200			string	serverResponse.Description	"OK"
+//	@Failure		404			string	serverResponse.Description	"follow request not found"
+//	@Failure		500			string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/follow-requests/{username} [delete]
+func (h *SubscriptionHandler) DeclineFollowRequest(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	if err := h.SubscriptionService.DeclineFollowRequest(ctx, claims.UserID, r.PathValue("username")); err != nil {
+		handleSubscriptionError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+func (h *SubscriptionHandler) notify(notificationType, sender, receiver string) {
+	h.NotificationChan <- domain.WebMessage{
+		Type: NotificationType,
+		Content: domain.Notification{
+			Type:             notificationType,
+			CreatedAt:        time.Now(),
+			SenderUsername:   sender,
+			ReceiverUsername: receiver,
+			AdditionalData:   nil,
+		},
+	}
+}
+
 func getQueryPagination(w http.ResponseWriter, r *http.Request) (int, int, error) {
 	page := r.URL.Query().Get("page")
 	if page == "" {
@@ -239,6 +379,9 @@ func getQueryPagination(w http.ResponseWriter, r *http.Request) (int, int, error
 
 func handleSubscriptionError(w http.ResponseWriter, err error) {
 	switch {
+	case errors.Is(err, domain.ErrFollowRequestNotFound):
+		HttpErrorToJson(w, err.Error(), http.StatusNotFound)
+		return
 	case errors.Is(err, domain.ErrConflict):
 		HttpErrorToJson(w, http.StatusText(http.StatusConflict), http.StatusConflict)
 		return
@@ -200,7 +200,7 @@ func TestCreateSubscription(t *testing.T) {
 
 		mockSubscriptionService.EXPECT().
 			CreateSubscription(gomock.Any(), "current_user", "target_user", 42).
-			Return(nil)
+			Return(domain.FollowStatusFollowing, nil)
 
 		body, _ := json.Marshal(subData)
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/subscription", bytes.NewBuffer(body))
@@ -214,6 +214,26 @@ func TestCreateSubscription(t *testing.T) {
 		assert.Equal(t, http.StatusCreated, rr.Code)
 	})
 
+	t.Run("Private account", func(t *testing.T) {
+		subData := SubscriptionData{TargetUsername: "target_user"}
+
+		mockSubscriptionService.EXPECT().
+			CreateSubscription(gomock.Any(), "current_user", "target_user", 42).
+			Return(domain.FollowStatusRequested, nil)
+
+		body, _ := json.Marshal(subData)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/subscription", bytes.NewBuffer(body))
+		ctx := context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 42, Username: "current_user"})
+		req = req.WithContext(ctx)
+
+		rr := httptest.NewRecorder()
+
+		handler.CreateSubscription(rr, req)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Requested")
+	})
+
 	t.Run("Validation Error - Missing Target User", func(t *testing.T) {
 		subData := SubscriptionData{}
 
@@ -235,7 +255,7 @@ func TestCreateSubscription(t *testing.T) {
 
 		mockSubscriptionService.EXPECT().
 			CreateSubscription(gomock.Any(), "current_user", "target_user", 42).
-			Return(domain.ErrConflict)
+			Return("", domain.ErrConflict)
 
 		body, _ := json.Marshal(subData)
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/subscription", bytes.NewBuffer(body))
@@ -318,3 +338,78 @@ func TestDeleteSubscription(t *testing.T) {
 		assert.Contains(t, rr.Body.String(), "Not Found")
 	})
 }
+
+func TestFollowRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubscriptionService := mocks.NewMockSubscriptionService(ctrl)
+
+	handler := SubscriptionHandler{
+		NotificationChan:    make(chan<- domain.WebMessage, 5),
+		ContextExpiration:   time.Second,
+		SubscriptionService: mockSubscriptionService,
+	}
+
+	withClaims := func(req *http.Request) *http.Request {
+		ctx := context.WithValue(req.Context(), auth.ClaimsContextKey, &auth.Claims{UserID: 42, Username: "current_user"})
+		return req.WithContext(ctx)
+	}
+
+	t.Run("List", func(t *testing.T) {
+		mockSubscriptionService.EXPECT().
+			GetFollowRequests(gomock.Any(), 42, 1, 10).
+			Return([]domain.FollowRequest{{User: domain.PublicUser{Username: "requester"}}}, nil)
+
+		req := withClaims(httptest.NewRequest(http.MethodGet, "/api/v1/profile/follow-requests?page=1&size=10", nil))
+		rr := httptest.NewRecorder()
+
+		handler.GetFollowRequests(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "requester")
+	})
+
+	t.Run("Accept", func(t *testing.T) {
+		mockSubscriptionService.EXPECT().
+			AcceptFollowRequest(gomock.Any(), "current_user", 42, "requester").
+			Return(7, nil)
+
+		req := withClaims(httptest.NewRequest(http.MethodPost, "/api/v1/profile/follow-requests/requester", nil))
+		req.SetPathValue("username", "requester")
+		rr := httptest.NewRecorder()
+
+		handler.AcceptFollowRequest(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Accept missing request", func(t *testing.T) {
+		mockSubscriptionService.EXPECT().
+			AcceptFollowRequest(gomock.Any(), "current_user", 42, "stranger").
+			Return(0, domain.ErrFollowRequestNotFound)
+
+		req := withClaims(httptest.NewRequest(http.MethodPost, "/api/v1/profile/follow-requests/stranger", nil))
+		req.SetPathValue("username", "stranger")
+		rr := httptest.NewRecorder()
+
+		handler.AcceptFollowRequest(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Contains(t, rr.Body.String(), "follow request not found")
+	})
+
+	t.Run("Decline", func(t *testing.T) {
+		mockSubscriptionService.EXPECT().
+			DeclineFollowRequest(gomock.Any(), 42, "requester").
+			Return(nil)
+
+		req := withClaims(httptest.NewRequest(http.MethodDelete, "/api/v1/profile/follow-requests/requester", nil))
+		req.SetPathValue("username", "requester")
+		rr := httptest.NewRecorder()
+
+		handler.DeclineFollowRequest(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
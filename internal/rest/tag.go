@@ -0,0 +1,230 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+)
+
+type TagService interface {
+	GetTag(ctx context.Context, name string, userID int) (domain.Tag, error)
+	GetTagFlows(ctx context.Context, name string, page, pageSize int, nsfwMode string) ([]domain.PinData, error)
+	Autocomplete(ctx context.Context, prefix string) ([]domain.Tag, error)
+	FollowTag(ctx context.Context, name string, userID int) error
+	UnfollowTag(ctx context.Context, name string, userID int) error
+	GetFollowedTags(ctx context.Context, userID int) ([]domain.Tag, error)
+}
+
+type TagHandler struct {
+	Service           TagService
+	ContextExpiration time.Duration
+}
+
+// AutocompleteTags godoc
+//	@Summary		Autocomplete tags
+//	@Description	Returns the most used tags starting with the query, a leading # is ignored
+//	@Produce		json
+//	@Param			query	query	string						true	"beginning of the tag"	example("?query=ca")
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"bad request"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/tags [get]
+func (h *TagHandler) AutocompleteTags(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	tags, err := h.Service.Autocomplete(ctx, r.URL.Query().Get("query"))
+	if err != nil {
+		handleTagError(w, err)
+		return
+	}
+
+	if tags == nil {
+		tags = []domain.Tag{}
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        tags,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// GetTag godoc
+//	@Summary		Get a tag
+//	@Description	Returns the tag with the number of public flows and whether the user follows it
+//	@Produce		json
+//	@Param			name	path	string						true	"tag name"
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"invalid tag"
+//	@Failure		404		string	serverResponse.Description	"tag not found"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/tags/{name} [get]
+func (h *TagHandler) GetTag(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	tag, err := h.Service.GetTag(ctx, r.PathValue("name"), int(viewerID(r)))
+	if err != nil {
+		handleTagError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        tag,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// GetTagFlows godoc
+//	@Summary		Get flows of a tag
+//	@Description	Returns a pageSized number of public flows with the tag, most popular first
+//	@Produce		json
+//	@Param			name	path	string						true	"tag name"
+//	@Param			page	query	int							true	"requested page"	example("?page=3")
+//	@Param			size	query	int							true	"requested size"	example("?size=15")
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"bad request"
+//	@Failure		404		string	serverResponse.Description	"page not found"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/tags/{name}/flows [get]
+func (h *TagHandler) GetTagFlows(w http.ResponseWriter, r *http.Request) {
+	page, size, err := getQueryPagination(w, r)
+	if err != nil {
+		return
+	}
+
+	nsfwMode := domain.NSFWModeFromContext(r.Context())
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	flows, err := h.Service.GetTagFlows(ctx, r.PathValue("name"), page, size, nsfwMode)
+	if err != nil {
+		handleTagError(w, err)
+		return
+	}
+
+	flows = domain.FilterNSFW(flows, nsfwMode, viewerID(r))
+
+	if len(flows) == 0 {
+		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	domain.EscapeFlows(flows)
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        flows,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// FollowTag godoc
+//	@Summary		Follow a topic
+//	@Description	Flows with the tag will appear in the user's feed
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			name	path	string						true	"tag name"
+//	@Success		200		string	serverResponse.Description	"OK"
+//	@Failure		400		string	serverResponse.Description	"invalid tag"
+//	@Failure		404		string	serverResponse.Description	"tag not found"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/tags/{name}/follow [post]
+func (h *TagHandler) FollowTag(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	if err := h.Service.FollowTag(ctx, r.PathValue("name"), claims.UserID); err != nil {
+		handleTagError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// UnfollowTag godoc
+//	@Summary		Unfollow a topic
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			name	path	string						true	"tag name"
+//	@Success		200		string	serverResponse.Description	"OK"
+//	@Failure		400		string	serverResponse.Description	"invalid tag"
+//	@Failure		500		string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/tags/{name}/follow [delete]
+func (h *TagHandler) UnfollowTag(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	if err := h.Service.UnfollowTag(ctx, r.PathValue("name"), claims.UserID); err != nil {
+		handleTagError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// GetFollowedTags godoc
+//	@Summary		Get followed topics
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Success		200	string	serverResponse.Data			"OK"
+//	@Failure		500	string	serverResponse.Description	"internal server error"
+//	@Router			/api/v1/profile/tags [get]
+func (h *TagHandler) GetFollowedTags(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.ContextExpiration)
+	defer cancel()
+
+	tags, err := h.Service.GetFollowedTags(ctx, claims.UserID)
+	if err != nil {
+		handleTagError(w, err)
+		return
+	}
+
+	if tags == nil {
+		tags = []domain.Tag{}
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        tags,
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+func handleTagError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidTag):
+		HttpErrorToJson(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrNotFound):
+		HttpErrorToJson(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	default:
+		log.Printf("tag error: %v", err)
+		HttpErrorToJson(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	mocks "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/tag/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTagFlows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTagService(ctrl)
+	handler := TagHandler{
+		Service:           mockService,
+		ContextExpiration: time.Second,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockService.EXPECT().
+			GetTagFlows(gomock.Any(), "cats", 1, 10, domain.NSFWModeHide).
+			Return([]domain.PinData{{FlowID: 1, Header: "<b>cat</b>"}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tags/cats/flows?page=1&size=10", nil)
+		req.SetPathValue("name", "cats")
+		rr := httptest.NewRecorder()
+
+		handler.GetTagFlows(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"flow_id":1`)
+		assert.NotContains(t, rr.Body.String(), "<b>")
+	})
+
+	t.Run("Invalid tag", func(t *testing.T) {
+		mockService.EXPECT().
+			GetTagFlows(gomock.Any(), "two words", 1, 10, domain.NSFWModeHide).
+			Return(nil, domain.ErrInvalidTag)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tags/x/flows?page=1&size=10", nil)
+		req.SetPathValue("name", "two words")
+		rr := httptest.NewRecorder()
+
+		handler.GetTagFlows(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("No pagination", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tags/cats/flows", nil)
+		req.SetPathValue("name", "cats")
+		rr := httptest.NewRecorder()
+
+		handler.GetTagFlows(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestFollowTag_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTagService(ctrl)
+	handler := TagHandler{
+		Service:           mockService,
+		ContextExpiration: time.Second,
+	}
+
+	claims := &auth.Claims{UserID: 7}
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tags/cats/follow", nil)
+	req.SetPathValue("name", "cats")
+	req = req.WithContext(context.WithValue(req.Context(), auth.ClaimsContextKey, claims))
+
+	mockService.EXPECT().FollowTag(gomock.Any(), "cats", 7).Return(domain.ErrNotFound)
+
+	rr := httptest.NewRecorder()
+	handler.FollowTag(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestAutocompleteTags_Empty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTagService(ctrl)
+	handler := TagHandler{
+		Service:           mockService,
+		ContextExpiration: time.Second,
+	}
+
+	mockService.EXPECT().Autocomplete(gomock.Any(), "#").Return(nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tags?query=%23", nil)
+	rr := httptest.NewRecorder()
+	handler.AutocompleteTags(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"data":[]`)
+}
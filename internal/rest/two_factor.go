@@ -0,0 +1,295 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/csrf"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	gen "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requireTwoFactor вместо сессии выдаёт токен для второго шага входа
+func (app AuthHandler) requireTwoFactor(w http.ResponseWriter, email, username string, userID uint64) {
+	token, err := app.JWTManager.CreatePendingJWT(email, username, int(userID), domain.TwoFactorPendingTTL)
+	if err != nil {
+		handleAuthError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "Two-factor authentication required",
+		Data: domain.TwoFactorChallenge{
+			TwoFactorRequired: true,
+			PendingToken:      token,
+			ExpiresIn:         int(domain.TwoFactorPendingTTL.Seconds()),
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusAccepted)
+}
+
+// TwoFactorLoginHandler godoc
+//	@Summary		Finish login with a two-factor code
+//	@Description	Exchanges the pending token from /api/v1/auth/login and a code from the authenticator app (or a recovery code) for a session
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body	domain.TwoFactorLogin		true	"pending token and code"
+//	@Success		200		string	serverResponse.Data			"OK"
+//	@Failure		400		string	serverResponse.Description	"Bad Request"
+//	@Failure		401		string	serverResponse.Description	"invalid two-factor code"
+//	@Failure		429		string	serverResponse.Description	"too many invalid two-factor codes"
+//	@Failure		500		string	serverResponse.Description	"Internal server error"
+//	@Router			/api/v1/auth/login/2fa [post]
+func (app AuthHandler) TwoFactorLoginHandler(w http.ResponseWriter, r *http.Request) {
+	var data domain.TwoFactorLogin
+	if err := DecodeData(w, r.Body, &data); err != nil {
+		return
+	}
+
+	if data.PendingToken == "" || data.Code == "" {
+		HttpErrorToJson(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	claims, err := app.JWTManager.ParsePendingJWT(data.PendingToken)
+	if err != nil {
+		HttpErrorToJson(w, "login session expired", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	if _, err := app.UserService.VerifyTwoFactor(ctx, &gen.TwoFactorCodeRequest{
+		ID:   int64(claims.UserID),
+		Code: data.Code,
+	}); err != nil {
+		handleGRPCTwoFactorError(w, err)
+		return
+	}
+
+	if err := app.setCookieJWT(w, app.Config, claims.Email, claims.Username, uint64(claims.UserID)); err != nil {
+		handleAuthError(w, err)
+		return
+	}
+
+	token, err := csrf.GenerateCSRF()
+	if err != nil {
+		handleAuthError(w, err)
+		return
+	}
+
+	app.setCookieCSRF(w, app.Config, token)
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data: domain.CSRFResponse{
+			CSRFToken: token,
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// GetTwoFactorStatus godoc
+//	@Summary		Get two-factor authentication status
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Success		200	string	serverResponse.Data			"OK"
+//	@Failure		401	string	serverResponse.Description	"Unauthorized"
+//	@Router			/api/v1/auth/2fa [get]
+func (app AuthHandler) GetTwoFactorStatus(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	grpcResp, err := app.UserService.GetTwoFactorStatus(ctx, &gen.TwoFactorRequest{
+		ID: int64(claims.UserID),
+	})
+	if err != nil {
+		handleGRPCTwoFactorError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data: domain.TwoFactorStatus{
+			Enabled:           grpcResp.Enabled,
+			RecoveryCodesLeft: int(grpcResp.RecoveryCodesLeft),
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// SetupTwoFactor godoc
+//	@Summary		Start two-factor authentication setup
+//	@Description	Generates a new TOTP secret. provisioning_uri is an otpauth:// link to be shown as a QR code. 2FA is enabled only after /api/v1/auth/2fa/confirm
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Success		200	string	serverResponse.Data			"secret and provisioning URI"
+//	@Failure		409	string	serverResponse.Description	"two-factor authentication is already enabled"
+//	@Router			/api/v1/auth/2fa/setup [post]
+func (app AuthHandler) SetupTwoFactor(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	grpcResp, err := app.UserService.SetupTwoFactor(ctx, &gen.TwoFactorRequest{
+		ID: int64(claims.UserID),
+	})
+	if err != nil {
+		handleGRPCTwoFactorError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data: domain.TwoFactorSetup{
+			Secret:          grpcResp.Secret,
+			ProvisioningURI: grpcResp.ProvisioningURI,
+		},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// ConfirmTwoFactor godoc
+//	@Summary		Confirm two-factor authentication setup
+//	@Description	Enables 2FA with a code from the authenticator app and returns single-use recovery codes. They are shown only once
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			code	body	domain.TwoFactorCode		true	"code from the authenticator app"
+//	@Success		200		string	serverResponse.Data			"recovery codes"
+//	@Failure		401		string	serverResponse.Description	"invalid two-factor code"
+//	@Failure		409		string	serverResponse.Description	"setup is not started or 2FA is already enabled"
+//	@Router			/api/v1/auth/2fa/confirm [post]
+func (app AuthHandler) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	code, ok := decodeTwoFactorCode(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	grpcResp, err := app.UserService.ConfirmTwoFactor(ctx, &gen.TwoFactorCodeRequest{
+		ID:   int64(claims.UserID),
+		Code: code,
+	})
+	if err != nil {
+		handleGRPCTwoFactorError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        domain.RecoveryCodes{Codes: grpcResp.RecoveryCodes},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// RegenerateRecoveryCodes godoc
+//	@Summary		Regenerate recovery codes
+//	@Description	Replaces all recovery codes with new ones
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			code	body	domain.TwoFactorCode		true	"code from the authenticator app or a recovery code"
+//	@Success		200		string	serverResponse.Data			"recovery codes"
+//	@Failure		401		string	serverResponse.Description	"invalid two-factor code"
+//	@Failure		409		string	serverResponse.Description	"two-factor authentication is not enabled"
+//	@Router			/api/v1/auth/2fa/recovery-codes [post]
+func (app AuthHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	code, ok := decodeTwoFactorCode(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	grpcResp, err := app.UserService.RegenerateRecoveryCodes(ctx, &gen.TwoFactorCodeRequest{
+		ID:   int64(claims.UserID),
+		Code: code,
+	})
+	if err != nil {
+		handleGRPCTwoFactorError(w, err)
+		return
+	}
+
+	resp := ServerResponse{
+		Description: "OK",
+		Data:        domain.RecoveryCodes{Codes: grpcResp.RecoveryCodes},
+	}
+
+	ServerGenerateJSONResponse(w, resp, http.StatusOK)
+}
+
+// DisableTwoFactor godoc
+//	@Summary		Disable two-factor authentication
+//	@Accept			json
+//	@Produce		json
+//	@Security		jwt_auth
+//	@Param			code	body	domain.TwoFactorCode		true	"code from the authenticator app or a recovery code"
+//	@Success		200		string	serverResponse.Description	"OK"
+//	@Failure		401		string	serverResponse.Description	"invalid two-factor code"
+//	@Failure		409		string	serverResponse.Description	"two-factor authentication is not enabled"
+//	@Router			/api/v1/auth/2fa [delete]
+func (app AuthHandler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	claims, _ := r.Context().Value(auth.ClaimsContextKey).(*auth.Claims)
+
+	code, ok := decodeTwoFactorCode(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.ContextDuration)
+	defer cancel()
+
+	if _, err := app.UserService.DisableTwoFactor(ctx, &gen.TwoFactorCodeRequest{
+		ID:   int64(claims.UserID),
+		Code: code,
+	}); err != nil {
+		handleGRPCTwoFactorError(w, err)
+		return
+	}
+
+	ServerGenerateJSONResponse(w, ServerResponse{Description: "OK"}, http.StatusOK)
+}
+
+func decodeTwoFactorCode(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var data domain.TwoFactorCode
+	if err := DecodeData(w, r.Body, &data); err != nil {
+		return "", false
+	}
+
+	if data.Code == "" {
+		HttpErrorToJson(w, "field [code] is required", http.StatusBadRequest)
+		return "", false
+	}
+
+	return data.Code, true
+}
+
+// handleGRPCTwoFactorError отдаёт причину отказа вместо общего Unauthorized
+func handleGRPCTwoFactorError(w http.ResponseWriter, err error) {
+	if st, ok := status.FromError(err); ok && st.Code() == codes.Unauthenticated {
+		HttpErrorToJson(w, st.Message(), http.StatusUnauthorized)
+		return
+	}
+
+	handleGRPCAuthError(w, err)
+}
@@ -0,0 +1,140 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest"
+	auth "github.com/go-park-mail-ru/2025_1_SuperChips/internal/rest/auth"
+	mock_user "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/auth/grpc"
+	gen "github.com/go-park-mail-ru/2025_1_SuperChips/protos/gen/auth"
+	tu "github.com/go-park-mail-ru/2025_1_SuperChips/test_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func hasAuthCookie(rr *httptest.ResponseRecorder) bool {
+	for _, c := range rr.Result().Cookies() {
+		if c.Name == auth.AuthToken {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestLoginHandler_TwoFactorRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := tu.TestConfig
+	mockUserService := mock_user.NewMockAuthClient(ctrl)
+	mockUserService.EXPECT().
+		LoginUser(gomock.Any(), &gen.LoginUserRequest{Email: "user@mail.ru", Password: "qwerty123"}).
+		Return(&gen.LoginUserResponse{ID: 42, Username: "user", TwoFactorRequired: true}, nil)
+
+	app := rest.AuthHandler{
+		Config:          cfg,
+		UserService:     mockUserService,
+		JWTManager:      *auth.NewJWTManager(cfg),
+		ContextDuration: time.Second,
+	}
+
+	body := tu.Marshal(domain.LoginData{Email: "user@mail.ru", Password: "qwerty123"})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+
+	app.LoginHandler(rr, req)
+
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"two_factor_required":true`)
+	assert.False(t, hasAuthCookie(rr), "session must not start before the second factor")
+}
+
+func TestTwoFactorLoginHandler(t *testing.T) {
+	cfg := tu.TestConfig
+	jwtManager := auth.NewJWTManager(cfg)
+
+	pending, err := jwtManager.CreatePendingJWT("user@mail.ru", "user", 42, time.Minute)
+	require.NoError(t, err)
+
+	session, err := jwtManager.CreateJWT("user@mail.ru", "user", 42)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		token      string
+		verifyErr  error
+		expectCall bool
+		expStatus  int
+		expBody    string
+	}{
+		{
+			name:       "Сценарий: верный код",
+			token:      pending,
+			expectCall: true,
+			expStatus:  http.StatusOK,
+			expBody:    `"csrf_token"`,
+		},
+		{
+			name:       "Сценарий: неверный код",
+			token:      pending,
+			verifyErr:  status.Error(codes.Unauthenticated, "invalid two-factor code"),
+			expectCall: true,
+			expStatus:  http.StatusUnauthorized,
+			expBody:    `invalid two-factor code`,
+		},
+		{
+			name:       "Сценарий: слишком много попыток",
+			token:      pending,
+			verifyErr:  status.Error(codes.ResourceExhausted, "too many invalid two-factor codes, try again later"),
+			expectCall: true,
+			expStatus:  http.StatusTooManyRequests,
+			expBody:    `too many invalid two-factor codes`,
+		},
+		{
+			name:      "Сценарий: вместо токена второго шага токен сессии",
+			token:     session,
+			expStatus: http.StatusUnauthorized,
+			expBody:   `login session expired`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserService := mock_user.NewMockAuthClient(ctrl)
+			if tt.expectCall {
+				mockUserService.EXPECT().
+					VerifyTwoFactor(gomock.Any(), &gen.TwoFactorCodeRequest{ID: 42, Code: "123456"}).
+					Return(&gen.VerifyTwoFactorResponse{Verified: tt.verifyErr == nil}, tt.verifyErr)
+			}
+
+			app := rest.AuthHandler{
+				Config:          cfg,
+				UserService:     mockUserService,
+				JWTManager:      *jwtManager,
+				ContextDuration: time.Second,
+			}
+
+			body := tu.Marshal(domain.TwoFactorLogin{PendingToken: tt.token, Code: "123456"})
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login/2fa", strings.NewReader(body))
+			rr := httptest.NewRecorder()
+
+			app.TwoFactorLoginHandler(rr, req)
+
+			assert.Equal(t, tt.expStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expBody)
+			assert.Equal(t, tt.expStatus == http.StatusOK, hasAuthCookie(rr))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	totpPeriod = 30 // секунд на один код
+	totpDigits = 6
+	totpSkew   = 1 // сколько соседних интервалов принимается из-за расхождения часов
+
+	recoveryAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	recoveryHalf     = 5
+)
+
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret возвращает 160-битный секрет в base32, как его ждут аутентификаторы
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return secretEncoding.EncodeToString(b), nil
+}
+
+// TOTPProvisioningURI собирает otpauth:// ссылку для QR-кода
+func TOTPProvisioningURI(secret, issuer, account string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// TOTPCode считает код для интервала step по RFC 6238
+func TOTPCode(secret string, step int64) (string, error) {
+	key, err := secretEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, value%1_000_000), nil
+}
+
+// ValidateTOTP проверяет код с допуском в один интервал и возвращает
+// интервал, которому он соответствует, чтобы код нельзя было использовать повторно
+func ValidateTOTP(secret, code string, now time.Time) (int64, bool) {
+	code = strings.ReplaceAll(code, " ", "")
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		expected, err := TOTPCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if hmac.Equal([]byte(expected), []byte(code)) {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// GenerateRecoveryCode возвращает код вида xxxxx-xxxxx без похожих символов
+func GenerateRecoveryCode() (string, error) {
+	b := make([]byte, recoveryHalf*2)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	code := make([]byte, 0, recoveryHalf*2+1)
+	for i, v := range b {
+		if i == recoveryHalf {
+			code = append(code, '-')
+		}
+		code = append(code, recoveryAlphabet[int(v)%len(recoveryAlphabet)])
+	}
+
+	return string(code), nil
+}
+
+// HashRecoveryCode хеширует код восстановления. Коды случайные, поэтому
+// bcrypt не нужен, а SHA-256 позволяет искать код по хешу
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,56 @@
+package security
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// секрет "12345678901234567890" из приложения B RFC 6238
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	tests := []struct {
+		unix     int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		code, err := TOTPCode(rfcSecret, tt.unix/totpPeriod)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, code)
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+
+	step, ok := ValidateTOTP(rfcSecret, "081804", now)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1111111109/totpPeriod), step)
+
+	// код предыдущего интервала ещё принимается
+	_, ok = ValidateTOTP(rfcSecret, "081804", now.Add(totpPeriod*time.Second))
+	assert.True(t, ok)
+
+	_, ok = ValidateTOTP(rfcSecret, "081804", now.Add(3*totpPeriod*time.Second))
+	assert.False(t, ok)
+
+	_, ok = ValidateTOTP(rfcSecret, "12345", now)
+	assert.False(t, ok)
+}
+
+func TestRecoveryCode(t *testing.T) {
+	code, err := GenerateRecoveryCode()
+	assert.NoError(t, err)
+	assert.Len(t, code, 11)
+	assert.Equal(t, byte('-'), code[5])
+
+	assert.Equal(t, HashRecoveryCode(code), HashRecoveryCode(" "+code[:5]+code[6:]+" "))
+}
@@ -8,7 +8,7 @@ import (
 )
 
 type PinRepository interface {
-	GetPins(page int, pageSize int) ([]domain.PinData, error)
+	GetPins(page int, pageSize int, nsfwMode string) ([]domain.PinData, error)
 }
 
 type PinService struct {
@@ -25,8 +25,8 @@ func NewPinService(r PinRepository, baseURL, imageDir string) *PinService {
 	}
 }
 
-func (p *PinService) GetPins(page int, pageSize int) ([]domain.PinData, error) {
-	pins, err := p.repo.GetPins(page, pageSize)
+func (p *PinService) GetPins(page int, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	pins, err := p.repo.GetPins(page, pageSize, nsfwMode)
 	if err != nil {
 		return []domain.PinData{}, err
 	}
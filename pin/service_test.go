@@ -26,7 +26,7 @@ func TestPinService_GetPins(t *testing.T) {
 		pageSize := 10
 
 		mockRepo.EXPECT().
-			GetPins(page, pageSize).
+			GetPins(page, pageSize, domain.NSFWModeHide).
 			Return([]domain.PinData{
 				{
 					FlowID:   1,
@@ -40,7 +40,7 @@ func TestPinService_GetPins(t *testing.T) {
 				},
 			}, nil)
 
-		pins, err := service.GetPins(page, pageSize)
+		pins, err := service.GetPins(page, pageSize, domain.NSFWModeHide)
 		assert.NoError(t, err)
 		assert.Len(t, pins, 2)
 
@@ -56,10 +56,10 @@ func TestPinService_GetPins(t *testing.T) {
 		pageSize := 10
 
 		mockRepo.EXPECT().
-			GetPins(page, pageSize).
+			GetPins(page, pageSize, domain.NSFWModeHide).
 			Return(nil, errors.New("database error"))
 
-		_, err := service.GetPins(page, pageSize)
+		_, err := service.GetPins(page, pageSize, domain.NSFWModeHide)
 		assert.Error(t, err)
 	})
 }
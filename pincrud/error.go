@@ -3,9 +3,21 @@ package pincrud
 import "errors"
 
 var (
-	ErrForbidden        = errors.New("access to private pin is forbidden")
-	ErrPinNotFound      = errors.New("no pin with given id")
-	ErrUntracked        = errors.New("untracked service error")
-	ErrNoFieldsToUpdate = errors.New("no fields to update")
-	ErrInvalidImageExt  = errors.New("invalid image extension")
+	ErrForbidden         = errors.New("access to private pin is forbidden")
+	ErrPinNotFound       = errors.New("no pin with given id")
+	ErrUntracked         = errors.New("untracked service error")
+	ErrNoFieldsToUpdate  = errors.New("no fields to update")
+	ErrInvalidImageExt   = errors.New("invalid image extension")
+	ErrInvalidPublishAt  = errors.New("publish time must be in the future and not later than a year")
+	ErrNotScheduled      = errors.New("flow is not scheduled")
+	ErrNotDraft          = errors.New("flow is not a draft")
+	ErrDraftPrivacy      = errors.New("draft must be published before making it public")
+	ErrRevisionNotFound  = errors.New("no revision with given id")
+	ErrInvalidMediaCount = errors.New("flow must contain from 1 to 10 media items")
+	ErrVideoUnsupported  = errors.New("video uploads are not available")
+	ErrInvalidVideo      = errors.New("invalid video file")
+	ErrVideoTooLong      = errors.New("video is too long")
+	ErrInvalidLink       = errors.New("link must be an absolute http or https url")
+	ErrLinkFetch         = errors.New("failed to fetch the link")
+	ErrNoPreviewImage    = errors.New("page has no usable preview image")
 )
@@ -0,0 +1,235 @@
+package pincrud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/opengraph"
+)
+
+const (
+	maxLinkLength    = 2048
+	maxPageSize      = 2 << 20  // 2 Мбайт, метаданные лежат в начале страницы
+	maxPreviewImage  = 10 << 20 // как у обычной загрузки
+	maxTitleLength   = 128
+	maxDescLength    = 1024
+	linkFetchTimeout = 10 * time.Second
+)
+
+// расширения для картинок превью, Save принимает файл только по расширению
+var previewExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+	"image/bmp":  ".bmp",
+}
+
+var errPrivateAddress = errors.New("address is not public")
+
+// newLinkClient ходит только на публичные адреса, чтобы через превью
+// ссылок нельзя было достучаться до внутренних сервисов
+func newLinkClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+				ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() {
+				return errPrivateAddress
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: linkFetchTimeout,
+		Transport: &http.Transport{
+			Proxy:       nil,
+			DialContext: dialer.DialContext,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("too many redirects")
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return ErrInvalidLink
+			}
+			return nil
+		},
+	}
+}
+
+// normalizeLink проверяет ссылку и возвращает её вместе с доменом источника
+func normalizeLink(raw string) (string, string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || len(raw) > maxLinkLength {
+		return "", "", ErrInvalidLink
+	}
+
+	link, err := url.Parse(raw)
+	if err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Hostname() == "" {
+		return "", "", ErrInvalidLink
+	}
+	link.Fragment = ""
+
+	return link.String(), domain.NormalizeSourceDomain(link.Hostname()), nil
+}
+
+// CreatePinFromURL создаёт флоу по странице: берёт OpenGraph-заголовок,
+// описание и картинку и сохраняет их как обычный флоу со ссылкой на источник
+func (s *PinCRUDService) CreatePinFromURL(ctx context.Context, req domain.PinFromURL, userID uint64) (uint64, error) {
+	link, _, err := normalizeLink(req.URL)
+	if err != nil {
+		return 0, err
+	}
+
+	meta, err := s.fetchPreview(ctx, link)
+	if err != nil {
+		return 0, err
+	}
+	if meta.Image == "" {
+		return 0, ErrNoPreviewImage
+	}
+
+	upload, cleanup, err := s.downloadPreviewImage(ctx, meta.Image)
+	if err != nil {
+		return 0, err
+	}
+	defer cleanup()
+
+	data := domain.PinDataCreate{
+		Header:      req.Header,
+		Description: req.Description,
+		IsPrivate:   req.IsPrivate,
+		Link:        link,
+	}
+	if data.Header == "" {
+		data.Header = truncate(meta.Title, maxTitleLength)
+	}
+	if data.Description == "" {
+		data.Description = truncate(meta.Description, maxDescLength)
+	}
+
+	pinID, _, err := s.CreatePin(ctx, data, []MediaUpload{upload}, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return pinID, nil
+}
+
+func (s *PinCRUDService) fetchPreview(ctx context.Context, link string) (opengraph.Meta, error) {
+	resp, err := s.get(ctx, link)
+	if err != nil {
+		return opengraph.Meta{}, err
+	}
+	defer resp.Body.Close()
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return opengraph.Meta{}, fmt.Errorf("%w: unexpected content type %q", ErrLinkFetch, mediaType)
+	}
+
+	// после редиректов относительные ссылки считаются от итоговой страницы
+	meta, err := opengraph.Parse(io.LimitReader(resp.Body, maxPageSize), resp.Request.URL)
+	if err != nil {
+		return opengraph.Meta{}, fmt.Errorf("%w: %v", ErrLinkFetch, err)
+	}
+
+	return meta, nil
+}
+
+// downloadPreviewImage сохраняет картинку во временный файл, чтобы
+// передать её в CreatePin так же, как файл из формы
+func (s *PinCRUDService) downloadPreviewImage(ctx context.Context, imageURL string) (MediaUpload, func(), error) {
+	resp, err := s.get(ctx, imageURL)
+	if err != nil {
+		return MediaUpload{}, nil, err
+	}
+	defer resp.Body.Close()
+
+	contentType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	ext, ok := previewExtensions[contentType]
+	if !ok {
+		return MediaUpload{}, nil, ErrNoPreviewImage
+	}
+
+	file, err := os.CreateTemp("", "flow-preview-*"+ext)
+	if err != nil {
+		return MediaUpload{}, nil, err
+	}
+	cleanup := func() {
+		file.Close()
+		os.Remove(file.Name())
+	}
+
+	size, err := io.Copy(file, io.LimitReader(resp.Body, maxPreviewImage+1))
+	if err == nil && size > maxPreviewImage {
+		err = ErrNoPreviewImage
+	}
+	if err == nil {
+		_, err = file.Seek(0, 0)
+	}
+	if err != nil {
+		cleanup()
+		return MediaUpload{}, nil, err
+	}
+
+	header := &multipart.FileHeader{
+		Filename: "preview" + ext,
+		Size:     size,
+		Header:   textproto.MIMEHeader{"Content-Type": {contentType}},
+	}
+
+	return MediaUpload{
+		File:        file,
+		Header:      header,
+		ContentType: contentType,
+	}, cleanup, nil
+}
+
+func (s *PinCRUDService) get(ctx context.Context, link string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return nil, ErrInvalidLink
+	}
+	req.Header.Set("User-Agent", "FlowPreviewBot/1.0")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLinkFetch, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: status %d", ErrLinkFetch, resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+func truncate(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+
+	return string([]rune(s)[:limit])
+}
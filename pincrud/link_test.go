@@ -0,0 +1,109 @@
+package pincrud
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	mock_pincrud "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/pincrud/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newPreviewServer(t *testing.T, page string) *httptest.Server {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(page))
+	})
+	mux.HandleFunc("/cover.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(buf.Bytes())
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCreatePinFromURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newPreviewServer(t, `<html><head>
+		<meta property="og:title" content="Article">
+		<meta property="og:description" content="About things">
+		<meta property="og:image" content="/cover.png">
+	</head></html>`)
+
+	mockRepo := mock_pincrud.NewMockPinRepository(ctrl)
+	mockBoards := mock_pincrud.NewMockBoardRepository(ctrl)
+	mockFiles := mock_pincrud.NewMockFileRepository(ctrl)
+
+	service := NewPinCRUDService(mockRepo, mockBoards, mockFiles, "")
+	service.httpClient = server.Client()
+
+	mockFiles.EXPECT().Save(gomock.Any(), gomock.Any()).Return("cover.png", nil)
+	mockRepo.EXPECT().CreatePin(gomock.Any(), gomock.Any(), "cover.png", uint64(1)).
+		DoAndReturn(func(_ context.Context, data domain.PinDataCreate, _ string, _ uint64) (uint64, error) {
+			assert.Equal(t, "Article", data.Header)
+			assert.Equal(t, "About things", data.Description)
+			assert.Equal(t, server.URL+"/article", data.Link)
+			assert.Equal(t, "127.0.0.1", data.SourceDomain)
+			assert.Equal(t, 4, data.Width)
+			assert.Len(t, data.Media, 1)
+			return 7, nil
+		})
+	mockBoards.EXPECT().AddToSavedBoard(gomock.Any(), 1, 7).Return(nil)
+
+	pinID, err := service.CreatePinFromURL(context.Background(), domain.PinFromURL{URL: server.URL + "/article#top"}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), pinID)
+}
+
+func TestCreatePinFromURL_Errors(t *testing.T) {
+	server := newPreviewServer(t, `<html><head><title>No image</title></head></html>`)
+
+	service := NewPinCRUDService(nil, nil, nil, "")
+	service.httpClient = server.Client()
+
+	tests := []struct {
+		name string
+		url  string
+		err  error
+	}{
+		{"Сценарий: не http", "ftp://example.com/file", ErrInvalidLink},
+		{"Сценарий: нет картинки", server.URL + "/article", ErrNoPreviewImage},
+		{"Сценарий: страница не найдена", server.URL + "/missing", ErrLinkFetch},
+		{"Сценарий: не html", server.URL + "/cover.png", ErrLinkFetch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CreatePinFromURL(context.Background(), domain.PinFromURL{URL: tt.url}, 1)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestDefaultLinkClient_BlocksPrivateAddresses(t *testing.T) {
+	server := newPreviewServer(t, "")
+
+	service := NewPinCRUDService(nil, nil, nil, "")
+
+	_, err := service.CreatePinFromURL(context.Background(), domain.PinFromURL{URL: server.URL + "/article"}, 1)
+	assert.ErrorIs(t, err, ErrLinkFetch)
+}
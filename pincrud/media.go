@@ -0,0 +1,172 @@
+package pincrud
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"log"
+	"mime/multipart"
+	"os"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	imageUtil "github.com/go-park-mail-ru/2025_1_SuperChips/utils/image"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/video"
+	"github.com/google/uuid"
+)
+
+type MediaUpload struct {
+	File        multipart.File
+	Header      *multipart.FileHeader
+	ContentType string
+}
+
+func (u MediaUpload) IsVideo() bool {
+	return strings.HasPrefix(u.ContentType, "video/")
+}
+
+// savedMedia — сохранённый элемент и картинка, по которой считаются
+// размеры и цвета обложки
+type savedMedia struct {
+	item  domain.FlowMedia
+	cover image.Image
+}
+
+// saveMedia сохраняет все элементы флоу. При ошибке уже сохранённые
+// файлы удаляются, чтобы не копить мусор в хранилище.
+func (s *PinCRUDService) saveMedia(ctx context.Context, uploads []MediaUpload) ([]savedMedia, error) {
+	if len(uploads) == 0 || len(uploads) > domain.MaxFlowMedia {
+		return nil, ErrInvalidMediaCount
+	}
+
+	var files []string
+	media := make([]savedMedia, 0, len(uploads))
+	for _, upload := range uploads {
+		var saved savedMedia
+		var err error
+		if upload.IsVideo() {
+			saved, err = s.saveVideo(ctx, upload, &files)
+		} else {
+			saved, err = s.saveImage(upload, &files)
+		}
+		if err != nil {
+			s.deleteFiles(files)
+			return nil, err
+		}
+		media = append(media, saved)
+	}
+
+	return media, nil
+}
+
+func (s *PinCRUDService) saveImage(upload MediaUpload, files *[]string) (savedMedia, error) {
+	imgName, err := s.imgStrg.Save(upload.File, upload.Header)
+	if err != nil {
+		return savedMedia{}, err
+	}
+	*files = append(*files, imgName)
+
+	if _, err := upload.File.Seek(0, 0); err != nil {
+		return savedMedia{}, err
+	}
+
+	img, _, err := image.Decode(upload.File)
+	if err != nil {
+		return savedMedia{}, err
+	}
+
+	width, height, err := imageUtil.GetImageDimensions(img)
+	if err != nil {
+		return savedMedia{}, err
+	}
+
+	return savedMedia{
+		item: domain.FlowMedia{
+			Type:   domain.MediaTypeImage,
+			URL:    imgName,
+			Width:  width,
+			Height: height,
+		},
+		cover: img,
+	}, nil
+}
+
+func (s *PinCRUDService) saveVideo(ctx context.Context, upload MediaUpload, files *[]string) (savedMedia, error) {
+	// без ffmpeg не получить обложку, а без неё видео не показать в ленте
+	if s.ffmpegPath == "" {
+		return savedMedia{}, ErrVideoUnsupported
+	}
+
+	// ffprobe читает файл с диска: moov в MP4 бывает в конце и через pipe недоступен.
+	// при ошибке сохранённый файл удалит вызывающий код по списку files
+	videoName, err := s.imgStrg.SaveVideo(upload.File, upload.Header)
+	if err != nil {
+		return savedMedia{}, err
+	}
+	*files = append(*files, videoName)
+
+	info, err := video.Probe(ctx, video.ProbePath(s.ffmpegPath), s.imgStrg.Path(videoName))
+	if err != nil {
+		return savedMedia{}, fmt.Errorf("%w: %v", ErrInvalidVideo, err)
+	}
+	if info.Duration > domain.MaxVideoDuration {
+		return savedMedia{}, ErrVideoTooLong
+	}
+
+	posterName := uuid.New().String() + ".jpg"
+	err = video.ExtractPoster(ctx, s.ffmpegPath, s.imgStrg.Path(videoName), s.imgStrg.Path(posterName), video.PosterOffset(info.Duration))
+	if err != nil {
+		return savedMedia{}, fmt.Errorf("%w: %v", ErrInvalidVideo, err)
+	}
+	*files = append(*files, posterName)
+
+	poster, err := os.Open(s.imgStrg.Path(posterName))
+	if err != nil {
+		return savedMedia{}, err
+	}
+	defer poster.Close()
+
+	img, _, err := image.Decode(poster)
+	if err != nil {
+		return savedMedia{}, err
+	}
+
+	return savedMedia{
+		item: domain.FlowMedia{
+			Type:       domain.MediaTypeVideo,
+			URL:        videoName,
+			Width:      info.Width,
+			Height:     info.Height,
+			DurationMs: int(info.Duration.Milliseconds()),
+			PosterURL:  posterName,
+		},
+		cover: img,
+	}, nil
+}
+
+func (s *PinCRUDService) deleteFiles(files []string) {
+	for _, name := range files {
+		if err := s.imgStrg.Delete(name); err != nil {
+			log.Printf("failed to delete media file %s: %v", name, err)
+		}
+	}
+}
+
+func (s *PinCRUDService) deleteSaved(saved []savedMedia) {
+	var files []string
+	for _, m := range saved {
+		files = append(files, m.item.URL)
+		if m.item.PosterURL != "" {
+			files = append(files, m.item.PosterURL)
+		}
+	}
+	s.deleteFiles(files)
+}
+
+// coverName — файл, который показывается вместо флоу там, где нужна одна картинка
+func coverName(item domain.FlowMedia) string {
+	if item.Type == domain.MediaTypeVideo {
+		return item.PosterURL
+	}
+	return item.URL
+}
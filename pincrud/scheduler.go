@@ -0,0 +1,75 @@
+package pincrud
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+type PublishRepository interface {
+	PublishDuePins(ctx context.Context, limit int) ([]uint64, error)
+}
+
+type SchedulerConfig struct {
+	PollInterval time.Duration // точность публикации
+	BatchSize    int           // сколько флоу публиковать за один запрос
+}
+
+func DefaultSchedulerConfig() SchedulerConfig {
+	return SchedulerConfig{
+		PollInterval: 10 * time.Second,
+		BatchSize:    100,
+	}
+}
+
+type PublishScheduler struct {
+	repo PublishRepository
+	cfg  SchedulerConfig
+}
+
+func NewPublishScheduler(repo PublishRepository, cfg SchedulerConfig) *PublishScheduler {
+	return &PublishScheduler{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// Run публикует отложенные флоу до отмены контекста. Время публикации
+// хранится в базе, поэтому пропущенные за время простоя флоу будут
+// опубликованы сразу после запуска.
+func (s *PublishScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			published, err := s.PublishDue(ctx)
+			if err != nil {
+				log.Printf("publish scheduler error: %v", err)
+				break
+			}
+			if published < s.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PublishScheduler) PublishDue(ctx context.Context) (int, error) {
+	ids, err := s.repo.PublishDuePins(ctx, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(ids) > 0 {
+		log.Printf("published scheduled flows: %v", ids)
+	}
+
+	return len(ids), nil
+}
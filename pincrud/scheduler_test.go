@@ -0,0 +1,70 @@
+package pincrud
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_scheduler "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/pincrud/scheduler"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPublishDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_scheduler.NewMockPublishRepository(ctrl)
+	scheduler := NewPublishScheduler(mockRepo, SchedulerConfig{BatchSize: 2})
+
+	mockRepo.EXPECT().PublishDuePins(gomock.Any(), 2).Return([]uint64{3, 4}, nil)
+
+	published, err := scheduler.PublishDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, published)
+}
+
+func TestPublishDue_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_scheduler.NewMockPublishRepository(ctrl)
+	scheduler := NewPublishScheduler(mockRepo, DefaultSchedulerConfig())
+
+	mockRepo.EXPECT().PublishDuePins(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+	_, err := scheduler.PublishDue(context.Background())
+	assert.Error(t, err)
+}
+
+func TestRun_StopsOnCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_scheduler.NewMockPublishRepository(ctrl)
+	scheduler := NewPublishScheduler(mockRepo, SchedulerConfig{PollInterval: time.Hour, BatchSize: 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// полная пачка обрабатывается сразу, не дожидаясь следующего тика
+	gomock.InOrder(
+		mockRepo.EXPECT().PublishDuePins(gomock.Any(), 1).Return([]uint64{1}, nil),
+		mockRepo.EXPECT().PublishDuePins(gomock.Any(), 1).DoAndReturn(func(context.Context, int) ([]uint64, error) {
+			cancel()
+			return nil, nil
+		}),
+	)
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop")
+	}
+}
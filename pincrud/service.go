@@ -2,22 +2,38 @@ package pincrud
 
 import (
 	"context"
-	"image"
+	"errors"
 	"log"
 	"mime/multipart"
+	"net/http"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/hashtag"
 	imageUtil "github.com/go-park-mail-ru/2025_1_SuperChips/utils/image"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/textdiff"
 )
 
 const UnauthorizedID = 0
 
+// насколько далеко вперёд можно отложить публикацию
+const maxScheduleAhead = 365 * 24 * time.Hour
+
 type PinRepository interface {
 	GetPin(ctx context.Context, pinID, userID uint64) (domain.PinData, uint64, error)
 	DeletePin(ctx context.Context, pinID uint64, userID uint64) error
 	UpdatePin(ctx context.Context, patch domain.PinDataUpdate, userID uint64) error
 	CreatePin(ctx context.Context, data domain.PinDataCreate, imgName string, userID uint64) (uint64, error)
+	SetUserTags(ctx context.Context, pinID uint64, tags []string) error
 	GetPinCleanMediaURL(ctx context.Context, pinID uint64) (string, uint64, error)
+	GetFlowMediaFiles(ctx context.Context, pinID uint64) ([]string, error)
+	GetScheduledPins(ctx context.Context, userID uint64, page, pageSize int) ([]domain.PinData, error)
+	ReschedulePin(ctx context.Context, pinID, userID uint64, publishAt time.Time) error
+	CancelSchedule(ctx context.Context, pinID, userID uint64) error
+	GetDrafts(ctx context.Context, userID uint64, page, pageSize int) ([]domain.PinData, error)
+	PublishDraft(ctx context.Context, pinID, userID uint64) error
+	GetRevisions(ctx context.Context, pinID uint64, limit int) ([]domain.FlowRevision, error)
+	GetRevision(ctx context.Context, pinID uint64, revisionID int) (domain.FlowRevision, error)
 }
 
 type BoardRepository interface {
@@ -26,20 +42,30 @@ type BoardRepository interface {
 
 type FileRepository interface {
 	Save(file multipart.File, header *multipart.FileHeader) (string, error)
+	SaveVideo(file multipart.File, header *multipart.FileHeader) (string, error)
 	Delete(imgName string) error
+	Path(name string) string
 }
 
 type PinCRUDService struct {
-	pinRepo   PinRepository
-	boardRepo BoardRepository
-	imgStrg   FileRepository
+	pinRepo    PinRepository
+	boardRepo  BoardRepository
+	imgStrg    FileRepository
+	ffmpegPath string
+	// клиент для превью ссылок, в тестах подменяется
+	httpClient *http.Client
+	now        func() time.Time
 }
 
-func NewPinCRUDService(p PinRepository, b BoardRepository, imgStrg FileRepository) *PinCRUDService {
+// пустой ffmpegPath отключает загрузку видео
+func NewPinCRUDService(p PinRepository, b BoardRepository, imgStrg FileRepository, ffmpegPath string) *PinCRUDService {
 	return &PinCRUDService{
-		pinRepo:   p,
-		boardRepo: b,
-		imgStrg:   imgStrg,
+		pinRepo:    p,
+		boardRepo:  b,
+		imgStrg:    imgStrg,
+		ffmpegPath: ffmpegPath,
+		httpClient: newLinkClient(),
+		now:        time.Now,
 	}
 }
 
@@ -69,6 +95,10 @@ func (s *PinCRUDService) DeletePin(ctx context.Context, pinID uint64, userID uin
 	if authorID != userID {
 		return ErrForbidden
 	}
+	files, err := s.pinRepo.GetFlowMediaFiles(ctx, pinID)
+	if err != nil {
+		return err
+	}
 	err = s.pinRepo.DeletePin(ctx, pinID, userID)
 	if err != nil {
 		return err
@@ -77,55 +107,108 @@ func (s *PinCRUDService) DeletePin(ctx context.Context, pinID uint64, userID uin
 	if err != nil {
 		return err
 	}
+	// обложка флоу совпадает с одним из элементов и уже удалена
+	for _, name := range files {
+		if name == mediaURL {
+			continue
+		}
+		if err := s.imgStrg.Delete(name); err != nil {
+			log.Printf("failed to delete media file %s: %v", name, err)
+		}
+	}
 	return nil
 }
 
 func (s *PinCRUDService) UpdatePin(ctx context.Context, patch domain.PinDataUpdate, userID uint64) error {
-	_, authorID, err := s.pinRepo.GetPin(ctx, *patch.FlowID, userID)
+	pin, authorID, err := s.pinRepo.GetPin(ctx, *patch.FlowID, userID)
 	if err != nil {
 		return err
 	}
 	if authorID != userID {
 		return ErrForbidden
 	}
+	if pin.IsDraft && patch.IsPrivate != nil && !*patch.IsPrivate {
+		return ErrDraftPrivacy
+	}
+	if patch.Link != nil && *patch.Link != "" {
+		link, sourceDomain, err := normalizeLink(*patch.Link)
+		if err != nil {
+			return err
+		}
+		patch.Link = &link
+		patch.SourceDomain = sourceDomain
+	}
 	err = s.pinRepo.UpdatePin(ctx, patch, userID)
 	if err != nil {
 		return err
 	}
+	if err := s.syncUserTags(ctx, pin, patch); err != nil {
+		return err
+	}
+	// явная смена приватности отменяет отложенную публикацию
+	if patch.IsPrivate != nil && pin.PublishAt != nil {
+		err = s.pinRepo.CancelSchedule(ctx, *patch.FlowID, userID)
+		if err != nil && !errors.Is(err, ErrNotScheduled) {
+			return err
+		}
+	}
 	return nil
 }
 
-func (s *PinCRUDService) CreatePin(ctx context.Context, data domain.PinDataCreate, file multipart.File, header *multipart.FileHeader, extension string, userID uint64) (uint64, string, error) {
-	imgName, err := s.imgStrg.Save(file, header)
-	if err != nil {
-		return 0, "", err
+func (s *PinCRUDService) CreatePin(ctx context.Context, data domain.PinDataCreate, uploads []MediaUpload, userID uint64) (uint64, string, error) {
+	if data.IsDraft {
+		// черновик публикуется только вручную
+		if data.PublishAt != nil {
+			return 0, "", ErrInvalidPublishAt
+		}
+		data.IsPrivate = true
 	}
 
-	if _, err := file.Seek(0, 0); err != nil {
-        return 0, "", err
-    }
+	if data.PublishAt != nil {
+		// приватный флоу публиковать нечего
+		if data.IsPrivate || !s.validPublishTime(*data.PublishAt) {
+			return 0, "", ErrInvalidPublishAt
+		}
+		// до публикации флоу виден только автору
+		data.IsPrivate = true
+	}
+
+	if data.Link != "" {
+		link, sourceDomain, err := normalizeLink(data.Link)
+		if err != nil {
+			return 0, "", err
+		}
+		data.Link = link
+		data.SourceDomain = sourceDomain
+	}
 
-	img, _, err := image.Decode(file)
+	data.Tags = hashtag.Extract(data.Header + " " + data.Description)
+
+	saved, err := s.saveMedia(ctx, uploads)
 	if err != nil {
 		return 0, "", err
 	}
 
-	width, height, err := imageUtil.GetImageDimensions(img)
+	// обложкой флоу служит первый элемент, для видео — его постер
+	imgName := coverName(saved[0].item)
+	width, height, err := imageUtil.GetImageDimensions(saved[0].cover)
 	if err != nil {
+		s.deleteSaved(saved)
 		return 0, "", err
 	}
 
 	data.Width = width
 	data.Height = height
+	data.Colors = imageUtil.GetImageMainColors(saved[0].cover)
 
-	colors := imageUtil.GetImageMainColors(img)
-
-	log.Printf("usecase colors len: %v", len(colors))
-
-	data.Colors = colors
+	data.Media = make([]domain.FlowMedia, 0, len(saved))
+	for _, m := range saved {
+		data.Media = append(data.Media, m.item)
+	}
 
 	pinID, err := s.pinRepo.CreatePin(ctx, data, imgName, userID)
 	if err != nil {
+		s.deleteSaved(saved)
 		return 0, "", err
 	}
 
@@ -135,3 +218,146 @@ func (s *PinCRUDService) CreatePin(ctx context.Context, data domain.PinDataCreat
 
 	return pinID, imgName, nil
 }
+
+func (s *PinCRUDService) GetScheduledPins(ctx context.Context, userID uint64, page, pageSize int) ([]domain.PinData, error) {
+	return s.pinRepo.GetScheduledPins(ctx, userID, page, pageSize)
+}
+
+func (s *PinCRUDService) ReschedulePin(ctx context.Context, pinID, userID uint64, publishAt time.Time) error {
+	if !s.validPublishTime(publishAt) {
+		return ErrInvalidPublishAt
+	}
+
+	if err := s.checkScheduledAuthor(ctx, pinID, userID); err != nil {
+		return err
+	}
+
+	return s.pinRepo.ReschedulePin(ctx, pinID, userID, publishAt)
+}
+
+func (s *PinCRUDService) CancelSchedule(ctx context.Context, pinID, userID uint64) error {
+	if err := s.checkScheduledAuthor(ctx, pinID, userID); err != nil {
+		return err
+	}
+
+	return s.pinRepo.CancelSchedule(ctx, pinID, userID)
+}
+
+func (s *PinCRUDService) checkScheduledAuthor(ctx context.Context, pinID, userID uint64) error {
+	pin, err := s.getAuthorPin(ctx, pinID, userID)
+	if err != nil {
+		return err
+	}
+	if pin.PublishAt == nil {
+		return ErrNotScheduled
+	}
+
+	return nil
+}
+
+func (s *PinCRUDService) validPublishTime(publishAt time.Time) bool {
+	now := s.now()
+	return publishAt.After(now) && !publishAt.After(now.Add(maxScheduleAhead))
+}
+
+func (s *PinCRUDService) GetDrafts(ctx context.Context, userID uint64, page, pageSize int) ([]domain.PinData, error) {
+	return s.pinRepo.GetDrafts(ctx, userID, page, pageSize)
+}
+
+func (s *PinCRUDService) PublishDraft(ctx context.Context, pinID, userID uint64) error {
+	pin, err := s.getAuthorPin(ctx, pinID, userID)
+	if err != nil {
+		return err
+	}
+	if !pin.IsDraft {
+		return ErrNotDraft
+	}
+
+	return s.pinRepo.PublishDraft(ctx, pinID, userID)
+}
+
+// GetRevisions возвращает историю правок. Изменения каждой ревизии
+// считаются относительно следующей за ней или текущего текста флоу.
+func (s *PinCRUDService) GetRevisions(ctx context.Context, pinID, userID uint64) ([]domain.FlowRevision, error) {
+	pin, err := s.getAuthorPin(ctx, pinID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.pinRepo.GetRevisions(ctx, pinID, domain.MaxFlowRevisions)
+	if err != nil {
+		return nil, err
+	}
+
+	header, description := pin.Header, pin.Description
+	for i := range revisions {
+		revisions[i].HeaderDiff = diffText(revisions[i].Header, header)
+		revisions[i].DescriptionDiff = diffText(revisions[i].Description, description)
+		header, description = revisions[i].Header, revisions[i].Description
+	}
+
+	return revisions, nil
+}
+
+// RevertRevision возвращает текст флоу к ревизии. Текущий текст при этом
+// сам сохраняется ревизией, так что откат тоже можно отменить.
+func (s *PinCRUDService) RevertRevision(ctx context.Context, pinID uint64, revisionID int, userID uint64) error {
+	pin, err := s.getAuthorPin(ctx, pinID, userID)
+	if err != nil {
+		return err
+	}
+
+	revision, err := s.pinRepo.GetRevision(ctx, pinID, revisionID)
+	if err != nil {
+		return err
+	}
+
+	patch := domain.PinDataUpdate{
+		FlowID:      &pinID,
+		Header:      &revision.Header,
+		Description: &revision.Description,
+	}
+
+	if err := s.pinRepo.UpdatePin(ctx, patch, userID); err != nil {
+		return err
+	}
+
+	return s.syncUserTags(ctx, pin, patch)
+}
+
+// syncUserTags пересобирает хэштеги автора, если правка затронула текст флоу
+func (s *PinCRUDService) syncUserTags(ctx context.Context, pin domain.PinData, patch domain.PinDataUpdate) error {
+	if patch.Header == nil && patch.Description == nil {
+		return nil
+	}
+
+	header, description := pin.Header, pin.Description
+	if patch.Header != nil {
+		header = *patch.Header
+	}
+	if patch.Description != nil {
+		description = *patch.Description
+	}
+
+	return s.pinRepo.SetUserTags(ctx, *patch.FlowID, hashtag.Extract(header+" "+description))
+}
+
+func (s *PinCRUDService) getAuthorPin(ctx context.Context, pinID, userID uint64) (domain.PinData, error) {
+	pin, authorID, err := s.pinRepo.GetPin(ctx, pinID, userID)
+	if err != nil {
+		return domain.PinData{}, err
+	}
+	if authorID != userID {
+		return domain.PinData{}, ErrForbidden
+	}
+
+	return pin, nil
+}
+
+func diffText(old, new string) []domain.TextChange {
+	changes := []domain.TextChange{}
+	for _, c := range textdiff.Words(old, new) {
+		changes = append(changes, domain.TextChange{Op: c.Op, Text: c.Text})
+	}
+	return changes
+}
@@ -0,0 +1,252 @@
+package pincrud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	mock_pincrud "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/pincrud/repository"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/textdiff"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var testNow = time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestPinService(repo PinRepository) *PinCRUDService {
+	service := NewPinCRUDService(repo, nil, nil, "")
+	service.now = func() time.Time {
+		return testNow
+	}
+
+	return service
+}
+
+func TestReschedulePin(t *testing.T) {
+	publishAt := testNow.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		publishAt time.Time
+		pin       domain.PinData
+		authorID  uint64
+		expectSet bool
+		expected  error
+	}{
+		{"Сценарий: перенос", testNow.Add(2 * time.Hour), domain.PinData{PublishAt: &publishAt}, 1, true, nil},
+		{"Сценарий: время в прошлом", testNow.Add(-time.Minute), domain.PinData{}, 1, false, ErrInvalidPublishAt},
+		{"Сценарий: больше года вперёд", testNow.Add(maxScheduleAhead + time.Minute), domain.PinData{}, 1, false, ErrInvalidPublishAt},
+		{"Сценарий: чужой флоу", testNow.Add(time.Hour), domain.PinData{PublishAt: &publishAt}, 2, false, ErrForbidden},
+		{"Сценарий: флоу уже опубликован", testNow.Add(time.Hour), domain.PinData{}, 1, false, ErrNotScheduled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock_pincrud.NewMockPinRepository(ctrl)
+			service := newTestPinService(mockRepo)
+
+			if tt.expected != ErrInvalidPublishAt {
+				mockRepo.EXPECT().GetPin(gomock.Any(), uint64(5), uint64(1)).Return(tt.pin, tt.authorID, nil)
+			}
+			if tt.expectSet {
+				mockRepo.EXPECT().ReschedulePin(gomock.Any(), uint64(5), uint64(1), tt.publishAt).Return(nil)
+			}
+
+			err := service.ReschedulePin(context.Background(), 5, 1, tt.publishAt)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestUpdatePin_CancelsSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_pincrud.NewMockPinRepository(ctrl)
+	service := newTestPinService(mockRepo)
+
+	flowID := uint64(5)
+	isPrivate := false
+	publishAt := testNow.Add(time.Hour)
+	patch := domain.PinDataUpdate{FlowID: &flowID, IsPrivate: &isPrivate}
+
+	mockRepo.EXPECT().GetPin(gomock.Any(), flowID, uint64(1)).Return(domain.PinData{PublishAt: &publishAt}, uint64(1), nil)
+	mockRepo.EXPECT().UpdatePin(gomock.Any(), patch, uint64(1)).Return(nil)
+	mockRepo.EXPECT().CancelSchedule(gomock.Any(), flowID, uint64(1)).Return(nil)
+
+	assert.NoError(t, service.UpdatePin(context.Background(), patch, 1))
+}
+
+func TestCreatePin_InvalidPublishAt(t *testing.T) {
+	service := newTestPinService(nil)
+
+	past := testNow.Add(-time.Hour)
+	future := testNow.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		data domain.PinDataCreate
+	}{
+		{"Сценарий: время в прошлом", domain.PinDataCreate{PublishAt: &past}},
+		{"Сценарий: приватный флоу", domain.PinDataCreate{PublishAt: &future, IsPrivate: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := service.CreatePin(context.Background(), tt.data, nil, 1)
+			assert.ErrorIs(t, err, ErrInvalidPublishAt)
+		})
+	}
+}
+
+func TestCreatePin_DraftScheduled(t *testing.T) {
+	service := newTestPinService(nil)
+
+	future := testNow.Add(time.Hour)
+	data := domain.PinDataCreate{IsDraft: true, PublishAt: &future}
+
+	_, _, err := service.CreatePin(context.Background(), data, nil, 1)
+	assert.ErrorIs(t, err, ErrInvalidPublishAt)
+}
+
+func TestCreatePin_InvalidMedia(t *testing.T) {
+	service := newTestPinService(nil)
+
+	tests := []struct {
+		name    string
+		uploads []MediaUpload
+		err     error
+	}{
+		{"Сценарий: без файлов", nil, ErrInvalidMediaCount},
+		{"Сценарий: слишком много файлов", make([]MediaUpload, domain.MaxFlowMedia+1), ErrInvalidMediaCount},
+		{"Сценарий: видео без ffmpeg", []MediaUpload{{ContentType: "video/mp4"}}, ErrVideoUnsupported},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := service.CreatePin(context.Background(), domain.PinDataCreate{}, tt.uploads, 1)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestUpdatePin_DraftPrivacy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_pincrud.NewMockPinRepository(ctrl)
+	service := newTestPinService(mockRepo)
+
+	flowID := uint64(5)
+	isPrivate := false
+	patch := domain.PinDataUpdate{FlowID: &flowID, IsPrivate: &isPrivate}
+
+	mockRepo.EXPECT().GetPin(gomock.Any(), flowID, uint64(1)).Return(domain.PinData{IsDraft: true}, uint64(1), nil)
+
+	assert.ErrorIs(t, service.UpdatePin(context.Background(), patch, 1), ErrDraftPrivacy)
+}
+
+func TestPublishDraft(t *testing.T) {
+	tests := []struct {
+		name       string
+		pin        domain.PinData
+		authorID   uint64
+		expectPubl bool
+		expected   error
+	}{
+		{"Сценарий: публикация черновика", domain.PinData{IsDraft: true}, 1, true, nil},
+		{"Сценарий: уже опубликован", domain.PinData{}, 1, false, ErrNotDraft},
+		{"Сценарий: чужой черновик", domain.PinData{IsDraft: true}, 2, false, ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock_pincrud.NewMockPinRepository(ctrl)
+			service := newTestPinService(mockRepo)
+
+			mockRepo.EXPECT().GetPin(gomock.Any(), uint64(5), uint64(1)).Return(tt.pin, tt.authorID, nil)
+			if tt.expectPubl {
+				mockRepo.EXPECT().PublishDraft(gomock.Any(), uint64(5), uint64(1)).Return(nil)
+			}
+
+			assert.ErrorIs(t, service.PublishDraft(context.Background(), 5, 1), tt.expected)
+		})
+	}
+}
+
+func TestGetRevisions_Diffs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_pincrud.NewMockPinRepository(ctrl)
+	service := newTestPinService(mockRepo)
+
+	current := domain.PinData{Header: "закат над морем", Description: "фото"}
+	revisions := []domain.FlowRevision{
+		{ID: 2, Header: "закат", Description: "фото"},
+		{ID: 1, Header: "рассвет", Description: ""},
+	}
+
+	mockRepo.EXPECT().GetPin(gomock.Any(), uint64(5), uint64(1)).Return(current, uint64(1), nil)
+	mockRepo.EXPECT().GetRevisions(gomock.Any(), uint64(5), domain.MaxFlowRevisions).Return(revisions, nil)
+
+	result, err := service.GetRevisions(context.Background(), 5, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.TextChange{
+		{Op: textdiff.OpEqual, Text: "закат"},
+		{Op: textdiff.OpInsert, Text: " над морем"},
+	}, result[0].HeaderDiff)
+	assert.Equal(t, []domain.TextChange{{Op: textdiff.OpEqual, Text: "фото"}}, result[0].DescriptionDiff)
+	// старейшая ревизия сравнивается со следующей, а не с текущим текстом
+	assert.Equal(t, []domain.TextChange{
+		{Op: textdiff.OpDelete, Text: "рассвет"},
+		{Op: textdiff.OpInsert, Text: "закат"},
+	}, result[1].HeaderDiff)
+}
+
+func TestRevertRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_pincrud.NewMockPinRepository(ctrl)
+	service := newTestPinService(mockRepo)
+
+	flowID := uint64(5)
+	revision := domain.FlowRevision{ID: 3, Header: "закат", Description: "фото"}
+
+	mockRepo.EXPECT().GetPin(gomock.Any(), flowID, uint64(1)).Return(domain.PinData{}, uint64(1), nil)
+	mockRepo.EXPECT().GetRevision(gomock.Any(), flowID, 3).Return(revision, nil)
+	mockRepo.EXPECT().UpdatePin(gomock.Any(), domain.PinDataUpdate{
+		FlowID:      &flowID,
+		Header:      &revision.Header,
+		Description: &revision.Description,
+	}, uint64(1)).Return(nil)
+	mockRepo.EXPECT().SetUserTags(gomock.Any(), flowID, nil).Return(nil)
+
+	assert.NoError(t, service.RevertRevision(context.Background(), flowID, 3, 1))
+}
+
+func TestUpdatePin_SyncsUserTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_pincrud.NewMockPinRepository(ctrl)
+	service := newTestPinService(mockRepo)
+
+	flowID := uint64(5)
+	description := "рецепт #Пирог и #выпечка"
+	patch := domain.PinDataUpdate{FlowID: &flowID, Description: &description}
+
+	mockRepo.EXPECT().GetPin(gomock.Any(), flowID, uint64(1)).Return(domain.PinData{Header: "#осень"}, uint64(1), nil)
+	mockRepo.EXPECT().UpdatePin(gomock.Any(), patch, uint64(1)).Return(nil)
+	mockRepo.EXPECT().SetUserTags(gomock.Any(), flowID, []string{"осень", "пирог", "выпечка"}).Return(nil)
+
+	assert.NoError(t, service.UpdatePin(context.Background(), patch, 1))
+}
@@ -1,7 +1,9 @@
 package profile
 
 import (
+	"context"
 	"path/filepath"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
 	"github.com/go-park-mail-ru/2025_1_SuperChips/internal/security"
@@ -14,6 +16,14 @@ type ProfileRepository interface {
 	UpdateUserData(user domain.User, oldEmail string) error
 	GetHashedPassword(email string) (string, error)
 	SetNewPassword(email string, newPassword string) (int, error)
+	GetNSFWSettings(ctx context.Context, userID int) (time.Time, bool, error)
+	SetShowNSFW(ctx context.Context, userID int, showNSFW bool) error
+	GetLastUsernameChange(ctx context.Context, userID int) (time.Time, error)
+	ChangeUsername(ctx context.Context, user domain.User, reservedUntil time.Time) error
+	GetRenamedUsername(ctx context.Context, oldUsername string) (string, error)
+	GetFollowStatus(ctx context.Context, targetID, viewerID int) (string, error)
+	GetPrivateAccount(ctx context.Context, userID int) (bool, error)
+	SetPrivateAccount(ctx context.Context, userID int, isPrivate bool) error
 }
 
 type ProfileService struct {
@@ -92,6 +102,36 @@ func (p *ProfileService) UpdateUserData(user domain.User, oldEmail string) error
 	return nil
 }
 
+// ChangeUsername сохраняет профиль с новым именем пользователя. Имя меняется
+// не чаще UsernameChangeCooldown, прежнее остаётся за пользователем
+// UsernameReservationPeriod
+func (p *ProfileService) ChangeUsername(ctx context.Context, user domain.User) error {
+	if err := user.ValidateUserNoPassword(); err != nil {
+		return err
+	}
+
+	lastChange, err := p.repo.GetLastUsernameChange(ctx, int(user.ID))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if !lastChange.IsZero() && now.Sub(lastChange) < domain.UsernameChangeCooldown {
+		return domain.ErrUsernameChangeTooSoon
+	}
+
+	return p.repo.ChangeUsername(ctx, user, now.Add(domain.UsernameReservationPeriod))
+}
+
+// GetRenamedUsername находит текущее имя пользователя по прежнему
+func (p *ProfileService) GetRenamedUsername(ctx context.Context, oldUsername string) (string, error) {
+	if err := domain.ValidateUsername(oldUsername); err != nil {
+		return "", err
+	}
+
+	return p.repo.GetRenamedUsername(ctx, oldUsername)
+}
+
 func (p *ProfileService) ChangeUserPassword(email, oldPassword, newPassword string) (int, error) {
 	if err := domain.ValidatePassword(newPassword); err != nil {
 		return 0, err
@@ -119,6 +159,90 @@ func (p *ProfileService) ChangeUserPassword(email, oldPassword, newPassword stri
 	return id, nil
 }
 
+func (p *ProfileService) GetNSFWSettings(ctx context.Context, userID int) (domain.NSFWSettings, error) {
+	birthday, showNSFW, err := p.repo.GetNSFWSettings(ctx, userID)
+	if err != nil {
+		return domain.NSFWSettings{}, err
+	}
+
+	return domain.NewNSFWSettings(birthday, showNSFW, time.Now()), nil
+}
+
+func (p *ProfileService) UpdateNSFWSettings(ctx context.Context, userID int, showNSFW bool) (domain.NSFWSettings, error) {
+	birthday, _, err := p.repo.GetNSFWSettings(ctx, userID)
+	if err != nil {
+		return domain.NSFWSettings{}, err
+	}
+
+	now := time.Now()
+
+	// включить показ может только совершеннолетний, выключить - любой
+	if showNSFW && !domain.IsAdult(birthday, now) {
+		return domain.NSFWSettings{}, domain.ErrNSFWAgeRestricted
+	}
+
+	if err := p.repo.SetShowNSFW(ctx, userID, showNSFW); err != nil {
+		return domain.NSFWSettings{}, err
+	}
+
+	return domain.NewNSFWSettings(birthday, showNSFW, now), nil
+}
+
+// GetNSFWMode определяет, как показывать NSFW пины пользователю
+func (p *ProfileService) GetNSFWMode(ctx context.Context, userID int) (string, error) {
+	if userID <= 0 {
+		return domain.NSFWModeHide, nil
+	}
+
+	birthday, showNSFW, err := p.repo.GetNSFWSettings(ctx, userID)
+	if err != nil {
+		return domain.NSFWModeHide, err
+	}
+
+	return domain.ResolveNSFWMode(birthday, showNSFW, time.Now()), nil
+}
+
+// RestrictPrivateProfile скрывает профиль закрытого аккаунта от всех,
+// кроме владельца и одобренных подписчиков
+func (p *ProfileService) RestrictPrivateProfile(ctx context.Context, user domain.User, viewerID int) (domain.User, error) {
+	if !user.IsPrivateAccount || uint64(viewerID) == user.ID {
+		return user, nil
+	}
+
+	status := domain.FollowStatusNone
+	if viewerID > 0 {
+		var err error
+		status, err = p.repo.GetFollowStatus(ctx, int(user.ID), viewerID)
+		if err != nil {
+			return domain.User{}, err
+		}
+	}
+
+	user.FollowStatus = status
+	if status != domain.FollowStatusFollowing {
+		user.HidePrivateProfile()
+	}
+
+	return user, nil
+}
+
+func (p *ProfileService) GetAccountPrivacy(ctx context.Context, userID int) (domain.AccountPrivacy, error) {
+	isPrivate, err := p.repo.GetPrivateAccount(ctx, userID)
+	if err != nil {
+		return domain.AccountPrivacy{}, err
+	}
+
+	return domain.AccountPrivacy{IsPrivateAccount: isPrivate}, nil
+}
+
+func (p *ProfileService) UpdateAccountPrivacy(ctx context.Context, userID int, isPrivate bool) (domain.AccountPrivacy, error) {
+	if err := p.repo.SetPrivateAccount(ctx, userID, isPrivate); err != nil {
+		return domain.AccountPrivacy{}, err
+	}
+
+	return domain.AccountPrivacy{IsPrivateAccount: isPrivate}, nil
+}
+
 func (p *ProfileService) generateAvatarURL(filename string) string {
 	if filename == "" {
 		return ""
@@ -178,11 +178,12 @@ func (x *LoginUserRequest) GetPassword() string {
 }
 
 type LoginUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ID                int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Username          string                 `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+	TwoFactorRequired bool                   `protobuf:"varint,3,opt,name=TwoFactorRequired,proto3" json:"TwoFactorRequired,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *LoginUserResponse) Reset() {
@@ -229,6 +230,13 @@ func (x *LoginUserResponse) GetUsername() string {
 	return ""
 }
 
+func (x *LoginUserResponse) GetTwoFactorRequired() bool {
+	if x != nil {
+		return x.TwoFactorRequired
+	}
+	return false
+}
+
 type LoginExternalUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=Email,proto3" json:"Email,omitempty"`
@@ -282,12 +290,13 @@ func (x *LoginExternalUserRequest) GetExternalID() string {
 }
 
 type LoginExternalUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ID                int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Username          string                 `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+	Email             string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	TwoFactorRequired bool                   `protobuf:"varint,4,opt,name=TwoFactorRequired,proto3" json:"TwoFactorRequired,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *LoginExternalUserResponse) Reset() {
@@ -341,6 +350,13 @@ func (x *LoginExternalUserResponse) GetEmail() string {
 	return ""
 }
 
+func (x *LoginExternalUserResponse) GetTwoFactorRequired() bool {
+	if x != nil {
+		return x.TwoFactorRequired
+	}
+	return false
+}
+
 type AddExternalUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=Email,proto3" json:"Email,omitempty"`
@@ -549,53 +565,930 @@ func (x *CheckImgPermissionResponse) GetHasAccess() bool {
 	return false
 }
 
-var File_protos_proto_auth_auth_proto protoreflect.FileDescriptor
+type TwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_protos_proto_auth_auth_proto_rawDesc = "" +
-	"\n" +
-	"\x1cprotos/proto/auth/auth.proto\x12\n" +
-	"proto_auth\"^\n" +
-	"\x0eAddUserRequest\x12\x1a\n" +
-	"\bUsername\x18\x01 \x01(\tR\bUsername\x12\x1a\n" +
-	"\bPassword\x18\x02 \x01(\tR\bPassword\x12\x14\n" +
-	"\x05Email\x18\x03 \x01(\tR\x05Email\"!\n" +
-	"\x0fAddUserResponse\x12\x0e\n" +
-	"\x02ID\x18\x01 \x01(\x03R\x02ID\"D\n" +
-	"\x10LoginUserRequest\x12\x14\n" +
-	"\x05Email\x18\x01 \x01(\tR\x05Email\x12\x1a\n" +
-	"\bPassword\x18\x02 \x01(\tR\bPassword\"?\n" +
-	"\x11LoginUserResponse\x12\x0e\n" +
-	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
-	"\bUsername\x18\x02 \x01(\tR\bUsername\"P\n" +
-	"\x18LoginExternalUserRequest\x12\x14\n" +
-	"\x05Email\x18\x01 \x01(\tR\x05Email\x12\x1e\n" +
-	"\n" +
-	"ExternalID\x18\x02 \x01(\tR\n" +
-	"ExternalID\"]\n" +
-	"\x19LoginExternalUserResponse\x12\x0e\n" +
-	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
-	"\bUsername\x18\x02 \x01(\tR\bUsername\x12\x14\n" +
-	"\x05Email\x18\x03 \x01(\tR\x05Email\"\x82\x01\n" +
-	"\x16AddExternalUserRequest\x12\x14\n" +
-	"\x05Email\x18\x01 \x01(\tR\x05Email\x12\x1a\n" +
-	"\bUsername\x18\x02 \x01(\tR\bUsername\x12\x1e\n" +
-	"\n" +
-	"externalID\x18\x03 \x01(\tR\n" +
-	"externalID\x12\x16\n" +
-	"\x06Avatar\x18\x04 \x01(\tR\x06Avatar\")\n" +
-	"\x17AddExternalUserResponse\x12\x0e\n" +
-	"\x02ID\x18\x01 \x01(\x03R\x02ID\"I\n" +
-	"\x19CheckImgPermissionRequest\x12\x0e\n" +
-	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1c\n" +
-	"\tImageName\x18\x02 \x01(\tR\tImageName\":\n" +
-	"\x1aCheckImgPermissionResponse\x12\x1c\n" +
-	"\tHasAccess\x18\x01 \x01(\bR\tHasAccess2\xc1\x03\n" +
+func (x *TwoFactorRequest) Reset() {
+	*x = TwoFactorRequest{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorRequest) ProtoMessage() {}
+
+func (x *TwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*TwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TwoFactorRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type TwoFactorCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwoFactorCodeRequest) Reset() {
+	*x = TwoFactorCodeRequest{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorCodeRequest) ProtoMessage() {}
+
+func (x *TwoFactorCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorCodeRequest.ProtoReflect.Descriptor instead.
+func (*TwoFactorCodeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TwoFactorCodeRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *TwoFactorCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type TwoFactorStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Enabled           bool                   `protobuf:"varint,1,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	RecoveryCodesLeft int64                  `protobuf:"varint,2,opt,name=RecoveryCodesLeft,proto3" json:"RecoveryCodesLeft,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TwoFactorStatusResponse) Reset() {
+	*x = TwoFactorStatusResponse{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorStatusResponse) ProtoMessage() {}
+
+func (x *TwoFactorStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorStatusResponse.ProtoReflect.Descriptor instead.
+func (*TwoFactorStatusResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TwoFactorStatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TwoFactorStatusResponse) GetRecoveryCodesLeft() int64 {
+	if x != nil {
+		return x.RecoveryCodesLeft
+	}
+	return 0
+}
+
+type SetupTwoFactorResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Secret          string                 `protobuf:"bytes,1,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	ProvisioningURI string                 `protobuf:"bytes,2,opt,name=ProvisioningURI,proto3" json:"ProvisioningURI,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetupTwoFactorResponse) Reset() {
+	*x = SetupTwoFactorResponse{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetupTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupTwoFactorResponse) ProtoMessage() {}
+
+func (x *SetupTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*SetupTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetupTwoFactorResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *SetupTwoFactorResponse) GetProvisioningURI() string {
+	if x != nil {
+		return x.ProvisioningURI
+	}
+	return ""
+}
+
+type RecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=RecoveryCodes,proto3" json:"RecoveryCodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoveryCodesResponse) Reset() {
+	*x = RecoveryCodesResponse{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type VerifyTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verified      bool                   `protobuf:"varint,1,opt,name=Verified,proto3" json:"Verified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTwoFactorResponse) Reset() {
+	*x = VerifyTwoFactorResponse{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFactorResponse) ProtoMessage() {}
+
+func (x *VerifyTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VerifyTwoFactorResponse) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type DisableTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disabled      bool                   `protobuf:"varint,1,opt,name=Disabled,proto3" json:"Disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTwoFactorResponse) Reset() {
+	*x = DisableTwoFactorResponse{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorResponse) ProtoMessage() {}
+
+func (x *DisableTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DisableTwoFactorResponse) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type LoginOAuthUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=Provider,proto3" json:"Provider,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginOAuthUserRequest) Reset() {
+	*x = LoginOAuthUserRequest{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginOAuthUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginOAuthUserRequest) ProtoMessage() {}
+
+func (x *LoginOAuthUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginOAuthUserRequest.ProtoReflect.Descriptor instead.
+func (*LoginOAuthUserRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LoginOAuthUserRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LoginOAuthUserRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type AddOAuthUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=Provider,proto3" json:"Provider,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
+	Avatar        string                 `protobuf:"bytes,5,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOAuthUserRequest) Reset() {
+	*x = AddOAuthUserRequest{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOAuthUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOAuthUserRequest) ProtoMessage() {}
+
+func (x *AddOAuthUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOAuthUserRequest.ProtoReflect.Descriptor instead.
+func (*AddOAuthUserRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddOAuthUserRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *AddOAuthUserRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AddOAuthUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddOAuthUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddOAuthUserRequest) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+type LinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=Provider,proto3" json:"Provider,omitempty"`
+	Subject       string                 `protobuf:"bytes,3,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityRequest) ProtoMessage() {}
+
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LinkIdentityRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *LinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type LinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Linked        bool                   `protobuf:"varint,1,opt,name=Linked,proto3" json:"Linked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityResponse) Reset() {
+	*x = LinkIdentityResponse{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityResponse) ProtoMessage() {}
+
+func (x *LinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LinkIdentityResponse) GetLinked() bool {
+	if x != nil {
+		return x.Linked
+	}
+	return false
+}
+
+type UnlinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=Provider,proto3" json:"Provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnlinkIdentityRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UnlinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type UnlinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unlinked      bool                   `protobuf:"varint,1,opt,name=Unlinked,proto3" json:"Unlinked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityResponse) Reset() {
+	*x = UnlinkIdentityResponse{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnlinkIdentityResponse) GetUnlinked() bool {
+	if x != nil {
+		return x.Unlinked
+	}
+	return false
+}
+
+type IdentitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentitiesRequest) Reset() {
+	*x = IdentitiesRequest{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentitiesRequest) ProtoMessage() {}
+
+func (x *IdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*IdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *IdentitiesRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type LinkedIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=Provider,proto3" json:"Provider,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkedIdentity) Reset() {
+	*x = LinkedIdentity{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkedIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedIdentity) ProtoMessage() {}
+
+func (x *LinkedIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedIdentity.ProtoReflect.Descriptor instead.
+func (*LinkedIdentity) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LinkedIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type IdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*LinkedIdentity      `protobuf:"bytes,1,rep,name=Identities,proto3" json:"Identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentitiesResponse) Reset() {
+	*x = IdentitiesResponse{}
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentitiesResponse) ProtoMessage() {}
+
+func (x *IdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_auth_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*IdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_proto_auth_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IdentitiesResponse) GetIdentities() []*LinkedIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+var File_protos_proto_auth_auth_proto protoreflect.FileDescriptor
+
+const file_protos_proto_auth_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x1cprotos/proto/auth/auth.proto\x12\n" +
+	"proto_auth\"^\n" +
+	"\x0eAddUserRequest\x12\x1a\n" +
+	"\bUsername\x18\x01 \x01(\tR\bUsername\x12\x1a\n" +
+	"\bPassword\x18\x02 \x01(\tR\bPassword\x12\x14\n" +
+	"\x05Email\x18\x03 \x01(\tR\x05Email\"!\n" +
+	"\x0fAddUserResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\"D\n" +
+	"\x10LoginUserRequest\x12\x14\n" +
+	"\x05Email\x18\x01 \x01(\tR\x05Email\x12\x1a\n" +
+	"\bPassword\x18\x02 \x01(\tR\bPassword\"m\n" +
+	"\x11LoginUserResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bUsername\x18\x02 \x01(\tR\bUsername\x12,\n" +
+	"\x11TwoFactorRequired\x18\x03 \x01(\bR\x11TwoFactorRequired\"P\n" +
+	"\x18LoginExternalUserRequest\x12\x14\n" +
+	"\x05Email\x18\x01 \x01(\tR\x05Email\x12\x1e\n" +
+	"\n" +
+	"ExternalID\x18\x02 \x01(\tR\n" +
+	"ExternalID\"\x8b\x01\n" +
+	"\x19LoginExternalUserResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bUsername\x18\x02 \x01(\tR\bUsername\x12\x14\n" +
+	"\x05Email\x18\x03 \x01(\tR\x05Email\x12,\n" +
+	"\x11TwoFactorRequired\x18\x04 \x01(\bR\x11TwoFactorRequired\"\x82\x01\n" +
+	"\x16AddExternalUserRequest\x12\x14\n" +
+	"\x05Email\x18\x01 \x01(\tR\x05Email\x12\x1a\n" +
+	"\bUsername\x18\x02 \x01(\tR\bUsername\x12\x1e\n" +
+	"\n" +
+	"externalID\x18\x03 \x01(\tR\n" +
+	"externalID\x12\x16\n" +
+	"\x06Avatar\x18\x04 \x01(\tR\x06Avatar\")\n" +
+	"\x17AddExternalUserResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\"I\n" +
+	"\x19CheckImgPermissionRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1c\n" +
+	"\tImageName\x18\x02 \x01(\tR\tImageName\":\n" +
+	"\x1aCheckImgPermissionResponse\x12\x1c\n" +
+	"\tHasAccess\x18\x01 \x01(\bR\tHasAccess\"\"\n" +
+	"\x10TwoFactorRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\":\n" +
+	"\x14TwoFactorCodeRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x12\n" +
+	"\x04Code\x18\x02 \x01(\tR\x04Code\"a\n" +
+	"\x17TwoFactorStatusResponse\x12\x18\n" +
+	"\aEnabled\x18\x01 \x01(\bR\aEnabled\x12,\n" +
+	"\x11RecoveryCodesLeft\x18\x02 \x01(\x03R\x11RecoveryCodesLeft\"Z\n" +
+	"\x16SetupTwoFactorResponse\x12\x16\n" +
+	"\x06Secret\x18\x01 \x01(\tR\x06Secret\x12(\n" +
+	"\x0fProvisioningURI\x18\x02 \x01(\tR\x0fProvisioningURI\"=\n" +
+	"\x15RecoveryCodesResponse\x12$\n" +
+	"\rRecoveryCodes\x18\x01 \x03(\tR\rRecoveryCodes\"5\n" +
+	"\x17VerifyTwoFactorResponse\x12\x1a\n" +
+	"\bVerified\x18\x01 \x01(\bR\bVerified\"6\n" +
+	"\x18DisableTwoFactorResponse\x12\x1a\n" +
+	"\bDisabled\x18\x01 \x01(\bR\bDisabled\"M\n" +
+	"\x15LoginOAuthUserRequest\x12\x1a\n" +
+	"\bProvider\x18\x01 \x01(\tR\bProvider\x12\x18\n" +
+	"\aSubject\x18\x02 \x01(\tR\aSubject\"\x95\x01\n" +
+	"\x13AddOAuthUserRequest\x12\x1a\n" +
+	"\bProvider\x18\x01 \x01(\tR\bProvider\x12\x18\n" +
+	"\aSubject\x18\x02 \x01(\tR\aSubject\x12\x14\n" +
+	"\x05Email\x18\x03 \x01(\tR\x05Email\x12\x1a\n" +
+	"\bUsername\x18\x04 \x01(\tR\bUsername\x12\x16\n" +
+	"\x06Avatar\x18\x05 \x01(\tR\x06Avatar\"q\n" +
+	"\x13LinkIdentityRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bProvider\x18\x02 \x01(\tR\bProvider\x12\x18\n" +
+	"\aSubject\x18\x03 \x01(\tR\aSubject\x12\x14\n" +
+	"\x05Email\x18\x04 \x01(\tR\x05Email\".\n" +
+	"\x14LinkIdentityResponse\x12\x16\n" +
+	"\x06Linked\x18\x01 \x01(\bR\x06Linked\"C\n" +
+	"\x15UnlinkIdentityRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\x12\x1a\n" +
+	"\bProvider\x18\x02 \x01(\tR\bProvider\"4\n" +
+	"\x16UnlinkIdentityResponse\x12\x1a\n" +
+	"\bUnlinked\x18\x01 \x01(\bR\bUnlinked\"#\n" +
+	"\x11IdentitiesRequest\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\"`\n" +
+	"\x0eLinkedIdentity\x12\x1a\n" +
+	"\bProvider\x18\x01 \x01(\tR\bProvider\x12\x14\n" +
+	"\x05Email\x18\x02 \x01(\tR\x05Email\x12\x1c\n" +
+	"\tCreatedAt\x18\x03 \x01(\x03R\tCreatedAt\"P\n" +
+	"\x12IdentitiesResponse\x12:\n" +
+	"\n" +
+	"Identities\x18\x01 \x03(\v2\x1a.proto_auth.LinkedIdentityR\n" +
+	"Identities2\xa1\v\n" +
 	"\x04Auth\x12D\n" +
 	"\aAddUser\x12\x1a.proto_auth.AddUserRequest\x1a\x1b.proto_auth.AddUserResponse\"\x00\x12J\n" +
 	"\tLoginUser\x12\x1c.proto_auth.LoginUserRequest\x1a\x1d.proto_auth.LoginUserResponse\"\x00\x12b\n" +
 	"\x11LoginExternalUser\x12$.proto_auth.LoginExternalUserRequest\x1a%.proto_auth.LoginExternalUserResponse\"\x00\x12\\\n" +
 	"\x0fAddExternalUser\x12\".proto_auth.AddExternalUserRequest\x1a#.proto_auth.AddExternalUserResponse\"\x00\x12e\n" +
-	"\x12CheckImgPermission\x12%.proto_auth.CheckImgPermissionRequest\x1a&.proto_auth.CheckImgPermissionResponse\"\x00B\x18Z\x16./protos/gen/auth/;genb\x06proto3"
+	"\x12CheckImgPermission\x12%.proto_auth.CheckImgPermissionRequest\x1a&.proto_auth.CheckImgPermissionResponse\"\x00\x12Y\n" +
+	"\x12GetTwoFactorStatus\x12\x1c.proto_auth.TwoFactorRequest\x1a#.proto_auth.TwoFactorStatusResponse\"\x00\x12T\n" +
+	"\x0eSetupTwoFactor\x12\x1c.proto_auth.TwoFactorRequest\x1a\".proto_auth.SetupTwoFactorResponse\"\x00\x12Y\n" +
+	"\x10ConfirmTwoFactor\x12 .proto_auth.TwoFactorCodeRequest\x1a!.proto_auth.RecoveryCodesResponse\"\x00\x12Z\n" +
+	"\x0fVerifyTwoFactor\x12 .proto_auth.TwoFactorCodeRequest\x1a#.proto_auth.VerifyTwoFactorResponse\"\x00\x12\\\n" +
+	"\x10DisableTwoFactor\x12 .proto_auth.TwoFactorCodeRequest\x1a$.proto_auth.DisableTwoFactorResponse\"\x00\x12`\n" +
+	"\x17RegenerateRecoveryCodes\x12 .proto_auth.TwoFactorCodeRequest\x1a!.proto_auth.RecoveryCodesResponse\"\x00\x12\\\n" +
+	"\x0eLoginOAuthUser\x12!.proto_auth.LoginOAuthUserRequest\x1a%.proto_auth.LoginExternalUserResponse\"\x00\x12V\n" +
+	"\fAddOAuthUser\x12\x1f.proto_auth.AddOAuthUserRequest\x1a#.proto_auth.AddExternalUserResponse\"\x00\x12S\n" +
+	"\fLinkIdentity\x12\x1f.proto_auth.LinkIdentityRequest\x1a .proto_auth.LinkIdentityResponse\"\x00\x12Y\n" +
+	"\x0eUnlinkIdentity\x12!.proto_auth.UnlinkIdentityRequest\x1a\".proto_auth.UnlinkIdentityResponse\"\x00\x12P\n" +
+	"\rGetIdentities\x12\x1d.proto_auth.IdentitiesRequest\x1a\x1e.proto_auth.IdentitiesResponse\"\x00B\x18Z\x16./protos/gen/auth/;genb\x06proto3"
 
 var (
 	file_protos_proto_auth_auth_proto_rawDescOnce sync.Once
@@ -609,7 +1502,7 @@ func file_protos_proto_auth_auth_proto_rawDescGZIP() []byte {
 	return file_protos_proto_auth_auth_proto_rawDescData
 }
 
-var file_protos_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_protos_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_protos_proto_auth_auth_proto_goTypes = []any{
 	(*AddUserRequest)(nil),             // 0: proto_auth.AddUserRequest
 	(*AddUserResponse)(nil),            // 1: proto_auth.AddUserResponse
@@ -621,23 +1514,62 @@ var file_protos_proto_auth_auth_proto_goTypes = []any{
 	(*AddExternalUserResponse)(nil),    // 7: proto_auth.AddExternalUserResponse
 	(*CheckImgPermissionRequest)(nil),  // 8: proto_auth.CheckImgPermissionRequest
 	(*CheckImgPermissionResponse)(nil), // 9: proto_auth.CheckImgPermissionResponse
+	(*TwoFactorRequest)(nil),           // 10: proto_auth.TwoFactorRequest
+	(*TwoFactorCodeRequest)(nil),       // 11: proto_auth.TwoFactorCodeRequest
+	(*TwoFactorStatusResponse)(nil),    // 12: proto_auth.TwoFactorStatusResponse
+	(*SetupTwoFactorResponse)(nil),     // 13: proto_auth.SetupTwoFactorResponse
+	(*RecoveryCodesResponse)(nil),      // 14: proto_auth.RecoveryCodesResponse
+	(*VerifyTwoFactorResponse)(nil),    // 15: proto_auth.VerifyTwoFactorResponse
+	(*DisableTwoFactorResponse)(nil),   // 16: proto_auth.DisableTwoFactorResponse
+	(*LoginOAuthUserRequest)(nil),      // 17: proto_auth.LoginOAuthUserRequest
+	(*AddOAuthUserRequest)(nil),        // 18: proto_auth.AddOAuthUserRequest
+	(*LinkIdentityRequest)(nil),        // 19: proto_auth.LinkIdentityRequest
+	(*LinkIdentityResponse)(nil),       // 20: proto_auth.LinkIdentityResponse
+	(*UnlinkIdentityRequest)(nil),      // 21: proto_auth.UnlinkIdentityRequest
+	(*UnlinkIdentityResponse)(nil),     // 22: proto_auth.UnlinkIdentityResponse
+	(*IdentitiesRequest)(nil),          // 23: proto_auth.IdentitiesRequest
+	(*LinkedIdentity)(nil),             // 24: proto_auth.LinkedIdentity
+	(*IdentitiesResponse)(nil),         // 25: proto_auth.IdentitiesResponse
 }
 var file_protos_proto_auth_auth_proto_depIdxs = []int32{
-	0, // 0: proto_auth.Auth.AddUser:input_type -> proto_auth.AddUserRequest
-	2, // 1: proto_auth.Auth.LoginUser:input_type -> proto_auth.LoginUserRequest
-	4, // 2: proto_auth.Auth.LoginExternalUser:input_type -> proto_auth.LoginExternalUserRequest
-	6, // 3: proto_auth.Auth.AddExternalUser:input_type -> proto_auth.AddExternalUserRequest
-	8, // 4: proto_auth.Auth.CheckImgPermission:input_type -> proto_auth.CheckImgPermissionRequest
-	1, // 5: proto_auth.Auth.AddUser:output_type -> proto_auth.AddUserResponse
-	3, // 6: proto_auth.Auth.LoginUser:output_type -> proto_auth.LoginUserResponse
-	5, // 7: proto_auth.Auth.LoginExternalUser:output_type -> proto_auth.LoginExternalUserResponse
-	7, // 8: proto_auth.Auth.AddExternalUser:output_type -> proto_auth.AddExternalUserResponse
-	9, // 9: proto_auth.Auth.CheckImgPermission:output_type -> proto_auth.CheckImgPermissionResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	24, // 0: proto_auth.IdentitiesResponse.Identities:type_name -> proto_auth.LinkedIdentity
+	0,  // 1: proto_auth.Auth.AddUser:input_type -> proto_auth.AddUserRequest
+	2,  // 2: proto_auth.Auth.LoginUser:input_type -> proto_auth.LoginUserRequest
+	4,  // 3: proto_auth.Auth.LoginExternalUser:input_type -> proto_auth.LoginExternalUserRequest
+	6,  // 4: proto_auth.Auth.AddExternalUser:input_type -> proto_auth.AddExternalUserRequest
+	8,  // 5: proto_auth.Auth.CheckImgPermission:input_type -> proto_auth.CheckImgPermissionRequest
+	10, // 6: proto_auth.Auth.GetTwoFactorStatus:input_type -> proto_auth.TwoFactorRequest
+	10, // 7: proto_auth.Auth.SetupTwoFactor:input_type -> proto_auth.TwoFactorRequest
+	11, // 8: proto_auth.Auth.ConfirmTwoFactor:input_type -> proto_auth.TwoFactorCodeRequest
+	11, // 9: proto_auth.Auth.VerifyTwoFactor:input_type -> proto_auth.TwoFactorCodeRequest
+	11, // 10: proto_auth.Auth.DisableTwoFactor:input_type -> proto_auth.TwoFactorCodeRequest
+	11, // 11: proto_auth.Auth.RegenerateRecoveryCodes:input_type -> proto_auth.TwoFactorCodeRequest
+	17, // 12: proto_auth.Auth.LoginOAuthUser:input_type -> proto_auth.LoginOAuthUserRequest
+	18, // 13: proto_auth.Auth.AddOAuthUser:input_type -> proto_auth.AddOAuthUserRequest
+	19, // 14: proto_auth.Auth.LinkIdentity:input_type -> proto_auth.LinkIdentityRequest
+	21, // 15: proto_auth.Auth.UnlinkIdentity:input_type -> proto_auth.UnlinkIdentityRequest
+	23, // 16: proto_auth.Auth.GetIdentities:input_type -> proto_auth.IdentitiesRequest
+	1,  // 17: proto_auth.Auth.AddUser:output_type -> proto_auth.AddUserResponse
+	3,  // 18: proto_auth.Auth.LoginUser:output_type -> proto_auth.LoginUserResponse
+	5,  // 19: proto_auth.Auth.LoginExternalUser:output_type -> proto_auth.LoginExternalUserResponse
+	7,  // 20: proto_auth.Auth.AddExternalUser:output_type -> proto_auth.AddExternalUserResponse
+	9,  // 21: proto_auth.Auth.CheckImgPermission:output_type -> proto_auth.CheckImgPermissionResponse
+	12, // 22: proto_auth.Auth.GetTwoFactorStatus:output_type -> proto_auth.TwoFactorStatusResponse
+	13, // 23: proto_auth.Auth.SetupTwoFactor:output_type -> proto_auth.SetupTwoFactorResponse
+	14, // 24: proto_auth.Auth.ConfirmTwoFactor:output_type -> proto_auth.RecoveryCodesResponse
+	15, // 25: proto_auth.Auth.VerifyTwoFactor:output_type -> proto_auth.VerifyTwoFactorResponse
+	16, // 26: proto_auth.Auth.DisableTwoFactor:output_type -> proto_auth.DisableTwoFactorResponse
+	14, // 27: proto_auth.Auth.RegenerateRecoveryCodes:output_type -> proto_auth.RecoveryCodesResponse
+	5,  // 28: proto_auth.Auth.LoginOAuthUser:output_type -> proto_auth.LoginExternalUserResponse
+	7,  // 29: proto_auth.Auth.AddOAuthUser:output_type -> proto_auth.AddExternalUserResponse
+	20, // 30: proto_auth.Auth.LinkIdentity:output_type -> proto_auth.LinkIdentityResponse
+	22, // 31: proto_auth.Auth.UnlinkIdentity:output_type -> proto_auth.UnlinkIdentityResponse
+	25, // 32: proto_auth.Auth.GetIdentities:output_type -> proto_auth.IdentitiesResponse
+	17, // [17:33] is the sub-list for method output_type
+	1,  // [1:17] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_protos_proto_auth_auth_proto_init() }
@@ -651,7 +1583,7 @@ func file_protos_proto_auth_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_proto_auth_auth_proto_rawDesc), len(file_protos_proto_auth_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
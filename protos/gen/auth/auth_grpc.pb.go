@@ -19,11 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Auth_AddUser_FullMethodName            = "/proto_auth.Auth/AddUser"
-	Auth_LoginUser_FullMethodName          = "/proto_auth.Auth/LoginUser"
-	Auth_LoginExternalUser_FullMethodName  = "/proto_auth.Auth/LoginExternalUser"
-	Auth_AddExternalUser_FullMethodName    = "/proto_auth.Auth/AddExternalUser"
-	Auth_CheckImgPermission_FullMethodName = "/proto_auth.Auth/CheckImgPermission"
+	Auth_AddUser_FullMethodName                 = "/proto_auth.Auth/AddUser"
+	Auth_LoginUser_FullMethodName               = "/proto_auth.Auth/LoginUser"
+	Auth_LoginExternalUser_FullMethodName       = "/proto_auth.Auth/LoginExternalUser"
+	Auth_AddExternalUser_FullMethodName         = "/proto_auth.Auth/AddExternalUser"
+	Auth_CheckImgPermission_FullMethodName      = "/proto_auth.Auth/CheckImgPermission"
+	Auth_GetTwoFactorStatus_FullMethodName      = "/proto_auth.Auth/GetTwoFactorStatus"
+	Auth_SetupTwoFactor_FullMethodName          = "/proto_auth.Auth/SetupTwoFactor"
+	Auth_ConfirmTwoFactor_FullMethodName        = "/proto_auth.Auth/ConfirmTwoFactor"
+	Auth_VerifyTwoFactor_FullMethodName         = "/proto_auth.Auth/VerifyTwoFactor"
+	Auth_DisableTwoFactor_FullMethodName        = "/proto_auth.Auth/DisableTwoFactor"
+	Auth_RegenerateRecoveryCodes_FullMethodName = "/proto_auth.Auth/RegenerateRecoveryCodes"
+	Auth_LoginOAuthUser_FullMethodName          = "/proto_auth.Auth/LoginOAuthUser"
+	Auth_AddOAuthUser_FullMethodName            = "/proto_auth.Auth/AddOAuthUser"
+	Auth_LinkIdentity_FullMethodName            = "/proto_auth.Auth/LinkIdentity"
+	Auth_UnlinkIdentity_FullMethodName          = "/proto_auth.Auth/UnlinkIdentity"
+	Auth_GetIdentities_FullMethodName           = "/proto_auth.Auth/GetIdentities"
 )
 
 // AuthClient is the client API for Auth service.
@@ -35,6 +46,17 @@ type AuthClient interface {
 	LoginExternalUser(ctx context.Context, in *LoginExternalUserRequest, opts ...grpc.CallOption) (*LoginExternalUserResponse, error)
 	AddExternalUser(ctx context.Context, in *AddExternalUserRequest, opts ...grpc.CallOption) (*AddExternalUserResponse, error)
 	CheckImgPermission(ctx context.Context, in *CheckImgPermissionRequest, opts ...grpc.CallOption) (*CheckImgPermissionResponse, error)
+	GetTwoFactorStatus(ctx context.Context, in *TwoFactorRequest, opts ...grpc.CallOption) (*TwoFactorStatusResponse, error)
+	SetupTwoFactor(ctx context.Context, in *TwoFactorRequest, opts ...grpc.CallOption) (*SetupTwoFactorResponse, error)
+	ConfirmTwoFactor(ctx context.Context, in *TwoFactorCodeRequest, opts ...grpc.CallOption) (*RecoveryCodesResponse, error)
+	VerifyTwoFactor(ctx context.Context, in *TwoFactorCodeRequest, opts ...grpc.CallOption) (*VerifyTwoFactorResponse, error)
+	DisableTwoFactor(ctx context.Context, in *TwoFactorCodeRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *TwoFactorCodeRequest, opts ...grpc.CallOption) (*RecoveryCodesResponse, error)
+	LoginOAuthUser(ctx context.Context, in *LoginOAuthUserRequest, opts ...grpc.CallOption) (*LoginExternalUserResponse, error)
+	AddOAuthUser(ctx context.Context, in *AddOAuthUserRequest, opts ...grpc.CallOption) (*AddExternalUserResponse, error)
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error)
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*UnlinkIdentityResponse, error)
+	GetIdentities(ctx context.Context, in *IdentitiesRequest, opts ...grpc.CallOption) (*IdentitiesResponse, error)
 }
 
 type authClient struct {
@@ -95,6 +117,116 @@ func (c *authClient) CheckImgPermission(ctx context.Context, in *CheckImgPermiss
 	return out, nil
 }
 
+func (c *authClient) GetTwoFactorStatus(ctx context.Context, in *TwoFactorRequest, opts ...grpc.CallOption) (*TwoFactorStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TwoFactorStatusResponse)
+	err := c.cc.Invoke(ctx, Auth_GetTwoFactorStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) SetupTwoFactor(ctx context.Context, in *TwoFactorRequest, opts ...grpc.CallOption) (*SetupTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupTwoFactorResponse)
+	err := c.cc.Invoke(ctx, Auth_SetupTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ConfirmTwoFactor(ctx context.Context, in *TwoFactorCodeRequest, opts ...grpc.CallOption) (*RecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, Auth_ConfirmTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) VerifyTwoFactor(ctx context.Context, in *TwoFactorCodeRequest, opts ...grpc.CallOption) (*VerifyTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTwoFactorResponse)
+	err := c.cc.Invoke(ctx, Auth_VerifyTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) DisableTwoFactor(ctx context.Context, in *TwoFactorCodeRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, Auth_DisableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) RegenerateRecoveryCodes(ctx context.Context, in *TwoFactorCodeRequest, opts ...grpc.CallOption) (*RecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, Auth_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) LoginOAuthUser(ctx context.Context, in *LoginOAuthUserRequest, opts ...grpc.CallOption) (*LoginExternalUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginExternalUserResponse)
+	err := c.cc.Invoke(ctx, Auth_LoginOAuthUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) AddOAuthUser(ctx context.Context, in *AddOAuthUserRequest, opts ...grpc.CallOption) (*AddExternalUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddExternalUserResponse)
+	err := c.cc.Invoke(ctx, Auth_AddOAuthUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkIdentityResponse)
+	err := c.cc.Invoke(ctx, Auth_LinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*UnlinkIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkIdentityResponse)
+	err := c.cc.Invoke(ctx, Auth_UnlinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) GetIdentities(ctx context.Context, in *IdentitiesRequest, opts ...grpc.CallOption) (*IdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IdentitiesResponse)
+	err := c.cc.Invoke(ctx, Auth_GetIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServer is the server API for Auth service.
 // All implementations must embed UnimplementedAuthServer
 // for forward compatibility.
@@ -104,6 +236,17 @@ type AuthServer interface {
 	LoginExternalUser(context.Context, *LoginExternalUserRequest) (*LoginExternalUserResponse, error)
 	AddExternalUser(context.Context, *AddExternalUserRequest) (*AddExternalUserResponse, error)
 	CheckImgPermission(context.Context, *CheckImgPermissionRequest) (*CheckImgPermissionResponse, error)
+	GetTwoFactorStatus(context.Context, *TwoFactorRequest) (*TwoFactorStatusResponse, error)
+	SetupTwoFactor(context.Context, *TwoFactorRequest) (*SetupTwoFactorResponse, error)
+	ConfirmTwoFactor(context.Context, *TwoFactorCodeRequest) (*RecoveryCodesResponse, error)
+	VerifyTwoFactor(context.Context, *TwoFactorCodeRequest) (*VerifyTwoFactorResponse, error)
+	DisableTwoFactor(context.Context, *TwoFactorCodeRequest) (*DisableTwoFactorResponse, error)
+	RegenerateRecoveryCodes(context.Context, *TwoFactorCodeRequest) (*RecoveryCodesResponse, error)
+	LoginOAuthUser(context.Context, *LoginOAuthUserRequest) (*LoginExternalUserResponse, error)
+	AddOAuthUser(context.Context, *AddOAuthUserRequest) (*AddExternalUserResponse, error)
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error)
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*UnlinkIdentityResponse, error)
+	GetIdentities(context.Context, *IdentitiesRequest) (*IdentitiesResponse, error)
 	mustEmbedUnimplementedAuthServer()
 }
 
@@ -129,6 +272,39 @@ func (UnimplementedAuthServer) AddExternalUser(context.Context, *AddExternalUser
 func (UnimplementedAuthServer) CheckImgPermission(context.Context, *CheckImgPermissionRequest) (*CheckImgPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckImgPermission not implemented")
 }
+func (UnimplementedAuthServer) GetTwoFactorStatus(context.Context, *TwoFactorRequest) (*TwoFactorStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwoFactorStatus not implemented")
+}
+func (UnimplementedAuthServer) SetupTwoFactor(context.Context, *TwoFactorRequest) (*SetupTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupTwoFactor not implemented")
+}
+func (UnimplementedAuthServer) ConfirmTwoFactor(context.Context, *TwoFactorCodeRequest) (*RecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactor not implemented")
+}
+func (UnimplementedAuthServer) VerifyTwoFactor(context.Context, *TwoFactorCodeRequest) (*VerifyTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTwoFactor not implemented")
+}
+func (UnimplementedAuthServer) DisableTwoFactor(context.Context, *TwoFactorCodeRequest) (*DisableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedAuthServer) RegenerateRecoveryCodes(context.Context, *TwoFactorCodeRequest) (*RecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAuthServer) LoginOAuthUser(context.Context, *LoginOAuthUserRequest) (*LoginExternalUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginOAuthUser not implemented")
+}
+func (UnimplementedAuthServer) AddOAuthUser(context.Context, *AddOAuthUserRequest) (*AddExternalUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOAuthUser not implemented")
+}
+func (UnimplementedAuthServer) LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedAuthServer) UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*UnlinkIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkIdentity not implemented")
+}
+func (UnimplementedAuthServer) GetIdentities(context.Context, *IdentitiesRequest) (*IdentitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentities not implemented")
+}
 func (UnimplementedAuthServer) mustEmbedUnimplementedAuthServer() {}
 func (UnimplementedAuthServer) testEmbeddedByValue()              {}
 
@@ -240,6 +416,204 @@ func _Auth_CheckImgPermission_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_GetTwoFactorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).GetTwoFactorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_GetTwoFactorStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).GetTwoFactorStatus(ctx, req.(*TwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_SetupTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).SetupTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_SetupTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).SetupTwoFactor(ctx, req.(*TwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ConfirmTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ConfirmTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ConfirmTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ConfirmTwoFactor(ctx, req.(*TwoFactorCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_VerifyTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).VerifyTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_VerifyTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).VerifyTwoFactor(ctx, req.(*TwoFactorCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_DisableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).DisableTwoFactor(ctx, req.(*TwoFactorCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwoFactorCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).RegenerateRecoveryCodes(ctx, req.(*TwoFactorCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_LoginOAuthUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginOAuthUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).LoginOAuthUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_LoginOAuthUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).LoginOAuthUser(ctx, req.(*LoginOAuthUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_AddOAuthUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOAuthUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).AddOAuthUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_AddOAuthUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).AddOAuthUser(ctx, req.(*AddOAuthUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).LinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_LinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).LinkIdentity(ctx, req.(*LinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_UnlinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).UnlinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_UnlinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).UnlinkIdentity(ctx, req.(*UnlinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_GetIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).GetIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_GetIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).GetIdentities(ctx, req.(*IdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Auth_ServiceDesc is the grpc.ServiceDesc for Auth service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +641,50 @@ var Auth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckImgPermission",
 			Handler:    _Auth_CheckImgPermission_Handler,
 		},
+		{
+			MethodName: "GetTwoFactorStatus",
+			Handler:    _Auth_GetTwoFactorStatus_Handler,
+		},
+		{
+			MethodName: "SetupTwoFactor",
+			Handler:    _Auth_SetupTwoFactor_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactor",
+			Handler:    _Auth_ConfirmTwoFactor_Handler,
+		},
+		{
+			MethodName: "VerifyTwoFactor",
+			Handler:    _Auth_VerifyTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _Auth_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _Auth_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "LoginOAuthUser",
+			Handler:    _Auth_LoginOAuthUser_Handler,
+		},
+		{
+			MethodName: "AddOAuthUser",
+			Handler:    _Auth_AddOAuthUser_Handler,
+		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _Auth_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "UnlinkIdentity",
+			Handler:    _Auth_UnlinkIdentity_Handler,
+		},
+		{
+			MethodName: "GetIdentities",
+			Handler:    _Auth_GetIdentities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/proto/auth/auth.proto",
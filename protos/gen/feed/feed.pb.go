@@ -25,6 +25,7 @@ type GetPinsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int64                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NsfwMode      string                 `protobuf:"bytes,3,opt,name=nsfw_mode,json=nsfwMode,proto3" json:"nsfw_mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,6 +74,97 @@ func (x *GetPinsRequest) GetPageSize() int64 {
 	return 0
 }
 
+func (x *GetPinsRequest) GetNsfwMode() string {
+	if x != nil {
+		return x.NsfwMode
+	}
+	return ""
+}
+
+type Media struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Width         int64                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int64                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	PosterUrl     string                 `protobuf:"bytes,6,opt,name=poster_url,json=posterUrl,proto3" json:"poster_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_protos_proto_feed_feed_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_proto_feed_feed_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_protos_proto_feed_feed_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Media) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Media) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Media) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Media) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Media) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *Media) GetPosterUrl() string {
+	if x != nil {
+		return x.PosterUrl
+	}
+	return ""
+}
+
 type Pin struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	FlowId         uint64                 `protobuf:"varint,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
@@ -88,13 +180,16 @@ type Pin struct {
 	LikeCount      int64                  `protobuf:"varint,11,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
 	Width          int64                  `protobuf:"varint,12,opt,name=width,proto3" json:"width,omitempty"`
 	Height         int64                  `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
+	IsNsfw         bool                   `protobuf:"varint,14,opt,name=is_nsfw,json=isNsfw,proto3" json:"is_nsfw,omitempty"`
+	Media          []*Media               `protobuf:"bytes,15,rep,name=media,proto3" json:"media,omitempty"`
+	SaveCount      int64                  `protobuf:"varint,16,opt,name=save_count,json=saveCount,proto3" json:"save_count,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Pin) Reset() {
 	*x = Pin{}
-	mi := &file_protos_proto_feed_feed_proto_msgTypes[1]
+	mi := &file_protos_proto_feed_feed_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -106,7 +201,7 @@ func (x *Pin) String() string {
 func (*Pin) ProtoMessage() {}
 
 func (x *Pin) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_feed_feed_proto_msgTypes[1]
+	mi := &file_protos_proto_feed_feed_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -119,7 +214,7 @@ func (x *Pin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pin.ProtoReflect.Descriptor instead.
 func (*Pin) Descriptor() ([]byte, []int) {
-	return file_protos_proto_feed_feed_proto_rawDescGZIP(), []int{1}
+	return file_protos_proto_feed_feed_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Pin) GetFlowId() uint64 {
@@ -213,6 +308,27 @@ func (x *Pin) GetHeight() int64 {
 	return 0
 }
 
+func (x *Pin) GetIsNsfw() bool {
+	if x != nil {
+		return x.IsNsfw
+	}
+	return false
+}
+
+func (x *Pin) GetMedia() []*Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Pin) GetSaveCount() int64 {
+	if x != nil {
+		return x.SaveCount
+	}
+	return 0
+}
+
 type GetPinsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pins          []*Pin                 `protobuf:"bytes,1,rep,name=pins,proto3" json:"pins,omitempty"`
@@ -222,7 +338,7 @@ type GetPinsResponse struct {
 
 func (x *GetPinsResponse) Reset() {
 	*x = GetPinsResponse{}
-	mi := &file_protos_proto_feed_feed_proto_msgTypes[2]
+	mi := &file_protos_proto_feed_feed_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -234,7 +350,7 @@ func (x *GetPinsResponse) String() string {
 func (*GetPinsResponse) ProtoMessage() {}
 
 func (x *GetPinsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_proto_feed_feed_proto_msgTypes[2]
+	mi := &file_protos_proto_feed_feed_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +363,7 @@ func (x *GetPinsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPinsResponse.ProtoReflect.Descriptor instead.
 func (*GetPinsResponse) Descriptor() ([]byte, []int) {
-	return file_protos_proto_feed_feed_proto_rawDescGZIP(), []int{2}
+	return file_protos_proto_feed_feed_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetPinsResponse) GetPins() []*Pin {
@@ -262,10 +378,20 @@ var File_protos_proto_feed_feed_proto protoreflect.FileDescriptor
 const file_protos_proto_feed_feed_proto_rawDesc = "" +
 	"\n" +
 	"\x1cprotos/proto/feed/feed.proto\x12\n" +
-	"proto_feed\"A\n" +
+	"proto_feed\"^\n" +
 	"\x0eGetPinsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x03R\bpageSize\"\x80\x03\n" +
+	"\tpage_size\x18\x02 \x01(\x03R\bpageSize\x12\x1b\n" +
+	"\tnsfw_mode\x18\x03 \x01(\tR\bnsfwMode\"\x9b\x01\n" +
+	"\x05Media\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x03R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x03R\x06height\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\x12\x1d\n" +
+	"\n" +
+	"poster_url\x18\x06 \x01(\tR\tposterUrl\"\xe1\x03\n" +
 	"\x03Pin\x12\x17\n" +
 	"\aflow_id\x18\x01 \x01(\x04R\x06flowId\x12\x16\n" +
 	"\x06header\x18\x02 \x01(\tR\x06header\x12\x1b\n" +
@@ -284,7 +410,11 @@ const file_protos_proto_feed_feed_proto_rawDesc = "" +
 	"\n" +
 	"like_count\x18\v \x01(\x03R\tlikeCount\x12\x14\n" +
 	"\x05width\x18\f \x01(\x03R\x05width\x12\x16\n" +
-	"\x06height\x18\r \x01(\x03R\x06height\"6\n" +
+	"\x06height\x18\r \x01(\x03R\x06height\x12\x17\n" +
+	"\ais_nsfw\x18\x0e \x01(\bR\x06isNsfw\x12'\n" +
+	"\x05media\x18\x0f \x03(\v2\x11.proto_feed.MediaR\x05media\x12\x1d\n" +
+	"\n" +
+	"save_count\x18\x10 \x01(\x03R\tsaveCount\"6\n" +
 	"\x0fGetPinsResponse\x12#\n" +
 	"\x04pins\x18\x01 \x03(\v2\x0f.proto_feed.PinR\x04pins2L\n" +
 	"\x04Feed\x12D\n" +
@@ -302,21 +432,23 @@ func file_protos_proto_feed_feed_proto_rawDescGZIP() []byte {
 	return file_protos_proto_feed_feed_proto_rawDescData
 }
 
-var file_protos_proto_feed_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_protos_proto_feed_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_protos_proto_feed_feed_proto_goTypes = []any{
 	(*GetPinsRequest)(nil),  // 0: proto_feed.GetPinsRequest
-	(*Pin)(nil),             // 1: proto_feed.Pin
-	(*GetPinsResponse)(nil), // 2: proto_feed.GetPinsResponse
+	(*Media)(nil),           // 1: proto_feed.Media
+	(*Pin)(nil),             // 2: proto_feed.Pin
+	(*GetPinsResponse)(nil), // 3: proto_feed.GetPinsResponse
 }
 var file_protos_proto_feed_feed_proto_depIdxs = []int32{
-	1, // 0: proto_feed.GetPinsResponse.pins:type_name -> proto_feed.Pin
-	0, // 1: proto_feed.Feed.GetPins:input_type -> proto_feed.GetPinsRequest
-	2, // 2: proto_feed.Feed.GetPins:output_type -> proto_feed.GetPinsResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: proto_feed.Pin.media:type_name -> proto_feed.Media
+	2, // 1: proto_feed.GetPinsResponse.pins:type_name -> proto_feed.Pin
+	0, // 2: proto_feed.Feed.GetPins:input_type -> proto_feed.GetPinsRequest
+	3, // 3: proto_feed.Feed.GetPins:output_type -> proto_feed.GetPinsResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_protos_proto_feed_feed_proto_init() }
@@ -330,7 +462,7 @@ func file_protos_proto_feed_feed_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_proto_feed_feed_proto_rawDesc), len(file_protos_proto_feed_feed_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
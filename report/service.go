@@ -0,0 +1,100 @@
+package report
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+)
+
+type ReportRepository interface {
+	AddReport(ctx context.Context, report domain.Report) (int, error)
+	TargetExists(ctx context.Context, targetType string, targetID, userID int) (bool, error)
+	IsModerator(ctx context.Context, userID int) (bool, error)
+	GetReports(ctx context.Context, status string, page, size int) ([]domain.Report, error)
+	GetReport(ctx context.Context, reportID int) (domain.Report, error)
+	ResolveReport(ctx context.Context, report domain.Report, moderatorID int, resolution domain.ReportResolution) error
+	GetModeratorActions(ctx context.Context, page, size int) ([]domain.ModeratorAction, error)
+}
+
+type ReportService struct {
+	repo ReportRepository
+}
+
+func NewReportService(repo ReportRepository) *ReportService {
+	return &ReportService{
+		repo: repo,
+	}
+}
+
+func (s *ReportService) CreateReport(ctx context.Context, report domain.Report) (int, error) {
+	if err := report.Validate(); err != nil {
+		return 0, err
+	}
+
+	exists, err := s.repo.TargetExists(ctx, report.TargetType, report.TargetID, report.ReporterID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !exists {
+		return 0, domain.ErrNotFound
+	}
+
+	return s.repo.AddReport(ctx, report)
+}
+
+func (s *ReportService) GetReports(ctx context.Context, userID int, status string, page, size int) ([]domain.Report, error) {
+	if err := s.checkModerator(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case domain.ReportStatusOpen, domain.ReportStatusResolved, domain.ReportStatusDismissed:
+	default:
+		return nil, domain.ErrInvalidReportStatus
+	}
+
+	return s.repo.GetReports(ctx, status, page, size)
+}
+
+func (s *ReportService) ResolveReport(ctx context.Context, userID, reportID int, resolution domain.ReportResolution) error {
+	if err := s.checkModerator(ctx, userID); err != nil {
+		return err
+	}
+
+	report, err := s.repo.GetReport(ctx, reportID)
+	if err != nil {
+		return err
+	}
+
+	if report.Status != domain.ReportStatusOpen {
+		return domain.ErrReportResolved
+	}
+
+	if err := resolution.Validate(report.TargetType); err != nil {
+		return err
+	}
+
+	return s.repo.ResolveReport(ctx, report, userID, resolution)
+}
+
+func (s *ReportService) GetModeratorActions(ctx context.Context, userID, page, size int) ([]domain.ModeratorAction, error) {
+	if err := s.checkModerator(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetModeratorActions(ctx, page, size)
+}
+
+func (s *ReportService) checkModerator(ctx context.Context, userID int) error {
+	isModerator, err := s.repo.IsModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !isModerator {
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
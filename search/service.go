@@ -9,7 +9,7 @@ import (
 )
 
 type SearchRepository interface {
-	SearchPins(ctx context.Context, query string, page, pageSize int) ([]domain.PinData, error)
+	SearchPins(ctx context.Context, query, sourceDomain string, tags []string, page, pageSize int, nsfwMode string) ([]domain.PinData, error)
 	SearchUsers(ctx context.Context, query string, page, pageSize int) ([]domain.PublicUser, error)
 	SearchBoards(ctx context.Context, query string, page, pageSize, previewNum, previewStart int) ([]domain.Board, error) 
 }
@@ -37,14 +37,14 @@ func NewSearchService(repo SearchRepository, baseURL, imageDir, staticDir, avata
 	}
 }
 
-func (s *SearchService) SearchPins(ctx context.Context, query string, page, pageSize int) ([]domain.PinData, error) {
-	pins, err := s.repo.SearchPins(ctx, query, page, pageSize)
+func (s *SearchService) SearchPins(ctx context.Context, query, sourceDomain string, tags []string, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	pins, err := s.repo.SearchPins(ctx, query, sourceDomain, tags, page, pageSize, nsfwMode)
 	if err != nil {
 		return nil, err
 	}
 
 	for v := range pins {
-		pins[v].MediaURL = s.generateImageURL(pins[v].MediaURL)
+		pins[v].MapMediaURLs(s.generateImageURL)
 	}
 
 	return pins, err
@@ -13,6 +13,12 @@ type SubscriptionRepository interface {
 	GetUserFollowing(ctx context.Context, id, page, size int) ([]domain.PublicUser, error)
 	CreateSubscription(ctx context.Context, targetUsername string, currentID int) error
 	DeleteSubscription(ctx context.Context, targetUsername string, currentID int) error	
+	IsPrivateAccount(ctx context.Context, username string) (bool, error)
+	CreateFollowRequest(ctx context.Context, targetUsername string, currentID int) error
+	CancelFollowRequest(ctx context.Context, targetUsername string, currentID int) error
+	GetFollowRequests(ctx context.Context, targetID, page, size int) ([]domain.FollowRequest, error)
+	AcceptFollowRequest(ctx context.Context, targetID int, requesterUsername string) (int, error)
+	DeclineFollowRequest(ctx context.Context, targetID int, requesterUsername string) error
 }
 
 type ContactRepository interface {
@@ -67,17 +73,76 @@ func (service *SubscriptionService) GetUserFollowing(ctx context.Context, id, pa
 	return following, nil
 }
 
-func (service *SubscriptionService) CreateSubscription(ctx context.Context, username, targetUsername string, currentID int) error {
-	err := service.contactRepo.AddToContacts(ctx, username, targetUsername)
+// CreateSubscription подписывает на пользователя. На закрытый аккаунт
+// вместо подписки отправляется заявка, возвращается статус подписки
+func (service *SubscriptionService) CreateSubscription(ctx context.Context, username, targetUsername string, currentID int) (string, error) {
+	isPrivate, err := service.subRepo.IsPrivateAccount(ctx, targetUsername)
+	if err != nil {
+		return "", err
+	}
+
+	if isPrivate && username != targetUsername {
+		if err := service.subRepo.CreateFollowRequest(ctx, targetUsername, currentID); err != nil {
+			return "", err
+		}
+
+		return domain.FollowStatusRequested, nil
+	}
+
+	err = service.contactRepo.AddToContacts(ctx, username, targetUsername)
 	if err != nil && !errors.Is(err, domain.ErrConflict) {
-		return err
+		return "", err
 	}
 
-	return service.subRepo.CreateSubscription(ctx, targetUsername, currentID)
+	if err := service.subRepo.CreateSubscription(ctx, targetUsername, currentID); err != nil {
+		return "", err
+	}
+
+	return domain.FollowStatusFollowing, nil
 }
 
+// DeleteSubscription отписывает от пользователя, а если подписки нет — отзывает заявку
 func (service *SubscriptionService) DeleteSubscription(ctx context.Context, targetUsername string, currentID int) error {
-	return service.subRepo.DeleteSubscription(ctx, targetUsername, currentID)
+	err := service.subRepo.DeleteSubscription(ctx, targetUsername, currentID)
+	if !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	return service.subRepo.CancelFollowRequest(ctx, targetUsername, currentID)
+}
+
+func (service *SubscriptionService) GetFollowRequests(ctx context.Context, targetID, page, size int) ([]domain.FollowRequest, error) {
+	requests, err := service.subRepo.GetFollowRequests(ctx, targetID, page, size)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range requests {
+		if !requests[i].User.IsExternalAvatar {
+			requests[i].User.Avatar = service.generateAvatarURL(requests[i].User.Avatar)
+		}
+	}
+
+	return requests, nil
+}
+
+// AcceptFollowRequest одобряет заявку и возвращает id нового подписчика
+func (service *SubscriptionService) AcceptFollowRequest(ctx context.Context, username string, targetID int, requesterUsername string) (int, error) {
+	requesterID, err := service.subRepo.AcceptFollowRequest(ctx, targetID, requesterUsername)
+	if err != nil {
+		return 0, err
+	}
+
+	err = service.contactRepo.AddToContacts(ctx, requesterUsername, username)
+	if err != nil && !errors.Is(err, domain.ErrConflict) {
+		return 0, err
+	}
+
+	return requesterID, nil
+}
+
+func (service *SubscriptionService) DeclineFollowRequest(ctx context.Context, targetID int, requesterUsername string) error {
+	return service.subRepo.DeclineFollowRequest(ctx, targetID, requesterUsername)
 }
 
 func (s *SubscriptionService) generateAvatarURL(filename string) string {
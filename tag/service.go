@@ -0,0 +1,119 @@
+package tag
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	"github.com/go-park-mail-ru/2025_1_SuperChips/utils/hashtag"
+)
+
+type TagRepository interface {
+	GetTag(ctx context.Context, name string, userID int) (domain.Tag, error)
+	GetTagFlows(ctx context.Context, name string, page, pageSize int, nsfwMode string) ([]domain.PinData, error)
+	Autocomplete(ctx context.Context, prefix string, limit int) ([]domain.Tag, error)
+	FollowTag(ctx context.Context, name string, userID int) error
+	UnfollowTag(ctx context.Context, name string, userID int) error
+	GetFollowedTags(ctx context.Context, userID int) ([]domain.Tag, error)
+	GetFollowedTagsFlow(ctx context.Context, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error)
+}
+
+// сколько подсказок отдаётся при автодополнении
+const autocompleteLimit = 10
+
+type TagService struct {
+	repo     TagRepository
+	baseURL  string
+	imageDir string
+}
+
+func NewTagService(repo TagRepository, baseURL, imageDir string) *TagService {
+	return &TagService{
+		repo:     repo,
+		baseURL:  baseURL,
+		imageDir: imageDir,
+	}
+}
+
+func (s *TagService) GetTag(ctx context.Context, name string, userID int) (domain.Tag, error) {
+	name, ok := hashtag.Normalize(name)
+	if !ok {
+		return domain.Tag{}, domain.ErrInvalidTag
+	}
+
+	return s.repo.GetTag(ctx, name, userID)
+}
+
+func (s *TagService) GetTagFlows(ctx context.Context, name string, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	name, ok := hashtag.Normalize(name)
+	if !ok {
+		return nil, domain.ErrInvalidTag
+	}
+
+	flows, err := s.repo.GetTagFlows(ctx, name, page, pageSize, nsfwMode)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mapMediaURLs(flows)
+
+	return flows, nil
+}
+
+// Autocomplete принимает начало тега; пока введена только решётка, подсказок нет
+func (s *TagService) Autocomplete(ctx context.Context, prefix string) ([]domain.Tag, error) {
+	if strings.TrimPrefix(strings.TrimSpace(prefix), "#") == "" {
+		return nil, nil
+	}
+
+	prefix, ok := hashtag.Normalize(prefix)
+	if !ok {
+		return nil, domain.ErrInvalidTag
+	}
+
+	return s.repo.Autocomplete(ctx, prefix, autocompleteLimit)
+}
+
+func (s *TagService) FollowTag(ctx context.Context, name string, userID int) error {
+	name, ok := hashtag.Normalize(name)
+	if !ok {
+		return domain.ErrInvalidTag
+	}
+
+	return s.repo.FollowTag(ctx, name, userID)
+}
+
+func (s *TagService) UnfollowTag(ctx context.Context, name string, userID int) error {
+	name, ok := hashtag.Normalize(name)
+	if !ok {
+		return domain.ErrInvalidTag
+	}
+
+	return s.repo.UnfollowTag(ctx, name, userID)
+}
+
+func (s *TagService) GetFollowedTags(ctx context.Context, userID int) ([]domain.Tag, error) {
+	return s.repo.GetFollowedTags(ctx, userID)
+}
+
+func (s *TagService) GetFollowedTagsFlow(ctx context.Context, userID, page, pageSize int, nsfwMode string) ([]domain.PinData, error) {
+	flows, err := s.repo.GetFollowedTagsFlow(ctx, userID, page, pageSize, nsfwMode)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mapMediaURLs(flows)
+
+	return flows, nil
+}
+
+func (s *TagService) mapMediaURLs(flows []domain.PinData) {
+	for i := range flows {
+		flows[i].MapMediaURLs(s.generateImageURL)
+	}
+}
+
+func (s *TagService) generateImageURL(filename string) string {
+	return s.baseURL + filepath.Join(strings.ReplaceAll(s.imageDir, ".", ""), filename)
+}
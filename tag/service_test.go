@@ -0,0 +1,47 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_SuperChips/domain"
+	mock_tag "github.com/go-park-mail-ru/2025_1_SuperChips/mocks/tag/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetTagFlows_NormalizesName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_tag.NewMockTagRepository(ctrl)
+	service := NewTagService(mockRepo, "https://host", "./static/img")
+
+	mockRepo.EXPECT().GetTagFlows(gomock.Any(), "котики", 1, 10, domain.NSFWModeHide).
+		Return([]domain.PinData{{MediaURL: "a.jpg"}}, nil)
+
+	flows, err := service.GetTagFlows(context.Background(), "#Котики", 1, 10, domain.NSFWModeHide)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://host/static/img/a.jpg", flows[0].MediaURL)
+}
+
+func TestAutocomplete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_tag.NewMockTagRepository(ctrl)
+	service := NewTagService(mockRepo, "", "")
+
+	tags, err := service.Autocomplete(context.Background(), " # ")
+	assert.NoError(t, err)
+	assert.Nil(t, tags)
+
+	_, err = service.Autocomplete(context.Background(), "bad-tag")
+	assert.ErrorIs(t, err, domain.ErrInvalidTag)
+
+	mockRepo.EXPECT().Autocomplete(gomock.Any(), "ca", autocompleteLimit).Return([]domain.Tag{{Name: "cats", FlowCount: 3}}, nil)
+
+	tags, err = service.Autocomplete(context.Background(), "#Ca")
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Tag{{Name: "cats", FlowCount: 3}}, tags)
+}
@@ -0,0 +1,68 @@
+package hashtag
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MaxLength = 64
+	// больше тегов из одного текста не берётся
+	MaxTags = 30
+)
+
+// Normalize приводит тег к виду, в котором он хранится: без решётки,
+// в нижнем регистре, только буквы, цифры и подчёркивание
+func Normalize(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if tag == "" || utf8.RuneCountInString(tag) > MaxLength {
+		return "", false
+	}
+
+	for _, r := range tag {
+		if !isTagRune(r) {
+			return "", false
+		}
+	}
+
+	return tag, true
+}
+
+// Extract находит в тексте хэштеги вида #слово. Повторы убираются,
+// порядок сохраняется, слишком длинные теги пропускаются.
+func Extract(text string) []string {
+	var tags []string
+	seen := make(map[string]struct{})
+
+	runes := []rune(text)
+	for i := 0; i < len(runes) && len(tags) < MaxTags; i++ {
+		// решётка внутри слова (например, в ссылке) тегом не считается
+		if runes[i] != '#' || (i > 0 && isTagRune(runes[i-1])) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isTagRune(runes[end]) {
+			end++
+		}
+
+		tag, ok := Normalize(string(runes[i+1 : end]))
+		i = end - 1
+		if !ok {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+
+		seen[tag] = struct{}{}
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
@@ -0,0 +1,39 @@
+package hashtag
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{"Сценарий: несколько тегов", "Закат #Море и #закат_2024, снова #море", []string{"море", "закат_2024"}},
+		{"Сценарий: решётка внутри слова", "https://example.com/page#top и a#b", nil},
+		{"Сценарий: пустая решётка", "# просто текст ##", nil},
+		{"Сценарий: слишком длинный тег", "#" + strings.Repeat("a", MaxLength+1) + " #ok", []string{"ok"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Extract(tt.text))
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tag, ok := Normalize(" #Кухня ")
+	assert.True(t, ok)
+	assert.Equal(t, "кухня", tag)
+
+	_, ok = Normalize("два слова")
+	assert.False(t, ok)
+
+	_, ok = Normalize("#")
+	assert.False(t, ok)
+}
@@ -0,0 +1,107 @@
+package opengraph
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+type Meta struct {
+	Title       string
+	Description string
+	Image       string
+}
+
+// Parse достаёт из страницы og:title, og:description и og:image.
+// Если OpenGraph-тегов нет, используются <title> и meta description.
+// Относительная ссылка на картинку приводится к абсолютной по pageURL.
+func Parse(r io.Reader, pageURL *url.URL) (Meta, error) {
+	var meta Meta
+	var fallbackTitle, fallbackDescription string
+	var inTitle bool
+
+	tokenizer := html.NewTokenizer(r)
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			if tokenizer.Err() != io.EOF {
+				return Meta{}, tokenizer.Err()
+			}
+			return finish(meta, fallbackTitle, fallbackDescription, pageURL), nil
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "title":
+				inTitle = true
+			case "meta":
+				key, content := metaAttrs(token)
+				switch key {
+				case "og:title":
+					setOnce(&meta.Title, content)
+				case "og:description":
+					setOnce(&meta.Description, content)
+				case "og:image", "og:image:url", "og:image:secure_url":
+					setOnce(&meta.Image, content)
+				case "description":
+					setOnce(&fallbackDescription, content)
+				}
+			case "body":
+				// метаданные лежат в head, тело страницы читать незачем
+				return finish(meta, fallbackTitle, fallbackDescription, pageURL), nil
+			}
+		case html.TextToken:
+			if inTitle {
+				setOnce(&fallbackTitle, string(tokenizer.Text()))
+			}
+		case html.EndTagToken:
+			if tokenizer.Token().Data == "title" {
+				inTitle = false
+			}
+		}
+	}
+}
+
+func metaAttrs(token html.Token) (string, string) {
+	var key, content string
+	for _, attr := range token.Attr {
+		switch attr.Key {
+		case "property", "name":
+			if key == "" {
+				key = strings.ToLower(strings.TrimSpace(attr.Val))
+			}
+		case "content":
+			content = attr.Val
+		}
+	}
+
+	return key, content
+}
+
+func setOnce(dst *string, value string) {
+	value = strings.TrimSpace(value)
+	if *dst == "" && value != "" {
+		*dst = value
+	}
+}
+
+func finish(meta Meta, fallbackTitle, fallbackDescription string, pageURL *url.URL) Meta {
+	if meta.Title == "" {
+		meta.Title = fallbackTitle
+	}
+	if meta.Description == "" {
+		meta.Description = fallbackDescription
+	}
+
+	if meta.Image != "" && pageURL != nil {
+		image, err := pageURL.Parse(meta.Image)
+		if err != nil || (image.Scheme != "http" && image.Scheme != "https") {
+			meta.Image = ""
+		} else {
+			meta.Image = image.String()
+		}
+	}
+
+	return meta
+}
@@ -0,0 +1,48 @@
+package opengraph
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	pageURL, _ := url.Parse("https://example.com/recipes/pie")
+
+	tests := []struct {
+		name     string
+		page     string
+		expected Meta
+	}{
+		{
+			"Сценарий: OpenGraph",
+			`<html><head>
+				<title>Ignored</title>
+				<meta property="og:title" content=" Apple pie ">
+				<meta property="og:description" content="Grandma's recipe">
+				<meta property="og:image" content="/img/pie.jpg">
+			</head><body><meta property="og:title" content="in body"></body></html>`,
+			Meta{Title: "Apple pie", Description: "Grandma's recipe", Image: "https://example.com/img/pie.jpg"},
+		},
+		{
+			"Сценарий: без OpenGraph",
+			`<html><head><title>Plain page</title><meta name="Description" content="About"></head></html>`,
+			Meta{Title: "Plain page", Description: "About"},
+		},
+		{
+			"Сценарий: картинка с чужой схемой",
+			`<meta property="og:image" content="javascript:alert(1)">`,
+			Meta{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta, err := Parse(strings.NewReader(tt.page), pageURL)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, meta)
+		})
+	}
+}
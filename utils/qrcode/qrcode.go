@@ -0,0 +1,491 @@
+package qrcode
+
+import (
+	"errors"
+)
+
+// Кодировщик QR-кодов по ISO/IEC 18004 для коротких строк вроде ссылок:
+// байтовый режим, уровень коррекции M, версии 1–10 (до 213 байт).
+// Маска выбирается по штрафным баллам стандарта.
+
+var ErrTooLong = errors.New("data is too long for qr code")
+
+// параметры блоков коррекции уровня M для версий 1–10
+type blockLayout struct {
+	ecPerBlock int
+	groups     [2][2]int // {количество блоков, байт данных в блоке}
+}
+
+var layoutsM = [...]blockLayout{
+	1:  {10, [2][2]int{{1, 16}}},
+	2:  {16, [2][2]int{{1, 28}}},
+	3:  {26, [2][2]int{{1, 44}}},
+	4:  {18, [2][2]int{{2, 32}}},
+	5:  {24, [2][2]int{{2, 43}}},
+	6:  {16, [2][2]int{{4, 27}}},
+	7:  {18, [2][2]int{{4, 31}}},
+	8:  {22, [2][2]int{{2, 38}, {2, 39}}},
+	9:  {22, [2][2]int{{3, 36}, {2, 37}}},
+	10: {26, [2][2]int{{4, 43}, {1, 44}}},
+}
+
+var alignmentPositions = [...][]int{
+	2:  {6, 18},
+	3:  {6, 22},
+	4:  {6, 26},
+	5:  {6, 30},
+	6:  {6, 34},
+	7:  {6, 22, 38},
+	8:  {6, 24, 42},
+	9:  {6, 26, 46},
+	10: {6, 28, 50},
+}
+
+const (
+	minVersion = 1
+	maxVersion = 10
+
+	formatBitsM = 0 // биты уровня M в информации о формате
+)
+
+// Code — матрица модулей, true означает тёмный модуль
+type Code struct {
+	Size    int
+	modules [][]bool
+	// служебные модули не маскируются и не заполняются данными
+	function [][]bool
+}
+
+// Dark сообщает, тёмный ли модуль в строке y и столбце x
+func (c *Code) Dark(x, y int) bool {
+	return c.modules[y][x]
+}
+
+// Encode строит QR-код минимальной версии, вмещающей data
+func Encode(data []byte) (*Code, error) {
+	version := minVersion
+	for ; version <= maxVersion; version++ {
+		if len(data) <= capacity(version) {
+			break
+		}
+	}
+	if version > maxVersion {
+		return nil, ErrTooLong
+	}
+
+	codewords := addErrorCorrection(version, encodeData(version, data))
+
+	c := newCode(version)
+	c.drawFunctionPatterns(version)
+	c.drawCodewords(codewords)
+
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		c.applyMask(mask)
+		c.drawFormatBits(mask)
+		if penalty := c.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+		c.applyMask(mask) // повторное наложение снимает маску
+	}
+
+	c.applyMask(best)
+	c.drawFormatBits(best)
+
+	return c, nil
+}
+
+func dataCodewords(version int) int {
+	total := 0
+	for _, group := range layoutsM[version].groups {
+		total += group[0] * group[1]
+	}
+	return total
+}
+
+// длина поля счётчика символов байтового режима зависит от версии
+func countBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+func capacity(version int) int {
+	return (dataCodewords(version)*8 - 4 - countBits(version)) / 8
+}
+
+func encodeData(version int, data []byte) []byte {
+	var bits bitBuffer
+	bits.append(0b0100, 4) // байтовый режим
+	bits.append(len(data), countBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+
+	limit := dataCodewords(version) * 8
+	bits.append(0, min(4, limit-len(bits)))
+	bits.append(0, (8-len(bits)%8)%8)
+	for pad := 0xEC; len(bits) < limit; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+
+	return bits.bytes()
+}
+
+// addErrorCorrection делит данные на блоки, добавляет коды Рида-Соломона
+// и перемежает байты блоков
+func addErrorCorrection(version int, data []byte) []byte {
+	layout := layoutsM[version]
+	divisor := rsDivisor(layout.ecPerBlock)
+
+	var blocks, ecBlocks [][]byte
+	for _, group := range layout.groups {
+		for i := 0; i < group[0]; i++ {
+			block := data[:group[1]]
+			data = data[group[1]:]
+			blocks = append(blocks, block)
+			ecBlocks = append(ecBlocks, rsRemainder(block, divisor))
+		}
+	}
+
+	var result []byte
+	for i := 0; ; i++ {
+		appended := false
+		for _, block := range blocks {
+			if i < len(block) {
+				result = append(result, block[i])
+				appended = true
+			}
+		}
+		if !appended {
+			break
+		}
+	}
+	for i := 0; i < layout.ecPerBlock; i++ {
+		for _, block := range ecBlocks {
+			result = append(result, block[i])
+		}
+	}
+
+	return result
+}
+
+func newCode(version int) *Code {
+	size := version*4 + 17
+	c := &Code{
+		Size:     size,
+		modules:  make([][]bool, size),
+		function: make([][]bool, size),
+	}
+	for i := range c.modules {
+		c.modules[i] = make([]bool, size)
+		c.function[i] = make([]bool, size)
+	}
+	return c
+}
+
+func (c *Code) setFunction(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.function[y][x] = true
+}
+
+func (c *Code) drawFunctionPatterns(version int) {
+	for i := 0; i < c.Size; i++ {
+		c.setFunction(6, i, i%2 == 0)
+		c.setFunction(i, 6, i%2 == 0)
+	}
+
+	c.drawFinder(3, 3)
+	c.drawFinder(c.Size-4, 3)
+	c.drawFinder(3, c.Size-4)
+
+	positions := alignmentPositions[version]
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// углы заняты поисковыми узорами
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			c.drawAlignment(x, y)
+		}
+	}
+
+	// резервирует место под информацию о формате до выбора маски
+	c.drawFormatBits(0)
+	c.drawVersion(version)
+}
+
+func (c *Code) drawFinder(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || xx >= c.Size || yy < 0 || yy >= c.Size {
+				continue
+			}
+			dist := max(abs(dx), abs(dy))
+			c.setFunction(xx, yy, dist != 2 && dist != 4)
+		}
+	}
+}
+
+func (c *Code) drawAlignment(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+func (c *Code) drawFormatBits(mask int) {
+	bits := formatBits(mask)
+
+	for i := 0; i <= 5; i++ {
+		c.setFunction(8, i, bit(bits, i))
+	}
+	c.setFunction(8, 7, bit(bits, 6))
+	c.setFunction(8, 8, bit(bits, 7))
+	c.setFunction(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		c.setFunction(14-i, 8, bit(bits, i))
+	}
+
+	for i := 0; i < 8; i++ {
+		c.setFunction(c.Size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunction(8, c.Size-15+i, bit(bits, i))
+	}
+	c.setFunction(8, c.Size-8, true)
+}
+
+// formatBits — 15 бит информации о формате с кодом БЧХ и маской 0x5412
+func formatBits(mask int) int {
+	data := formatBitsM<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+func (c *Code) drawVersion(version int) {
+	if version < 7 {
+		return
+	}
+
+	bits := versionBits(version)
+	for i := 0; i < 18; i++ {
+		a, b := c.Size-11+i%3, i/3
+		c.setFunction(a, b, bit(bits, i))
+		c.setFunction(b, a, bit(bits, i))
+	}
+}
+
+// versionBits — 18 бит информации о версии с кодом Голея
+func versionBits(version int) int {
+	rem := version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	return version<<12 | rem
+}
+
+// drawCodewords раскладывает байты змейкой по парам столбцов снизу вверх
+func (c *Code) drawCodewords(codewords []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < c.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vert
+				}
+				if c.function[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				c.modules[y][x] = bit(int(codewords[i>>3]), 7-i&7)
+				i++
+			}
+		}
+	}
+}
+
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.function[y][x] {
+				continue
+			}
+
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			c.modules[y][x] = c.modules[y][x] != invert
+		}
+	}
+}
+
+// penalty считает штрафные баллы стандарта: длинные серии, блоки 2×2,
+// узоры, похожие на поисковые, и перекос доли тёмных модулей
+func (c *Code) penalty() int {
+	result := 0
+	dark := 0
+
+	for y := 0; y < c.Size; y++ {
+		result += linePenalty(c.Size, func(i int) bool { return c.modules[y][i] })
+	}
+	for x := 0; x < c.Size; x++ {
+		result += linePenalty(c.Size, func(i int) bool { return c.modules[i][x] })
+	}
+
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.modules[y][x] {
+				dark++
+			}
+			if x+1 < c.Size && y+1 < c.Size {
+				color := c.modules[y][x]
+				if color == c.modules[y][x+1] && color == c.modules[y+1][x] && color == c.modules[y+1][x+1] {
+					result += 3
+				}
+			}
+		}
+	}
+
+	total := c.Size * c.Size
+	deviation := abs(dark*20-total*10) / total
+	result += deviation * 10
+
+	return result
+}
+
+var finderLike = [...][11]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+func linePenalty(size int, module func(int) bool) int {
+	result := 0
+
+	run := 1
+	for i := 1; i <= size; i++ {
+		if i < size && module(i) == module(i-1) {
+			run++
+			continue
+		}
+		if run >= 5 {
+			result += run - 2
+		}
+		run = 1
+	}
+
+	for i := 0; i+11 <= size; i++ {
+		for _, pattern := range finderLike {
+			matched := true
+			for j, dark := range pattern {
+				if module(i+j) != dark {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				result += 40
+			}
+		}
+	}
+
+	return result
+}
+
+// rsDivisor возвращает коэффициенты порождающего многочлена степени degree
+func rsDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+
+	return result
+}
+
+func rsRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i := range result {
+			result[i] ^= gfMultiply(divisor[i], factor)
+		}
+	}
+	return result
+}
+
+// умножение в GF(2^8) по модулю x^8 + x^4 + x^3 + x^2 + 1
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+type bitBuffer []bool
+
+func (b *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*b = append(*b, bit(value, i))
+	}
+}
+
+func (b bitBuffer) bytes() []byte {
+	result := make([]byte, len(b)/8)
+	for i, set := range b {
+		if set {
+			result[i>>3] |= 1 << (7 - i&7)
+		}
+	}
+	return result
+}
+
+func bit(value, i int) bool {
+	return (value>>i)&1 != 0
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
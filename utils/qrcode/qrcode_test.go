@@ -0,0 +1,236 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// decode читает код обратно без исправления ошибок: формат, маска,
+// змейка, разбор блоков и байтового режима
+func decode(t *testing.T, c *Code) []byte {
+	t.Helper()
+
+	version := (c.Size - 17) / 4
+
+	var format int
+	for i := 0; i <= 5; i++ {
+		format |= boolToInt(c.Dark(8, i)) << i
+	}
+	format |= boolToInt(c.Dark(8, 7)) << 6
+	format |= boolToInt(c.Dark(8, 8)) << 7
+	format |= boolToInt(c.Dark(7, 8)) << 8
+	for i := 9; i < 15; i++ {
+		format |= boolToInt(c.Dark(14-i, 8)) << i
+	}
+
+	var second int
+	for i := 0; i < 8; i++ {
+		second |= boolToInt(c.Dark(c.Size-1-i, 8)) << i
+	}
+	for i := 8; i < 15; i++ {
+		second |= boolToInt(c.Dark(8, c.Size-15+i)) << i
+	}
+	if format != second {
+		t.Fatalf("format copies differ: %015b and %015b", format, second)
+	}
+
+	mask := -1
+	for m := 0; m < 8; m++ {
+		if formatBits(m) == format {
+			mask = m
+		}
+	}
+	if mask < 0 {
+		t.Fatalf("unknown format bits %015b", format)
+	}
+
+	// служебные модули восстанавливаются из версии
+	reference := newCode(version)
+	reference.drawFunctionPatterns(version)
+
+	unmasked := newCode(version)
+	for y := 0; y < c.Size; y++ {
+		copy(unmasked.modules[y], c.modules[y])
+		copy(unmasked.function[y], reference.function[y])
+	}
+	unmasked.applyMask(mask)
+
+	var bits bitBuffer
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < c.Size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vert
+				}
+				if !unmasked.function[y][x] {
+					bits = append(bits, unmasked.modules[y][x])
+				}
+			}
+		}
+	}
+	codewords := bits.bytes()
+
+	layout := layoutsM[version]
+	var sizes []int
+	for _, group := range layout.groups {
+		for i := 0; i < group[0]; i++ {
+			sizes = append(sizes, group[1])
+		}
+	}
+
+	blocks := make([][]byte, len(sizes))
+	pos := 0
+	for i := 0; i < sizes[len(sizes)-1]; i++ {
+		for b, size := range sizes {
+			if i < size {
+				blocks[b] = append(blocks[b], codewords[pos])
+				pos++
+			}
+		}
+	}
+
+	divisor := rsDivisor(layout.ecPerBlock)
+	for i := 0; i < layout.ecPerBlock; i++ {
+		for b := range blocks {
+			blocks[b] = append(blocks[b], codewords[pos])
+			pos++
+		}
+	}
+
+	var data []byte
+	for b, block := range blocks {
+		size := sizes[b]
+		if !bytes.Equal(rsRemainder(block[:size], divisor), block[size:]) {
+			t.Fatalf("block %d has invalid error correction", b)
+		}
+		data = append(data, block[:size]...)
+	}
+
+	var stream bitBuffer
+	for _, b := range data {
+		stream.append(int(b), 8)
+	}
+	read := func(n int) int {
+		value := 0
+		for i := 0; i < n; i++ {
+			value = value<<1 | boolToInt(stream[i])
+		}
+		stream = stream[n:]
+		return value
+	}
+
+	if mode := read(4); mode != 0b0100 {
+		t.Fatalf("unexpected mode %04b", mode)
+	}
+	length := read(countBits(version))
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = byte(read(8))
+	}
+
+	return result
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		version int
+	}{
+		{"Сценарий: короткая строка", "HELLO", 1},
+		{"Сценарий: ссылка-приглашение", "https://yourflow.ru/join/0f8fad5b-d9cb-469f-a165-70867728950e", 4},
+		{"Сценарий: версия с информацией о версии", strings.Repeat("a", 150), 8},
+		{"Сценарий: максимальная длина", strings.Repeat("z", 213), 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Encode([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.Size != tt.version*4+17 {
+				t.Errorf("expected version %d; got size %d", tt.version, c.Size)
+			}
+			if got := string(decode(t, c)); got != tt.data {
+				t.Errorf("decoded %q; want %q", got, tt.data)
+			}
+		})
+	}
+}
+
+func TestEncode_TooLong(t *testing.T) {
+	if _, err := Encode([]byte(strings.Repeat("z", 214))); err != ErrTooLong {
+		t.Errorf("expected ErrTooLong; got %v", err)
+	}
+}
+
+func TestFormatBits(t *testing.T) {
+	// значения из таблицы стандарта для уровня M
+	expected := map[int]int{
+		0: 0b101010000010010,
+		5: 0b100000011001110,
+	}
+
+	for mask, bits := range expected {
+		if got := formatBits(mask); got != bits {
+			t.Errorf("mask %d: expected %015b; got %015b", mask, bits, got)
+		}
+	}
+}
+
+func TestPNG(t *testing.T) {
+	c, err := Encode([]byte("HELLO"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	raw, err := c.PNG(4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("failed decoding png: %v", err)
+	}
+	if side := (c.Size + 2*quietZone) * 4; img.Bounds().Dx() != side {
+		t.Errorf("expected side %d; got %d", side, img.Bounds().Dx())
+	}
+}
+
+func TestVersionBits(t *testing.T) {
+	expected := map[int]int{
+		7:  0x07C94,
+		10: 0x0A4D3,
+	}
+
+	for version, bits := range expected {
+		if got := versionBits(version); got != bits {
+			t.Errorf("version %d: expected %018b; got %018b", version, bits, got)
+		}
+	}
+}
+
+func TestRSRemainder(t *testing.T) {
+	// «HELLO WORLD», версия 1-M в буквенно-цифровом режиме
+	data := []byte{0x20, 0x5B, 0x0B, 0x78, 0xD1, 0x72, 0xDC, 0x4D, 0x43, 0x40, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11}
+	expected := []byte{0xC4, 0x23, 0x27, 0x77, 0xEB, 0xD7, 0xE7, 0xE2, 0x5D, 0x17}
+
+	if got := rsRemainder(data, rsDivisor(10)); !bytes.Equal(got, expected) {
+		t.Errorf("expected % X; got % X", expected, got)
+	}
+}
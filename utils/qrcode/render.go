@@ -0,0 +1,56 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+// ширина светлой рамки вокруг кода в модулях, меньше сканеры не гарантируют
+const quietZone = 4
+
+// PNG рисует код, каждый модуль занимает scale×scale пикселей
+func (c *Code) PNG(scale int) ([]byte, error) {
+	side := (c.Size + 2*quietZone) * scale
+	img := image.NewGray(image.Rect(0, 0, side, side))
+
+	for py := 0; py < side; py++ {
+		for px := 0; px < side; px++ {
+			x, y := px/scale-quietZone, py/scale-quietZone
+			value := color.Gray{Y: 0xFF}
+			if x >= 0 && x < c.Size && y >= 0 && y < c.Size && c.Dark(x, y) {
+				value = color.Gray{Y: 0}
+			}
+			img.SetGray(px, py, value)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SVG описывает тёмные модули одним path, размер задаётся в модулях
+func (c *Code) SVG() []byte {
+	side := c.Size + 2*quietZone
+
+	var path strings.Builder
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.Dark(x, y) {
+				fmt.Fprintf(&path, "M%d,%dh1v1h-1z", x+quietZone, y+quietZone)
+			}
+		}
+	}
+
+	return []byte(fmt.Sprintf(
+		`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+			`<rect width="100%%" height="100%%" fill="#fff"/><path d="%s" fill="#000"/></svg>`,
+		side, side, path.String()))
+}
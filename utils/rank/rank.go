@@ -0,0 +1,267 @@
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+// Ключи сортировки — строки, упорядоченные побайтово (в postgres колонка
+// должна иметь COLLATE "C"). Ключ состоит из целой части переменной длины,
+// длина которой закодирована первым символом, и дробной части. Целая часть
+// позволяет бесконечно добавлять элементы в начало и конец списка без роста
+// длины ключа, дробная — вставлять элементы между соседями, не трогая остальные.
+
+const (
+	digits   = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	zero     = '0'
+	smallest = "A00000000000000000000000000"
+)
+
+var (
+	ErrInvalidKey   = errors.New("invalid rank key")
+	ErrInvalidRange = errors.New("rank keys are out of order")
+	ErrExhausted    = errors.New("rank key space exhausted")
+)
+
+// Between возвращает ключ строго между a и b.
+// Пустая строка означает отсутствие соседа с соответствующей стороны.
+func Between(a, b string) (string, error) {
+	if a != "" {
+		if err := validate(a); err != nil {
+			return "", err
+		}
+	}
+	if b != "" {
+		if err := validate(b); err != nil {
+			return "", err
+		}
+	}
+	if a != "" && b != "" && a >= b {
+		return "", ErrInvalidRange
+	}
+
+	if a == "" {
+		if b == "" {
+			return "a0", nil
+		}
+
+		ib := integerPart(b)
+		fb := b[len(ib):]
+		if ib == smallest {
+			return ib + midpoint("", fb), nil
+		}
+		if ib < b {
+			return ib, nil
+		}
+
+		res, ok := decrement(ib)
+		if !ok {
+			return "", ErrExhausted
+		}
+		return res, nil
+	}
+
+	if b == "" {
+		ia := integerPart(a)
+		fa := a[len(ia):]
+		res, ok := increment(ia)
+		if !ok {
+			return ia + midpoint(fa, ""), nil
+		}
+		return res, nil
+	}
+
+	ia := integerPart(a)
+	fa := a[len(ia):]
+	ib := integerPart(b)
+	fb := b[len(ib):]
+	if ia == ib {
+		return ia + midpoint(fa, fb), nil
+	}
+
+	res, ok := increment(ia)
+	if !ok {
+		return "", ErrExhausted
+	}
+	if res < b {
+		return res, nil
+	}
+
+	return ia + midpoint(fa, ""), nil
+}
+
+// Spread возвращает n возрастающих ключей, начиная с начала пространства ключей
+func Spread(n int) ([]string, error) {
+	keys := make([]string, 0, n)
+	prev := ""
+	for range n {
+		key, err := Between(prev, "")
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+		prev = key
+	}
+
+	return keys, nil
+}
+
+// midpoint работает только с дробными частями ключей, b == "" — верхняя граница
+func midpoint(a, b string) string {
+	if b != "" {
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + midpoint(rest, b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(digits, a[0])
+	}
+	digitB := len(digits)
+	if b != "" {
+		digitB = strings.IndexByte(digits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(digits[(digitA+digitB+1)/2])
+	}
+
+	if len(b) > 1 {
+		return b[:1]
+	}
+
+	rest := ""
+	if a != "" {
+		rest = a[1:]
+	}
+	return string(digits[digitA]) + midpoint(rest, "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return zero
+}
+
+func integerLength(head byte) (int, bool) {
+	switch {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2, true
+	case head >= 'A' && head <= 'Z':
+		return int('Z'-head) + 2, true
+	default:
+		return 0, false
+	}
+}
+
+func integerPart(key string) string {
+	n, _ := integerLength(key[0])
+	return key[:n]
+}
+
+func validate(key string) error {
+	if key == smallest {
+		return ErrInvalidKey
+	}
+
+	n, ok := integerLength(key[0])
+	if !ok || n > len(key) {
+		return ErrInvalidKey
+	}
+
+	for i := 1; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return ErrInvalidKey
+		}
+	}
+
+	// дробная часть не может оканчиваться нулём, иначе перед ключом
+	// не найдётся места
+	if len(key) > n && key[len(key)-1] == zero {
+		return ErrInvalidKey
+	}
+
+	return nil
+}
+
+func increment(x string) (string, bool) {
+	head := x[0]
+	digs := []byte(x[1:])
+
+	carry := true
+	for i := len(digs) - 1; carry && i >= 0; i-- {
+		d := strings.IndexByte(digits, digs[i]) + 1
+		if d == len(digits) {
+			digs[i] = zero
+		} else {
+			digs[i] = digits[d]
+			carry = false
+		}
+	}
+
+	if !carry {
+		return string(head) + string(digs), true
+	}
+
+	switch head {
+	case 'Z':
+		return "a0", true
+	case 'z':
+		return "", false
+	}
+
+	h := head + 1
+	if h > 'a' {
+		digs = append(digs, zero)
+	} else {
+		digs = digs[:len(digs)-1]
+	}
+
+	return string(h) + string(digs), true
+}
+
+func decrement(x string) (string, bool) {
+	head := x[0]
+	digs := []byte(x[1:])
+
+	borrow := true
+	for i := len(digs) - 1; borrow && i >= 0; i-- {
+		d := strings.IndexByte(digits, digs[i]) - 1
+		if d == -1 {
+			digs[i] = digits[len(digits)-1]
+		} else {
+			digs[i] = digits[d]
+			borrow = false
+		}
+	}
+
+	if !borrow {
+		return string(head) + string(digs), true
+	}
+
+	switch head {
+	case 'a':
+		return "Z" + string(digits[len(digits)-1]), true
+	case 'A':
+		return "", false
+	}
+
+	h := head - 1
+	if h < 'Z' {
+		digs = append(digs, digits[len(digits)-1])
+	} else {
+		digs = digs[:len(digs)-1]
+	}
+
+	return string(h) + string(digs), true
+}
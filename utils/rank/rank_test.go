@@ -0,0 +1,98 @@
+package rank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected string
+	}{
+		{"empty list", "", "", "a0"},
+		{"append", "a0", "", "a1"},
+		{"prepend", "", "a0", "Zz"},
+		{"between neighbours", "a0", "a1", "a0V"},
+		{"between fractions", "a0V", "a1", "a0l"},
+		{"integer overflow", "az", "", "b00"},
+		{"integer underflow", "", "Zz", "Zy"},
+		{"adjacent fractions", "a0V", "a0W", "a0VV"},
+		{"prepend to fraction", "", "a0V", "a0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.a, tt.b)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestBetweenErrors(t *testing.T) {
+	_, err := Between("a1", "a0")
+	assert.ErrorIs(t, err, ErrInvalidRange)
+
+	_, err = Between("a0", "a0")
+	assert.ErrorIs(t, err, ErrInvalidRange)
+
+	_, err = Between("a00", "")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = Between("", "!1")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = Between("b0", "")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestBetweenKeepsOrder(t *testing.T) {
+	keys := []string{"a0"}
+
+	// многократная вставка в начало, в конец и в середину
+	for i := range 500 {
+		var (
+			key string
+			err error
+			pos int
+		)
+
+		switch i % 3 {
+		case 0:
+			key, err = Between("", keys[0])
+			pos = 0
+		case 1:
+			key, err = Between(keys[len(keys)-1], "")
+			pos = len(keys)
+		default:
+			pos = len(keys) / 2
+			key, err = Between(keys[pos-1], keys[pos])
+		}
+		require.NoError(t, err)
+
+		keys = append(keys[:pos], append([]string{key}, keys[pos:]...)...)
+	}
+
+	for i := 1; i < len(keys); i++ {
+		assert.Less(t, keys[i-1], keys[i])
+	}
+
+	// вставки по краям не должны раздувать ключи
+	assert.LessOrEqual(t, len(keys[0]), 3)
+	assert.LessOrEqual(t, len(keys[len(keys)-1]), 3)
+}
+
+func TestSpread(t *testing.T) {
+	keys, err := Spread(100)
+	require.NoError(t, err)
+	require.Len(t, keys, 100)
+
+	assert.Equal(t, "a0", keys[0])
+	for i := 1; i < len(keys); i++ {
+		assert.Less(t, keys[i-1], keys[i])
+	}
+}
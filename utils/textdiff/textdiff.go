@@ -0,0 +1,121 @@
+package textdiff
+
+import "unicode"
+
+const (
+	OpEqual  = "equal"
+	OpInsert = "insert"
+	OpDelete = "delete"
+)
+
+// при большем числе сравнений текст считается заменённым целиком
+const maxCells = 1 << 20
+
+type Change struct {
+	Op   string
+	Text string
+}
+
+// Words сравнивает тексты по словам. Пробелы входят в токены, поэтому
+// склейка equal и delete даёт старый текст, а equal и insert — новый.
+func Words(old, new string) []Change {
+	a, b := tokenize(old), tokenize(new)
+
+	// общие начало и конец не участвуют в поиске подпоследовательности
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var changes []Change
+	changes = appendChange(changes, OpEqual, a[:prefix]...)
+	changes = append(changes, middle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	changes = appendChange(changes, OpEqual, a[len(a)-suffix:]...)
+
+	return merge(changes)
+}
+
+func middle(a, b []string) []Change {
+	if len(a)*len(b) > maxCells {
+		return appendChange(appendChange(nil, OpDelete, a...), OpInsert, b...)
+	}
+
+	// lcs[i][j] — длина общей подпоследовательности a[i:] и b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var changes []Change
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			changes = appendChange(changes, OpEqual, a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			changes = appendChange(changes, OpDelete, a[i])
+			i++
+		default:
+			changes = appendChange(changes, OpInsert, b[j])
+			j++
+		}
+	}
+	changes = appendChange(changes, OpDelete, a[i:]...)
+	changes = appendChange(changes, OpInsert, b[j:]...)
+
+	return changes
+}
+
+func appendChange(changes []Change, op string, tokens ...string) []Change {
+	for _, token := range tokens {
+		changes = append(changes, Change{Op: op, Text: token})
+	}
+	return changes
+}
+
+// merge склеивает соседние изменения одного типа
+func merge(changes []Change) []Change {
+	var merged []Change
+	for _, c := range changes {
+		if n := len(merged); n > 0 && merged[n-1].Op == c.Op {
+			merged[n-1].Text += c.Text
+			continue
+		}
+		merged = append(merged, c)
+	}
+	return merged
+}
+
+// tokenize делит текст на слова и промежутки между ними
+func tokenize(s string) []string {
+	var tokens []string
+	start := 0
+	prevSpace := false
+	for i, r := range s {
+		space := unicode.IsSpace(r)
+		if i > 0 && space != prevSpace {
+			tokens = append(tokens, s[start:i])
+			start = i
+		}
+		prevSpace = space
+	}
+	if start < len(s) {
+		tokens = append(tokens, s[start:])
+	}
+	return tokens
+}
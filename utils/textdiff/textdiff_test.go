@@ -0,0 +1,76 @@
+package textdiff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func join(changes []Change, skip string) string {
+	var sb strings.Builder
+	for _, c := range changes {
+		if c.Op != skip {
+			sb.WriteString(c.Text)
+		}
+	}
+	return sb.String()
+}
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		expected []Change
+	}{
+		{
+			"Сценарий: замена слова",
+			"красивый закат над морем",
+			"красивый рассвет над морем",
+			[]Change{{OpEqual, "красивый "}, {OpDelete, "закат"}, {OpInsert, "рассвет"}, {OpEqual, " над морем"}},
+		},
+		{
+			"Сценарий: добавление в конец",
+			"закат",
+			"закат над морем",
+			[]Change{{OpEqual, "закат"}, {OpInsert, " над морем"}},
+		},
+		{
+			"Сценарий: без изменений",
+			"закат",
+			"закат",
+			[]Change{{OpEqual, "закат"}},
+		},
+		{
+			"Сценарий: пустой старый текст",
+			"",
+			"новый текст",
+			[]Change{{OpInsert, "новый текст"}},
+		},
+		{
+			"Сценарий: оба текста пустые",
+			"",
+			"",
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := Words(tt.old, tt.new)
+			assert.Equal(t, tt.expected, changes)
+			assert.Equal(t, tt.old, join(changes, OpInsert))
+			assert.Equal(t, tt.new, join(changes, OpDelete))
+		})
+	}
+}
+
+func TestWords_Restores(t *testing.T) {
+	old := "a b c d e f g\nh i j"
+	new := "a x c d  f g h\ni j k"
+
+	changes := Words(old, new)
+	assert.Equal(t, old, join(changes, OpInsert))
+	assert.Equal(t, new, join(changes, OpDelete))
+}
@@ -0,0 +1,44 @@
+package video
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var videoExtensions = map[string]bool{
+	".mp4":  true,
+	".webm": true,
+}
+
+func IsVideoFile(filename string) bool {
+	return videoExtensions[strings.ToLower(filepath.Ext(filename))]
+}
+
+// PosterOffset выбирает кадр для обложки: первый кадр часто чёрный,
+// поэтому берётся кадр на первой секунде, но не дальше середины ролика
+func PosterOffset(duration time.Duration) time.Duration {
+	return min(time.Second, duration/2)
+}
+
+// ExtractPoster сохраняет кадр ролика в jpeg с помощью ffmpeg
+func ExtractPoster(ctx context.Context, ffmpegPath, src, dst string, at time.Duration) error {
+	cmd := exec.CommandContext(ctx, ffmpegPath,
+		"-v", "error",
+		"-y",
+		"-ss", fmt.Sprintf("%.3f", at.Seconds()),
+		"-i", src,
+		"-frames:v", "1",
+		"-q:v", "3",
+		dst,
+	)
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("ffmpeg: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
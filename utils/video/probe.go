@@ -0,0 +1,109 @@
+package video
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported video container")
+	ErrNoVideoTrack      = errors.New("no video track")
+	ErrUnknownDuration   = errors.New("video duration is unknown")
+)
+
+type Info struct {
+	Width    int
+	Height   int
+	Duration time.Duration
+}
+
+// ProbePath возвращает путь к ffprobe, он ставится вместе с ffmpeg
+func ProbePath(ffmpegPath string) string {
+	return filepath.Join(filepath.Dir(ffmpegPath), "ffprobe")
+}
+
+// Probe читает размеры и длительность ролика с помощью ffprobe
+func Probe(ctx context.Context, ffprobePath, src string) (Info, error) {
+	cmd := exec.CommandContext(ctx, ffprobePath,
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=width,height:stream_tags=rotate:stream_side_data=rotation:format=format_name,duration",
+		"-of", "json",
+		src,
+	)
+
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return Info{}, fmt.Errorf("ffprobe: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return parseProbeOutput(output)
+}
+
+type probeOutput struct {
+	Streams []struct {
+		Width        int `json:"width"`
+		Height       int `json:"height"`
+		SideDataList []struct {
+			Rotation float64 `json:"rotation"`
+		} `json:"side_data_list"`
+		Tags struct {
+			Rotate string `json:"rotate"`
+		} `json:"tags"`
+	} `json:"streams"`
+	Format struct {
+		FormatName string `json:"format_name"`
+		Duration   string `json:"duration"`
+	} `json:"format"`
+}
+
+func parseProbeOutput(data []byte) (Info, error) {
+	var out probeOutput
+	if err := json.Unmarshal(data, &out); err != nil {
+		return Info{}, fmt.Errorf("ffprobe: %w", err)
+	}
+
+	// принимаются только те контейнеры, что разрешены по расширению
+	formats := strings.Split(out.Format.FormatName, ",")
+	if !slices.Contains(formats, "mp4") && !slices.Contains(formats, "webm") {
+		return Info{}, ErrUnsupportedFormat
+	}
+
+	if len(out.Streams) == 0 || out.Streams[0].Width <= 0 || out.Streams[0].Height <= 0 {
+		return Info{}, ErrNoVideoTrack
+	}
+	stream := out.Streams[0]
+
+	// старые версии ffprobe отдают поворот в тегах, новые — в side data
+	rotation, _ := strconv.ParseFloat(stream.Tags.Rotate, 64)
+	for _, sd := range stream.SideDataList {
+		if sd.Rotation != 0 {
+			rotation = sd.Rotation
+		}
+	}
+
+	info := Info{Width: stream.Width, Height: stream.Height}
+	// поворот на 90 или 270 градусов меняет стороны местами
+	if math.Mod(math.Abs(rotation), 180) == 90 {
+		info.Width, info.Height = info.Height, info.Width
+	}
+
+	seconds, err := strconv.ParseFloat(out.Format.Duration, 64)
+	if err != nil || seconds <= 0 || math.IsNaN(seconds) || seconds > math.MaxInt64/float64(time.Second) {
+		return Info{}, ErrUnknownDuration
+	}
+	info.Duration = time.Duration(seconds * float64(time.Second))
+
+	return info, nil
+}
@@ -0,0 +1,72 @@
+package video
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProbeOutput_MP4(t *testing.T) {
+	data := `{"streams":[{"width":1280,"height":720}],"format":{"format_name":"mov,mp4,m4a,3gp,3g2,mj2","duration":"12.500000"}}`
+
+	info, err := parseProbeOutput([]byte(data))
+	assert.NoError(t, err)
+	assert.Equal(t, Info{Width: 1280, Height: 720, Duration: 12500 * time.Millisecond}, info)
+}
+
+func TestParseProbeOutput_WebM(t *testing.T) {
+	data := `{"streams":[{"width":640,"height":360}],"format":{"format_name":"matroska,webm","duration":"3.000000"}}`
+
+	info, err := parseProbeOutput([]byte(data))
+	assert.NoError(t, err)
+	assert.Equal(t, Info{Width: 640, Height: 360, Duration: 3 * time.Second}, info)
+}
+
+func TestParseProbeOutput_Rotated(t *testing.T) {
+	sideData := `{"streams":[{"width":1920,"height":1080,"side_data_list":[{"rotation":-90}]}],"format":{"format_name":"mov,mp4","duration":"1.0"}}`
+	info, err := parseProbeOutput([]byte(sideData))
+	assert.NoError(t, err)
+	assert.Equal(t, 1080, info.Width)
+	assert.Equal(t, 1920, info.Height)
+
+	tags := `{"streams":[{"width":1920,"height":1080,"tags":{"rotate":"270"}}],"format":{"format_name":"mov,mp4","duration":"1.0"}}`
+	info, err = parseProbeOutput([]byte(tags))
+	assert.NoError(t, err)
+	assert.Equal(t, 1080, info.Width)
+	assert.Equal(t, 1920, info.Height)
+
+	upsideDown := `{"streams":[{"width":1920,"height":1080,"tags":{"rotate":"180"}}],"format":{"format_name":"mov,mp4","duration":"1.0"}}`
+	info, err = parseProbeOutput([]byte(upsideDown))
+	assert.NoError(t, err)
+	assert.Equal(t, 1920, info.Width)
+	assert.Equal(t, 1080, info.Height)
+}
+
+func TestParseProbeOutput_UnsupportedFormat(t *testing.T) {
+	data := `{"streams":[{"width":640,"height":360}],"format":{"format_name":"avi","duration":"3.0"}}`
+
+	_, err := parseProbeOutput([]byte(data))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestParseProbeOutput_NoVideoTrack(t *testing.T) {
+	data := `{"streams":[],"format":{"format_name":"mov,mp4","duration":"3.0"}}`
+
+	_, err := parseProbeOutput([]byte(data))
+	assert.ErrorIs(t, err, ErrNoVideoTrack)
+}
+
+func TestParseProbeOutput_UnknownDuration(t *testing.T) {
+	for _, duration := range []string{"", "N/A", "0.000000", "-1"} {
+		data := `{"streams":[{"width":640,"height":360}],"format":{"format_name":"matroska,webm","duration":"` + duration + `"}}`
+
+		_, err := parseProbeOutput([]byte(data))
+		assert.ErrorIs(t, err, ErrUnknownDuration, duration)
+	}
+}
+
+func TestParseProbeOutput_InvalidJSON(t *testing.T) {
+	_, err := parseProbeOutput([]byte("not json"))
+	assert.Error(t, err)
+}